@@ -31,6 +31,7 @@ func RootCommand(version string) *ffcli.Command {
 
 	root.FlagSet.BoolVar(&versionRequested, "version", false, "Print version and exit")
 	shared.BindRootFlags(root.FlagSet)
+	bindOutputFilterFlags(root.Subcommands)
 
 	rootSubcommandNames := make([]string, 0, len(root.Subcommands))
 	for _, sub := range root.Subcommands {
@@ -57,3 +58,14 @@ func RootCommand(version string) *ffcli.Command {
 
 	return root
 }
+
+// bindOutputFilterFlags lets --fields, --select, --query, and --template
+// follow any command that prints with --output, not only the root command.
+func bindOutputFilterFlags(commands []*ffcli.Command) {
+	for _, command := range commands {
+		if command.FlagSet != nil && command.FlagSet.Lookup("output") != nil {
+			shared.BindOutputFilterFlags(command.FlagSet)
+		}
+		bindOutputFilterFlags(command.Subcommands)
+	}
+}
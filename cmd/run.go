@@ -198,6 +198,9 @@ func writeJUnitReport(commandName string, runErr error, elapsed time.Duration) e
 	if reportFile == "" {
 		return nil
 	}
+	if report := shared.CommandReport(); report != nil {
+		return report.Write(reportFile)
+	}
 
 	testCase := shared.JUnitTestCase{
 		Name:      commandName,
@@ -147,6 +147,35 @@ func TestWriteJUnitReport(t *testing.T) {
 	}
 }
 
+func TestWriteJUnitReportUsesCommandReport(t *testing.T) {
+	resetReportFlags(t)
+
+	reportPath := filepath.Join(t.TempDir(), "junit.xml")
+	shared.SetReportFile(reportPath)
+	shared.SetCommandReport(&shared.JUnitReport{
+		Name: "asc performance compare",
+		Tests: []shared.JUnitTestCase{
+			{Name: "launchTime", Classname: "performance.launch"},
+			{Name: "hangRate", Classname: "performance.hang", Failure: "regressed", Message: "+25%"},
+		},
+	})
+
+	if err := writeJUnitReport("asc performance compare", errors.New("1 metric(s) regressed"), time.Second); err != nil {
+		t.Fatalf("writeJUnitReport() error: %v", err)
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	report := string(data)
+	for _, want := range []string{`tests="2"`, `failures="1"`, `name="launchTime"`, `name="hangRate"`} {
+		if !strings.Contains(report, want) {
+			t.Fatalf("expected report to contain %q, got:\n%s", want, report)
+		}
+	}
+}
+
 func TestCmdSharedWrappersAndReportedError(t *testing.T) {
 	CleanupTempPrivateKey()
 	CleanupTempPrivateKeys()
@@ -175,6 +204,10 @@ func resetReportFlags(t *testing.T) {
 	t.Helper()
 	shared.SetReportFormat("")
 	shared.SetReportFile("")
+	shared.SetCommandReport(nil)
+	t.Cleanup(func() {
+		shared.SetCommandReport(nil)
+	})
 }
 
 func captureCommandOutput(t *testing.T, fn func()) (string, string) {
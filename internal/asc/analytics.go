@@ -18,6 +18,7 @@ const (
 	SalesReportTypeNewsstand         SalesReportType = "NEWSSTAND"
 	SalesReportTypeSubscription      SalesReportType = "SUBSCRIPTION"
 	SalesReportTypeSubscriptionEvent SalesReportType = "SUBSCRIPTION_EVENT"
+	SalesReportTypeSubscriber        SalesReportType = "SUBSCRIBER"
 )
 
 // SalesReportSubType represents the report detail level.
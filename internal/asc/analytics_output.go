@@ -1,6 +1,9 @@
 package asc
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+)
 
 // SalesReportResult represents CLI output for sales report downloads.
 type SalesReportResult struct {
@@ -17,6 +20,21 @@ type SalesReportResult struct {
 	DecompressedSize int64  `json:"decompressedSize,omitempty"`
 }
 
+// SalesReportRows represents CLI output for a parsed SALES report.
+type SalesReportRows struct {
+	Data []SalesReportRow `json:"data"`
+}
+
+// SubscriptionReportRows represents CLI output for a parsed SUBSCRIPTION report.
+type SubscriptionReportRows struct {
+	Data []SubscriptionReportRow `json:"data"`
+}
+
+// SubscriberReportRows represents CLI output for a parsed SUBSCRIBER report.
+type SubscriberReportRows struct {
+	Data []SubscriberReportRow `json:"data"`
+}
+
 // AnalyticsReportRequestResult represents CLI output for created requests.
 type AnalyticsReportRequestResult struct {
 	RequestID   string `json:"requestId"`
@@ -97,6 +115,105 @@ func salesReportResultRows(result *SalesReportResult) ([]string, [][]string) {
 	return headers, rows
 }
 
+func salesReportRowsRows(result *SalesReportRows) ([]string, [][]string) {
+	headers := []string{"Begin Date", "SKU", "Title", "Product Type", "Country", "Units", "Developer Proceeds", "Currency"}
+	rows := make([][]string, 0, len(result.Data))
+	for _, row := range result.Data {
+		rows = append(rows, []string{
+			NormalizeReportDate(row.BeginDate),
+			row.SKU,
+			compactWhitespace(row.Title),
+			row.ProductTypeIdentifier,
+			row.CountryCode,
+			formatReportNumber(row.Units),
+			formatReportAmount(row.DeveloperProceeds),
+			row.CurrencyOfProceeds,
+		})
+	}
+	return headers, rows
+}
+
+func subscriptionReportRowsRows(result *SubscriptionReportRows) ([]string, [][]string) {
+	headers := []string{"App", "Subscription", "Offer", "Country", "Proceeds", "Currency", "Active Standard", "Subscribers"}
+	rows := make([][]string, 0, len(result.Data))
+	for _, row := range result.Data {
+		rows = append(rows, []string{
+			compactWhitespace(row.AppName),
+			compactWhitespace(row.SubscriptionName),
+			compactWhitespace(row.SubscriptionOfferName),
+			row.Country,
+			formatReportAmount(row.DeveloperProceeds),
+			row.ProceedsCurrency,
+			formatReportNumber(row.ActiveStandardPriceSubscriptions),
+			formatReportNumber(row.Subscribers),
+		})
+	}
+	return headers, rows
+}
+
+func subscriberReportRowsRows(result *SubscriberReportRows) ([]string, [][]string) {
+	headers := []string{"Event Date", "Subscription", "Subscriber ID", "Country", "Offer Type", "Proceeds", "Currency", "Units", "Refund"}
+	rows := make([][]string, 0, len(result.Data))
+	for _, row := range result.Data {
+		rows = append(rows, []string{
+			NormalizeReportDate(row.EventDate),
+			compactWhitespace(row.SubscriptionName),
+			row.SubscriberID,
+			row.Country,
+			row.SubscriptionOfferType,
+			formatReportAmount(row.DeveloperProceeds),
+			row.ProceedsCurrency,
+			formatReportNumber(row.Units),
+			row.Refund,
+		})
+	}
+	return headers, rows
+}
+
+func salesReportSummaryRows(result *SalesReportSummary) ([]string, [][]string) {
+	var headers []string
+	for _, dimension := range result.GroupBy {
+		switch dimension {
+		case SalesSummaryByDate:
+			headers = append(headers, "Date")
+		case SalesSummaryBySKU:
+			headers = append(headers, "SKU", "Title")
+		case SalesSummaryByTerritory:
+			headers = append(headers, "Territory")
+		case SalesSummaryByProductType:
+			headers = append(headers, "Product Type")
+		}
+	}
+	headers = append(headers, "Units", "Proceeds", "Currency")
+
+	rows := make([][]string, 0, len(result.Data))
+	for _, entry := range result.Data {
+		var row []string
+		for _, dimension := range result.GroupBy {
+			switch dimension {
+			case SalesSummaryByDate:
+				row = append(row, entry.Date)
+			case SalesSummaryBySKU:
+				row = append(row, entry.SKU, compactWhitespace(entry.Title))
+			case SalesSummaryByTerritory:
+				row = append(row, entry.Territory)
+			case SalesSummaryByProductType:
+				row = append(row, entry.ProductType)
+			}
+		}
+		rows = append(rows, append(row, formatReportNumber(entry.Units), formatReportAmount(entry.Proceeds), entry.Currency))
+	}
+	return headers, rows
+}
+
+func formatReportNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func formatReportAmount(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
+
 func analyticsReportRequestResultRows(result *AnalyticsReportRequestResult) ([]string, [][]string) {
 	headers := []string{"Request ID", "App ID", "Access Type", "State", "Created Date"}
 	rows := [][]string{{result.RequestID, result.AppID, result.AccessType, result.State, result.CreatedDate}}
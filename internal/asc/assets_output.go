@@ -56,6 +56,25 @@ type AssetDeleteResult struct {
 	Deleted bool   `json:"deleted"`
 }
 
+// AssetSyncItem represents one change made or planned by assets sync.
+type AssetSyncItem struct {
+	Locale      string `json:"locale"`
+	AssetType   string `json:"assetType"`
+	DisplayType string `json:"displayType"`
+	Action      string `json:"action"`
+	FileName    string `json:"fileName,omitempty"`
+	AssetID     string `json:"assetId,omitempty"`
+}
+
+// AssetSyncResult represents assets sync output.
+type AssetSyncResult struct {
+	VersionID string          `json:"versionId"`
+	Dir       string          `json:"dir"`
+	DryRun    bool            `json:"dryRun"`
+	Prune     bool            `json:"prune"`
+	Items     []AssetSyncItem `json:"items"`
+}
+
 func appScreenshotSetsRows(resp *AppScreenshotSetsResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Display Type"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -203,3 +222,12 @@ func assetDeleteResultRows(result *AssetDeleteResult) ([]string, [][]string) {
 	rows := [][]string{{result.ID, fmt.Sprintf("%t", result.Deleted)}}
 	return headers, rows
 }
+
+func assetSyncResultRows(result *AssetSyncResult) ([]string, [][]string) {
+	headers := []string{"Locale", "Asset Type", "Display Type", "Action", "File Name", "Asset ID"}
+	rows := make([][]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		rows = append(rows, []string{item.Locale, item.AssetType, item.DisplayType, item.Action, item.FileName, item.AssetID})
+	}
+	return headers, rows
+}
@@ -8,6 +8,13 @@ type BetaCrashLogAttributes struct {
 // BetaCrashLogResponse is the response from beta crash log endpoints.
 type BetaCrashLogResponse = SingleResponse[BetaCrashLogAttributes]
 
+// BetaFeedbackSubmissionRelationships describes the relationships of crash
+// and screenshot feedback submissions.
+type BetaFeedbackSubmissionRelationships struct {
+	Build  *Relationship `json:"build,omitempty"`
+	Tester *Relationship `json:"tester,omitempty"`
+}
+
 // BetaFeedbackCrashSubmissionResponse is the response from crash submission detail endpoint.
 type BetaFeedbackCrashSubmissionResponse = SingleResponse[CrashAttributes]
 
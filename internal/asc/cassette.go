@@ -0,0 +1,462 @@
+package asc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+// CassetteMode selects whether a cassette records live traffic or replays it.
+type CassetteMode string
+
+const (
+	// CassetteModeRecord forwards requests and saves every interaction.
+	CassetteModeRecord CassetteMode = "record"
+	// CassetteModeReplay answers requests from a saved cassette without network access.
+	CassetteModeReplay CassetteMode = "replay"
+
+	cassetteVersion = 1
+	// maxCassetteRequestBody caps how much of a request body is stored.
+	// Larger bodies (upload chunks) are recorded by size only.
+	maxCassetteRequestBody = 64 * 1024
+)
+
+// ErrCassetteMiss is returned in replay mode when no recorded interaction
+// matches a request.
+var ErrCassetteMiss = errors.New("cassette: no recorded interaction")
+
+// CassetteInteraction is one recorded request/response pair.
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is a recorded request. The Authorization header, signed
+// or sensitive query parameters, and sensitive JSON attributes in the body
+// are redacted before saving.
+type CassetteRequest struct {
+	Method   string            `json:"method"`
+	URL      string            `json:"url"`
+	Headers  map[string]string `json:"headers,omitempty"`
+	Body     string            `json:"body,omitempty"`
+	BodySize int64             `json:"bodySize,omitempty"`
+}
+
+// CassetteResponse is a recorded response. Binary bodies are base64 encoded.
+// Presigned or credential-bearing URLs in headers and text bodies, and
+// sensitive JSON attributes in the body, are redacted before saving.
+type CassetteResponse struct {
+	Status       int               `json:"status"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	Body         string            `json:"body,omitempty"`
+	BodyEncoding string            `json:"bodyEncoding,omitempty"`
+}
+
+type cassetteFile struct {
+	Version      int                   `json:"version"`
+	Interactions []CassetteInteraction `json:"interactions"`
+}
+
+// Cassette records HTTP interactions to a file or replays them.
+// Replay matches requests by method, path, and (redacted) query in recorded
+// order, so repeated requests such as polling return successive responses.
+type Cassette struct {
+	mu           sync.Mutex
+	path         string
+	mode         CassetteMode
+	interactions []CassetteInteraction
+	used         []bool
+}
+
+// OpenCassette opens a cassette file. Record mode starts an empty cassette
+// (overwriting the file on the first interaction); replay mode loads it.
+func OpenCassette(path string, mode CassetteMode) (*Cassette, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, fmt.Errorf("cassette: path is required")
+	}
+	cassette := &Cassette{path: path, mode: mode}
+	switch mode {
+	case CassetteModeRecord:
+		return cassette, nil
+	case CassetteModeReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("cassette: %w", err)
+		}
+		var file cassetteFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("cassette: parse %s: %w", path, err)
+		}
+		if file.Version != cassetteVersion {
+			return nil, fmt.Errorf("cassette: unsupported version %d in %s", file.Version, path)
+		}
+		cassette.interactions = file.Interactions
+		cassette.used = make([]bool, len(file.Interactions))
+		return cassette, nil
+	default:
+		return nil, fmt.Errorf("cassette: unsupported mode %q (expected record or replay)", mode)
+	}
+}
+
+// Mode returns the cassette mode.
+func (c *Cassette) Mode() CassetteMode {
+	return c.mode
+}
+
+// Path returns the cassette file path.
+func (c *Cassette) Path() string {
+	return c.path
+}
+
+// Interactions returns a copy of the recorded interactions.
+func (c *Cassette) Interactions() []CassetteInteraction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]CassetteInteraction(nil), c.interactions...)
+}
+
+// Transport wraps base so requests are recorded to or replayed from the cassette.
+// A nil base uses http.DefaultTransport.
+func (c *Cassette) Transport(base http.RoundTripper) http.RoundTripper {
+	if existing, ok := base.(*cassetteTransport); ok && existing.cassette == c {
+		return existing
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &cassetteTransport{cassette: c, base: base}
+}
+
+// WrapClient returns a copy of client whose transport uses the cassette.
+func (c *Cassette) WrapClient(client *http.Client) *http.Client {
+	if client == nil {
+		client = &http.Client{}
+	}
+	wrapped := *client
+	wrapped.Transport = c.Transport(client.Transport)
+	return &wrapped
+}
+
+type cassetteTransport struct {
+	cassette *Cassette
+	base     http.RoundTripper
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.cassette.mode == CassetteModeReplay {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return t.cassette.replay(req)
+	}
+	return t.cassette.record(req, t.base)
+}
+
+func (c *Cassette) record(req *http.Request, base http.RoundTripper) (*http.Response, error) {
+	recorded := CassetteRequest{
+		Method:  req.Method,
+		URL:     sanitizeURLForLog(req.URL.String()),
+		Headers: cassetteRequestHeaders(req.Header),
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("cassette: read request body: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		recorded.BodySize = int64(len(body))
+		if len(body) <= maxCassetteRequestBody && utf8.Valid(body) {
+			recorded.Body = redactSensitiveJSON(string(body))
+		}
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("cassette: read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	response := CassetteResponse{
+		Status:  resp.StatusCode,
+		Headers: cassetteResponseHeaders(resp.Header),
+	}
+	if utf8.Valid(body) {
+		response.Body = redactURLsInText(redactSensitiveJSON(string(body)))
+	} else {
+		response.Body = base64.StdEncoding.EncodeToString(body)
+		response.BodyEncoding = "base64"
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interactions = append(c.interactions, CassetteInteraction{Request: recorded, Response: response})
+	if err := c.saveLocked(); err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request) (*http.Response, error) {
+	key := cassetteMatchKey(req.Method, req.URL.String())
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, interaction := range c.interactions {
+		if c.used[i] || cassetteMatchKey(interaction.Request.Method, interaction.Request.URL) != key {
+			continue
+		}
+		c.used[i] = true
+		return interaction.Response.toHTTP(req)
+	}
+	return nil, fmt.Errorf("%w for %s %s", ErrCassetteMiss, req.Method, sanitizeURLForLog(req.URL.String()))
+}
+
+func (r CassetteResponse) toHTTP(req *http.Request) (*http.Response, error) {
+	body := []byte(r.Body)
+	if r.BodyEncoding == "base64" {
+		decoded, err := base64.StdEncoding.DecodeString(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("cassette: decode response body: %w", err)
+		}
+		body = decoded
+	}
+	header := make(http.Header, len(r.Headers))
+	for key, value := range r.Headers {
+		header.Set(key, value)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.Status, http.StatusText(r.Status)),
+		StatusCode:    r.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// saveLocked rewrites the cassette file. Writing after every interaction
+// keeps the cassette usable even if the process exits mid-run.
+func (c *Cassette) saveLocked() error {
+	data, err := json.MarshalIndent(cassetteFile{Version: cassetteVersion, Interactions: c.interactions}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cassette: encode: %w", err)
+	}
+	if err := fileutil.WriteFileAtomic(c.path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	return nil
+}
+
+// cassetteMatchKey identifies a request by method, path, and redacted query.
+// Scheme and host are ignored so a cassette recorded against one base URL
+// replays against another.
+func cassetteMatchKey(method, rawURL string) string {
+	sanitized := sanitizeURLForLog(rawURL)
+	parsed, err := url.Parse(sanitized)
+	if err != nil {
+		return strings.ToUpper(method) + " " + sanitized
+	}
+	key := strings.ToUpper(method) + " " + parsed.EscapedPath()
+	if query := parsed.Query(); len(query) > 0 {
+		key += "?" + query.Encode()
+	}
+	return key
+}
+
+func cassetteRequestHeaders(header http.Header) map[string]string {
+	values := flattenCassetteHeaders(header)
+	if auth, ok := values["Authorization"]; ok {
+		values["Authorization"] = sanitizeAuthHeader(auth)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+func cassetteResponseHeaders(header http.Header) map[string]string {
+	values := flattenCassetteHeaders(header)
+	delete(values, "Set-Cookie")
+	delete(values, "Date")
+	for key, value := range values {
+		values[key] = redactURLsInText(value)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+func flattenCassetteHeaders(header http.Header) map[string]string {
+	values := make(map[string]string, len(header))
+	for key, vals := range header {
+		values[http.CanonicalHeaderKey(key)] = strings.Join(vals, ", ")
+	}
+	return values
+}
+
+// embeddedURLPattern matches absolute URLs in response text, including the
+// \u0026 and \/ escapes JSON encoders may use.
+var embeddedURLPattern = regexp.MustCompile(`https?://(?:[^\s"'<>\\]|\\u0026|\\/)+`)
+
+// redactURLsInText applies sanitizeURLForLog to every URL in text that carries
+// credentials, such as presigned upload and report download URLs. Other URLs,
+// including pagination links, are left untouched.
+func redactURLsInText(text string) string {
+	if !strings.Contains(text, "://") {
+		return text
+	}
+	return embeddedURLPattern.ReplaceAllStringFunc(text, func(match string) string {
+		rawURL := strings.NewReplacer(`\u0026`, "&", `\/`, "/").Replace(match)
+		parsed, err := url.Parse(rawURL)
+		if err != nil || !urlHasCredentials(parsed) {
+			return match
+		}
+		return sanitizeURLForLog(rawURL)
+	})
+}
+
+func urlHasCredentials(parsed *url.URL) bool {
+	if parsed.User != nil {
+		return true
+	}
+	values := parsed.Query()
+	if hasSignedQuery(values) {
+		return true
+	}
+	for key := range values {
+		if isSensitiveQueryKey(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// cassetteSensitiveAttributes are JSON attributes whose values are redacted
+// in recorded bodies: App Review demo account credentials and contact details.
+var cassetteSensitiveAttributes = map[string]bool{
+	"contactEmail":        true,
+	"contactPhone":        true,
+	"demoAccountName":     true,
+	"demoAccountPassword": true,
+	"password":            true,
+}
+
+// redactSensitiveJSON replaces the values of cassetteSensitiveAttributes in a
+// JSON body. Bodies that are not JSON or hold none of them are unchanged.
+func redactSensitiveJSON(body string) string {
+	found := false
+	for key := range cassetteSensitiveAttributes {
+		if strings.Contains(body, `"`+key+`"`) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return body
+	}
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return body
+	}
+	if !redactJSONValue(value) {
+		return body
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	return string(data)
+}
+
+func redactJSONValue(value any) bool {
+	redacted := false
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, child := range typed {
+			if cassetteSensitiveAttributes[key] && child != nil {
+				typed[key] = "[REDACTED]"
+				redacted = true
+				continue
+			}
+			if redactJSONValue(child) {
+				redacted = true
+			}
+		}
+	case []any:
+		for _, child := range typed {
+			if redactJSONValue(child) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+var cassetteRegistry struct {
+	mu       sync.Mutex
+	key      string
+	cassette *Cassette
+}
+
+// ResolveCassette returns the process-wide cassette configured by
+// ASC_CASSETTE (file path) and ASC_CASSETTE_MODE (record or replay; default
+// replay). It returns nil when ASC_CASSETTE is unset. Clients and uploads in
+// the same process share one cassette so a whole command run lands in one file.
+func ResolveCassette() (*Cassette, error) {
+	path, _ := envValue("ASC_CASSETTE")
+	if path == "" {
+		return nil, nil
+	}
+	mode := CassetteModeReplay
+	if value, ok := envValue("ASC_CASSETTE_MODE"); ok && value != "" {
+		mode = CassetteMode(strings.ToLower(value))
+	}
+
+	key := string(mode) + "\x00" + path
+	cassetteRegistry.mu.Lock()
+	defer cassetteRegistry.mu.Unlock()
+	if cassetteRegistry.cassette != nil && cassetteRegistry.key == key {
+		return cassetteRegistry.cassette, nil
+	}
+	cassette, err := OpenCassette(path, mode)
+	if err != nil {
+		return nil, err
+	}
+	cassetteRegistry.key = key
+	cassetteRegistry.cassette = cassette
+	return cassette, nil
+}
+
+// WithCassette records or replays the client's HTTP traffic with cassette,
+// taking precedence over ASC_CASSETTE.
+func WithCassette(cassette *Cassette) ClientOption {
+	return func(c *Client) {
+		c.cassette = cassette
+	}
+}
@@ -0,0 +1,272 @@
+package asc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCassetteRecordsAndReplaysClientRequests(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	t.Setenv("ASC_BASE_URL", "")
+	t.Setenv("ASC_CASSETTE", "")
+	keyPath := writeTestPrivateKey(t)
+	cassettePath := filepath.Join(t.TempDir(), "cassettes", "apps.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/apps":
+			_, _ = io.WriteString(w, `{"data":[{"type":"apps","id":"1","attributes":{"name":"Demo"}}],"links":{}}`)
+		case "/v1/apps/missing":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"errors":[{"code":"NOT_FOUND","title":"Not found","detail":"missing"}]}`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	recorder, err := OpenCassette(cassettePath, CassetteModeRecord)
+	if err != nil {
+		t.Fatalf("OpenCassette(record) error: %v", err)
+	}
+	client, err := NewClient("KEY123", "ISSUER456", keyPath, WithBaseURL(server.URL), WithCassette(recorder))
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+	if _, err := client.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if _, err := client.GetApp(context.Background(), "missing"); !IsNotFound(err) {
+		t.Fatalf("expected not found error while recording, got %v", err)
+	}
+	server.Close()
+
+	data, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("read cassette: %v", err)
+	}
+	if strings.Contains(string(data), "eyJ") {
+		t.Fatalf("expected JWT to be redacted from cassette, got %s", data)
+	}
+	interactions := recorder.Interactions()
+	if len(interactions) != 2 {
+		t.Fatalf("expected 2 interactions, got %d", len(interactions))
+	}
+	if got := interactions[0].Request.Headers["Authorization"]; got != "Bearer [REDACTED]" {
+		t.Fatalf("expected redacted Authorization header, got %q", got)
+	}
+
+	replayer, err := OpenCassette(cassettePath, CassetteModeReplay)
+	if err != nil {
+		t.Fatalf("OpenCassette(replay) error: %v", err)
+	}
+	replayClient, err := NewClient("KEY123", "ISSUER456", keyPath, WithCassette(replayer))
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+	apps, err := replayClient.GetApps(context.Background())
+	if err != nil {
+		t.Fatalf("replayed GetApps() error: %v", err)
+	}
+	if len(apps.Data) != 1 || apps.Data[0].Attributes.Name != "Demo" {
+		t.Fatalf("unexpected replayed apps: %+v", apps.Data)
+	}
+	if _, err := replayClient.GetApp(context.Background(), "missing"); !IsNotFound(err) {
+		t.Fatalf("expected replayed not found error, got %v", err)
+	}
+	if _, err := replayClient.GetApps(context.Background()); !errors.Is(err, ErrCassetteMiss) {
+		t.Fatalf("expected cassette miss after interactions are used, got %v", err)
+	}
+}
+
+func TestCassetteRecordsUploadsWithRedactedURLs(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	cassettePath := filepath.Join(t.TempDir(), "upload.json")
+	t.Setenv("ASC_CASSETTE", cassettePath)
+	t.Setenv("ASC_CASSETTE_MODE", "record")
+
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received += len(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	filePath := filepath.Join(t.TempDir(), "app.ipa")
+	if err := os.WriteFile(filePath, []byte("payload"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	ops := []UploadOperation{{
+		Method: http.MethodPut,
+		URL:    server.URL + "/upload?X-Amz-Signature=secret&partNumber=1",
+		Offset: 0,
+		Length: 7,
+	}}
+	if err := ExecuteUploadOperations(context.Background(), filePath, ops); err != nil {
+		t.Fatalf("ExecuteUploadOperations() error: %v", err)
+	}
+	if received != 7 {
+		t.Fatalf("expected server to receive 7 bytes, got %d", received)
+	}
+
+	data, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("read cassette: %v", err)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Fatalf("expected signed query to be redacted, got %s", data)
+	}
+
+	t.Setenv("ASC_CASSETTE_MODE", "replay")
+	server.Close()
+	if err := ExecuteUploadOperations(context.Background(), filePath, ops); err != nil {
+		t.Fatalf("replayed ExecuteUploadOperations() error: %v", err)
+	}
+	if err := ExecuteUploadOperations(context.Background(), filePath, ops); !errors.Is(err, ErrCassetteMiss) {
+		t.Fatalf("expected cassette miss on second replay, got %v", err)
+	}
+}
+
+func TestCassetteRedactsSignedURLsInResponses(t *testing.T) {
+	const signed = "https://upload.example.com/part?X-Amz-Signature=secret-sig&X-Amz-Credential=secret-cred&partNumber=1"
+	const next = "https://api.example.com/v1/apps?cursor=AQ&limit=200"
+
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":{"attributes":{"uploadOperations":[{"method":"PUT","url":"` + signed + `"}],` +
+			`"escapedUrl":"https://cdn.example.com/report.gz?Signature=secret-escaped\u0026Key-Pair-Id=secret-kp"}},` +
+			`"links":{"next":"` + next + `"}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Content-Type": []string{"application/json"},
+				"Location":     []string{"https://cdn.example.com/download?token=secret-token"},
+			},
+			Body:    io.NopCloser(strings.NewReader(body)),
+			Request: req,
+		}, nil
+	})
+
+	cassettePath := filepath.Join(t.TempDir(), "signed.json")
+	recorder, err := OpenCassette(cassettePath, CassetteModeRecord)
+	if err != nil {
+		t.Fatalf("OpenCassette(record) error: %v", err)
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://api.example.com/v1/apps", nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error: %v", err)
+	}
+	resp, err := recorder.Transport(base).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error: %v", err)
+	}
+	live, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(live), "secret-sig") {
+		t.Fatalf("expected the live response to be unchanged, got %s", live)
+	}
+
+	data, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("read cassette: %v", err)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Fatalf("expected signed URLs to be redacted from cassette, got %s", data)
+	}
+	interaction := recorder.Interactions()[0]
+	if !strings.Contains(interaction.Response.Body, "https://upload.example.com/part?") {
+		t.Fatalf("expected the redacted upload URL to be kept, got %s", interaction.Response.Body)
+	}
+	if !strings.Contains(interaction.Response.Body, next) {
+		t.Fatalf("expected unsigned pagination links to be unchanged, got %s", interaction.Response.Body)
+	}
+	if got := interaction.Response.Headers["Location"]; !strings.HasPrefix(got, "https://cdn.example.com/download?token=") || strings.Contains(got, "secret") {
+		t.Fatalf("expected redacted Location header, got %q", got)
+	}
+}
+
+func TestCassetteRedactsReviewDetailSecrets(t *testing.T) {
+	const attributes = `"attributes":{"contactEmail":"reviewer-contact@example.com","contactPhone":"+1 555 0100",` +
+		`"demoAccountName":"demo-user","demoAccountPassword":"hunter2-secret","demoAccountRequired":true,"notes":"Tap Sign In"}`
+
+	var sent string
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		sent = string(body)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"appStoreReviewDetails","id":"DETAIL_1",` + attributes + `}}`)),
+			Request:    req,
+		}, nil
+	})
+
+	cassettePath := filepath.Join(t.TempDir(), "review-detail.json")
+	recorder, err := OpenCassette(cassettePath, CassetteModeRecord)
+	if err != nil {
+		t.Fatalf("OpenCassette(record) error: %v", err)
+	}
+	requestBody := `{"data":{"type":"appStoreReviewDetails","id":"DETAIL_1",` + attributes + `}}`
+	req, err := http.NewRequest(http.MethodPatch, "https://api.example.com/v1/appStoreReviewDetails/DETAIL_1", strings.NewReader(requestBody))
+	if err != nil {
+		t.Fatalf("NewRequest() error: %v", err)
+	}
+	if _, err := recorder.Transport(base).RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip() error: %v", err)
+	}
+	if sent != requestBody {
+		t.Fatalf("expected the live request body to be unchanged, got %s", sent)
+	}
+
+	data, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("read cassette: %v", err)
+	}
+	for _, secret := range []string{"hunter2-secret", "demo-user", "reviewer-contact@example.com", "555 0100"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("expected %q to be redacted from cassette, got %s", secret, data)
+		}
+	}
+	interaction := recorder.Interactions()[0]
+	for _, body := range []string{interaction.Request.Body, interaction.Response.Body} {
+		if !strings.Contains(body, `"demoAccountPassword":"[REDACTED]"`) || !strings.Contains(body, `"notes":"Tap Sign In"`) {
+			t.Fatalf("expected only sensitive attributes to be redacted, got %s", body)
+		}
+	}
+}
+
+func TestOpenCassetteErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		mode    CassetteMode
+		wantErr string
+	}{
+		{name: "missing path", path: " ", mode: CassetteModeRecord, wantErr: "path is required"},
+		{name: "unknown mode", path: "cassette.json", mode: "rewind", wantErr: "unsupported mode"},
+		{name: "missing replay file", path: filepath.Join(t.TempDir(), "missing.json"), mode: CassetteModeReplay, wantErr: "no such file"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := OpenCassette(test.path, test.mode)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCassetteMatchKeyIgnoresHostAndQueryOrder(t *testing.T) {
+	a := cassetteMatchKey("get", "https://api.appstoreconnect.apple.com/v1/apps?limit=2&filter%5BbundleId%5D=x")
+	b := cassetteMatchKey("GET", "http://127.0.0.1:8787/v1/apps?filter%5BbundleId%5D=x&limit=2")
+	if a != b {
+		t.Fatalf("expected matching keys, got %q and %q", a, b)
+	}
+}
@@ -292,6 +292,28 @@ func (c *Client) DeleteAppScreenshot(ctx context.Context, screenshotID string) e
 	return err
 }
 
+// ReplaceAppScreenshotSetScreenshots sets the screenshots in a set, in order.
+func (c *Client) ReplaceAppScreenshotSetScreenshots(ctx context.Context, setID string, screenshotIDs []string) error {
+	payload := RelationshipRequest{
+		Data: make([]RelationshipData, 0, len(screenshotIDs)),
+	}
+	for _, screenshotID := range screenshotIDs {
+		payload.Data = append(payload.Data, RelationshipData{
+			Type: ResourceTypeAppScreenshots,
+			ID:   screenshotID,
+		})
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/v1/appScreenshotSets/%s/relationships/appScreenshots", setID)
+	_, err = c.do(ctx, "PATCH", path, body)
+	return err
+}
+
 // GetAppPreviewSets retrieves preview sets for a localization.
 func (c *Client) GetAppPreviewSets(ctx context.Context, localizationID string) (*AppPreviewSetsResponse, error) {
 	path := fmt.Sprintf("/v1/appStoreVersionLocalizations/%s/appPreviewSets", localizationID)
@@ -474,3 +496,25 @@ func (c *Client) DeleteAppPreview(ctx context.Context, previewID string) error {
 	_, err := c.do(ctx, "DELETE", path, nil)
 	return err
 }
+
+// ReplaceAppPreviewSetPreviews sets the previews in a set, in order.
+func (c *Client) ReplaceAppPreviewSetPreviews(ctx context.Context, setID string, previewIDs []string) error {
+	payload := RelationshipRequest{
+		Data: make([]RelationshipData, 0, len(previewIDs)),
+	}
+	for _, previewID := range previewIDs {
+		payload.Data = append(payload.Data, RelationshipData{
+			Type: ResourceTypeAppPreviews,
+			ID:   previewID,
+		})
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/v1/appPreviewSets/%s/relationships/appPreviews", setID)
+	_, err = c.do(ctx, "PATCH", path, body)
+	return err
+}
@@ -0,0 +1,156 @@
+package asc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeTestPrivateKey(t *testing.T) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "AuthKey.p8")
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	return path
+}
+
+func TestResolveBaseURL(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	t.Setenv("ASC_CONFIG_PATH", configPath)
+
+	t.Setenv("ASC_BASE_URL", "")
+	if got, err := ResolveBaseURL(); err != nil || got != BaseURL {
+		t.Fatalf("expected default base URL, got %q, %v", got, err)
+	}
+
+	t.Setenv("ASC_BASE_URL", "http://127.0.0.1:8787/")
+	if got, err := ResolveBaseURL(); err != nil || got != "http://127.0.0.1:8787" {
+		t.Fatalf("expected env base URL without trailing slash, got %q, %v", got, err)
+	}
+
+	for _, invalid := range []string{"http://asc.example.com", "http://mock:8787", "ftp://127.0.0.1"} {
+		t.Setenv("ASC_BASE_URL", invalid)
+		if got, err := ResolveBaseURL(); err == nil || !strings.Contains(err.Error(), "ASC_BASE_URL") {
+			t.Fatalf("expected invalid env base URL %q to be an error, got %q, %v", invalid, got, err)
+		}
+	}
+
+	t.Setenv("ASC_BASE_URL", "")
+	if err := os.WriteFile(configPath, []byte(`{"base_url":"http://mock:8787"}`), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if got, err := ResolveBaseURL(); err == nil || !strings.Contains(err.Error(), "base_url") {
+		t.Fatalf("expected invalid config base_url to be an error, got %q, %v", got, err)
+	}
+	if err := os.WriteFile(configPath, []byte(`{"base_url":"https://mock.example.com/"}`), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if got, err := ResolveBaseURL(); err != nil || got != "https://mock.example.com" {
+		t.Fatalf("expected config base URL, got %q, %v", got, err)
+	}
+}
+
+func TestNewClientRejectsInvalidBaseURLOverride(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	t.Setenv("ASC_BASE_URL", "http://mock:8787")
+	keyPath := writeTestPrivateKey(t)
+
+	if _, err := NewClient("KEY", "ISSUER", keyPath); err == nil || !strings.Contains(err.Error(), "ASC_BASE_URL") {
+		t.Fatalf("expected NewClient() to reject an invalid ASC_BASE_URL, got %v", err)
+	}
+}
+
+func TestNewClientWithBaseURLOption(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	t.Setenv("ASC_BASE_URL", "")
+	keyPath := writeTestPrivateKey(t)
+
+	var requested string
+	httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL.String()
+		return jsonResponse(http.StatusOK, `{"data":[],"links":{}}`), nil
+	})}
+
+	client, err := NewClientWithHTTPClient("KEY", "ISSUER", keyPath, httpClient, WithBaseURL("http://localhost:9999/"))
+	if err != nil {
+		t.Fatalf("NewClientWithHTTPClient() error: %v", err)
+	}
+	if _, err := client.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if requested != "http://localhost:9999/v1/apps" {
+		t.Fatalf("expected request to custom base URL, got %q", requested)
+	}
+
+	if err := validateNextURL("http://localhost:9999/v1/apps?cursor=2"); err != nil {
+		t.Fatalf("expected pagination URL on custom base to be trusted, got %v", err)
+	}
+	if err := validateNextURL("https://localhost:9999/v1/apps?cursor=2"); err == nil {
+		t.Fatal("expected scheme mismatch to be rejected")
+	}
+	if err := validateNextURL("http://evil.example.com/v1/apps"); err == nil {
+		t.Fatal("expected untrusted host to be rejected")
+	}
+}
+
+func TestNewClientUsesEnvBaseURL(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	t.Setenv("ASC_BASE_URL", "http://127.0.0.1:8788")
+	keyPath := writeTestPrivateKey(t)
+
+	// Pagination trusts the base URL of constructed clients rather than
+	// re-reading the environment for every page.
+	resetTrustedBaseURLs(t)
+	if err := validateNextURL("http://127.0.0.1:8788/v1/apps?cursor=2"); err == nil {
+		t.Fatal("expected base URL to be untrusted before a client uses it")
+	}
+
+	client, err := NewClient("KEY", "ISSUER", keyPath)
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+	if err := validateNextURL("http://127.0.0.1:8788/v1/apps?cursor=2"); err != nil {
+		t.Fatalf("expected pagination URL on the client's base URL to be trusted, got %v", err)
+	}
+	req, err := client.newRequest(context.Background(), http.MethodGet, "/v1/apps", nil)
+	if err != nil {
+		t.Fatalf("newRequest() error: %v", err)
+	}
+	if req.URL.String() != "http://127.0.0.1:8788/v1/apps" {
+		t.Fatalf("expected env base URL, got %q", req.URL.String())
+	}
+}
+
+// resetTrustedBaseURLs clears the base URLs trusted by earlier clients for the
+// duration of a test.
+func resetTrustedBaseURLs(t *testing.T) {
+	t.Helper()
+	trustedBaseURLs.mu.Lock()
+	saved := trustedBaseURLs.urls
+	trustedBaseURLs.urls = nil
+	trustedBaseURLs.mu.Unlock()
+	t.Cleanup(func() {
+		trustedBaseURLs.mu.Lock()
+		trustedBaseURLs.urls = saved
+		trustedBaseURLs.mu.Unlock()
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -114,3 +115,44 @@ func (c *Client) DeleteBetaFeedbackScreenshotSubmission(ctx context.Context, sub
 	_, err := c.do(ctx, "DELETE", path, nil)
 	return err
 }
+
+// DownloadBetaFeedbackScreenshot downloads a feedback screenshot from the
+// signed URL in its submission.
+func (c *Client) DownloadBetaFeedbackScreenshot(ctx context.Context, screenshotURL string) (*ReportDownload, error) {
+	if err := validateFeedbackScreenshotURL(screenshotURL); err != nil {
+		return nil, fmt.Errorf("feedback screenshot download: %w", err)
+	}
+
+	resp, err := c.doStreamNoAuth(ctx, "GET", screenshotURL, "image/*")
+	if err != nil {
+		return nil, err
+	}
+	return &ReportDownload{Body: resp.Body, ContentLength: resp.ContentLength}, nil
+}
+
+func validateFeedbackScreenshotURL(screenshotURL string) error {
+	if strings.TrimSpace(screenshotURL) == "" {
+		return fmt.Errorf("empty screenshot URL")
+	}
+	parsedURL, err := url.Parse(screenshotURL)
+	if err != nil {
+		return fmt.Errorf("invalid screenshot URL: %w", err)
+	}
+	if parsedURL.Scheme != "https" {
+		return fmt.Errorf("rejected screenshot URL with insecure scheme %q (expected https)", parsedURL.Scheme)
+	}
+	host := strings.ToLower(parsedURL.Hostname())
+	if isAllowedAnalyticsHost(host) {
+		return nil
+	}
+	if isAllowedAnalyticsCDNHost(host) {
+		if !hasSignedQuery(parsedURL.Query()) {
+			return fmt.Errorf("rejected screenshot URL from CDN host %q without signed query", parsedURL.Host)
+		}
+		return nil
+	}
+	if host == "" {
+		return fmt.Errorf("rejected screenshot URL with empty host")
+	}
+	return fmt.Errorf("rejected screenshot URL from untrusted host %q", parsedURL.Host)
+}
@@ -19,7 +19,8 @@ import (
 )
 
 const (
-	// BaseURL is the App Store Connect API base URL
+	// BaseURL is the default App Store Connect API base URL.
+	// Use ResolveBaseURL or WithBaseURL to target a different host.
 	BaseURL = "https://api.appstoreconnect.apple.com"
 	// DefaultTimeout is the default request timeout
 	DefaultTimeout = 30 * time.Second
@@ -421,32 +422,77 @@ func resolveTimeoutWithDefaultAndEnv(defaultTimeout time.Duration, durationEnv,
 	return timeout
 }
 
+// ResolveBaseURL returns the App Store Connect API base URL.
+// Precedence: ASC_BASE_URL env > config base_url > BaseURL.
+// An invalid override, or a config file that fails validation, is an error
+// rather than a silent fallback, so a run meant for a mock server never
+// reaches the real API.
+func ResolveBaseURL() (string, error) {
+	if override, ok := envValue("ASC_BASE_URL"); ok && override != "" {
+		if err := config.ValidateBaseURL(override); err != nil {
+			return "", fmt.Errorf("invalid ASC_BASE_URL %q: %w", override, err)
+		}
+		return normalizeBaseURL(override), nil
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		// An invalid config may hold an invalid base_url; don't guess.
+		if errors.Is(err, config.ErrInvalidConfig) {
+			return "", err
+		}
+		return BaseURL, nil
+	}
+	if override := strings.TrimSpace(cfg.BaseURL); override != "" {
+		return normalizeBaseURL(override), nil
+	}
+	return BaseURL, nil
+}
+
+func normalizeBaseURL(value string) string {
+	return strings.TrimRight(strings.TrimSpace(value), "/")
+}
+
 // Client is an App Store Connect API client
 type Client struct {
 	httpClient    *http.Client
 	keyID         string
 	issuerID      string
 	privateKey    *ecdsa.PrivateKey
+	baseURL       string // empty uses BaseURL constant
+	cassette      *Cassette
 	notaryBaseURL string // override for testing; empty uses NotaryBaseURL constant
 }
 
+// ClientOption configures a Client at construction time.
+type ClientOption func(*Client)
+
+// WithBaseURL overrides the App Store Connect API base URL for a client.
+// Pagination links on the same host are trusted for the lifetime of the process.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		if normalized := normalizeBaseURL(baseURL); normalized != "" {
+			c.baseURL = normalized
+		}
+	}
+}
+
 // NewClient creates a new ASC client.
-func NewClient(keyID, issuerID, privateKeyPath string) (*Client, error) {
+func NewClient(keyID, issuerID, privateKeyPath string, opts ...ClientOption) (*Client, error) {
 	return newClientWithHTTPClient(keyID, issuerID, privateKeyPath, &http.Client{
 		Timeout: ResolveTimeout(),
-	})
+	}, opts...)
 }
 
 // NewClientWithHTTPClient creates a new ASC client using the provided HTTP client.
 // If httpClient is nil, a default client with ASC timeouts is used.
-func NewClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client) (*Client, error) {
+func NewClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
 	if httpClient == nil {
 		httpClient = &http.Client{Timeout: ResolveTimeout()}
 	}
-	return newClientWithHTTPClient(keyID, issuerID, privateKeyPath, httpClient)
+	return newClientWithHTTPClient(keyID, issuerID, privateKeyPath, httpClient, opts...)
 }
 
-func newClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client) (*Client, error) {
+func newClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
 	if err := auth.ValidateKeyFile(privateKeyPath); err != nil {
 		return nil, fmt.Errorf("invalid private key: %w", err)
 	}
@@ -456,10 +502,39 @@ func newClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient
 		return nil, fmt.Errorf("failed to load private key: %w", err)
 	}
 
-	return &Client{
+	baseURL, err := ResolveBaseURL()
+	if err != nil {
+		return nil, err
+	}
+
+	client := &Client{
 		httpClient: httpClient,
 		keyID:      keyID,
 		issuerID:   issuerID,
 		privateKey: key,
-	}, nil
+		baseURL:    baseURL,
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	if client.cassette == nil {
+		cassette, err := ResolveCassette()
+		if err != nil {
+			return nil, err
+		}
+		client.cassette = cassette
+	}
+	if client.cassette != nil {
+		client.httpClient = client.cassette.WrapClient(client.httpClient)
+	}
+	trustBaseURL(client.baseURL)
+	return client, nil
+}
+
+// resolveBaseURL returns the effective API base URL for this client.
+func (c *Client) resolveBaseURL() string {
+	if c.baseURL != "" {
+		return c.baseURL
+	}
+	return BaseURL
 }
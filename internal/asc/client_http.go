@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -27,7 +28,7 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 
 	url := path
 	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
-		url = BaseURL + path
+		url = c.resolveBaseURL() + path
 	}
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
@@ -257,8 +258,42 @@ func parseRetryAfterHeader(value string) time.Duration {
 	return 0
 }
 
+// trustedBaseURLs holds base URLs configured via ASC_BASE_URL, config, or
+// WithBaseURL. Pagination links are allowed on any of these hosts.
+var trustedBaseURLs struct {
+	mu   sync.RWMutex
+	urls map[string]struct{}
+}
+
+func trustBaseURL(baseURL string) {
+	baseURL = normalizeBaseURL(baseURL)
+	if baseURL == "" || baseURL == BaseURL {
+		return
+	}
+	trustedBaseURLs.mu.Lock()
+	defer trustedBaseURLs.mu.Unlock()
+	if trustedBaseURLs.urls == nil {
+		trustedBaseURLs.urls = make(map[string]struct{})
+	}
+	trustedBaseURLs.urls[baseURL] = struct{}{}
+}
+
+// trustedPaginationBases lists BaseURL and the base URL of every client
+// constructed so far; the environment and config are read once per client,
+// not per page.
+func trustedPaginationBases() []string {
+	bases := []string{BaseURL}
+	trustedBaseURLs.mu.RLock()
+	defer trustedBaseURLs.mu.RUnlock()
+	for base := range trustedBaseURLs.urls {
+		bases = append(bases, base)
+	}
+	return bases
+}
+
 // validateNextURL validates that a pagination URL is safe to use.
-// It ensures the URL is on the same host as BaseURL and uses HTTPS.
+// It ensures the URL is on the same host and scheme as a trusted base URL
+// (BaseURL, ASC_BASE_URL/config base_url, or a WithBaseURL override).
 func validateNextURL(nextURL string) error {
 	if nextURL == "" {
 		return nil
@@ -275,22 +310,25 @@ func validateNextURL(nextURL string) error {
 		return fmt.Errorf("invalid pagination URL: %w", err)
 	}
 
-	baseURL, err := url.Parse(BaseURL)
+	defaultBase, err := url.Parse(BaseURL)
 	if err != nil {
 		return fmt.Errorf("invalid base URL: %w", err)
 	}
 
-	// Allow URLs on the same host as BaseURL
-	if parsedURL.Host != baseURL.Host {
-		return fmt.Errorf("rejected pagination URL from untrusted host %q (expected %q)", parsedURL.Host, baseURL.Host)
-	}
-
-	// Require HTTPS for authentication endpoints
-	if parsedURL.Scheme != "https" {
-		return fmt.Errorf("rejected pagination URL with insecure scheme %q (expected https)", parsedURL.Scheme)
+	for _, base := range trustedPaginationBases() {
+		baseURL, err := url.Parse(base)
+		if err != nil || parsedURL.Host != baseURL.Host {
+			continue
+		}
+		// Require the trusted scheme (https for App Store Connect; a custom
+		// base such as a local mock server may use http).
+		if parsedURL.Scheme != baseURL.Scheme {
+			return fmt.Errorf("rejected pagination URL with insecure scheme %q (expected %s)", parsedURL.Scheme, baseURL.Scheme)
+		}
+		return nil
 	}
 
-	return nil
+	return fmt.Errorf("rejected pagination URL from untrusted host %q (expected %q)", parsedURL.Host, defaultBase.Host)
 }
 
 // allowedAnalyticsHosts contains the allowed host suffixes for analytics report downloads.
@@ -3908,6 +3908,54 @@ func TestDeleteAppPreview(t *testing.T) {
 	}
 }
 
+func TestReplaceAppScreenshotSetScreenshots(t *testing.T) {
+	response := jsonResponse(http.StatusNoContent, "")
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/appScreenshotSets/SET_123/relationships/appScreenshots" {
+			t.Fatalf("expected path /v1/appScreenshotSets/SET_123/relationships/appScreenshots, got %s", req.URL.Path)
+		}
+		var payload RelationshipRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if len(payload.Data) != 2 || payload.Data[0].ID != "SHOT_2" || payload.Data[1].ID != "SHOT_1" || payload.Data[0].Type != ResourceTypeAppScreenshots {
+			t.Fatalf("unexpected payload: %+v", payload)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if err := client.ReplaceAppScreenshotSetScreenshots(context.Background(), "SET_123", []string{"SHOT_2", "SHOT_1"}); err != nil {
+		t.Fatalf("ReplaceAppScreenshotSetScreenshots() error: %v", err)
+	}
+}
+
+func TestReplaceAppPreviewSetPreviews(t *testing.T) {
+	response := jsonResponse(http.StatusNoContent, "")
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/appPreviewSets/SET_123/relationships/appPreviews" {
+			t.Fatalf("expected path /v1/appPreviewSets/SET_123/relationships/appPreviews, got %s", req.URL.Path)
+		}
+		var payload RelationshipRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if len(payload.Data) != 1 || payload.Data[0].ID != "PREVIEW_1" || payload.Data[0].Type != ResourceTypeAppPreviews {
+			t.Fatalf("unexpected payload: %+v", payload)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if err := client.ReplaceAppPreviewSetPreviews(context.Background(), "SET_123", []string{"PREVIEW_1"}); err != nil {
+		t.Fatalf("ReplaceAppPreviewSetPreviews() error: %v", err)
+	}
+}
+
 func TestCreateAppStoreVersionSubmission(t *testing.T) {
 	response := jsonResponse(http.StatusCreated, `{"data":{"type":"appStoreVersionSubmissions","id":"SUBMIT_123","attributes":{"createdDate":"2026-01-20T00:00:00Z"}}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -9138,3 +9186,36 @@ func TestListReviewSubmissionsGlobal_UsesNextURL(t *testing.T) {
 		t.Fatalf("ListReviewSubmissions() error: %v", err)
 	}
 }
+
+func TestDownloadBetaFeedbackScreenshot_NoAuthHeader(t *testing.T) {
+	downloadURL := "https://tf-feedback.itunes.apple.com/eimg/screenshot.png?sig=abc"
+	response := rawResponse(http.StatusOK, "png-data")
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.String() != downloadURL {
+			t.Fatalf("expected URL %q, got %q", downloadURL, req.URL.String())
+		}
+		if req.Header.Get("Authorization") != "" {
+			t.Fatalf("expected no Authorization header")
+		}
+	}, response)
+
+	download, err := client.DownloadBetaFeedbackScreenshot(context.Background(), downloadURL)
+	if err != nil {
+		t.Fatalf("DownloadBetaFeedbackScreenshot() error: %v", err)
+	}
+	_ = download.Body.Close()
+}
+
+func TestDownloadBetaFeedbackScreenshot_RejectsUntrustedURLs(t *testing.T) {
+	client := newTestClient(t, nil, nil)
+	for _, downloadURL := range []string{
+		"",
+		"http://tf-feedback.itunes.apple.com/eimg/screenshot.png",
+		"https://images.example.com/screenshot.png",
+		"https://bucket.s3.amazonaws.com/screenshot.png",
+	} {
+		if _, err := client.DownloadBetaFeedbackScreenshot(context.Background(), downloadURL); err == nil {
+			t.Fatalf("expected error for %q", downloadURL)
+		}
+	}
+}
@@ -269,6 +269,9 @@ type DiagnosticLogsOption func(*diagnosticLogsQuery)
 // TerritoryAvailabilitiesOption is a functional option for GetTerritoryAvailabilities.
 type TerritoryAvailabilitiesOption func(*territoryAvailabilitiesQuery)
 
+// AppPricesOption is a functional option for app price schedule price endpoints.
+type AppPricesOption func(*appPricesQuery)
+
 // LinkagesOption is a functional option for linkages endpoints.
 type LinkagesOption func(*linkagesQuery)
 
@@ -400,6 +403,13 @@ func WithFeedbackIncludeScreenshots() FeedbackOption {
 	}
 }
 
+// WithFeedbackIncludeBuild includes the build of each feedback submission.
+func WithFeedbackIncludeBuild() FeedbackOption {
+	return func(q *feedbackQuery) {
+		q.includeBuild = true
+	}
+}
+
 // WithCrashDeviceModels filters crashes by device model(s).
 func WithCrashDeviceModels(models []string) CrashOption {
 	return func(q *crashQuery) {
@@ -1010,6 +1020,13 @@ func WithCrashSort(sort string) CrashOption {
 	}
 }
 
+// WithCrashIncludeBuild includes the build of each crash submission.
+func WithCrashIncludeBuild() CrashOption {
+	return func(q *crashQuery) {
+		q.includeBuild = true
+	}
+}
+
 // WithRating filters reviews by star rating (1-5).
 func WithRating(rating int) ReviewOption {
 	return func(r *reviewQuery) {
@@ -1707,6 +1724,13 @@ func WithReviewSubmissionItemsNextURL(next string) ReviewSubmissionItemsOption {
 	}
 }
 
+// WithReviewSubmissionItemsInclude includes related resources for review submission items.
+func WithReviewSubmissionItemsInclude(include []string) ReviewSubmissionItemsOption {
+	return func(q *reviewSubmissionItemsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 // WithPreReleaseVersionsPlatform filters pre-release versions by platform.
 func WithPreReleaseVersionsPlatform(platform string) PreReleaseVersionsOption {
 	return func(q *preReleaseVersionsQuery) {
@@ -3155,6 +3179,22 @@ func WithPricePointsTerritory(territory string) PricePointsOption {
 	}
 }
 
+// WithAppPricesLimit sets the max number of app prices to return.
+func WithAppPricesLimit(limit int) AppPricesOption {
+	return func(q *appPricesQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithAppPricesInclude sets include for app price schedule prices.
+func WithAppPricesInclude(include []string) AppPricesOption {
+	return func(q *appPricesQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 // WithAppCustomProductPagesLimit sets the max number of custom product pages to return.
 func WithAppCustomProductPagesLimit(limit int) AppCustomProductPagesOption {
 	return func(q *appCustomProductPagesQuery) {
@@ -19,9 +19,33 @@ func (r *PreReleaseVersionsResponse) GetData() any {
 // PaginateFunc is a function that fetches a page of results
 type PaginateFunc func(ctx context.Context, nextURL string) (PaginatedResponse, error)
 
+// PageHandler receives each page fetched by PaginateAll when streaming.
+type PageHandler func(page PaginatedResponse) error
+
+type pageHandlerKey struct{}
+
+// WithPageHandler returns a context that makes PaginateAll pass each page to
+// handler as it arrives instead of aggregating pages in memory. PaginateAll
+// then returns an empty response of the same type as the first page.
+func WithPageHandler(ctx context.Context, handler PageHandler) context.Context {
+	if handler == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, pageHandlerKey{}, handler)
+}
+
+func pageHandlerFromContext(ctx context.Context) PageHandler {
+	if ctx == nil {
+		return nil
+	}
+	handler, _ := ctx.Value(pageHandlerKey{}).(PageHandler)
+	return handler
+}
+
 // PaginateAll fetches all pages and aggregates results.
 // It uses reflection to create an empty result container of the same type as
 // firstPage, eliminating the need for a type switch per response type.
+// When ctx carries a handler from WithPageHandler, pages are streamed to it.
 func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext PaginateFunc) (PaginatedResponse, error) {
 	if firstPage == nil {
 		return nil, nil
@@ -39,12 +63,20 @@ func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext Pag
 		return nil, err
 	}
 
+	handler := pageHandlerFromContext(ctx)
 	page := 1
 	seenNext := make(map[string]struct{})
 	for {
-		// Aggregate data from current page using reflection over the Data field.
-		if err := aggregatePageData(result, firstPage); err != nil {
-			return nil, fmt.Errorf("page %d: %w", page, err)
+		if handler != nil {
+			// Stream the current page instead of holding every page in memory.
+			if err := handler(firstPage); err != nil {
+				return result, fmt.Errorf("page %d: %w", page, err)
+			}
+		} else {
+			// Aggregate data from current page using reflection over the Data field.
+			if err := aggregatePageData(result, firstPage); err != nil {
+				return nil, fmt.Errorf("page %d: %w", page, err)
+			}
 		}
 
 		// Check for next page
@@ -560,3 +560,55 @@ func TestPaginateAll_SubscriptionGroups(t *testing.T) {
 		t.Fatalf("expected %d subscription groups, got %d", expected, len(groups.Data))
 	}
 }
+
+func TestPaginateAll_PageHandlerStreamsPages(t *testing.T) {
+	const totalPages = 3
+	const perPage = 2
+
+	var streamed []string
+	ctx := WithPageHandler(context.Background(), func(page PaginatedResponse) error {
+		for _, item := range page.(*BetaGroupsResponse).Data {
+			streamed = append(streamed, item.ID)
+		}
+		return nil
+	})
+
+	firstPage := makeBetaGroupsPage(1, perPage, totalPages)
+	result, err := PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		page, err := parseMockPageNum(nextURL)
+		if err != nil {
+			return nil, err
+		}
+		if len(streamed) != (page-1)*perPage {
+			t.Fatalf("expected page %d to be streamed before fetching page %d, streamed %v", page-1, page, streamed)
+		}
+		return makeBetaGroupsPage(page, perPage, totalPages), nil
+	})
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+	if len(streamed) != totalPages*perPage {
+		t.Fatalf("expected %d streamed items, got %v", totalPages*perPage, streamed)
+	}
+	groups, ok := result.(*BetaGroupsResponse)
+	if !ok {
+		t.Fatalf("expected *BetaGroupsResponse, got %T", result)
+	}
+	if len(groups.Data) != 0 {
+		t.Fatalf("expected streamed result to be empty, got %d items", len(groups.Data))
+	}
+}
+
+func TestPaginateAll_PageHandlerErrorStops(t *testing.T) {
+	ctx := WithPageHandler(context.Background(), func(page PaginatedResponse) error {
+		return errors.New("write failed")
+	})
+
+	_, err := PaginateAll(ctx, makeBetaGroupsPage(1, 1, 2), func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		t.Fatal("fetchNext should not be called after a handler error")
+		return nil, nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "page 1: write failed") {
+		t.Fatalf("expected handler error, got %v", err)
+	}
+}
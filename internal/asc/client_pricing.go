@@ -252,9 +252,17 @@ func (c *Client) GetAppPriceScheduleBaseTerritory(ctx context.Context, scheduleI
 }
 
 // GetAppPriceScheduleManualPrices retrieves manual prices for a schedule.
-func (c *Client) GetAppPriceScheduleManualPrices(ctx context.Context, scheduleID string) (*AppPricesResponse, error) {
+func (c *Client) GetAppPriceScheduleManualPrices(ctx context.Context, scheduleID string, opts ...AppPricesOption) (*AppPricesResponse, error) {
+	query := &appPricesQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
 	scheduleID = strings.TrimSpace(scheduleID)
 	path := fmt.Sprintf("/v1/appPriceSchedules/%s/manualPrices", scheduleID)
+	if queryString := buildAppPricesQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
 
 	data, err := c.do(ctx, "GET", path, nil)
 	if err != nil {
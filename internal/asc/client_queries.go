@@ -23,6 +23,7 @@ type feedbackQuery struct {
 	testerIDs                 []string
 	sort                      string
 	includeScreenshots        bool
+	includeBuild              bool
 }
 
 type crashQuery struct {
@@ -35,6 +36,7 @@ type crashQuery struct {
 	buildPreReleaseVersionIDs []string
 	testerIDs                 []string
 	sort                      string
+	includeBuild              bool
 }
 
 type reviewQuery struct {
@@ -249,6 +251,7 @@ type reviewSubmissionsQuery struct {
 
 type reviewSubmissionItemsQuery struct {
 	listQuery
+	include []string
 }
 
 type preReleaseVersionsQuery struct {
@@ -545,6 +548,11 @@ type pricePointsQuery struct {
 	territory string
 }
 
+type appPricesQuery struct {
+	listQuery
+	include []string
+}
+
 type accessibilityDeclarationsQuery struct {
 	listQuery
 	deviceFamilies []string
@@ -726,7 +734,7 @@ func buildNominationsDetailQuery(query *nominationsQuery) string {
 func buildFeedbackQuery(query *feedbackQuery) string {
 	values := url.Values{}
 	if query.includeScreenshots {
-		values.Set("fields[betaFeedbackScreenshotSubmissions]", strings.Join([]string{
+		fields := []string{
 			"createdDate",
 			"comment",
 			"email",
@@ -735,8 +743,13 @@ func buildFeedbackQuery(query *feedbackQuery) string {
 			"appPlatform",
 			"devicePlatform",
 			"screenshots",
-		}, ","))
+		}
+		if query.includeBuild {
+			fields = append(fields, "build")
+		}
+		values.Set("fields[betaFeedbackScreenshotSubmissions]", strings.Join(fields, ","))
 	}
+	addBuildInclude(values, query.includeBuild)
 	addCSV(values, "filter[deviceModel]", query.deviceModels)
 	addCSV(values, "filter[osVersion]", query.osVersions)
 	addCSV(values, "filter[appPlatform]", query.appPlatforms)
@@ -760,6 +773,7 @@ func buildCrashQuery(query *crashQuery) string {
 	addCSV(values, "filter[build]", query.buildIDs)
 	addCSV(values, "filter[build.preReleaseVersion]", query.buildPreReleaseVersionIDs)
 	addCSV(values, "filter[tester]", query.testerIDs)
+	addBuildInclude(values, query.includeBuild)
 	if query.sort != "" {
 		values.Set("sort", query.sort)
 	}
@@ -767,6 +781,15 @@ func buildCrashQuery(query *crashQuery) string {
 	return values.Encode()
 }
 
+// addBuildInclude includes each submission's build with its version.
+func addBuildInclude(values url.Values, include bool) {
+	if !include {
+		return
+	}
+	values.Set("include", "build")
+	values.Set("fields[builds]", "version,uploadedDate")
+}
+
 func buildBetaGroupsQuery(query *betaGroupsQuery) string {
 	values := url.Values{}
 	addLimit(values, query.limit)
@@ -1297,6 +1320,7 @@ func buildReviewSubmissionsQuery(query *reviewSubmissionsQuery) string {
 
 func buildReviewSubmissionItemsQuery(query *reviewSubmissionItemsQuery) string {
 	values := url.Values{}
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -1524,6 +1548,13 @@ func buildLinkagesQuery(query *linkagesQuery) string {
 	return values.Encode()
 }
 
+func buildAppPricesQuery(query *appPricesQuery) string {
+	values := url.Values{}
+	addCSV(values, "include", query.include)
+	addLimit(values, query.limit)
+	return values.Encode()
+}
+
 func buildPricePointsQuery(query *pricePointsQuery) string {
 	values := url.Values{}
 	if strings.TrimSpace(query.territory) != "" {
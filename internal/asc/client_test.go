@@ -124,6 +124,45 @@ func TestBuildFeedbackQuery_IncludesScreenshots(t *testing.T) {
 	}
 }
 
+func TestBuildFeedbackQuery_IncludesBuild(t *testing.T) {
+	query := &feedbackQuery{}
+	WithFeedbackIncludeScreenshots()(query)
+	WithFeedbackIncludeBuild()(query)
+
+	values, err := url.ParseQuery(buildFeedbackQuery(query))
+	if err != nil {
+		t.Fatalf("failed to parse query: %v", err)
+	}
+
+	if got := values.Get("include"); got != "build" {
+		t.Fatalf("expected include=build, got %q", got)
+	}
+	if got := values.Get("fields[builds]"); got != "version,uploadedDate" {
+		t.Fatalf("expected fields[builds]=version,uploadedDate, got %q", got)
+	}
+	expected := "createdDate,comment,email,deviceModel,osVersion,appPlatform,devicePlatform,screenshots,build"
+	if got := values.Get("fields[betaFeedbackScreenshotSubmissions]"); got != expected {
+		t.Fatalf("expected fields to be %q, got %q", expected, got)
+	}
+}
+
+func TestBuildCrashQuery_IncludesBuild(t *testing.T) {
+	query := &crashQuery{}
+	WithCrashIncludeBuild()(query)
+
+	values, err := url.ParseQuery(buildCrashQuery(query))
+	if err != nil {
+		t.Fatalf("failed to parse query: %v", err)
+	}
+
+	if got := values.Get("include"); got != "build" {
+		t.Fatalf("expected include=build, got %q", got)
+	}
+	if got := values.Get("fields[builds]"); got != "version,uploadedDate" {
+		t.Fatalf("expected fields[builds]=version,uploadedDate, got %q", got)
+	}
+}
+
 func TestBuildCrashQuery(t *testing.T) {
 	query := &crashQuery{}
 	opts := []CrashOption{
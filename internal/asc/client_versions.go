@@ -14,6 +14,7 @@ type AppStoreVersionAttributes struct {
 	AppStoreState   string   `json:"appStoreState,omitempty"`
 	AppVersionState string   `json:"appVersionState,omitempty"`
 	CreatedDate     string   `json:"createdDate,omitempty"`
+	Copyright       string   `json:"copyright,omitempty"`
 }
 
 // AppStoreVersionCreateAttributes describes app store version create payload attributes.
@@ -0,0 +1,62 @@
+package asc
+
+import "fmt"
+
+// CrashSymbolicationFrame is one frame of a symbolicated crash report.
+type CrashSymbolicationFrame struct {
+	Thread       string `json:"thread"`
+	Crashed      bool   `json:"crashed,omitempty"`
+	Frame        int    `json:"frame"`
+	Image        string `json:"image,omitempty"`
+	Address      string `json:"address"`
+	Symbol       string `json:"symbol,omitempty"`
+	Offset       uint64 `json:"offset,omitempty"`
+	File         string `json:"file,omitempty"`
+	Line         int    `json:"line,omitempty"`
+	Symbolicated bool   `json:"symbolicated"`
+}
+
+// CrashSymbolicationImage is a binary image that has unsymbolicated frames
+// and no matching dSYM.
+type CrashSymbolicationImage struct {
+	Name string `json:"name"`
+	UUID string `json:"uuid"`
+}
+
+// CrashSymbolicationResult represents crashes symbolicate output.
+type CrashSymbolicationResult struct {
+	Source       string                    `json:"source"`
+	Format       string                    `json:"format"`
+	OutputFile   string                    `json:"outputFile,omitempty"`
+	Symbolicated int                       `json:"symbolicated"`
+	Missing      []CrashSymbolicationImage `json:"missing,omitempty"`
+	Frames       []CrashSymbolicationFrame `json:"frames"`
+}
+
+func crashSymbolicationResultRows(result *CrashSymbolicationResult) ([]string, [][]string) {
+	headers := []string{"Thread", "Frame", "Image", "Address", "Symbol", "Location"}
+	rows := make([][]string, 0, len(result.Frames))
+	for _, frame := range result.Frames {
+		thread := frame.Thread
+		if frame.Crashed {
+			thread += " (crashed)"
+		}
+		symbol := frame.Symbol
+		if frame.Symbolicated {
+			symbol = fmt.Sprintf("%s + %d", frame.Symbol, frame.Offset)
+		}
+		location := ""
+		if frame.File != "" && frame.Line > 0 {
+			location = fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+		rows = append(rows, []string{
+			sanitizeTerminal(thread),
+			fmt.Sprintf("%d", frame.Frame),
+			sanitizeTerminal(frame.Image),
+			frame.Address,
+			sanitizeTerminal(symbol),
+			sanitizeTerminal(location),
+		})
+	}
+	return headers, rows
+}
@@ -0,0 +1,50 @@
+package asc
+
+import "fmt"
+
+// Feedback export file statuses.
+const (
+	FeedbackExportDownloaded  = "downloaded"
+	FeedbackExportSkipped     = "skipped"
+	FeedbackExportUnavailable = "unavailable"
+	FeedbackExportFailed      = "failed"
+)
+
+// FeedbackExportResult represents CLI output for a TestFlight feedback and
+// crash export into a local directory.
+type FeedbackExportResult struct {
+	AppID      string               `json:"appId"`
+	Dir        string               `json:"dir"`
+	Index      string               `json:"index"`
+	Since      string               `json:"since,omitempty"`
+	Feedback   int                  `json:"feedback"`
+	Crashes    int                  `json:"crashes"`
+	Downloaded int                  `json:"downloaded"`
+	Skipped    int                  `json:"skipped"`
+	Failed     int                  `json:"failed"`
+	Files      []FeedbackExportFile `json:"files"`
+}
+
+// FeedbackExportFile is the outcome for one screenshot or crash log of an
+// export.
+type FeedbackExportFile struct {
+	SubmissionID string `json:"submissionId"`
+	Kind         string `json:"kind"`
+	Status       string `json:"status"`
+	Path         string `json:"path,omitempty"`
+	Size         int64  `json:"size,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+func feedbackExportResultRows(result *FeedbackExportResult) ([]string, [][]string) {
+	headers := []string{"Submission", "Kind", "Status", "Path", "Size", "Error"}
+	rows := make([][]string, 0, len(result.Files))
+	for _, file := range result.Files {
+		size := ""
+		if file.Size > 0 {
+			size = fmt.Sprintf("%d", file.Size)
+		}
+		rows = append(rows, []string{file.SubmissionID, file.Kind, file.Status, file.Path, size, compactWhitespace(file.Error)})
+	}
+	return headers, rows
+}
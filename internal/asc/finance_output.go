@@ -15,6 +15,11 @@ type FinanceReportResult struct {
 	DecompressedBytes int64  `json:"decompressedSize,omitempty"`
 }
 
+// FinancialReportRows represents CLI output for a parsed FINANCIAL report.
+type FinancialReportRows struct {
+	Data []FinancialReportRow `json:"data"`
+}
+
 func financeReportResultRows(result *FinanceReportResult) ([]string, [][]string) {
 	headers := []string{"Vendor", "Type", "Region", "Date", "Compressed File", "Compressed Size", "Decompressed File", "Decompressed Size"}
 	rows := [][]string{{
@@ -43,3 +48,22 @@ func financeRegionsRows(result *FinanceRegionsResult) ([]string, [][]string) {
 	}
 	return headers, rows
 }
+
+func financialReportRowsRows(result *FinancialReportRows) ([]string, [][]string) {
+	headers := []string{"Start Date", "End Date", "Vendor Identifier", "Title", "Country", "Quantity", "Partner Share", "Extended Partner Share", "Currency"}
+	rows := make([][]string, 0, len(result.Data))
+	for _, row := range result.Data {
+		rows = append(rows, []string{
+			NormalizeReportDate(row.StartDate),
+			NormalizeReportDate(row.EndDate),
+			row.VendorIdentifier,
+			compactWhitespace(row.Title),
+			row.CountryOfSale,
+			formatReportNumber(row.Quantity),
+			formatReportAmount(row.PartnerShare),
+			formatReportAmount(row.ExtendedPartnerShare),
+			row.PartnerShareCurrency,
+		})
+	}
+	return headers, rows
+}
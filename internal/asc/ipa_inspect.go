@@ -0,0 +1,95 @@
+package asc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+// IPA bundle kinds.
+const (
+	IPABundleKindApp          = "app"
+	IPABundleKindAppExtension = "app-extension"
+	IPABundleKindWatchApp     = "watch-app"
+	IPABundleKindAppClip      = "app-clip"
+	IPABundleKindFramework    = "framework"
+)
+
+// IPAInspectResult describes the bundles packaged in an IPA.
+type IPAInspectResult struct {
+	Path    string      `json:"path"`
+	Size    int64       `json:"size"`
+	Bundles []IPABundle `json:"bundles"`
+	// Lint results are only present when linting was requested.
+	Summary *validation.Summary      `json:"summary,omitempty"`
+	Checks  []validation.CheckResult `json:"checks,omitempty"`
+	Strict  bool                     `json:"strict,omitempty"`
+}
+
+// IPABundle describes one bundle (app, extension, watch app, App Clip, or
+// framework) inside an IPA.
+type IPABundle struct {
+	Path                       string                `json:"path"`
+	Kind                       string                `json:"kind"`
+	BundleID                   string                `json:"bundleId"`
+	Name                       string                `json:"name,omitempty"`
+	Version                    string                `json:"version,omitempty"`
+	BuildNumber                string                `json:"buildNumber,omitempty"`
+	MinimumOSVersion           string                `json:"minimumOsVersion,omitempty"`
+	RequiredDeviceCapabilities []string              `json:"requiredDeviceCapabilities,omitempty"`
+	Executable                 string                `json:"executable,omitempty"`
+	Architectures              []string              `json:"architectures,omitempty"`
+	HasPrivacyManifest         bool                  `json:"hasPrivacyManifest"`
+	HasIcons                   bool                  `json:"hasIcons"`
+	UsesNonExemptEncryption    *bool                 `json:"usesNonExemptEncryption,omitempty"`
+	Entitlements               map[string]any        `json:"entitlements,omitempty"`
+	Profile                    *ProfileInspectResult `json:"profile,omitempty"`
+	// Errors records parts of the bundle that could not be read.
+	Errors []string `json:"errors,omitempty"`
+	// Info holds the raw Info.plist for checks that need extra keys.
+	Info map[string]any `json:"-"`
+}
+
+func ipaInspectResultRender(result *IPAInspectResult, render func([]string, [][]string)) error {
+	rows := make([][]string, 0, len(result.Bundles))
+	for _, bundle := range result.Bundles {
+		profile := ""
+		if bundle.Profile != nil {
+			profile = bundle.Profile.ProfileType + " (" + bundle.Profile.Name + ")"
+		}
+		rows = append(rows, []string{
+			bundle.Path,
+			bundle.Kind,
+			bundle.BundleID,
+			strings.TrimSpace(bundle.Version + " (" + bundle.BuildNumber + ")"),
+			bundle.MinimumOSVersion,
+			strings.Join(bundle.Architectures, ", "),
+			strings.Join(bundle.RequiredDeviceCapabilities, ", "),
+			formatBool(bundle.HasPrivacyManifest),
+			profile,
+		})
+	}
+	render([]string{"Bundle", "Kind", "Bundle ID", "Version", "Min OS", "Architectures", "Capabilities", "Privacy Manifest", "Profile"}, rows)
+
+	if result.Summary == nil {
+		return nil
+	}
+	render([]string{"Path", "Errors", "Warnings", "Infos", "Blocking", "Strict"}, [][]string{{
+		result.Path,
+		fmt.Sprintf("%d", result.Summary.Errors),
+		fmt.Sprintf("%d", result.Summary.Warnings),
+		fmt.Sprintf("%d", result.Summary.Infos),
+		fmt.Sprintf("%d", result.Summary.Blocking),
+		formatBool(result.Strict),
+	}})
+	checkRows := make([][]string, 0, len(result.Checks))
+	for _, check := range result.Checks {
+		checkRows = append(checkRows, []string{string(check.Severity), check.ID, check.ResourceID, check.Field, check.Message, check.Remediation})
+	}
+	if len(checkRows) == 0 {
+		checkRows = append(checkRows, []string{"info", "ipa.ok", "", "", "No issues found", ""})
+	}
+	render([]string{"Severity", "Check ID", "Bundle", "Field", "Message", "Remediation"}, checkRows)
+	return nil
+}
@@ -15,6 +15,7 @@ type BuildUploadResult struct {
 	Uploaded            *bool             `json:"uploaded,omitempty"`
 	ChecksumVerified    *bool             `json:"checksumVerified,omitempty"`
 	SourceFileChecksums *Checksums        `json:"sourceFileChecksums,omitempty"`
+	Resumed             bool              `json:"resumed,omitempty"`
 }
 
 // BuildBetaGroupsUpdateResult represents CLI output for build beta group updates.
@@ -0,0 +1,97 @@
+package asc
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// HasTableRows reports whether data has registered table rows. Unregistered
+// types fall back to JSON in table, markdown, csv, and tsv output.
+func HasTableRows(data any) bool {
+	t := reflect.TypeOf(data)
+	_, direct := directRenderRegistry[t]
+	_, rows := outputRegistry[t]
+	return direct || rows
+}
+
+// PrintColumns renders data as table, markdown, csv, or tsv keeping only the
+// named columns, in header order. Names match headers case-insensitively and
+// ignore spaces, so "uploadedDate" selects "Uploaded Date". Tables of
+// multi-table types without a matching column are omitted.
+func PrintColumns(data any, format string, columns []string) error {
+	var render func([]string, [][]string)
+	switch strings.ToLower(format) {
+	case "table":
+		render = RenderTable
+	case "markdown", "md":
+		render = RenderMarkdown
+	case "csv":
+		render = separateTables(RenderCSV)
+	case "tsv":
+		render = separateTables(RenderTSV)
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+
+	wanted := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		wanted[normalizeColumnName(column)] = true
+	}
+
+	type table struct {
+		headers []string
+		rows    [][]string
+	}
+	var tables []table
+	var available []string
+	collect := func(headers []string, rows [][]string) {
+		available = append(available, headers...)
+		var keep []int
+		for i, header := range headers {
+			if wanted[normalizeColumnName(header)] {
+				keep = append(keep, i)
+			}
+		}
+		if len(keep) == 0 {
+			return
+		}
+		filtered := table{headers: pick(headers, keep), rows: make([][]string, 0, len(rows))}
+		for _, row := range rows {
+			filtered.rows = append(filtered.rows, pick(row, keep))
+		}
+		tables = append(tables, filtered)
+	}
+
+	if err := renderByRegistry(data, collect); err != nil {
+		return err
+	}
+	if len(tables) == 0 {
+		return fmt.Errorf("--fields matched no columns (available: %s)", strings.Join(available, ", "))
+	}
+	for _, t := range tables {
+		render(t.headers, t.rows)
+	}
+	return nil
+}
+
+func pick(values []string, indexes []int) []string {
+	out := make([]string, len(indexes))
+	for i, index := range indexes {
+		if index < len(values) {
+			out[i] = values[index]
+		}
+	}
+	return out
+}
+
+func normalizeColumnName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
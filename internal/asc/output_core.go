@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
 )
 
 func printPrettyRawJSON(data json.RawMessage) error {
@@ -27,6 +30,105 @@ func PrintTable(data any) error {
 	return renderByRegistry(data, RenderTable)
 }
 
+// PrintCSV prints data as comma-separated values using the table rows.
+func PrintCSV(data any) error {
+	return renderByRegistry(data, separateTables(RenderCSV))
+}
+
+// PrintTSV prints data as tab-separated values using the table rows.
+func PrintTSV(data any) error {
+	return renderByRegistry(data, separateTables(RenderTSV))
+}
+
+// separateTables inserts a blank line between the tables of multi-table types.
+func separateTables(render func([]string, [][]string)) func([]string, [][]string) {
+	first := true
+	return func(headers []string, rows [][]string) {
+		if !first {
+			fmt.Fprintln(os.Stdout)
+		}
+		first = false
+		render(headers, rows)
+	}
+}
+
+// PrintYAML prints data as YAML, using the same field names as JSON output.
+func PrintYAML(data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	// JSON is valid YAML, so decoding into a node keeps field order.
+	var node yaml.Node
+	if err := yaml.Unmarshal(payload, &node); err != nil {
+		return fmt.Errorf("convert to yaml: %w", err)
+	}
+	useBlockStyle(&node)
+
+	enc := yaml.NewEncoder(os.Stdout)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// useBlockStyle clears the flow and quoting styles inherited from JSON.
+// The encoder still quotes strings that would otherwise change type.
+func useBlockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		useBlockStyle(child)
+	}
+}
+
+// PrintNDJSON prints list responses as one JSON resource per line.
+// Other values are printed as a single JSON line.
+func PrintNDJSON(data any) error {
+	items, ok := ndjsonItems(data)
+	if !ok {
+		return PrintJSON(data)
+	}
+	enc := json.NewEncoder(os.Stdout)
+	for i := 0; i < items.Len(); i++ {
+		if err := enc.Encode(items.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ndjsonItems returns the Data slice of a list response, or the "data"
+// array of a decoded JSON document.
+func ndjsonItems(data any) (reflect.Value, bool) {
+	if document, ok := data.(map[string]any); ok {
+		switch items := document["data"].(type) {
+		case []any:
+			return reflect.ValueOf(items), true
+		case nil:
+			// An emptied list (e.g. after streaming pages) prints nothing.
+			_, present := document["data"]
+			return reflect.ValueOf([]any(nil)), present
+		}
+		return reflect.Value{}, false
+	}
+	value := reflect.ValueOf(data)
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}, false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	field := value.FieldByName("Data")
+	if !field.IsValid() || field.Kind() != reflect.Slice || field.Type().Elem().Kind() == reflect.Uint8 {
+		return reflect.Value{}, false
+	}
+	return field, true
+}
+
 // PrintJSON prints data as minified JSON (best for AI agents).
 func PrintJSON(data any) error {
 	enc := json.NewEncoder(os.Stdout)
@@ -0,0 +1,115 @@
+package asc
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func testFeedbackResponse() *FeedbackResponse {
+	return &FeedbackResponse{
+		Data: []Resource[FeedbackAttributes]{
+			{
+				ID: "1",
+				Attributes: FeedbackAttributes{
+					CreatedDate: "2026-01-20T00:00:00Z",
+					Email:       "tester@example.com",
+					Comment:     "Looks good, ship it",
+				},
+			},
+			{
+				ID: "2",
+				Attributes: FeedbackAttributes{
+					CreatedDate: "2026-01-21T00:00:00Z",
+					Email:       "other@example.com",
+					Comment:     "Crashes\ton launch",
+				},
+			},
+		},
+	}
+}
+
+func TestPrintDelimited(t *testing.T) {
+	tests := []struct {
+		name  string
+		print func(any) error
+		comma rune
+	}{
+		{name: "csv", print: PrintCSV, comma: ','},
+		{name: "tsv", print: PrintTSV, comma: '\t'},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := captureStdout(t, func() error {
+				return test.print(testFeedbackResponse())
+			})
+
+			reader := csv.NewReader(strings.NewReader(output))
+			reader.Comma = test.comma
+			records, err := reader.ReadAll()
+			if err != nil {
+				t.Fatalf("parse %s output %q: %v", test.name, output, err)
+			}
+			if len(records) != 3 {
+				t.Fatalf("expected header and 2 rows, got %d: %q", len(records), output)
+			}
+			if records[0][0] != "Created" {
+				t.Fatalf("expected table headers, got %v", records[0])
+			}
+			if !strings.Contains(strings.Join(records[1], "|"), "Looks good, ship it") {
+				t.Fatalf("expected comment in first row, got %v", records[1])
+			}
+		})
+	}
+}
+
+func TestPrintYAML_UsesJSONFieldNames(t *testing.T) {
+	output := captureStdout(t, func() error {
+		return PrintYAML(&AppsResponse{
+			Data: []Resource[AppAttributes]{
+				{Type: ResourceTypeApps, ID: "123", Attributes: AppAttributes{Name: "Demo", BundleID: "com.example.demo", SKU: "true"}},
+			},
+		})
+	})
+
+	for _, want := range []string{"data:\n", "  - type: apps\n", "    id: \"123\"\n", "      bundleId: com.example.demo\n", "      sku: \"true\"\n"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in YAML output, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestPrintNDJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      any
+		wantLines int
+	}{
+		{name: "list prints one resource per line", data: testFeedbackResponse(), wantLines: 2},
+		{name: "empty list prints nothing", data: &FeedbackResponse{}, wantLines: 0},
+		{name: "single resource prints one line", data: &AppResponse{Data: Resource[AppAttributes]{ID: "123"}}, wantLines: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := captureStdout(t, func() error {
+				return PrintNDJSON(test.data)
+			})
+			lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+			if output == "" {
+				lines = nil
+			}
+			if len(lines) != test.wantLines {
+				t.Fatalf("expected %d lines, got %d: %q", test.wantLines, len(lines), output)
+			}
+			for _, line := range lines {
+				var decoded map[string]any
+				if err := json.Unmarshal([]byte(line), &decoded); err != nil {
+					t.Fatalf("line %q is not a JSON object: %v", line, err)
+				}
+			}
+		})
+	}
+}
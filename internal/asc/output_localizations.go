@@ -32,6 +32,8 @@ type LocalizationDownloadResult struct {
 	VersionID  string                   `json:"versionId,omitempty"`
 	AppID      string                   `json:"appId,omitempty"`
 	AppInfoID  string                   `json:"appInfoId,omitempty"`
+	BuildID    string                   `json:"buildId,omitempty"`
+	Format     string                   `json:"format,omitempty"`
 	OutputPath string                   `json:"outputPath"`
 	Files      []LocalizationFileResult `json:"files"`
 }
@@ -49,6 +51,8 @@ type LocalizationUploadResult struct {
 	VersionID string                           `json:"versionId,omitempty"`
 	AppID     string                           `json:"appId,omitempty"`
 	AppInfoID string                           `json:"appInfoId,omitempty"`
+	BuildID   string                           `json:"buildId,omitempty"`
+	Format    string                           `json:"format,omitempty"`
 	DryRun    bool                             `json:"dryRun"`
 	Results   []LocalizationUploadLocaleResult `json:"results"`
 }
@@ -0,0 +1,41 @@
+package asc
+
+// MetadataChange describes one field-level change in a metadata plan.
+type MetadataChange struct {
+	Action string `json:"action"`
+	Locale string `json:"locale,omitempty"`
+	Field  string `json:"field"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// MetadataPlanResult represents CLI output for metadata plan and apply.
+type MetadataPlanResult struct {
+	File      string           `json:"file"`
+	AppID     string           `json:"appId"`
+	VersionID string           `json:"versionId"`
+	Applied   bool             `json:"applied"`
+	Changes   []MetadataChange `json:"changes"`
+}
+
+func metadataPlanResultRows(result *MetadataPlanResult) ([]string, [][]string) {
+	headers := []string{"Action", "Locale", "Field", "From", "To", "Status"}
+	rows := make([][]string, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		status := change.Status
+		if change.Error != "" {
+			status = status + ": " + change.Error
+		}
+		rows = append(rows, []string{
+			change.Action,
+			change.Locale,
+			change.Field,
+			compactWhitespace(change.From),
+			compactWhitespace(change.To),
+			compactWhitespace(status),
+		})
+	}
+	return headers, rows
+}
@@ -3,6 +3,7 @@ package asc
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 )
 
 // PerformanceDownloadResult represents CLI output for performance downloads.
@@ -18,6 +19,43 @@ type PerformanceDownloadResult struct {
 	DecompressedSize      int64  `json:"decompressedSize,omitempty"`
 }
 
+// Performance comparison statuses.
+const (
+	PerformanceMetricRegressed = "regressed"
+	PerformanceMetricImproved  = "improved"
+	PerformanceMetricUnchanged = "unchanged"
+	PerformanceMetricAdded     = "added"
+	PerformanceMetricRemoved   = "removed"
+)
+
+// PerformanceComparisonResult represents CLI output for a build-over-build
+// performance metrics comparison.
+type PerformanceComparisonResult struct {
+	AppID            string                    `json:"appId,omitempty"`
+	Base             string                    `json:"base"`
+	Head             string                    `json:"head"`
+	ThresholdPercent float64                   `json:"thresholdPercent"`
+	Regressions      int                       `json:"regressions"`
+	Improvements     int                       `json:"improvements"`
+	Metrics          []PerformanceMetricChange `json:"metrics"`
+}
+
+// PerformanceMetricChange compares one metric percentile on one device class
+// between the base and head builds. Base and Head are nil when the metric is
+// only reported for the other build.
+type PerformanceMetricChange struct {
+	Category     string   `json:"category"`
+	Metric       string   `json:"metric"`
+	Device       string   `json:"device"`
+	Percentile   string   `json:"percentile,omitempty"`
+	Unit         string   `json:"unit,omitempty"`
+	Base         *float64 `json:"base,omitempty"`
+	Head         *float64 `json:"head,omitempty"`
+	Delta        *float64 `json:"delta,omitempty"`
+	DeltaPercent *float64 `json:"deltaPercent,omitempty"`
+	Status       string   `json:"status"`
+}
+
 type perfPowerMetricsSummary struct {
 	Version         string
 	ProductCount    int
@@ -157,3 +195,37 @@ func performanceDownloadResultRows(result *PerformanceDownloadResult) ([]string,
 	}}
 	return headers, rows
 }
+
+func performanceComparisonResultRows(result *PerformanceComparisonResult) ([]string, [][]string) {
+	headers := []string{"Category", "Metric", "Device", "Percentile", "Base", "Head", "Delta", "Change", "Status"}
+	rows := make([][]string, 0, len(result.Metrics))
+	for _, metric := range result.Metrics {
+		change := ""
+		if metric.DeltaPercent != nil {
+			change = fmt.Sprintf("%+.1f%%", *metric.DeltaPercent)
+		}
+		rows = append(rows, []string{
+			metric.Category,
+			metric.Metric,
+			metric.Device,
+			metric.Percentile,
+			formatPerformanceValue(metric.Base, metric.Unit),
+			formatPerformanceValue(metric.Head, metric.Unit),
+			formatPerformanceValue(metric.Delta, metric.Unit),
+			change,
+			metric.Status,
+		})
+	}
+	return headers, rows
+}
+
+func formatPerformanceValue(value *float64, unit string) string {
+	if value == nil {
+		return ""
+	}
+	formatted := strconv.FormatFloat(*value, 'f', -1, 64)
+	if unit != "" {
+		formatted += " " + unit
+	}
+	return formatted
+}
@@ -0,0 +1,83 @@
+package asc
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// rawResponseRows renders JSON:API resources as Type/ID/Attributes rows, or
+// top-level keys as Field/Value rows for other documents.
+func rawResponseRows(resp *RawResponse) ([]string, [][]string) {
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(resp.Body, &document); err != nil || document == nil {
+		return []string{"Value"}, [][]string{{compactRawJSON(resp.Body)}}
+	}
+
+	if rawData, ok := document["data"]; ok {
+		var items []json.RawMessage
+		if err := json.Unmarshal(rawData, &items); err != nil {
+			items = []json.RawMessage{rawData}
+		}
+		headers := []string{"Type", "ID", "Attributes"}
+		rows := make([][]string, 0, len(items))
+		for _, item := range items {
+			var resource struct {
+				Type       string                     `json:"type"`
+				ID         string                     `json:"id"`
+				Attributes map[string]json.RawMessage `json:"attributes"`
+			}
+			if err := json.Unmarshal(item, &resource); err != nil {
+				rows = append(rows, []string{"", "", compactRawJSON(item)})
+				continue
+			}
+			rows = append(rows, []string{resource.Type, resource.ID, formatRawAttributes(resource.Attributes)})
+		}
+		return headers, rows
+	}
+
+	keys := make([]string, 0, len(document))
+	for key := range document {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	rows := make([][]string, 0, len(keys))
+	for _, key := range keys {
+		rows = append(rows, []string{key, compactRawJSON(document[key])})
+	}
+	return []string{"Field", "Value"}, rows
+}
+
+func rawListResponseRows(resp *RawListResponse) ([]string, [][]string) {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return []string{"Value"}, nil
+	}
+	return rawResponseRows(&RawResponse{Body: data})
+}
+
+func formatRawAttributes(attributes map[string]json.RawMessage) string {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value := compactRawJSON(attributes[key])
+		if value == "null" {
+			continue
+		}
+		parts = append(parts, key+"="+strings.Trim(value, `"`))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func compactRawJSON(data json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return string(data)
+	}
+	return buf.String()
+}
@@ -441,6 +441,8 @@ func init() {
 		return nil
 	})
 	registerRows(buildExpireAllResultRows)
+	registerRows(testFlightSyncPushResultRows)
+	registerRows(metadataPlanResultRows)
 	registerRows(appScreenshotListResultRows)
 	registerRows(screenshotSizesRows)
 	registerRows(appPreviewListResultRows)
@@ -465,6 +467,11 @@ func init() {
 	registerRows(appClipAdvancedExperienceImageUploadResultRows)
 	registerRows(appClipHeaderImageUploadResultRows)
 	registerRows(assetDeleteResultRows)
+	registerRows(assetSyncResultRows)
+	registerRows(crashSymbolicationResultRows)
+	registerRows(crashTriageResultRows)
+	registerRows(feedbackTriageResultRows)
+	registerRows(feedbackExportResultRows)
 	registerRows(appClipDefaultExperienceDeleteResultRows)
 	registerRows(appClipDefaultExperienceLocalizationDeleteResultRows)
 	registerRows(appClipAdvancedExperienceDeleteResultRows)
@@ -475,7 +482,13 @@ func init() {
 	registerRows(testFlightPublishResultRows)
 	registerRows(appStorePublishResultRows)
 	registerRows(salesReportResultRows)
+	registerRows(salesReportRowsRows)
+	registerRows(subscriptionReportRowsRows)
+	registerRows(subscriberReportRowsRows)
+	registerRows(salesReportSummaryRows)
 	registerRows(financeReportResultRows)
+	registerRows(financialReportRowsRows)
+	registerRows(reportBackfillResultRows)
 	registerRows(financeRegionsRows)
 	registerRows(analyticsReportRequestResultRows)
 	registerRows(analyticsReportRequestDeleteResultRows)
@@ -703,6 +716,10 @@ func init() {
 	registerRows(endUserLicenseAgreementDeleteResultRows)
 	registerRows(profileDownloadResultRows)
 	registerRows(signingFetchResultRows)
+	registerRows(certificateKeyResultRows)
+	registerDirect(profileInspectResultRender)
+	registerDirect(ipaInspectResultRender)
+	registerDirect(signingAuditResultRender)
 	registerRows(xcodeCloudRunResultRows)
 	registerRows(xcodeCloudStatusResultRows)
 	registerRows(ciProductsRows)
@@ -813,7 +830,12 @@ func init() {
 	registerRows(diagnosticSignaturesRows)
 	registerRowsErr(diagnosticLogsRows)
 	registerRows(performanceDownloadResultRows)
+	registerRows(performanceComparisonResultRows)
+	registerRows(storeKitExportResultRows)
+	registerRows(storeKitImportResultRows)
 	registerRows(notarySubmissionStatusRows)
 	registerRows(notarySubmissionsListRows)
 	registerRows(notarySubmissionLogsRows)
+	registerRows(rawResponseRows)
+	registerRows(rawListResponseRows)
 }
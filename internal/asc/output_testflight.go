@@ -162,3 +162,41 @@ func betaGroupMetricsRows(items []Resource[BetaGroupMetricAttributes]) ([]string
 	}
 	return headers, rows
 }
+
+// TestFlightSyncAction describes one change in a TestFlight sync push plan.
+type TestFlightSyncAction struct {
+	Action  string `json:"action"`
+	Group   string `json:"group,omitempty"`
+	Target  string `json:"target,omitempty"`
+	Details string `json:"details,omitempty"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+// TestFlightSyncPushResult represents CLI output for testflight sync push.
+type TestFlightSyncPushResult struct {
+	File    string                 `json:"file"`
+	AppID   string                 `json:"appId"`
+	DryRun  bool                   `json:"dryRun"`
+	Prune   bool                   `json:"prune"`
+	Actions []TestFlightSyncAction `json:"actions"`
+}
+
+func testFlightSyncPushResultRows(result *TestFlightSyncPushResult) ([]string, [][]string) {
+	headers := []string{"Action", "Group", "Target", "Details", "Status"}
+	rows := make([][]string, 0, len(result.Actions))
+	for _, action := range result.Actions {
+		status := action.Status
+		if action.Error != "" {
+			status = status + ": " + action.Error
+		}
+		rows = append(rows, []string{
+			action.Action,
+			compactWhitespace(action.Group),
+			compactWhitespace(action.Target),
+			compactWhitespace(action.Details),
+			compactWhitespace(status),
+		})
+	}
+	return headers, rows
+}
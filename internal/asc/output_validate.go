@@ -13,6 +13,10 @@ func init() {
 		render(h, r)
 		oh, or := validationCheckRows(v)
 		render(oh, or)
+		if len(v.Suppressed) > 0 {
+			sh, sr := validationSuppressedRows(v)
+			render(sh, sr)
+		}
 		return nil
 	})
 }
@@ -54,6 +58,23 @@ func validationCheckRows(report *validation.Report) ([]string, [][]string) {
 	return headers, rows
 }
 
+func validationSuppressedRows(report *validation.Report) ([]string, [][]string) {
+	headers := []string{"Suppressed", "Check ID", "Locale", "Field", "Resource", "Message", "Justification"}
+	rows := make([][]string, 0, len(report.Suppressed))
+	for _, check := range report.Suppressed {
+		rows = append(rows, []string{
+			string(check.Severity),
+			check.ID,
+			check.Locale,
+			check.Field,
+			formatResource(check.ResourceType, check.ResourceID),
+			check.Message,
+			check.Justification,
+		})
+	}
+	return headers, rows
+}
+
 func formatResource(resourceType, resourceID string) string {
 	if resourceType == "" && resourceID == "" {
 		return ""
@@ -208,6 +208,26 @@ func TestGetAppPriceScheduleManualPrices(t *testing.T) {
 	}
 }
 
+func TestGetAppPriceScheduleManualPrices_WithInclude(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		values := req.URL.Query()
+		if values.Get("include") != "appPricePoint,territory" {
+			t.Fatalf("expected include=appPricePoint,territory, got %q", values.Get("include"))
+		}
+		if values.Get("limit") != "200" {
+			t.Fatalf("expected limit=200, got %q", values.Get("limit"))
+		}
+	}, jsonResponse(http.StatusOK, `{"data":[]}`))
+
+	_, err := client.GetAppPriceScheduleManualPrices(context.Background(), "schedule-1",
+		WithAppPricesInclude([]string{"appPricePoint", "territory"}),
+		WithAppPricesLimit(200),
+	)
+	if err != nil {
+		t.Fatalf("GetAppPriceScheduleManualPrices() error: %v", err)
+	}
+}
+
 func TestGetAppPriceScheduleAutomaticPrices(t *testing.T) {
 	resp := AppPricesResponse{
 		Data: []Resource[AppPriceAttributes]{{Type: ResourceTypeAppPrices, ID: "price-1"}},
@@ -0,0 +1,126 @@
+package asc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ProfileInspectResult describes a decoded provisioning profile.
+type ProfileInspectResult struct {
+	Source                string                   `json:"source"`
+	Name                  string                   `json:"name"`
+	UUID                  string                   `json:"uuid"`
+	ProfileType           string                   `json:"profileType"`
+	TeamID                string                   `json:"teamId,omitempty"`
+	TeamName              string                   `json:"teamName,omitempty"`
+	AppIDName             string                   `json:"appIdName,omitempty"`
+	AppIDPrefix           string                   `json:"appIdPrefix,omitempty"`
+	ApplicationIdentifier string                   `json:"applicationIdentifier,omitempty"`
+	BundleID              string                   `json:"bundleId,omitempty"`
+	Platforms             []string                 `json:"platforms,omitempty"`
+	CreationDate          string                   `json:"creationDate,omitempty"`
+	ExpirationDate        string                   `json:"expirationDate"`
+	Expired               bool                     `json:"expired"`
+	DaysUntilExpiration   int                      `json:"daysUntilExpiration"`
+	ProvisionsAllDevices  bool                     `json:"provisionsAllDevices,omitempty"`
+	ProvisionedDevices    []string                 `json:"provisionedDevices,omitempty"`
+	Entitlements          map[string]any           `json:"entitlements,omitempty"`
+	Certificates          []ProfileCertificateInfo `json:"certificates"`
+	CertificatesChecked   bool                     `json:"certificatesChecked"`
+	Warnings              []string                 `json:"warnings,omitempty"`
+}
+
+// ProfileCertificateInfo describes a developer certificate embedded in a
+// provisioning profile.
+type ProfileCertificateInfo struct {
+	CommonName     string `json:"commonName"`
+	SerialNumber   string `json:"serialNumber"`
+	ExpirationDate string `json:"expirationDate"`
+	Expired        bool   `json:"expired"`
+	SHA1           string `json:"sha1"`
+	SHA256         string `json:"sha256"`
+	// CertificateID is the matching App Store Connect certificate, when the
+	// profile was cross-checked and a match was found.
+	CertificateID string `json:"certificateId,omitempty"`
+	// InAppStoreConnect reports whether the certificate is still listed in
+	// App Store Connect; nil when the cross-check was skipped.
+	InAppStoreConnect *bool `json:"inAppStoreConnect,omitempty"`
+}
+
+func profileInspectResultRender(result *ProfileInspectResult, render func([]string, [][]string)) error {
+	devices := fmt.Sprintf("%d", len(result.ProvisionedDevices))
+	if result.ProvisionsAllDevices {
+		devices = "all (enterprise)"
+	}
+	render([]string{"Field", "Value"}, [][]string{
+		{"Source", result.Source},
+		{"Name", result.Name},
+		{"UUID", result.UUID},
+		{"Type", result.ProfileType},
+		{"Team", strings.TrimSpace(result.TeamID + " " + result.TeamName)},
+		{"App ID", result.ApplicationIdentifier},
+		{"App ID Prefix", result.AppIDPrefix},
+		{"Bundle ID", result.BundleID},
+		{"Platforms", strings.Join(result.Platforms, ", ")},
+		{"Created", result.CreationDate},
+		{"Expires", result.ExpirationDate},
+		{"Expired", fmt.Sprintf("%t", result.Expired)},
+		{"Devices", devices},
+	})
+
+	certRows := make([][]string, 0, len(result.Certificates))
+	for _, cert := range result.Certificates {
+		inASC := "unchecked"
+		if cert.InAppStoreConnect != nil {
+			inASC = fmt.Sprintf("%t", *cert.InAppStoreConnect)
+		}
+		certRows = append(certRows, []string{cert.CommonName, cert.SerialNumber, cert.ExpirationDate, cert.SHA1, cert.CertificateID, inASC})
+	}
+	render([]string{"Certificate", "Serial", "Expires", "SHA-1", "ASC ID", "In ASC"}, certRows)
+
+	if len(result.Entitlements) > 0 {
+		keys := make([]string, 0, len(result.Entitlements))
+		for key := range result.Entitlements {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		rows := make([][]string, 0, len(keys))
+		for _, key := range keys {
+			rows = append(rows, []string{key, formatEntitlementValue(result.Entitlements[key])})
+		}
+		render([]string{"Entitlement", "Value"}, rows)
+	}
+
+	if len(result.ProvisionedDevices) > 0 {
+		rows := make([][]string, 0, len(result.ProvisionedDevices))
+		for _, udid := range result.ProvisionedDevices {
+			rows = append(rows, []string{udid})
+		}
+		render([]string{"Provisioned Device"}, rows)
+	}
+
+	if len(result.Warnings) > 0 {
+		rows := make([][]string, 0, len(result.Warnings))
+		for _, warning := range result.Warnings {
+			rows = append(rows, []string{warning})
+		}
+		render([]string{"Warning"}, rows)
+	}
+	return nil
+}
+
+func formatEntitlementValue(value any) string {
+	switch v := value.(type) {
+	case []any:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			parts = append(parts, formatEntitlementValue(item))
+		}
+		return strings.Join(parts, ", ")
+	case map[string]any:
+		return fmt.Sprintf("%d keys", len(v))
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
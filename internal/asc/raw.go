@@ -0,0 +1,107 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// RawResponse wraps an untyped App Store Connect API document, as returned
+// by Client.Request. JSON output preserves the body as received.
+type RawResponse struct {
+	Body json.RawMessage `json:"-"`
+}
+
+// MarshalJSON preserves the raw API JSON.
+func (r RawResponse) MarshalJSON() ([]byte, error) {
+	if len(bytes.TrimSpace(r.Body)) == 0 {
+		return []byte("null"), nil
+	}
+	return r.Body, nil
+}
+
+// RawListResponse is an untyped JSON:API collection used to paginate raw
+// requests with PaginateAll.
+type RawListResponse struct {
+	Data     []json.RawMessage `json:"data"`
+	Included []json.RawMessage `json:"included,omitempty"`
+	Links    Links             `json:"links"`
+	Meta     json.RawMessage   `json:"meta,omitempty"`
+}
+
+// GetLinks returns the links field for pagination.
+func (r *RawListResponse) GetLinks() *Links {
+	return &r.Links
+}
+
+// GetData returns the data field for aggregation.
+func (r *RawListResponse) GetData() any {
+	return r.Data
+}
+
+// Request sends an authenticated request to an App Store Connect API path
+// (e.g. "/v1/apps/123") or to an absolute pagination URL on a trusted host.
+// Query values are appended to any query already present in path.
+// GET and HEAD requests are retried like other client calls.
+func (c *Client) Request(ctx context.Context, method, path string, query url.Values, body []byte) (*RawResponse, error) {
+	method = strings.ToUpper(strings.TrimSpace(method))
+	if method == "" {
+		return nil, fmt.Errorf("method is required")
+	}
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, fmt.Errorf("path is required")
+	}
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		if err := validateNextURL(path); err != nil {
+			return nil, err
+		}
+	} else if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	if len(query) > 0 {
+		path = appendRawQuery(path, query)
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	data, err := c.do(ctx, method, path, reader)
+	if err != nil {
+		return nil, err
+	}
+	return &RawResponse{Body: data}, nil
+}
+
+// RequestList sends a GET request and decodes a JSON:API collection.
+func (c *Client) RequestList(ctx context.Context, path string, query url.Values) (*RawListResponse, error) {
+	resp, err := c.Request(ctx, http.MethodGet, path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	var list RawListResponse
+	if err := json.Unmarshal(resp.Body, &list); err != nil {
+		return nil, fmt.Errorf("response is not a JSON:API collection: %w", err)
+	}
+	return &list, nil
+}
+
+func appendRawQuery(path string, query url.Values) string {
+	base, existing, _ := strings.Cut(path, "?")
+	values, err := url.ParseQuery(existing)
+	if err != nil {
+		values = url.Values{}
+	}
+	for key, vals := range query {
+		for _, value := range vals {
+			values.Add(key, value)
+		}
+	}
+	return base + "?" + values.Encode()
+}
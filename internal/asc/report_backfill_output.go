@@ -0,0 +1,47 @@
+package asc
+
+import "fmt"
+
+// Report backfill period statuses.
+const (
+	ReportPeriodDownloaded  = "downloaded"
+	ReportPeriodSkipped     = "skipped"
+	ReportPeriodUnavailable = "unavailable"
+	ReportPeriodFailed      = "failed"
+)
+
+// ReportBackfillResult represents CLI output for a sales or finance report
+// date-range backfill into a local archive.
+type ReportBackfillResult struct {
+	ArchiveDir   string                 `json:"archiveDir"`
+	ManifestPath string                 `json:"manifestPath"`
+	From         string                 `json:"from"`
+	To           string                 `json:"to"`
+	Downloaded   int                    `json:"downloaded"`
+	Skipped      int                    `json:"skipped"`
+	Unavailable  int                    `json:"unavailable"`
+	Failed       int                    `json:"failed"`
+	Data         []ReportBackfillPeriod `json:"data"`
+}
+
+// ReportBackfillPeriod is the outcome for one report period of a backfill.
+type ReportBackfillPeriod struct {
+	Period   string `json:"period"`
+	Status   string `json:"status"`
+	FilePath string `json:"filePath,omitempty"`
+	FileSize int64  `json:"fileSize,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+func reportBackfillResultRows(result *ReportBackfillResult) ([]string, [][]string) {
+	headers := []string{"Period", "Status", "File", "Size", "Error"}
+	rows := make([][]string, 0, len(result.Data))
+	for _, period := range result.Data {
+		size := ""
+		if period.FileSize > 0 {
+			size = fmt.Sprintf("%d", period.FileSize)
+		}
+		rows = append(rows, []string{period.Period, period.Status, period.FilePath, size, compactWhitespace(period.Error)})
+	}
+	return headers, rows
+}
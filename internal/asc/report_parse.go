@@ -0,0 +1,309 @@
+package asc
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SalesReportRow is one line of a SALES (Summary Sales) report.
+type SalesReportRow struct {
+	Provider              string  `json:"provider,omitempty" tsv:"Provider"`
+	ProviderCountry       string  `json:"providerCountry,omitempty" tsv:"Provider Country"`
+	SKU                   string  `json:"sku" tsv:"SKU,required"`
+	Developer             string  `json:"developer,omitempty" tsv:"Developer"`
+	Title                 string  `json:"title,omitempty" tsv:"Title"`
+	Version               string  `json:"version,omitempty" tsv:"Version"`
+	ProductTypeIdentifier string  `json:"productTypeIdentifier" tsv:"Product Type Identifier,required"`
+	Units                 float64 `json:"units" tsv:"Units,required"`
+	DeveloperProceeds     float64 `json:"developerProceeds" tsv:"Developer Proceeds,required"`
+	BeginDate             string  `json:"beginDate" tsv:"Begin Date,required"`
+	EndDate               string  `json:"endDate,omitempty" tsv:"End Date"`
+	CustomerCurrency      string  `json:"customerCurrency,omitempty" tsv:"Customer Currency"`
+	CountryCode           string  `json:"countryCode" tsv:"Country Code,required"`
+	CurrencyOfProceeds    string  `json:"currencyOfProceeds" tsv:"Currency of Proceeds,required"`
+	AppleIdentifier       string  `json:"appleIdentifier,omitempty" tsv:"Apple Identifier"`
+	CustomerPrice         float64 `json:"customerPrice" tsv:"Customer Price"`
+	PromoCode             string  `json:"promoCode,omitempty" tsv:"Promo Code"`
+	ParentIdentifier      string  `json:"parentIdentifier,omitempty" tsv:"Parent Identifier"`
+	Subscription          string  `json:"subscription,omitempty" tsv:"Subscription"`
+	Period                string  `json:"period,omitempty" tsv:"Period"`
+	Category              string  `json:"category,omitempty" tsv:"Category"`
+	CMB                   string  `json:"cmb,omitempty" tsv:"CMB"`
+	Device                string  `json:"device,omitempty" tsv:"Device"`
+	SupportedPlatforms    string  `json:"supportedPlatforms,omitempty" tsv:"Supported Platforms"`
+	ProceedsReason        string  `json:"proceedsReason,omitempty" tsv:"Proceeds Reason"`
+	PreservedPricing      string  `json:"preservedPricing,omitempty" tsv:"Preserved Pricing"`
+	Client                string  `json:"client,omitempty" tsv:"Client"`
+	OrderType             string  `json:"orderType,omitempty" tsv:"Order Type"`
+}
+
+// SubscriptionReportRow is one line of a SUBSCRIPTION report (active
+// subscription counts per app, subscription, offer, and country).
+type SubscriptionReportRow struct {
+	AppName                            string  `json:"appName" tsv:"App Name,required"`
+	AppAppleID                         string  `json:"appAppleId" tsv:"App Apple ID,required"`
+	SubscriptionName                   string  `json:"subscriptionName" tsv:"Subscription Name,required"`
+	SubscriptionAppleID                string  `json:"subscriptionAppleId" tsv:"Subscription Apple ID,required"`
+	SubscriptionGroupID                string  `json:"subscriptionGroupId,omitempty" tsv:"Subscription Group ID"`
+	StandardSubscriptionDuration       string  `json:"standardSubscriptionDuration,omitempty" tsv:"Standard Subscription Duration"`
+	SubscriptionOfferName              string  `json:"subscriptionOfferName,omitempty" tsv:"Subscription Offer Name|Promotional Offer Name"`
+	PromotionalOfferID                 string  `json:"promotionalOfferId,omitempty" tsv:"Promotional Offer ID"`
+	CustomerPrice                      float64 `json:"customerPrice" tsv:"Customer Price"`
+	CustomerCurrency                   string  `json:"customerCurrency,omitempty" tsv:"Customer Currency"`
+	DeveloperProceeds                  float64 `json:"developerProceeds" tsv:"Developer Proceeds"`
+	ProceedsCurrency                   string  `json:"proceedsCurrency,omitempty" tsv:"Proceeds Currency"`
+	PreservedPricing                   string  `json:"preservedPricing,omitempty" tsv:"Preserved Pricing"`
+	ProceedsReason                     string  `json:"proceedsReason,omitempty" tsv:"Proceeds Reason"`
+	Client                             string  `json:"client,omitempty" tsv:"Client"`
+	Device                             string  `json:"device,omitempty" tsv:"Device"`
+	State                              string  `json:"state,omitempty" tsv:"State"`
+	Country                            string  `json:"country" tsv:"Country,required"`
+	ActiveStandardPriceSubscriptions   float64 `json:"activeStandardPriceSubscriptions" tsv:"Active Standard Price Subscriptions"`
+	ActiveFreeTrialIntroductoryOffers  float64 `json:"activeFreeTrialIntroductoryOfferSubscriptions" tsv:"Active Free Trial Introductory Offer Subscriptions"`
+	ActivePayUpFrontIntroductoryOffers float64 `json:"activePayUpFrontIntroductoryOfferSubscriptions" tsv:"Active Pay Up Front Introductory Offer Subscriptions"`
+	ActivePayAsYouGoIntroductoryOffers float64 `json:"activePayAsYouGoIntroductoryOfferSubscriptions" tsv:"Active Pay As You Go Introductory Offer Subscriptions"`
+	FreeTrialPromotionalOffers         float64 `json:"freeTrialPromotionalOfferSubscriptions" tsv:"Free Trial Promotional Offer Subscriptions"`
+	PayUpFrontPromotionalOffers        float64 `json:"payUpFrontPromotionalOfferSubscriptions" tsv:"Pay Up Front Promotional Offer Subscriptions"`
+	PayAsYouGoPromotionalOffers        float64 `json:"payAsYouGoPromotionalOfferSubscriptions" tsv:"Pay As You Go Promotional Offer Subscriptions"`
+	FreeTrialOfferCodes                float64 `json:"freeTrialOfferCodeSubscriptions" tsv:"Free Trial Offer Code Subscriptions"`
+	PayUpFrontOfferCodes               float64 `json:"payUpFrontOfferCodeSubscriptions" tsv:"Pay Up Front Offer Code Subscriptions"`
+	PayAsYouGoOfferCodes               float64 `json:"payAsYouGoOfferCodeSubscriptions" tsv:"Pay As You Go Offer Code Subscriptions"`
+	MarketingOptIns                    float64 `json:"marketingOptIns" tsv:"Marketing Opt-Ins"`
+	BillingRetry                       float64 `json:"billingRetry" tsv:"Billing Retry"`
+	GracePeriod                        float64 `json:"gracePeriod" tsv:"Grace Period"`
+	Subscribers                        float64 `json:"subscribers" tsv:"Subscribers"`
+}
+
+// SubscriberReportRow is one line of a SUBSCRIBER report (subscription
+// events per anonymized subscriber).
+type SubscriberReportRow struct {
+	EventDate                    string  `json:"eventDate" tsv:"Event Date,required"`
+	AppName                      string  `json:"appName" tsv:"App Name,required"`
+	AppAppleID                   string  `json:"appAppleId" tsv:"App Apple ID,required"`
+	SubscriptionName             string  `json:"subscriptionName" tsv:"Subscription Name,required"`
+	SubscriptionAppleID          string  `json:"subscriptionAppleId" tsv:"Subscription Apple ID,required"`
+	SubscriptionGroupID          string  `json:"subscriptionGroupId,omitempty" tsv:"Subscription Group ID"`
+	StandardSubscriptionDuration string  `json:"standardSubscriptionDuration,omitempty" tsv:"Standard Subscription Duration"`
+	SubscriptionOfferName        string  `json:"subscriptionOfferName,omitempty" tsv:"Subscription Offer Name|Promotional Offer Name"`
+	PromotionalOfferID           string  `json:"promotionalOfferId,omitempty" tsv:"Promotional Offer ID"`
+	SubscriptionOfferType        string  `json:"subscriptionOfferType,omitempty" tsv:"Subscription Offer Type"`
+	SubscriptionOfferDuration    string  `json:"subscriptionOfferDuration,omitempty" tsv:"Subscription Offer Duration"`
+	MarketingOptInDuration       string  `json:"marketingOptInDuration,omitempty" tsv:"Marketing Opt-In Duration"`
+	CustomerPrice                float64 `json:"customerPrice" tsv:"Customer Price"`
+	CustomerCurrency             string  `json:"customerCurrency,omitempty" tsv:"Customer Currency"`
+	DeveloperProceeds            float64 `json:"developerProceeds" tsv:"Developer Proceeds"`
+	ProceedsCurrency             string  `json:"proceedsCurrency,omitempty" tsv:"Proceeds Currency"`
+	PreservedPricing             string  `json:"preservedPricing,omitempty" tsv:"Preserved Pricing"`
+	ProceedsReason               string  `json:"proceedsReason,omitempty" tsv:"Proceeds Reason"`
+	Client                       string  `json:"client,omitempty" tsv:"Client"`
+	Device                       string  `json:"device,omitempty" tsv:"Device"`
+	Country                      string  `json:"country" tsv:"Country,required"`
+	SubscriberID                 string  `json:"subscriberId" tsv:"Subscriber ID,required"`
+	SubscriberIDReset            string  `json:"subscriberIdReset,omitempty" tsv:"Subscriber ID Reset"`
+	Refund                       string  `json:"refund,omitempty" tsv:"Refund"`
+	PurchaseDate                 string  `json:"purchaseDate,omitempty" tsv:"Purchase Date"`
+	Units                        float64 `json:"units" tsv:"Units"`
+}
+
+// FinancialReportRow is one line of a FINANCIAL report.
+type FinancialReportRow struct {
+	StartDate             string  `json:"startDate" tsv:"Start Date,required"`
+	EndDate               string  `json:"endDate" tsv:"End Date,required"`
+	UPC                   string  `json:"upc,omitempty" tsv:"UPC"`
+	ISRC                  string  `json:"isrc,omitempty" tsv:"ISRC/ISBN"`
+	VendorIdentifier      string  `json:"vendorIdentifier" tsv:"Vendor Identifier,required"`
+	Quantity              float64 `json:"quantity" tsv:"Quantity,required"`
+	PartnerShare          float64 `json:"partnerShare" tsv:"Partner Share,required"`
+	ExtendedPartnerShare  float64 `json:"extendedPartnerShare" tsv:"Extended Partner Share,required"`
+	PartnerShareCurrency  string  `json:"partnerShareCurrency" tsv:"Partner Share Currency,required"`
+	SalesOrReturn         string  `json:"salesOrReturn,omitempty" tsv:"Sales or Return"`
+	AppleIdentifier       string  `json:"appleIdentifier,omitempty" tsv:"Apple Identifier"`
+	Developer             string  `json:"developer,omitempty" tsv:"Artist/Show/Developer/Author"`
+	Title                 string  `json:"title,omitempty" tsv:"Title"`
+	Publisher             string  `json:"publisher,omitempty" tsv:"Label/Studio/Network/Developer/Publisher"`
+	Grid                  string  `json:"grid,omitempty" tsv:"Grid"`
+	ProductTypeIdentifier string  `json:"productTypeIdentifier,omitempty" tsv:"Product Type Identifier"`
+	OtherIdentifier       string  `json:"otherIdentifier,omitempty" tsv:"ISAN/Other Identifier"`
+	CountryOfSale         string  `json:"countryOfSale" tsv:"Country Of Sale,required"`
+	PreOrderFlag          string  `json:"preOrderFlag,omitempty" tsv:"Pre-order Flag"`
+	PromoCode             string  `json:"promoCode,omitempty" tsv:"Promo Code"`
+	CustomerPrice         float64 `json:"customerPrice" tsv:"Customer Price"`
+	CustomerCurrency      string  `json:"customerCurrency,omitempty" tsv:"Customer Currency"`
+}
+
+// ParseSalesReport parses a SALES report; r may be gzip-compressed.
+func ParseSalesReport(r io.Reader) ([]SalesReportRow, error) {
+	return parseReport[SalesReportRow](r, "SALES")
+}
+
+// ParseSubscriptionReport parses a SUBSCRIPTION report; r may be gzip-compressed.
+func ParseSubscriptionReport(r io.Reader) ([]SubscriptionReportRow, error) {
+	return parseReport[SubscriptionReportRow](r, "SUBSCRIPTION")
+}
+
+// ParseSubscriberReport parses a SUBSCRIBER report; r may be gzip-compressed.
+func ParseSubscriberReport(r io.Reader) ([]SubscriberReportRow, error) {
+	return parseReport[SubscriberReportRow](r, "SUBSCRIBER")
+}
+
+// ParseFinancialReport parses a FINANCIAL report; r may be gzip-compressed.
+// Total_ trailer lines and repeated section headers are skipped.
+func ParseFinancialReport(r io.Reader) ([]FinancialReportRow, error) {
+	return parseReport[FinancialReportRow](r, "FINANCIAL")
+}
+
+// NormalizeReportDate converts report dates (MM/DD/YYYY or YYYY-MM-DD) to
+// YYYY-MM-DD, returning other values unchanged.
+func NormalizeReportDate(value string) string {
+	value = strings.TrimSpace(value)
+	if parsed, err := time.Parse("01/02/2006", value); err == nil {
+		return parsed.Format("2006-01-02")
+	}
+	return value
+}
+
+// reportColumn maps a header to a struct field.
+type reportColumn struct {
+	names    []string
+	field    int
+	required bool
+}
+
+func reportColumns(t reflect.Type) []reportColumn {
+	columns := make([]reportColumn, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("tsv")
+		if tag == "" {
+			continue
+		}
+		names, options, _ := strings.Cut(tag, ",")
+		columns = append(columns, reportColumn{
+			names:    strings.Split(names, "|"),
+			field:    i,
+			required: options == "required",
+		})
+	}
+	return columns
+}
+
+func parseReport[T any](r io.Reader, layout string) ([]T, error) {
+	reader, err := maybeGunzip(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tsv := csv.NewReader(reader)
+	tsv.Comma = '\t'
+	tsv.LazyQuotes = true
+	tsv.FieldsPerRecord = -1
+	tsv.ReuseRecord = true
+
+	header, err := tsv.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s report is empty", layout)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read %s report header: %w", layout, err)
+	}
+	header = append([]string(nil), header...)
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		positions[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	t := reflect.TypeFor[T]()
+	columns := reportColumns(t)
+	indexes := make([]int, len(columns))
+	for i, column := range columns {
+		indexes[i] = -1
+		for _, name := range column.names {
+			if pos, ok := positions[strings.ToLower(name)]; ok {
+				indexes[i] = pos
+				break
+			}
+		}
+		if indexes[i] < 0 && column.required {
+			return nil, fmt.Errorf("not a %s report: missing column %q", layout, column.names[0])
+		}
+	}
+
+	var rows []T
+	for line := 2; ; line++ {
+		record, err := tsv.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read %s report line %d: %w", layout, line, err)
+		}
+		if isReportFooter(record, header) {
+			continue
+		}
+
+		var row T
+		value := reflect.ValueOf(&row).Elem()
+		for i, column := range columns {
+			pos := indexes[i]
+			if pos < 0 || pos >= len(record) {
+				continue
+			}
+			raw := strings.TrimSpace(record[pos])
+			field := value.Field(column.field)
+			switch field.Kind() {
+			case reflect.Float64:
+				if raw == "" {
+					continue
+				}
+				number, err := strconv.ParseFloat(strings.ReplaceAll(raw, ",", ""), 64)
+				if err != nil {
+					return nil, fmt.Errorf("%s report line %d: column %q: invalid number %q", layout, line, column.names[0], raw)
+				}
+				field.SetFloat(number)
+			default:
+				field.SetString(raw)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// isReportFooter reports blank lines, Total_ trailers, and repeated headers.
+func isReportFooter(record, header []string) bool {
+	if len(record) == 0 {
+		return true
+	}
+	first := strings.TrimSpace(record[0])
+	if len(record) == 1 && first == "" {
+		return true
+	}
+	if strings.HasPrefix(first, "Total_") {
+		return true
+	}
+	return len(record) == len(header) && first == strings.TrimSpace(header[0]) && strings.TrimSpace(record[len(record)-1]) == strings.TrimSpace(header[len(header)-1])
+}
+
+func maybeGunzip(r io.Reader) (io.Reader, error) {
+	buffered := bufio.NewReader(r)
+	magic, err := buffered.Peek(2)
+	if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, fmt.Errorf("open gzip report: %w", err)
+		}
+		return gz, nil
+	}
+	return buffered, nil
+}
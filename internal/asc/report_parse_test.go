@@ -0,0 +1,173 @@
+package asc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+)
+
+const testSalesReport = "Provider\tProvider Country\tSKU\tDeveloper\tTitle\tVersion\tProduct Type Identifier\tUnits\tDeveloper Proceeds\tBegin Date\tEnd Date\tCustomer Currency\tCountry Code\tCurrency of Proceeds\tApple Identifier\tCustomer Price\n" +
+	"APPLE\tUS\tcom.example.app\tExample\tExample App\t1.0\t1F\t3\t0.70\t01/20/2024\t01/20/2024\tUSD\tUS\tUSD\t123\t0.99\n" +
+	"APPLE\tUS\tcom.example.app\tExample\tExample App\t1.0\t1F\t2\t0.70\t01/21/2024\t01/21/2024\tUSD\tUS\tUSD\t123\t0.99\n" +
+	"APPLE\tUS\tcom.example.app\tExample\tExample App\t1.0\t1F\t1\t0.85\t01/20/2024\t01/20/2024\tEUR\tDE\tEUR\t123\t0.99\n" +
+	"APPLE\tUS\tcom.example.pro\tExample\tPro Upgrade\t\tIA1\t-1\t2.10\t01/20/2024\t01/20/2024\tUSD\tUS\tUSD\t456\t2.99\n"
+
+func TestParseSalesReport(t *testing.T) {
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	if _, err := gz.Write([]byte(testSalesReport)); err != nil {
+		t.Fatalf("gzip: %v", err)
+	}
+	gz.Close()
+
+	for name, input := range map[string][]byte{"plain": []byte(testSalesReport), "gzip": compressed.Bytes()} {
+		t.Run(name, func(t *testing.T) {
+			rows, err := ParseSalesReport(bytes.NewReader(input))
+			if err != nil {
+				t.Fatalf("ParseSalesReport() error: %v", err)
+			}
+			if len(rows) != 4 {
+				t.Fatalf("expected 4 rows, got %d", len(rows))
+			}
+			first := rows[0]
+			if first.SKU != "com.example.app" || first.Units != 3 || first.DeveloperProceeds != 0.70 || first.CountryCode != "US" || first.BeginDate != "01/20/2024" {
+				t.Fatalf("unexpected first row: %+v", first)
+			}
+			if rows[3].Units != -1 || rows[3].ProductTypeIdentifier != "IA1" {
+				t.Fatalf("unexpected refund row: %+v", rows[3])
+			}
+		})
+	}
+}
+
+func TestParseReportErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		parse   func(string) error
+		input   string
+		wantErr string
+	}{
+		{
+			name:    "empty report",
+			parse:   func(s string) error { _, err := ParseSalesReport(strings.NewReader(s)); return err },
+			input:   "",
+			wantErr: "SALES report is empty",
+		},
+		{
+			name:    "wrong layout",
+			parse:   func(s string) error { _, err := ParseFinancialReport(strings.NewReader(s)); return err },
+			input:   testSalesReport,
+			wantErr: `not a FINANCIAL report: missing column "Start Date"`,
+		},
+		{
+			name:    "invalid number",
+			parse:   func(s string) error { _, err := ParseSalesReport(strings.NewReader(s)); return err },
+			input:   strings.Replace(testSalesReport, "\t3\t0.70", "\tthree\t0.70", 1),
+			wantErr: `SALES report line 2: column "Units": invalid number "three"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.parse(test.input)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseFinancialReportSkipsTrailers(t *testing.T) {
+	header := "Start Date\tEnd Date\tUPC\tISRC/ISBN\tVendor Identifier\tQuantity\tPartner Share\tExtended Partner Share\tPartner Share Currency\tSales or Return\tApple Identifier\tArtist/Show/Developer/Author\tTitle\tLabel/Studio/Network/Developer/Publisher\tGrid\tProduct Type Identifier\tISAN/Other Identifier\tCountry Of Sale\tPre-order Flag\tPromo Code\tCustomer Price\tCustomer Currency\n"
+	report := header +
+		"12/01/2025\t12/28/2025\t\t\tcom.example.app\t10\t0.70\t7.00\tUSD\tS\t123\tExample\tExample App\t\t\t1F\t\tUS\t\t\t0.99\tUSD\n" +
+		"Total_Rows\t1\n" +
+		"Total_Amount\t7.00\n" +
+		"Total_Units\t10\n" +
+		"\n" +
+		header +
+		"12/01/2025\t12/28/2025\t\t\tcom.example.app\t2\t0.60\t1.20\tEUR\tS\t123\tExample\tExample App\t\t\t1F\t\tDE\t\t\t0.99\tEUR\n" +
+		"Total_Rows\t1\n"
+
+	rows, err := ParseFinancialReport(strings.NewReader(report))
+	if err != nil {
+		t.Fatalf("ParseFinancialReport() error: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %+v", rows)
+	}
+	if rows[0].Quantity != 10 || rows[0].ExtendedPartnerShare != 7 || rows[1].PartnerShareCurrency != "EUR" || rows[1].CountryOfSale != "DE" {
+		t.Fatalf("unexpected rows: %+v", rows)
+	}
+}
+
+func TestParseSubscriptionReportAcceptsRenamedColumns(t *testing.T) {
+	report := "App Name\tApp Apple ID\tSubscription Name\tSubscription Apple ID\tPromotional Offer Name\tDeveloper Proceeds\tProceeds Currency\tCountry\tActive Standard Price Subscriptions\tSubscribers\n" +
+		"Example\t123\tMonthly\t456\tWinback\t3.50\tUSD\tUS\t42\t40\n"
+
+	rows, err := ParseSubscriptionReport(strings.NewReader(report))
+	if err != nil {
+		t.Fatalf("ParseSubscriptionReport() error: %v", err)
+	}
+	if len(rows) != 1 || rows[0].SubscriptionOfferName != "Winback" || rows[0].ActiveStandardPriceSubscriptions != 42 || rows[0].Subscribers != 40 {
+		t.Fatalf("unexpected rows: %+v", rows)
+	}
+}
+
+func TestSummarizeSalesReport(t *testing.T) {
+	rows, err := ParseSalesReport(strings.NewReader(testSalesReport))
+	if err != nil {
+		t.Fatalf("ParseSalesReport() error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		groupBy []string
+		want    []SalesReportSummaryRow
+	}{
+		{
+			name:    "by sku splits currencies",
+			groupBy: []string{"sku"},
+			want: []SalesReportSummaryRow{
+				{SKU: "com.example.app", Title: "Example App", Currency: "EUR", Units: 1, Proceeds: 0.85},
+				{SKU: "com.example.app", Title: "Example App", Currency: "USD", Units: 5, Proceeds: 3.5},
+				{SKU: "com.example.pro", Title: "Pro Upgrade", Currency: "USD", Units: -1, Proceeds: -2.1},
+			},
+		},
+		{
+			name:    "by date and product type",
+			groupBy: []string{"product-type", "date"},
+			want: []SalesReportSummaryRow{
+				{ProductType: "1F", Date: "2024-01-20", Currency: "EUR", Units: 1, Proceeds: 0.85},
+				{ProductType: "1F", Date: "2024-01-20", Currency: "USD", Units: 3, Proceeds: 2.1},
+				{ProductType: "IA1", Date: "2024-01-20", Currency: "USD", Units: -1, Proceeds: -2.1},
+				{ProductType: "1F", Date: "2024-01-21", Currency: "USD", Units: 2, Proceeds: 1.4},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			summary, err := SummarizeSalesReport(rows, test.groupBy)
+			if err != nil {
+				t.Fatalf("SummarizeSalesReport() error: %v", err)
+			}
+			if summary.TotalUnits != 5 {
+				t.Fatalf("expected 5 total units, got %v", summary.TotalUnits)
+			}
+			if len(summary.Data) != len(test.want) {
+				t.Fatalf("expected %d groups, got %+v", len(test.want), summary.Data)
+			}
+			for i, want := range test.want {
+				if summary.Data[i] != want {
+					t.Fatalf("group %d = %+v, want %+v", i, summary.Data[i], want)
+				}
+			}
+		})
+	}
+
+	if _, err := SummarizeSalesReport(rows, []string{"app"}); err == nil || !strings.Contains(err.Error(), `unsupported group-by dimension "app"`) {
+		t.Fatalf("expected unsupported dimension error, got %v", err)
+	}
+}
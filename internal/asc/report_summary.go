@@ -0,0 +1,111 @@
+package asc
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Sales summary grouping dimensions.
+const (
+	SalesSummaryBySKU         = "sku"
+	SalesSummaryByTerritory   = "territory"
+	SalesSummaryByProductType = "product-type"
+	SalesSummaryByDate        = "date"
+)
+
+// SalesSummaryDimensions lists the supported --group-by values in display order.
+var SalesSummaryDimensions = []string{SalesSummaryBySKU, SalesSummaryByTerritory, SalesSummaryByProductType, SalesSummaryByDate}
+
+// SalesReportSummary aggregates SALES report rows. Proceeds are always split
+// by proceeds currency because amounts in different currencies cannot be added.
+type SalesReportSummary struct {
+	GroupBy    []string                `json:"groupBy"`
+	Files      []string                `json:"files,omitempty"`
+	Data       []SalesReportSummaryRow `json:"data"`
+	TotalUnits float64                 `json:"totalUnits"`
+}
+
+// SalesReportSummaryRow is one group of a sales summary. Dimensions that are
+// not grouped on are left empty.
+type SalesReportSummaryRow struct {
+	SKU         string  `json:"sku,omitempty"`
+	Title       string  `json:"title,omitempty"`
+	Territory   string  `json:"territory,omitempty"`
+	ProductType string  `json:"productType,omitempty"`
+	Date        string  `json:"date,omitempty"`
+	Currency    string  `json:"currency"`
+	Units       float64 `json:"units"`
+	Proceeds    float64 `json:"proceeds"`
+}
+
+// SummarizeSalesReport groups rows by the given dimensions (see
+// SalesSummaryDimensions) and sums units and proceeds (units × developer
+// proceeds per unit) for each group and proceeds currency.
+func SummarizeSalesReport(rows []SalesReportRow, groupBy []string) (*SalesReportSummary, error) {
+	group := map[string]bool{}
+	for _, dimension := range groupBy {
+		switch dimension {
+		case SalesSummaryBySKU, SalesSummaryByTerritory, SalesSummaryByProductType, SalesSummaryByDate:
+			group[dimension] = true
+		default:
+			return nil, fmt.Errorf("unsupported group-by dimension %q (use %s)", dimension, strings.Join(SalesSummaryDimensions, ", "))
+		}
+	}
+	if len(group) == 0 {
+		return nil, fmt.Errorf("at least one group-by dimension is required")
+	}
+
+	summary := &SalesReportSummary{Data: []SalesReportSummaryRow{}}
+	for _, dimension := range SalesSummaryDimensions {
+		if group[dimension] {
+			summary.GroupBy = append(summary.GroupBy, dimension)
+		}
+	}
+
+	indexes := map[SalesReportSummaryRow]int{}
+	for _, row := range rows {
+		key := SalesReportSummaryRow{Currency: row.CurrencyOfProceeds}
+		if group[SalesSummaryBySKU] {
+			key.SKU = row.SKU
+		}
+		if group[SalesSummaryByTerritory] {
+			key.Territory = row.CountryCode
+		}
+		if group[SalesSummaryByProductType] {
+			key.ProductType = row.ProductTypeIdentifier
+		}
+		if group[SalesSummaryByDate] {
+			key.Date = NormalizeReportDate(row.BeginDate)
+		}
+
+		index, ok := indexes[key]
+		if !ok {
+			index = len(summary.Data)
+			indexes[key] = index
+			summary.Data = append(summary.Data, key)
+		}
+		entry := &summary.Data[index]
+		if group[SalesSummaryBySKU] && entry.Title == "" {
+			entry.Title = row.Title
+		}
+		entry.Units += row.Units
+		entry.Proceeds += row.Units * row.DeveloperProceeds
+		summary.TotalUnits += row.Units
+	}
+
+	for i := range summary.Data {
+		summary.Data[i].Proceeds = math.Round(summary.Data[i].Proceeds*100) / 100
+	}
+	sort.SliceStable(summary.Data, func(i, j int) bool {
+		a, b := summary.Data[i], summary.Data[j]
+		for _, pair := range [][2]string{{a.Date, b.Date}, {a.SKU, b.SKU}, {a.Territory, b.Territory}, {a.ProductType, b.ProductType}, {a.Currency, b.Currency}} {
+			if pair[0] != pair[1] {
+				return pair[0] < pair[1]
+			}
+		}
+		return false
+	})
+	return summary, nil
+}
@@ -31,6 +31,8 @@ type ReviewSubmissionItemRelationships struct {
 	AppEvent                           *Relationship `json:"appEvent,omitempty"`
 	AppStoreVersionExperiment          *Relationship `json:"appStoreVersionExperiment,omitempty"`
 	AppStoreVersionExperimentTreatment *Relationship `json:"appStoreVersionExperimentTreatment,omitempty"`
+	InAppPurchaseV2                    *Relationship `json:"inAppPurchaseV2,omitempty"`
+	Subscription                       *Relationship `json:"subscription,omitempty"`
 }
 
 // ReviewSubmissionItemResource represents a review submission item resource.
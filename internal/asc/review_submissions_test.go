@@ -380,6 +380,52 @@ func TestGetReviewSubmissionItems(t *testing.T) {
 	}
 }
 
+func TestGetReviewSubmissionItems_WithInclude(t *testing.T) {
+	response := reviewSubmissionsJSONResponse(http.StatusOK, `{
+		"data": [
+			{
+				"type": "reviewSubmissionItems",
+				"id": "item-1",
+				"relationships": {
+					"inAppPurchaseV2": {"data": {"type": "inAppPurchases", "id": "iap-1"}}
+				}
+			},
+			{
+				"type": "reviewSubmissionItems",
+				"id": "item-2",
+				"relationships": {
+					"subscription": {"data": {"type": "subscriptions", "id": "sub-1"}}
+				}
+			}
+		]
+	}`)
+
+	client := newTestClient(t, func(req *http.Request) {
+		if got := req.URL.Query().Get("include"); got != "inAppPurchaseV2,subscription" {
+			t.Fatalf("expected include=inAppPurchaseV2,subscription, got %q", got)
+		}
+		if got := req.URL.Query().Get("limit"); got != "200" {
+			t.Fatalf("expected limit=200, got %q", got)
+		}
+	}, response)
+
+	resp, err := client.GetReviewSubmissionItems(context.Background(), "submission-1",
+		WithReviewSubmissionItemsInclude([]string{"inAppPurchaseV2", "subscription"}),
+		WithReviewSubmissionItemsLimit(200))
+	if err != nil {
+		t.Fatalf("GetReviewSubmissionItems() error: %v", err)
+	}
+	if len(resp.Data) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(resp.Data))
+	}
+	if rel := resp.Data[0].Relationships; rel == nil || rel.InAppPurchaseV2 == nil || rel.InAppPurchaseV2.Data.ID != "iap-1" {
+		t.Fatalf("expected in-app purchase relationship, got %+v", resp.Data[0].Relationships)
+	}
+	if rel := resp.Data[1].Relationships; rel == nil || rel.Subscription == nil || rel.Subscription.Data.ID != "sub-1" {
+		t.Fatalf("expected subscription relationship, got %+v", resp.Data[1].Relationships)
+	}
+}
+
 func TestReviewSubmissionValidationErrors(t *testing.T) {
 	client := newTestClient(t, nil, nil)
 
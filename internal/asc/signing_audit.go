@@ -0,0 +1,73 @@
+package asc
+
+import (
+	"fmt"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+// Signing audit asset types.
+const (
+	SigningAuditCertificate = "certificate"
+	SigningAuditProfile     = "profile"
+	SigningAuditBundleID    = "bundleId"
+)
+
+// SigningAuditResult is the output of a signing asset audit.
+type SigningAuditResult struct {
+	GeneratedAt  string                   `json:"generatedAt"`
+	Days         int                      `json:"days"`
+	Strict       bool                     `json:"strict,omitempty"`
+	Certificates int                      `json:"certificates"`
+	Profiles     int                      `json:"profiles"`
+	BundleIDs    int                      `json:"bundleIds"`
+	Devices      int                      `json:"devices"`
+	Summary      validation.Summary       `json:"summary"`
+	Assets       []SigningAuditAsset      `json:"assets"`
+	Checks       []validation.CheckResult `json:"checks"`
+}
+
+// SigningAuditAsset is one audited certificate, profile, or bundle ID.
+type SigningAuditAsset struct {
+	Type                string `json:"type"`
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	Kind                string `json:"kind,omitempty"`
+	ExpirationDate      string `json:"expirationDate,omitempty"`
+	DaysUntilExpiration *int   `json:"daysUntilExpiration,omitempty"`
+	// Status is the most severe finding for the asset, or "ok".
+	Status string `json:"status"`
+}
+
+func signingAuditResultRender(result *SigningAuditResult, render func([]string, [][]string)) error {
+	render([]string{"Certificates", "Profiles", "Bundle IDs", "Devices", "Days", "Errors", "Warnings", "Blocking"}, [][]string{{
+		fmt.Sprintf("%d", result.Certificates),
+		fmt.Sprintf("%d", result.Profiles),
+		fmt.Sprintf("%d", result.BundleIDs),
+		fmt.Sprintf("%d", result.Devices),
+		fmt.Sprintf("%d", result.Days),
+		fmt.Sprintf("%d", result.Summary.Errors),
+		fmt.Sprintf("%d", result.Summary.Warnings),
+		fmt.Sprintf("%d", result.Summary.Blocking),
+	}})
+
+	assetRows := make([][]string, 0, len(result.Assets))
+	for _, asset := range result.Assets {
+		days := ""
+		if asset.DaysUntilExpiration != nil {
+			days = fmt.Sprintf("%d", *asset.DaysUntilExpiration)
+		}
+		assetRows = append(assetRows, []string{asset.Type, asset.ID, compactWhitespace(asset.Name), asset.Kind, asset.ExpirationDate, days, asset.Status})
+	}
+	render([]string{"Type", "ID", "Name", "Kind", "Expires", "Days Left", "Status"}, assetRows)
+
+	checkRows := make([][]string, 0, len(result.Checks))
+	for _, check := range result.Checks {
+		checkRows = append(checkRows, []string{string(check.Severity), check.ID, formatResource(check.ResourceType, check.ResourceID), check.Message, check.Remediation})
+	}
+	if len(checkRows) == 0 {
+		checkRows = append(checkRows, []string{"info", "signing.ok", "", "No issues found", ""})
+	}
+	render([]string{"Severity", "Check ID", "Resource", "Message", "Remediation"}, checkRows)
+	return nil
+}
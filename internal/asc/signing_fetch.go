@@ -12,3 +12,17 @@ type SigningFetchResult struct {
 	OutputPath       string   `json:"outputPath"`
 	Created          bool     `json:"created,omitempty"`
 }
+
+// CertificateKeyResult represents CLI output for a certificate created from a
+// locally generated private key.
+type CertificateKeyResult struct {
+	CertificateID   string `json:"certificateId"`
+	CertificateType string `json:"certificateType"`
+	Name            string `json:"name,omitempty"`
+	SerialNumber    string `json:"serialNumber,omitempty"`
+	ExpirationDate  string `json:"expirationDate,omitempty"`
+	KeyFile         string `json:"keyFile"`
+	CSRFile         string `json:"csrFile"`
+	CertificateFile string `json:"certificateFile"`
+	P12File         string `json:"p12File"`
+}
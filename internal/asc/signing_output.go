@@ -168,3 +168,17 @@ func certificateDisplayName(attrs CertificateAttributes) string {
 	}
 	return attrs.Name
 }
+
+func certificateKeyResultRows(result *CertificateKeyResult) ([]string, [][]string) {
+	headers := []string{"Certificate ID", "Type", "Serial Number", "Expiration Date", "Key File", "Certificate File", "P12 File"}
+	rows := [][]string{{
+		result.CertificateID,
+		result.CertificateType,
+		result.SerialNumber,
+		result.ExpirationDate,
+		result.KeyFile,
+		result.CertificateFile,
+		result.P12File,
+	}}
+	return headers, rows
+}
@@ -0,0 +1,71 @@
+package asc
+
+import "fmt"
+
+// StoreKitExportResult represents CLI output for a StoreKit configuration
+// file export.
+type StoreKitExportResult struct {
+	AppID                    string `json:"appId"`
+	Storefront               string `json:"storefront"`
+	Path                     string `json:"path"`
+	Products                 int    `json:"products"`
+	NonRenewingSubscriptions int    `json:"nonRenewingSubscriptions"`
+	SubscriptionGroups       int    `json:"subscriptionGroups"`
+	Subscriptions            int    `json:"subscriptions"`
+	MissingPrices            int    `json:"missingPrices"`
+}
+
+// StoreKitImportAction describes one change in a StoreKit import plan.
+type StoreKitImportAction struct {
+	Action    string `json:"action"`
+	ProductID string `json:"productId,omitempty"`
+	Name      string `json:"name"`
+	Details   string `json:"details,omitempty"`
+	Status    string `json:"status"`
+	ID        string `json:"id,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// StoreKitImportResult represents CLI output for a StoreKit configuration
+// file import.
+type StoreKitImportResult struct {
+	File    string                 `json:"file"`
+	AppID   string                 `json:"appId"`
+	DryRun  bool                   `json:"dryRun"`
+	Actions []StoreKitImportAction `json:"actions"`
+}
+
+func storeKitExportResultRows(result *StoreKitExportResult) ([]string, [][]string) {
+	headers := []string{"App", "Storefront", "Path", "Products", "Non-Renewing", "Groups", "Subscriptions", "Missing Prices"}
+	rows := [][]string{{
+		result.AppID,
+		result.Storefront,
+		result.Path,
+		fmt.Sprintf("%d", result.Products),
+		fmt.Sprintf("%d", result.NonRenewingSubscriptions),
+		fmt.Sprintf("%d", result.SubscriptionGroups),
+		fmt.Sprintf("%d", result.Subscriptions),
+		fmt.Sprintf("%d", result.MissingPrices),
+	}}
+	return headers, rows
+}
+
+func storeKitImportResultRows(result *StoreKitImportResult) ([]string, [][]string) {
+	headers := []string{"Action", "Product ID", "Name", "Details", "Status", "ID"}
+	rows := make([][]string, 0, len(result.Actions))
+	for _, action := range result.Actions {
+		status := action.Status
+		if action.Error != "" {
+			status = status + ": " + action.Error
+		}
+		rows = append(rows, []string{
+			action.Action,
+			action.ProductID,
+			compactWhitespace(action.Name),
+			compactWhitespace(action.Details),
+			compactWhitespace(status),
+			action.ID,
+		})
+	}
+	return headers, rows
+}
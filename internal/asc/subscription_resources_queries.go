@@ -48,6 +48,9 @@ type subscriptionImagesQuery struct {
 
 type subscriptionIntroductoryOffersQuery struct {
 	listQuery
+	territory        string
+	include          []string
+	pricePointFields []string
 }
 
 type subscriptionPromotionalOffersQuery struct {
@@ -56,6 +59,9 @@ type subscriptionPromotionalOffersQuery struct {
 
 type subscriptionPromotionalOfferPricesQuery struct {
 	listQuery
+	territory        string
+	include          []string
+	pricePointFields []string
 }
 
 type subscriptionOfferCodesQuery struct {
@@ -141,6 +147,29 @@ func WithSubscriptionIntroductoryOffersNextURL(next string) SubscriptionIntroduc
 	}
 }
 
+// WithSubscriptionIntroductoryOffersTerritory filters introductory offers by territory (e.g., "USA").
+func WithSubscriptionIntroductoryOffersTerritory(territory string) SubscriptionIntroductoryOffersOption {
+	return func(q *subscriptionIntroductoryOffersQuery) {
+		if strings.TrimSpace(territory) != "" {
+			q.territory = strings.ToUpper(strings.TrimSpace(territory))
+		}
+	}
+}
+
+// WithSubscriptionIntroductoryOffersInclude sets the relationships to include (e.g., "subscriptionPricePoint", "territory").
+func WithSubscriptionIntroductoryOffersInclude(include []string) SubscriptionIntroductoryOffersOption {
+	return func(q *subscriptionIntroductoryOffersQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
+// WithSubscriptionIntroductoryOffersPricePointFields sets fields for included subscriptionPricePoints.
+func WithSubscriptionIntroductoryOffersPricePointFields(fields []string) SubscriptionIntroductoryOffersOption {
+	return func(q *subscriptionIntroductoryOffersQuery) {
+		q.pricePointFields = normalizeList(fields)
+	}
+}
+
 // WithSubscriptionPromotionalOffersLimit sets the max number of offers to return.
 func WithSubscriptionPromotionalOffersLimit(limit int) SubscriptionPromotionalOffersOption {
 	return func(q *subscriptionPromotionalOffersQuery) {
@@ -177,6 +206,29 @@ func WithSubscriptionPromotionalOfferPricesNextURL(next string) SubscriptionProm
 	}
 }
 
+// WithSubscriptionPromotionalOfferPricesTerritory filters promotional offer prices by territory (e.g., "USA").
+func WithSubscriptionPromotionalOfferPricesTerritory(territory string) SubscriptionPromotionalOfferPricesOption {
+	return func(q *subscriptionPromotionalOfferPricesQuery) {
+		if strings.TrimSpace(territory) != "" {
+			q.territory = strings.ToUpper(strings.TrimSpace(territory))
+		}
+	}
+}
+
+// WithSubscriptionPromotionalOfferPricesInclude sets the relationships to include (e.g., "subscriptionPricePoint", "territory").
+func WithSubscriptionPromotionalOfferPricesInclude(include []string) SubscriptionPromotionalOfferPricesOption {
+	return func(q *subscriptionPromotionalOfferPricesQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
+// WithSubscriptionPromotionalOfferPricesPricePointFields sets fields for included subscriptionPricePoints.
+func WithSubscriptionPromotionalOfferPricesPricePointFields(fields []string) SubscriptionPromotionalOfferPricesOption {
+	return func(q *subscriptionPromotionalOfferPricesQuery) {
+		q.pricePointFields = normalizeList(fields)
+	}
+}
+
 // WithSubscriptionOfferCodesLimit sets the max number of offer codes to return.
 func WithSubscriptionOfferCodesLimit(limit int) SubscriptionOfferCodesOption {
 	return func(q *subscriptionOfferCodesQuery) {
@@ -338,6 +390,11 @@ func buildSubscriptionImagesQuery(query *subscriptionImagesQuery) string {
 
 func buildSubscriptionIntroductoryOffersQuery(query *subscriptionIntroductoryOffersQuery) string {
 	values := url.Values{}
+	if strings.TrimSpace(query.territory) != "" {
+		values.Set("filter[territory]", strings.TrimSpace(query.territory))
+	}
+	addCSV(values, "include", query.include)
+	addCSV(values, "fields[subscriptionPricePoints]", query.pricePointFields)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -350,6 +407,11 @@ func buildSubscriptionPromotionalOffersQuery(query *subscriptionPromotionalOffer
 
 func buildSubscriptionPromotionalOfferPricesQuery(query *subscriptionPromotionalOfferPricesQuery) string {
 	values := url.Values{}
+	if strings.TrimSpace(query.territory) != "" {
+		values.Set("filter[territory]", strings.TrimSpace(query.territory))
+	}
+	addCSV(values, "include", query.include)
+	addCSV(values, "fields[subscriptionPricePoints]", query.pricePointFields)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
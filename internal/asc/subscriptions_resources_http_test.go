@@ -278,6 +278,33 @@ func TestGetSubscriptionIntroductoryOffers_WithLimit(t *testing.T) {
 	}
 }
 
+func TestGetSubscriptionIntroductoryOffers_WithTerritoryAndInclude(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		query := req.URL.Query()
+		if query.Get("filter[territory]") != "USA" {
+			t.Fatalf("expected filter[territory]=USA, got %q", query.Get("filter[territory]"))
+		}
+		if query.Get("include") != "subscriptionPricePoint" {
+			t.Fatalf("expected include=subscriptionPricePoint, got %q", query.Get("include"))
+		}
+		if query.Get("fields[subscriptionPricePoints]") != "customerPrice" {
+			t.Fatalf("expected fields[subscriptionPricePoints]=customerPrice, got %q", query.Get("fields[subscriptionPricePoints]"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetSubscriptionIntroductoryOffers(
+		context.Background(),
+		"sub-1",
+		WithSubscriptionIntroductoryOffersTerritory("usa"),
+		WithSubscriptionIntroductoryOffersInclude([]string{"subscriptionPricePoint"}),
+		WithSubscriptionIntroductoryOffersPricePointFields([]string{"customerPrice"}),
+	); err != nil {
+		t.Fatalf("GetSubscriptionIntroductoryOffers() error: %v", err)
+	}
+}
+
 func TestGetSubscriptionIntroductoryOffer(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":{"type":"subscriptionIntroductoryOffers","id":"offer-1","attributes":{"duration":"ONE_MONTH","numberOfPeriods":1,"offerMode":"FREE_TRIAL"}}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -540,6 +567,29 @@ func TestGetSubscriptionPromotionalOfferPrices_WithLimit(t *testing.T) {
 	}
 }
 
+func TestGetSubscriptionPromotionalOfferPrices_WithTerritoryAndInclude(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		query := req.URL.Query()
+		if query.Get("filter[territory]") != "USA" {
+			t.Fatalf("expected filter[territory]=USA, got %q", query.Get("filter[territory]"))
+		}
+		if query.Get("include") != "subscriptionPricePoint,territory" {
+			t.Fatalf("expected include=subscriptionPricePoint,territory, got %q", query.Get("include"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetSubscriptionPromotionalOfferPrices(
+		context.Background(),
+		"offer-1",
+		WithSubscriptionPromotionalOfferPricesTerritory("USA"),
+		WithSubscriptionPromotionalOfferPricesInclude([]string{"subscriptionPricePoint", "territory"}),
+	); err != nil {
+		t.Fatalf("GetSubscriptionPromotionalOfferPrices() error: %v", err)
+	}
+}
+
 func TestGetSubscriptionOfferCodes_WithLimit(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionOfferCodes","id":"code-1","attributes":{"name":"Spring"}}]}`)
 	client := newTestClient(t, func(req *http.Request) {
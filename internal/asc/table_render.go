@@ -1,6 +1,7 @@
 package asc
 
 import (
+	"encoding/csv"
 	"os"
 
 	"github.com/olekukonko/tablewriter"
@@ -52,3 +53,21 @@ func RenderMarkdown(headers []string, rows [][]string) {
 	_ = table.Bulk(rows)
 	_ = table.Render()
 }
+
+// RenderCSV writes headers and rows as comma-separated values to stdout.
+func RenderCSV(headers []string, rows [][]string) {
+	renderDelimited(headers, rows, ',')
+}
+
+// RenderTSV writes headers and rows as tab-separated values to stdout.
+// Fields containing tabs, quotes, or newlines are quoted.
+func RenderTSV(headers []string, rows [][]string) {
+	renderDelimited(headers, rows, '\t')
+}
+
+func renderDelimited(headers []string, rows [][]string, comma rune) {
+	writer := csv.NewWriter(os.Stdout)
+	writer.Comma = comma
+	_ = writer.Write(headers)
+	_ = writer.WriteAll(rows)
+}
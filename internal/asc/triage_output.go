@@ -0,0 +1,102 @@
+package asc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TriageCount is the number of submissions sharing a value.
+type TriageCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// CrashTriageGroup is a group of crashes sharing a signature.
+type CrashTriageGroup struct {
+	Signature    string        `json:"signature"`
+	Exception    string        `json:"exception,omitempty"`
+	Frames       []string      `json:"frames,omitempty"`
+	Count        int           `json:"count"`
+	New          bool          `json:"new"`
+	FirstSeen    string        `json:"firstSeen"`
+	LastSeen     string        `json:"lastSeen"`
+	Builds       []TriageCount `json:"builds"`
+	OSVersions   []TriageCount `json:"osVersions"`
+	DeviceModels []TriageCount `json:"deviceModels"`
+	Testers      []string      `json:"testers"`
+	CrashIDs     []string      `json:"crashIds"`
+}
+
+// CrashTriageResult represents crashes triage output.
+type CrashTriageResult struct {
+	AppID         string             `json:"appId"`
+	Since         string             `json:"since"`
+	GroupBy       string             `json:"groupBy"`
+	LatestBuild   string             `json:"latestBuild,omitempty"`
+	PreviousBuild string             `json:"previousBuild,omitempty"`
+	Total         int                `json:"total"`
+	Groups        []CrashTriageGroup `json:"groups"`
+}
+
+// FeedbackTriageGroup is a group of feedback submissions with similar comments.
+type FeedbackTriageGroup struct {
+	Keywords     []string      `json:"keywords"`
+	Sample       string        `json:"sample"`
+	Count        int           `json:"count"`
+	FirstSeen    string        `json:"firstSeen"`
+	LastSeen     string        `json:"lastSeen"`
+	Builds       []TriageCount `json:"builds"`
+	OSVersions   []TriageCount `json:"osVersions"`
+	DeviceModels []TriageCount `json:"deviceModels"`
+	Testers      []string      `json:"testers"`
+	FeedbackIDs  []string      `json:"feedbackIds"`
+}
+
+// FeedbackTriageResult represents feedback triage output.
+type FeedbackTriageResult struct {
+	AppID  string                `json:"appId"`
+	Since  string                `json:"since"`
+	Total  int                   `json:"total"`
+	Groups []FeedbackTriageGroup `json:"groups"`
+}
+
+func formatTriageCounts(counts []TriageCount) string {
+	parts := make([]string, 0, len(counts))
+	for _, count := range counts {
+		parts = append(parts, fmt.Sprintf("%s (%d)", count.Value, count.Count))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func crashTriageResultRows(result *CrashTriageResult) ([]string, [][]string) {
+	headers := []string{"Count", "New", "Signature", "Builds", "OS Versions", "Devices", "Testers"}
+	rows := make([][]string, 0, len(result.Groups))
+	for _, group := range result.Groups {
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", group.Count),
+			fmt.Sprintf("%t", group.New),
+			sanitizeTerminal(group.Signature),
+			sanitizeTerminal(formatTriageCounts(group.Builds)),
+			sanitizeTerminal(formatTriageCounts(group.OSVersions)),
+			sanitizeTerminal(formatTriageCounts(group.DeviceModels)),
+			fmt.Sprintf("%d", len(group.Testers)),
+		})
+	}
+	return headers, rows
+}
+
+func feedbackTriageResultRows(result *FeedbackTriageResult) ([]string, [][]string) {
+	headers := []string{"Count", "Keywords", "Sample", "Builds", "Devices", "Testers"}
+	rows := make([][]string, 0, len(result.Groups))
+	for _, group := range result.Groups {
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", group.Count),
+			sanitizeTerminal(strings.Join(group.Keywords, ", ")),
+			compactWhitespace(group.Sample),
+			sanitizeTerminal(formatTriageCounts(group.Builds)),
+			sanitizeTerminal(formatTriageCounts(group.DeviceModels)),
+			fmt.Sprintf("%d", len(group.Testers)),
+		})
+	}
+	return headers, rows
+}
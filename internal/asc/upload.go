@@ -13,6 +13,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 // UploadOptions configure how upload operations are executed.
@@ -20,6 +21,7 @@ type UploadOptions struct {
 	Concurrency int
 	Client      *http.Client
 	RetryOpts   RetryOptions
+	State       *UploadState
 }
 
 // UploadOption configures upload options.
@@ -44,6 +46,23 @@ func WithUploadHTTPClient(client *http.Client) UploadOption {
 	}
 }
 
+// WithUploadRetryOptions sets the per-operation retry and backoff policy.
+func WithUploadRetryOptions(retryOpts RetryOptions) UploadOption {
+	return func(opts *UploadOptions) {
+		opts.RetryOpts = retryOpts
+	}
+}
+
+// WithUploadState makes the upload resumable: parts already completed in
+// state are skipped, each finished part is saved to the state file, and a
+// failed part no longer stops the others. Server errors and ETag mismatches
+// are retried with backoff.
+func WithUploadState(state *UploadState) UploadOption {
+	return func(opts *UploadOptions) {
+		opts.State = state
+	}
+}
+
 // newUploadClient creates a dedicated HTTP client for upload operations
 // with appropriate timeouts and a cloned transport when possible to avoid
 // sharing the connection pool with http.DefaultClient.
@@ -81,6 +100,13 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 	if uploadOpts.Client == nil {
 		uploadOpts.Client = newUploadClient()
 	}
+	cassette, err := ResolveCassette()
+	if err != nil {
+		return err
+	}
+	if cassette != nil {
+		uploadOpts.Client = cassette.WrapClient(uploadOpts.Client)
+	}
 	if uploadOpts.Concurrency > len(operations) {
 		uploadOpts.Concurrency = len(operations)
 	}
@@ -115,15 +141,38 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 		}
 	}
 
+	pending := make([]int, len(operations))
+	for i := range operations {
+		pending[i] = i
+	}
+	if uploadOpts.State != nil {
+		pending, err = uploadOpts.State.prepare(file, operations)
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		if uploadOpts.Concurrency > len(pending) {
+			uploadOpts.Concurrency = len(pending)
+		}
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	var firstErr error
+	var failed atomic.Int32
 	var errOnce sync.Once
 	setErr := func(err error) {
+		failed.Add(1)
 		errOnce.Do(func() {
 			firstErr = err
-			cancel()
+			// Resumable uploads keep going so every part that can
+			// succeed is recorded before the next attempt.
+			if uploadOpts.State == nil {
+				cancel()
+			}
 		})
 	}
 
@@ -138,7 +187,9 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 			}
 			if err := executeUploadOperation(ctx, file, task, uploadOpts); err != nil {
 				setErr(err)
-				return
+				if uploadOpts.State == nil {
+					return
+				}
 			}
 		}
 	}
@@ -149,16 +200,22 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 	}
 
 sendLoop:
-	for i, op := range operations {
+	for _, i := range pending {
 		select {
 		case <-ctx.Done():
 			break sendLoop
-		case jobs <- uploadTask{index: i, op: op}:
+		case jobs <- uploadTask{index: i, op: operations[i]}:
 		}
 	}
 	close(jobs)
 
 	wg.Wait()
+	if firstErr != nil && uploadOpts.State != nil {
+		return fmt.Errorf("%d of %d upload parts failed; progress saved to %s: %w", failed.Load(), len(pending), uploadOpts.State.Path, firstErr)
+	}
+	if firstErr == nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
 	return firstErr
 }
 
@@ -168,6 +225,16 @@ func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask,
 		method = http.MethodPut
 	}
 
+	resumable := uploadOpts.State != nil
+	var partSHA256, partMD5 string
+	if resumable {
+		sha, md5sum, err := hashUploadPartDigests(file, task.op.Offset, task.op.Length)
+		if err != nil {
+			return fmt.Errorf("upload operation %d: %w", task.index, err)
+		}
+		partSHA256, partMD5 = sha, md5sum
+	}
+
 	_, err := WithRetry(ctx, func() (struct{}, error) {
 		reader := io.NewSectionReader(file, task.op.Offset, task.op.Length)
 		req, err := http.NewRequestWithContext(ctx, method, task.op.URL, reader)
@@ -181,7 +248,7 @@ func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask,
 
 		resp, err := uploadOpts.Client.Do(req)
 		if err != nil {
-			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrCassetteMiss) {
 				return struct{}{}, err
 			}
 			return struct{}{}, &RetryableError{Err: fmt.Errorf("upload request failed: %w", err)}
@@ -196,18 +263,51 @@ func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask,
 				RetryAfter: retryAfter,
 			}
 		}
+		if resumable && (resp.StatusCode >= 500 || resp.StatusCode == http.StatusRequestTimeout) {
+			return struct{}{}, &RetryableError{Err: fmt.Errorf("upload request failed with status %s", resp.Status)}
+		}
 		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 			return struct{}{}, fmt.Errorf("upload request failed with status %s", resp.Status)
 		}
+		if resumable {
+			// Single-part object stores report the MD5 of the body as the ETag.
+			etag := strings.Trim(strings.TrimSpace(resp.Header.Get("ETag")), `"`)
+			if isMD5Hex(etag) && !strings.EqualFold(etag, partMD5) {
+				return struct{}{}, &RetryableError{Err: fmt.Errorf("upload part checksum mismatch (ETag %s, expected %s)", etag, partMD5)}
+			}
+		}
 
 		return struct{}{}, nil
 	}, uploadOpts.RetryOpts)
 	if err != nil {
 		return fmt.Errorf("upload operation %d: %w", task.index, err)
 	}
+	if resumable {
+		if err := uploadOpts.State.markCompleted(task.index, partSHA256); err != nil {
+			return fmt.Errorf("upload operation %d: %w", task.index, err)
+		}
+	}
 	return nil
 }
 
+// hashUploadPartDigests returns the SHA-256 and MD5 of a byte range.
+func hashUploadPartDigests(file io.ReaderAt, offset, length int64) (string, string, error) {
+	shaHash := sha256.New()
+	md5Hash := md5.New()
+	if _, err := io.Copy(io.MultiWriter(shaHash, md5Hash), io.NewSectionReader(file, offset, length)); err != nil {
+		return "", "", fmt.Errorf("hash upload part at offset %d: %w", offset, err)
+	}
+	return hex.EncodeToString(shaHash.Sum(nil)), hex.EncodeToString(md5Hash.Sum(nil)), nil
+}
+
+func isMD5Hex(value string) bool {
+	if len(value) != 32 {
+		return false
+	}
+	_, err := hex.DecodeString(value)
+	return err == nil
+}
+
 // VerifySourceFileChecksums computes and compares checksums provided by the API.
 func VerifySourceFileChecksums(filePath string, expected *Checksums) (*Checksums, error) {
 	if expected == nil {
@@ -0,0 +1,167 @@
+package asc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+// UploadState records the progress of a resumable upload so an interrupted
+// upload can continue from the parts that already completed. Presigned URLs
+// are not stored; they are fetched again when resuming.
+type UploadState struct {
+	AppID       string            `json:"appId"`
+	Version     string            `json:"version"`
+	BuildNumber string            `json:"buildNumber"`
+	Platform    string            `json:"platform"`
+	UploadID    string            `json:"uploadId"`
+	FileID      string            `json:"fileId"`
+	FileName    string            `json:"fileName"`
+	FileSize    int64             `json:"fileSize"`
+	FileModTime time.Time         `json:"fileModTime"`
+	Parts       []UploadPartState `json:"parts"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
+
+	// Path is the sidecar file the state is saved to.
+	Path string `json:"-"`
+
+	mu sync.Mutex
+}
+
+// UploadPartState tracks one upload operation by its byte range.
+type UploadPartState struct {
+	Offset    int64  `json:"offset"`
+	Length    int64  `json:"length"`
+	SHA256    string `json:"sha256,omitempty"`
+	Completed bool   `json:"completed"`
+}
+
+// DefaultUploadStatePath returns the sidecar state path for an upload file.
+func DefaultUploadStatePath(filePath string) string {
+	return filePath + ".asc-upload.json"
+}
+
+// LoadUploadState reads a sidecar state file. Errors wrap os.ErrNotExist when
+// there is no state to resume.
+func LoadUploadState(path string) (*UploadState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var state UploadState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parse upload state %s: %w", path, err)
+	}
+	if strings.TrimSpace(state.UploadID) == "" || strings.TrimSpace(state.FileID) == "" {
+		return nil, fmt.Errorf("upload state %s is missing upload or file IDs", path)
+	}
+	state.Path = path
+	return &state, nil
+}
+
+// MatchesFile reports whether the state was saved for the file as it is now.
+func (s *UploadState) MatchesFile(info os.FileInfo) bool {
+	return s.FileSize == info.Size() && s.FileModTime.Equal(info.ModTime())
+}
+
+// CompletedParts returns how many parts have been uploaded.
+func (s *UploadState) CompletedParts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, part := range s.Parts {
+		if part.Completed {
+			count++
+		}
+	}
+	return count
+}
+
+// Save writes the state atomically with owner-only permissions.
+func (s *UploadState) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.saveLocked()
+}
+
+func (s *UploadState) saveLocked() error {
+	if strings.TrimSpace(s.Path) == "" {
+		return errors.New("upload state path is required")
+	}
+	s.UpdatedAt = time.Now().UTC()
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := fileutil.WriteFileAtomic(s.Path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("save upload state: %w", err)
+	}
+	return nil
+}
+
+// Remove deletes the sidecar file once the upload is committed.
+func (s *UploadState) Remove() error {
+	if err := os.Remove(s.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// prepare aligns the recorded parts with the current operations and returns
+// the indexes still to upload. Completed parts are re-hashed so a changed
+// byte range is uploaded again.
+func (s *UploadState) prepare(file io.ReaderAt, operations []UploadOperation) ([]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous := make(map[[2]int64]UploadPartState, len(s.Parts))
+	for _, part := range s.Parts {
+		previous[[2]int64{part.Offset, part.Length}] = part
+	}
+
+	parts := make([]UploadPartState, len(operations))
+	var pending []int
+	for i, op := range operations {
+		part := UploadPartState{Offset: op.Offset, Length: op.Length}
+		if prior, ok := previous[[2]int64{op.Offset, op.Length}]; ok && prior.Completed {
+			sum, err := hashUploadPart(file, op.Offset, op.Length)
+			if err != nil {
+				return nil, err
+			}
+			if strings.EqualFold(sum, prior.SHA256) {
+				part = prior
+			}
+		}
+		if !part.Completed {
+			pending = append(pending, i)
+		}
+		parts[i] = part
+	}
+	s.Parts = parts
+	return pending, s.saveLocked()
+}
+
+func (s *UploadState) markCompleted(index int, sum string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Parts[index].Completed = true
+	s.Parts[index].SHA256 = sum
+	return s.saveLocked()
+}
+
+func hashUploadPart(file io.ReaderAt, offset, length int64) (string, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, io.NewSectionReader(file, offset, length)); err != nil {
+		return "", fmt.Errorf("hash upload part at offset %d: %w", offset, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,188 @@
+package asc
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestExecuteUploadOperations_WithUploadState(t *testing.T) {
+	fastRetry := RetryOptions{MaxRetries: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	ops := []UploadOperation{
+		{Method: "PUT", URL: "https://upload.example.test/op0", Offset: 0, Length: 5},
+		{Method: "PUT", URL: "https://upload.example.test/op1", Offset: 5, Length: 5},
+		{Method: "PUT", URL: "https://upload.example.test/op2", Offset: 10, Length: 5},
+	}
+
+	tests := []struct {
+		name string
+		// responses lists status codes per path, one per attempt; 200 after the list runs out.
+		responses map[string][]int
+		// badETag makes the first response for a path report a wrong ETag.
+		badETag       string
+		completed     []bool
+		modifyContent bool
+		wantErr       string
+		wantAttempts  map[string]int
+		wantCompleted []bool
+	}{
+		{
+			name:          "retries server errors per part",
+			responses:     map[string][]int{"/op1": {http.StatusInternalServerError, http.StatusBadGateway}},
+			wantAttempts:  map[string]int{"/op0": 1, "/op1": 3, "/op2": 1},
+			wantCompleted: []bool{true, true, true},
+		},
+		{
+			name:          "retries parts with a mismatched etag",
+			badETag:       "/op2",
+			wantAttempts:  map[string]int{"/op0": 1, "/op1": 1, "/op2": 2},
+			wantCompleted: []bool{true, true, true},
+		},
+		{
+			name:          "failed part does not stop the others",
+			responses:     map[string][]int{"/op0": {http.StatusForbidden}},
+			wantErr:       "1 of 3 upload parts failed",
+			wantAttempts:  map[string]int{"/op0": 1, "/op1": 1, "/op2": 1},
+			wantCompleted: []bool{false, true, true},
+		},
+		{
+			name:          "skips completed parts",
+			completed:     []bool{true, false, true},
+			wantAttempts:  map[string]int{"/op1": 1},
+			wantCompleted: []bool{true, true, true},
+		},
+		{
+			name:          "re-uploads completed parts whose bytes changed",
+			completed:     []bool{true, false, true},
+			modifyContent: true,
+			wantAttempts:  map[string]int{"/op0": 1, "/op1": 1},
+			wantCompleted: []bool{true, true, true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			filePath := filepath.Join(dir, "app.ipa")
+			content := []byte("abcdefghijklmno")
+			if err := os.WriteFile(filePath, content, 0o600); err != nil {
+				t.Fatalf("write file: %v", err)
+			}
+
+			state := &UploadState{UploadID: "upload-1", FileID: "file-1", Path: DefaultUploadStatePath(filePath)}
+			for i, op := range ops {
+				part := UploadPartState{Offset: op.Offset, Length: op.Length}
+				if i < len(test.completed) && test.completed[i] {
+					sum, err := hashUploadPart(strings.NewReader(string(content)), op.Offset, op.Length)
+					if err != nil {
+						t.Fatalf("hash part: %v", err)
+					}
+					part.Completed = true
+					part.SHA256 = sum
+				}
+				state.Parts = append(state.Parts, part)
+			}
+			if test.modifyContent {
+				if err := os.WriteFile(filePath, []byte("ABCDEfghijklmno"), 0o600); err != nil {
+					t.Fatalf("rewrite file: %v", err)
+				}
+			}
+
+			var mu sync.Mutex
+			attempts := map[string]int{}
+			client := &http.Client{
+				Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					body, _ := io.ReadAll(req.Body)
+					mu.Lock()
+					attempts[req.URL.Path]++
+					attempt := attempts[req.URL.Path]
+					mu.Unlock()
+
+					status := http.StatusOK
+					if codes := test.responses[req.URL.Path]; attempt <= len(codes) {
+						status = codes[attempt-1]
+					}
+					sum := md5.Sum(body)
+					etag := hex.EncodeToString(sum[:])
+					if req.URL.Path == test.badETag && attempt == 1 {
+						etag = strings.Repeat("0", 32)
+					}
+					return &http.Response{
+						StatusCode: status,
+						Status:     http.StatusText(status),
+						Body:       io.NopCloser(strings.NewReader("")),
+						Header:     http.Header{"Etag": []string{`"` + etag + `"`}},
+					}, nil
+				}),
+			}
+
+			err := ExecuteUploadOperations(context.Background(), filePath, ops,
+				WithUploadConcurrency(2),
+				WithUploadHTTPClient(client),
+				WithUploadRetryOptions(fastRetry),
+				WithUploadState(state),
+			)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("ExecuteUploadOperations() error: %v", err)
+			}
+
+			if len(attempts) != len(test.wantAttempts) {
+				t.Fatalf("attempts = %v, want %v", attempts, test.wantAttempts)
+			}
+			for path, want := range test.wantAttempts {
+				if attempts[path] != want {
+					t.Fatalf("attempts = %v, want %v", attempts, test.wantAttempts)
+				}
+			}
+
+			saved, err := LoadUploadState(state.Path)
+			if err != nil {
+				t.Fatalf("LoadUploadState() error: %v", err)
+			}
+			for i, want := range test.wantCompleted {
+				if saved.Parts[i].Completed != want {
+					t.Fatalf("part %d completed = %t, want %t (parts %+v)", i, saved.Parts[i].Completed, want, saved.Parts)
+				}
+			}
+		})
+	}
+}
+
+func TestUploadStateMatchesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.ipa")
+	if err := os.WriteFile(path, []byte("content"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat: %v", err)
+	}
+
+	state := &UploadState{FileSize: info.Size(), FileModTime: info.ModTime()}
+	if !state.MatchesFile(info) {
+		t.Fatalf("expected state to match unchanged file")
+	}
+	state.FileModTime = info.ModTime().Add(-time.Minute)
+	if state.MatchesFile(info) {
+		t.Fatalf("expected state not to match a modified file")
+	}
+}
+
+func TestLoadUploadStateMissing(t *testing.T) {
+	_, err := LoadUploadState(filepath.Join(t.TempDir(), "missing.json"))
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected not-exist error, got %v", err)
+	}
+}
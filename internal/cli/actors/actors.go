@@ -47,7 +47,7 @@ func ActorsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -103,7 +103,7 @@ Examples:
 					return fmt.Errorf("actors list: failed to fetch: %w", err)
 				}
 
-				actors, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				actors, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetActors(ctx, asc.WithActorsNextURL(nextURL))
 				})
 				if err != nil {
@@ -129,7 +129,7 @@ func ActorsGetCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Actor ID")
 	fields := fs.String("fields", "", "Fields to include: "+strings.Join(actorFieldsList(), ", "))
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
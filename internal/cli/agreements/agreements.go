@@ -68,7 +68,7 @@ func AgreementsTerritoriesListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -126,7 +126,7 @@ Examples:
 					return fmt.Errorf("agreements territories list: failed to fetch: %w", err)
 				}
 
-				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				paginated, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetEndUserLicenseAgreementTerritories(ctx, idValue, asc.WithEndUserLicenseAgreementTerritoriesNextURL(nextURL))
 				})
 				if err != nil {
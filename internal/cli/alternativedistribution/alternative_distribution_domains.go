@@ -49,7 +49,7 @@ func AlternativeDistributionDomainsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -92,7 +92,7 @@ Examples:
 					return fmt.Errorf("alternative-distribution domains list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				resp, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAlternativeDistributionDomains(ctx, asc.WithAlternativeDistributionDomainsNextURL(nextURL))
 				})
 				if err != nil {
@@ -117,7 +117,7 @@ func AlternativeDistributionDomainsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	domainID := fs.String("domain-id", "", "Alternative distribution domain ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -161,7 +161,7 @@ func AlternativeDistributionDomainsCreateCommand() *ffcli.Command {
 
 	domain := fs.String("domain", "", "Domain name")
 	referenceName := fs.String("reference-name", "", "Reference name for the domain")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -211,7 +211,7 @@ func AlternativeDistributionDomainsDeleteCommand() *ffcli.Command {
 
 	domainID := fs.String("domain-id", "", "Alternative distribution domain ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
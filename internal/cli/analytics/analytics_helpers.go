@@ -27,8 +27,10 @@ func normalizeSalesReportType(value string) (asc.SalesReportType, error) {
 		return asc.SalesReportTypeSubscription, nil
 	case string(asc.SalesReportTypeSubscriptionEvent):
 		return asc.SalesReportTypeSubscriptionEvent, nil
+	case string(asc.SalesReportTypeSubscriber):
+		return asc.SalesReportTypeSubscriber, nil
 	default:
-		return "", fmt.Errorf("--type must be SALES, PRE_ORDER, NEWSSTAND, SUBSCRIPTION, or SUBSCRIPTION_EVENT")
+		return "", fmt.Errorf("--type must be SALES, PRE_ORDER, NEWSSTAND, SUBSCRIPTION, SUBSCRIPTION_EVENT, or SUBSCRIBER")
 	}
 }
 
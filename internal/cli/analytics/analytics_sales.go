@@ -18,29 +18,49 @@ func AnalyticsSalesCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("sales", flag.ExitOnError)
 
 	vendor := fs.String("vendor", "", "Vendor number (or ASC_VENDOR_NUMBER/ASC_ANALYTICS_VENDOR_NUMBER env)")
-	reportType := fs.String("type", "", "Report type: SALES, PRE_ORDER, NEWSSTAND, SUBSCRIPTION, SUBSCRIPTION_EVENT")
+	reportType := fs.String("type", "", "Report type: SALES, PRE_ORDER, NEWSSTAND, SUBSCRIPTION, SUBSCRIPTION_EVENT, SUBSCRIBER")
 	reportSubType := fs.String("subtype", "", "Report subtype: SUMMARY, DETAILED")
 	frequency := fs.String("frequency", "", "Frequency: DAILY, WEEKLY, MONTHLY, YEARLY")
 	date := fs.String("date", "", "Report date: daily/weekly YYYY-MM-DD, monthly YYYY-MM, yearly YYYY")
+	from := fs.String("from", "", "Range start (same format as --date); downloads every period through --to")
+	to := fs.String("to", "", "Range end, inclusive (same format as --date)")
+	archiveDir := fs.String("archive-dir", "", "Archive directory for --from/--to downloads (keeps manifest.json)")
+	concurrency := fs.Int("concurrency", shared.DefaultReportBackfillConcurrency, "Parallel downloads for --from/--to")
 	version := fs.String("version", "1_0", "Report format version: 1_0 (default), 1_1")
 	output := fs.String("output", "", "Output file path (default: sales_report_{date}_{type}.tsv.gz)")
 	decompress := fs.Bool("decompress", false, "Decompress gzip output to .tsv")
-	outputFormat := fs.String("output-format", "json", "Output format for metadata: json (default), table, markdown")
+	outputFormat := fs.String("output-format", "json", "Output format for metadata: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "sales",
-		ShortUsage: "asc analytics sales [flags]",
+		ShortUsage: "asc analytics sales [flags] | asc analytics sales <subcommand> [flags]",
 		ShortHelp:  "Download sales and trends reports.",
 		LongHelp: `Download sales and trends reports.
 
+Use "parse" to read downloaded SALES, SUBSCRIPTION, or SUBSCRIBER reports as
+typed rows, and "summarize" to group units and proceeds from SALES reports.
+
+Use --from and --to instead of --date to backfill a date range into
+--archive-dir. Periods already in the archive are skipped, manifest.json
+records what was fetched and when, and periods that are not available yet are
+recorded as "unavailable" and retried on the next run. Weekly periods are the
+Sundays that end each week.
+
 Examples:
   asc analytics sales --vendor "12345678" --type SALES --subtype SUMMARY --frequency DAILY --date "2024-01-20"
   asc analytics sales --vendor "12345678" --type SUBSCRIPTION --subtype DETAILED --frequency MONTHLY --date "2024-01"
   asc analytics sales --vendor "12345678" --type SALES --subtype SUMMARY --frequency DAILY --date "2024-01-20" --decompress
-  asc analytics sales --vendor "12345678" --type SALES --subtype SUMMARY --frequency DAILY --date "2024-01-20" --output "reports/daily_sales.tsv.gz"`,
+  asc analytics sales --vendor "12345678" --type SALES --subtype SUMMARY --frequency DAILY --date "2024-01-20" --output "reports/daily_sales.tsv.gz"
+  asc analytics sales --vendor "12345678" --type SALES --subtype SUMMARY --frequency DAILY --from "2024-01-01" --to "2024-01-31" --archive-dir "reports"
+  asc analytics sales parse --file sales_report_2024-01-20_SALES.tsv.gz --output table
+  asc analytics sales summarize --file sales_report_2024-01-20_SALES.tsv.gz --group-by sku,territory`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			AnalyticsSalesParseCommand(),
+			AnalyticsSalesSummarizeCommand(),
+		},
 		Exec: func(ctx context.Context, args []string) error {
 			vendorNumber := shared.ResolveVendorNumber(*vendor)
 			if vendorNumber == "" {
@@ -59,9 +79,30 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --frequency is required")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*date) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --date is required")
+			rangeMode := strings.TrimSpace(*from) != "" || strings.TrimSpace(*to) != ""
+			if rangeMode {
+				if strings.TrimSpace(*date) != "" {
+					return fmt.Errorf("analytics sales: --date cannot be combined with --from/--to")
+				}
+				if strings.TrimSpace(*from) == "" || strings.TrimSpace(*to) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --from and --to are both required for a date range")
+					return flag.ErrHelp
+				}
+				if strings.TrimSpace(*archiveDir) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --archive-dir is required with --from/--to")
+					return flag.ErrHelp
+				}
+				if strings.TrimSpace(*output) != "" {
+					return fmt.Errorf("analytics sales: --output cannot be combined with --from/--to; files are written to --archive-dir")
+				}
+				if *concurrency < 1 {
+					return fmt.Errorf("analytics sales: --concurrency must be at least 1")
+				}
+			} else if strings.TrimSpace(*date) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --date is required (or use --from and --to)")
 				return flag.ErrHelp
+			} else if strings.TrimSpace(*archiveDir) != "" {
+				return fmt.Errorf("analytics sales: --archive-dir requires --from and --to")
 			}
 
 			salesType, err := normalizeSalesReportType(*reportType)
@@ -76,14 +117,27 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("analytics sales: %w", err)
 			}
-			reportDate, err := normalizeReportDate(*date, freq)
+			reportVersion, err := normalizeSalesReportVersion(*version)
 			if err != nil {
 				return fmt.Errorf("analytics sales: %w", err)
 			}
-			reportVersion, err := normalizeSalesReportVersion(*version)
+
+			params := asc.SalesReportParams{
+				VendorNumber:  vendorNumber,
+				ReportType:    salesType,
+				ReportSubType: subType,
+				Frequency:     freq,
+				Version:       reportVersion,
+			}
+			if rangeMode {
+				return runSalesReportBackfill(ctx, params, *from, *to, *archiveDir, *concurrency, *decompress, *outputFormat, *pretty)
+			}
+
+			reportDate, err := normalizeReportDate(*date, freq)
 			if err != nil {
 				return fmt.Errorf("analytics sales: %w", err)
 			}
+			params.ReportDate = reportDate
 
 			defaultOutput := fmt.Sprintf("sales_report_%s_%s.tsv.gz", reportDate, string(salesType))
 			compressedPath, decompressedPath := shared.ResolveReportOutputPaths(*output, defaultOutput, ".tsv", *decompress)
@@ -96,14 +150,7 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			download, err := client.GetSalesReport(requestCtx, asc.SalesReportParams{
-				VendorNumber:  vendorNumber,
-				ReportType:    salesType,
-				ReportSubType: subType,
-				Frequency:     freq,
-				ReportDate:    reportDate,
-				Version:       reportVersion,
-			})
+			download, err := client.GetSalesReport(requestCtx, params)
 			if err != nil {
 				return fmt.Errorf("analytics sales: failed to download report: %w", err)
 			}
@@ -0,0 +1,58 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// runSalesReportBackfill downloads every report period from..to into the
+// archive directory and prints the per-period outcome.
+func runSalesReportBackfill(ctx context.Context, params asc.SalesReportParams, from, to, archiveDir string, concurrency int, decompress bool, outputFormat string, pretty bool) error {
+	periods, err := shared.ReportPeriods(strings.TrimSpace(from), strings.TrimSpace(to), params.Frequency)
+	if err != nil {
+		return fmt.Errorf("analytics sales: %w", err)
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("analytics sales: %w", err)
+	}
+
+	series := fmt.Sprintf("%s_%s_%s_%s", params.ReportType, params.ReportSubType, params.Frequency, params.Version)
+	result, err := shared.RunReportBackfill(ctx, shared.ReportBackfillOptions{
+		ArchiveDir: archiveDir,
+		Subdir:     path.Join("sales", params.VendorNumber, series),
+		Template: shared.ReportArchiveEntry{
+			Kind:          "sales",
+			VendorNumber:  params.VendorNumber,
+			ReportType:    string(params.ReportType),
+			ReportSubType: string(params.ReportSubType),
+			Frequency:     string(params.Frequency),
+			Version:       string(params.Version),
+		},
+		Periods:     periods,
+		Concurrency: concurrency,
+		Decompress:  decompress,
+		Download: func(ctx context.Context, period string) (*asc.ReportDownload, error) {
+			request := params
+			request.ReportDate = period
+			return client.GetSalesReport(ctx, request)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("analytics sales: %w", err)
+	}
+
+	if err := shared.PrintOutput(result, outputFormat, pretty); err != nil {
+		return err
+	}
+	if result.Failed > 0 {
+		return fmt.Errorf("analytics sales: %d of %d report periods failed", result.Failed, len(result.Data))
+	}
+	return nil
+}
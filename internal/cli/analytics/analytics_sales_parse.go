@@ -0,0 +1,125 @@
+package analytics
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// AnalyticsSalesParseCommand parses downloaded sales reports into typed rows.
+func AnalyticsSalesParseCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("parse", flag.ExitOnError)
+
+	files := fs.String("file", "", "Report file(s) to parse, comma-separated (.tsv or .tsv.gz)")
+	reportType := fs.String("type", string(asc.SalesReportTypeSales), "Report layout: SALES (default), SUBSCRIPTION, SUBSCRIBER")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "parse",
+		ShortUsage: "asc analytics sales parse --file FILE [flags]",
+		ShortHelp:  "Parse downloaded sales reports into typed rows.",
+		LongHelp: `Parse downloaded sales reports into typed rows.
+
+Reads reports saved by "asc analytics sales" (gzip-compressed or not) and prints
+one typed row per report line. Columns are matched by header name, so report
+format versions with extra columns parse as well.
+
+Examples:
+  asc analytics sales parse --file sales_report_2024-01-20_SALES.tsv.gz
+  asc analytics sales parse --file subscription.tsv --type SUBSCRIPTION --output table
+  asc analytics sales parse --file subscriber.tsv.gz --type SUBSCRIBER --output csv`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			paths := shared.SplitCSV(*files)
+			if len(paths) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			switch strings.ToUpper(strings.TrimSpace(*reportType)) {
+			case string(asc.SalesReportTypeSales):
+				rows, err := shared.ParseReportFiles(paths, asc.ParseSalesReport)
+				if err != nil {
+					return fmt.Errorf("analytics sales parse: %w", err)
+				}
+				return shared.PrintOutput(&asc.SalesReportRows{Data: rows}, *output, *pretty)
+			case string(asc.SalesReportTypeSubscription):
+				rows, err := shared.ParseReportFiles(paths, asc.ParseSubscriptionReport)
+				if err != nil {
+					return fmt.Errorf("analytics sales parse: %w", err)
+				}
+				return shared.PrintOutput(&asc.SubscriptionReportRows{Data: rows}, *output, *pretty)
+			case string(asc.SalesReportTypeSubscriber):
+				rows, err := shared.ParseReportFiles(paths, asc.ParseSubscriberReport)
+				if err != nil {
+					return fmt.Errorf("analytics sales parse: %w", err)
+				}
+				return shared.PrintOutput(&asc.SubscriberReportRows{Data: rows}, *output, *pretty)
+			default:
+				return fmt.Errorf("analytics sales parse: --type must be SALES, SUBSCRIPTION, or SUBSCRIBER")
+			}
+		},
+	}
+}
+
+// AnalyticsSalesSummarizeCommand aggregates SALES reports by dimension.
+func AnalyticsSalesSummarizeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("summarize", flag.ExitOnError)
+
+	files := fs.String("file", "", "SALES report file(s) to summarize, comma-separated (.tsv or .tsv.gz)")
+	groupBy := fs.String("group-by", asc.SalesSummaryBySKU, "Dimensions to group by, comma-separated: "+strings.Join(asc.SalesSummaryDimensions, ", "))
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "summarize",
+		ShortUsage: "asc analytics sales summarize --file FILE [flags]",
+		ShortHelp:  "Summarize units and proceeds from SALES reports.",
+		LongHelp: `Summarize units and proceeds from SALES reports.
+
+Groups report lines by SKU, territory (country code), product type, and/or
+date, and sums units and proceeds (units × developer proceeds). Proceeds are
+always split by proceeds currency. Pass several files to summarize a range of
+daily or weekly reports.
+
+Examples:
+  asc analytics sales summarize --file sales_report_2024-01-20_SALES.tsv.gz
+  asc analytics sales summarize --file "day1.tsv.gz,day2.tsv.gz" --group-by sku,date --output table
+  asc analytics sales summarize --file sales.tsv --group-by territory,product-type --output csv`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			paths := shared.SplitCSV(*files)
+			if len(paths) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+			dimensions := shared.SplitCSV(strings.ToLower(*groupBy))
+			if len(dimensions) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --group-by is required")
+				return flag.ErrHelp
+			}
+
+			rows, err := shared.ParseReportFiles(paths, asc.ParseSalesReport)
+			if err != nil {
+				return fmt.Errorf("analytics sales summarize: %w", err)
+			}
+			summary, err := asc.SummarizeSalesReport(rows, dimensions)
+			if err != nil {
+				return fmt.Errorf("analytics sales summarize: %w", err)
+			}
+			summary.Files = paths
+
+			return shared.PrintOutput(summary, *output, *pretty)
+		},
+	}
+}
@@ -0,0 +1,336 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/openapi"
+)
+
+var supportedMethods = []string{http.MethodGet, http.MethodPost, http.MethodPatch, http.MethodDelete}
+
+// requestField is a key=value pair from --field or --typed-field.
+type requestField struct {
+	raw   string
+	typed bool
+}
+
+// fieldFlags collects repeated --field or --typed-field key=value flags into
+// one slice so their order is preserved.
+type fieldFlags struct {
+	fields *[]requestField
+	typed  bool
+}
+
+func (f fieldFlags) String() string {
+	if f.fields == nil {
+		return ""
+	}
+	values := make([]string, 0, len(*f.fields))
+	for _, field := range *f.fields {
+		if field.typed == f.typed {
+			values = append(values, field.raw)
+		}
+	}
+	return strings.Join(values, ",")
+}
+
+func (f fieldFlags) Set(value string) error {
+	*f.fields = append(*f.fields, requestField{raw: value, typed: f.typed})
+	return nil
+}
+
+// APICommand returns the raw API request command.
+func APICommand() *ffcli.Command {
+	fs := flag.NewFlagSet("api", flag.ExitOnError)
+
+	var fields []requestField
+	fs.Var(fieldFlags{fields: &fields}, "field", "Request field key=value sent as a string (repeatable; query for GET, JSON body for others)")
+	fs.Var(fieldFlags{fields: &fields, typed: true}, "typed-field", "Request field key=value sent as a JSON number, boolean, null, array, or object when it parses as one (repeatable)")
+	bodyFile := fs.String("body", "", "Path to a JSON request body file (- for stdin)")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (GET collections only)")
+	noValidate := fs.Bool("no-validate", false, "Skip checking the method and path against the OpenAPI snapshot")
+	confirm := fs.Bool("confirm", false, "Confirm DELETE requests")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "api",
+		ShortUsage: "asc api <METHOD> <path> [flags]",
+		ShortHelp:  "Send a raw App Store Connect API request.",
+		LongHelp: `Send an authenticated request to any App Store Connect API endpoint.
+
+The method and path are checked against the bundled OpenAPI snapshot
+(docs/openapi/paths.txt); use --no-validate for endpoints newer than the
+snapshot. Requests reuse asc authentication, retries, and pagination.
+
+For GET requests, --field values become query parameters. For POST and
+PATCH, --field builds a JSON body: dotted keys create nested objects and
+values are always sent as strings. Use --typed-field for values that should
+be sent as true/false/null, numbers, or JSON arrays/objects.
+
+Examples:
+  asc api GET /v1/apps --field "filter[bundleId]=com.example.app"
+  asc api GET /v1/apps/123/builds --field limit=200 --paginate
+  asc api PATCH /v1/apps/123 --field data.type=apps --field data.id=123 --field data.attributes.contentRightsDeclaration=USES_THIRD_PARTY_CONTENT
+  asc api PATCH /v1/betaGroups/GROUP_ID --field data.type=betaGroups --field data.id=GROUP_ID --typed-field data.attributes.publicLinkLimit=50
+  asc api POST /v1/betaGroups --body group.json
+  asc api DELETE /v1/betaGroups/GROUP_ID --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) < 2 {
+				fmt.Fprintln(os.Stderr, "Error: <METHOD> and <path> are required")
+				return flag.ErrHelp
+			}
+			// Allow flags after the positional arguments.
+			if err := fs.Parse(args[2:]); err != nil {
+				return err
+			}
+			if fs.NArg() > 0 {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument %q\n", shared.SanitizeTerminal(fs.Arg(0)))
+				return flag.ErrHelp
+			}
+
+			method := strings.ToUpper(strings.TrimSpace(args[0]))
+			if !isSupportedMethod(method) {
+				fmt.Fprintf(os.Stderr, "Error: unsupported method %q (expected %s)\n", shared.SanitizeTerminal(args[0]), strings.Join(supportedMethods, ", "))
+				return flag.ErrHelp
+			}
+			path, pathQuery, err := splitPath(args[1])
+			if err != nil {
+				return fmt.Errorf("api: %w", err)
+			}
+
+			if !*noValidate {
+				if _, err := openapi.Lookup(method, path); err != nil {
+					return fmt.Errorf("api: %w (use --no-validate to send anyway)", err)
+				}
+			}
+			if method == http.MethodDelete && !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required for DELETE requests")
+				return flag.ErrHelp
+			}
+			if *paginate && method != http.MethodGet {
+				return fmt.Errorf("api: --paginate is only supported for GET requests")
+			}
+			hasBody := strings.TrimSpace(*bodyFile) != ""
+			if hasBody && (method == http.MethodGet || method == http.MethodDelete) {
+				return fmt.Errorf("api: --body is not supported for %s requests", method)
+			}
+			if hasBody && len(fields) > 0 {
+				return fmt.Errorf("api: --body and --field are mutually exclusive for %s requests", method)
+			}
+
+			query := pathQuery
+			var body []byte
+			switch method {
+			case http.MethodGet, http.MethodDelete:
+				if err := addQueryFields(query, fields); err != nil {
+					return fmt.Errorf("api: %w", err)
+				}
+			default:
+				if hasBody {
+					body, err = readBody(*bodyFile)
+				} else if len(fields) > 0 {
+					body, err = buildBodyFromFields(fields)
+				}
+				if err != nil {
+					return fmt.Errorf("api: %w", err)
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("api: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if *paginate {
+				firstPage, err := client.RequestList(requestCtx, path, query)
+				if err != nil {
+					return fmt.Errorf("api: %w", err)
+				}
+				all, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					if err := shared.ValidateNextURL(nextURL); err != nil {
+						return nil, err
+					}
+					return client.RequestList(ctx, nextURL, nil)
+				})
+				if err != nil {
+					return fmt.Errorf("api: %w", err)
+				}
+				if list, ok := all.(*asc.RawListResponse); ok {
+					// Aggregated results span every page, so drop page links.
+					list.Links = asc.Links{}
+				}
+				return shared.PrintOutput(all, *output, *pretty)
+			}
+
+			resp, err := client.Request(requestCtx, method, path, query, body)
+			if err != nil {
+				return fmt.Errorf("api: %w", err)
+			}
+			if len(strings.TrimSpace(string(resp.Body))) == 0 {
+				return nil
+			}
+			return shared.PrintOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+func isSupportedMethod(method string) bool {
+	for _, supported := range supportedMethods {
+		if method == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// splitPath separates a path from an inline query string and rejects
+// absolute URLs so requests always target the configured base URL.
+func splitPath(raw string) (string, url.Values, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", nil, fmt.Errorf("path is required")
+	}
+	if strings.Contains(raw, "://") {
+		return "", nil, fmt.Errorf("path must be relative to the API base URL (e.g. /v1/apps), got %q", raw)
+	}
+	path, rawQuery, _ := strings.Cut(raw, "?")
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid query string: %w", err)
+	}
+	return path, query, nil
+}
+
+func parseField(field requestField) (string, string, error) {
+	key, value, ok := strings.Cut(field.raw, "=")
+	key = strings.TrimSpace(key)
+	if !ok || key == "" {
+		flagName := "--field"
+		if field.typed {
+			flagName = "--typed-field"
+		}
+		return "", "", fmt.Errorf("%s must be in key=value format, got %q", flagName, field.raw)
+	}
+	return key, value, nil
+}
+
+func addQueryFields(query url.Values, fields []requestField) error {
+	for _, field := range fields {
+		key, value, err := parseField(field)
+		if err != nil {
+			return err
+		}
+		query.Add(key, value)
+	}
+	return nil
+}
+
+// buildBodyFromFields builds a JSON object from dotted key=value fields,
+// e.g. data.attributes.name=Beta becomes {"data":{"attributes":{"name":"Beta"}}}.
+// Values are strings unless the field came from --typed-field.
+func buildBodyFromFields(fields []requestField) ([]byte, error) {
+	root := map[string]any{}
+	for _, field := range fields {
+		key, value, err := parseField(field)
+		if err != nil {
+			return nil, err
+		}
+		parts := strings.Split(key, ".")
+		node := root
+		for i, part := range parts {
+			if part == "" {
+				return nil, fmt.Errorf("--field key %q has an empty segment", key)
+			}
+			if i == len(parts)-1 {
+				if _, exists := node[part]; exists {
+					return nil, fmt.Errorf("--field key %q is set more than once", key)
+				}
+				if field.typed {
+					node[part] = typedFieldValue(value)
+				} else {
+					node[part] = value
+				}
+				break
+			}
+			child, exists := node[part]
+			if !exists {
+				next := map[string]any{}
+				node[part] = next
+				node = next
+				continue
+			}
+			next, ok := child.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("--field key %q conflicts with a value set for %q", key, strings.Join(parts[:i+1], "."))
+			}
+			node = next
+		}
+	}
+	return json.Marshal(root)
+}
+
+func typedFieldValue(value string) any {
+	trimmed := strings.TrimSpace(value)
+	switch trimmed {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+	if number, err := strconv.ParseInt(trimmed, 10, 64); err == nil && trimmed == value {
+		return number
+	}
+	// Other JSON numbers are sent as written, so 0.99 stays 0.99.
+	if _, err := strconv.ParseFloat(trimmed, 64); err == nil && trimmed == value && json.Valid([]byte(trimmed)) {
+		return json.Number(trimmed)
+	}
+	if strings.HasPrefix(trimmed, "[") || strings.HasPrefix(trimmed, "{") {
+		var decoded any
+		if err := json.Unmarshal([]byte(trimmed), &decoded); err == nil {
+			return decoded
+		}
+	}
+	return value
+}
+
+func readBody(path string) ([]byte, error) {
+	path = strings.TrimSpace(path)
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read --body: %w", err)
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("--body must contain valid JSON")
+	}
+	return data, nil
+}
@@ -14,7 +14,7 @@ import (
 )
 
 func appsListFlags(fs *flag.FlagSet) (output *string, pretty *bool, bundleID *string, name *string, sku *string, sort *string, limit *int, next *string, paginate *bool) {
-	output = fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output = fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty = fs.Bool("pretty", false, "Pretty-print JSON output")
 	bundleID = fs.String("bundle-id", "", "Filter by bundle ID(s), comma-separated")
 	name = fs.String("name", "", "Filter by app name(s), comma-separated")
@@ -109,7 +109,7 @@ func AppsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("apps get", flag.ExitOnError)
 
 	id := fs.String("id", "", "App Store Connect app ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -156,7 +156,7 @@ func AppsUpdateCommand() *ffcli.Command {
 	bundleID := fs.String("bundle-id", "", "Update bundle ID")
 	primaryLocale := fs.String("primary-locale", "", "Update primary locale (e.g., en-US)")
 	contentRights := fs.String("content-rights", "", "Content rights declaration: DOES_NOT_USE_THIRD_PARTY_CONTENT or USES_THIRD_PARTY_CONTENT")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -258,7 +258,7 @@ func appsList(ctx context.Context, output string, pretty bool, bundleID string,
 		}
 
 		// Fetch all remaining pages
-		apps, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		apps, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			return client.GetApps(ctx, asc.WithAppsNextURL(nextURL))
 		})
 		if err != nil {
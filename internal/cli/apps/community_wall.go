@@ -49,7 +49,7 @@ type communityWallEntry struct {
 func AppsWallCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("apps wall", flag.ExitOnError)
 
-	output := fs.String("output", defaultCommunityWallOutput, "Output format: table (default), json, markdown")
+	output := fs.String("output", defaultCommunityWallOutput, "Output format: table (default), json, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	sortBy := fs.String("sort", defaultCommunityWallSort, "Sort by name or -name")
 	limit := fs.Int("limit", 0, "Maximum number of apps to include (1-200)")
@@ -21,12 +21,14 @@ func AssetsCommand() *ffcli.Command {
 Examples:
   asc assets screenshots list --version-localization "LOC_ID"
   asc assets screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPHONE_65"
-  asc assets previews upload --version-localization "LOC_ID" --path "./previews" --device-type "IPHONE_65"`,
+  asc assets previews upload --version-localization "LOC_ID" --path "./previews" --device-type "IPHONE_65"
+  asc assets sync --version "VERSION_ID" --dir "./screenshots" --prune`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			AssetsScreenshotsCommand(),
 			AssetsPreviewsCommand(),
+			AssetsSyncCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
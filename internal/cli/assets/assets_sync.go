@@ -0,0 +1,626 @@
+package assets
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	assetSyncDefaultConcurrency = 4
+
+	assetTypeScreenshot = "screenshot"
+	assetTypePreview    = "preview"
+
+	assetSyncActionUpload    = "upload"
+	assetSyncActionUnchanged = "unchanged"
+	assetSyncActionDelete    = "delete"
+	assetSyncActionExtra     = "extra"
+	assetSyncActionReorder   = "reorder"
+)
+
+var (
+	screenshotFileExtensions = []string{".png", ".jpg", ".jpeg"}
+	previewFileExtensions    = []string{".mov", ".m4v", ".mp4"}
+)
+
+type assetSyncClient interface {
+	GetAppStoreVersionLocalizations(ctx context.Context, versionID string, opts ...asc.AppStoreVersionLocalizationsOption) (*asc.AppStoreVersionLocalizationsResponse, error)
+	GetAppScreenshotSets(ctx context.Context, localizationID string) (*asc.AppScreenshotSetsResponse, error)
+	CreateAppScreenshotSet(ctx context.Context, localizationID string, displayType string) (*asc.AppScreenshotSetResponse, error)
+	GetAppScreenshots(ctx context.Context, setID string) (*asc.AppScreenshotsResponse, error)
+	DeleteAppScreenshot(ctx context.Context, screenshotID string) error
+	ReplaceAppScreenshotSetScreenshots(ctx context.Context, setID string, screenshotIDs []string) error
+	GetAppPreviewSets(ctx context.Context, localizationID string) (*asc.AppPreviewSetsResponse, error)
+	CreateAppPreviewSet(ctx context.Context, localizationID string, previewType string) (*asc.AppPreviewSetResponse, error)
+	GetAppPreviews(ctx context.Context, setID string) (*asc.AppPreviewsResponse, error)
+	DeleteAppPreview(ctx context.Context, previewID string) error
+	ReplaceAppPreviewSetPreviews(ctx context.Context, setID string, previewIDs []string) error
+}
+
+type assetUploadFunc func(ctx context.Context, setID, filePath string) (asc.AssetUploadResultItem, error)
+
+// assetSyncLocale holds the local sets found in one locale directory.
+type assetSyncLocale struct {
+	locale string
+	sets   []assetSyncSet
+}
+
+// assetSyncSet holds the ordered local files for one screenshot or preview set.
+type assetSyncSet struct {
+	assetType   string
+	displayType string
+	files       []assetSyncFile
+}
+
+type assetSyncFile struct {
+	path     string
+	checksum string
+}
+
+type remoteAsset struct {
+	id       string
+	checksum string
+}
+
+// assetSetPlan lists the changes that make a remote set match local files.
+type assetSetPlan struct {
+	// slots follows local file order. A nil remote means the file is uploaded.
+	slots   []assetSlot
+	deletes []remoteAsset
+	extras  []remoteAsset
+}
+
+type assetSlot struct {
+	file   assetSyncFile
+	remote *remoteAsset
+}
+
+// AssetsSyncCommand returns the assets sync subcommand.
+func AssetsSyncCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("sync", flag.ExitOnError)
+
+	versionID := fs.String("version", "", "App Store version ID")
+	dir := fs.String("dir", "", "Directory laid out as <locale>/<display-type>/NN_name.png")
+	prune := fs.Bool("prune", false, "Delete remote screenshots and previews that have no local file")
+	dryRun := fs.Bool("dry-run", false, "Show the changes without making them")
+	concurrency := fs.Int("concurrency", assetSyncDefaultConcurrency, "Number of locales to sync in parallel")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "sync",
+		ShortUsage: "asc assets sync --version \"VERSION_ID\" --dir \"./screenshots\" [flags]",
+		ShortHelp:  "Sync screenshots and previews from a directory.",
+		LongHelp: `Sync screenshots and app previews for a version from a directory.
+
+The directory is laid out as <locale>/<display-type>/<files>, for example
+en-US/IPHONE_67/01_home.png or en-US/IPHONE_67/01_intro.mov. Images go to the
+screenshot set and videos to the preview set for that display type. Files are
+ordered by name.
+
+Files whose checksum matches an asset already in the set are not uploaded
+again. Remote assets without a local file are kept unless --prune is set.
+Sets are reordered to match file order. Locales and display types without a
+local directory are left alone. Locales are synced in parallel.
+
+Examples:
+  asc assets sync --version "VERSION_ID" --dir "./screenshots" --dry-run
+  asc assets sync --version "VERSION_ID" --dir "./screenshots"
+  asc assets sync --version "VERSION_ID" --dir "./screenshots" --prune --concurrency 2`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			version := strings.TrimSpace(*versionID)
+			if version == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version is required")
+				return flag.ErrHelp
+			}
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+			if *concurrency < 1 {
+				return fmt.Errorf("assets sync: --concurrency must be at least 1")
+			}
+
+			locales, err := scanAssetSyncDir(dirValue)
+			if err != nil {
+				return fmt.Errorf("assets sync: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("assets sync: %w", err)
+			}
+
+			requestCtx, cancel := contextWithAssetUploadTimeout(ctx)
+			defer cancel()
+
+			syncer := &assetSyncer{
+				client: client,
+				uploadScreenshot: func(ctx context.Context, setID, filePath string) (asc.AssetUploadResultItem, error) {
+					return uploadScreenshotAsset(ctx, client, setID, filePath)
+				},
+				uploadPreview: func(ctx context.Context, setID, filePath string) (asc.AssetUploadResultItem, error) {
+					return uploadPreviewAsset(ctx, client, setID, filePath)
+				},
+				prune:  *prune,
+				dryRun: *dryRun,
+			}
+			items, err := syncer.sync(requestCtx, version, locales, *concurrency)
+			if err != nil {
+				return fmt.Errorf("assets sync: %w", err)
+			}
+
+			result := asc.AssetSyncResult{
+				VersionID: version,
+				Dir:       dirValue,
+				DryRun:    *dryRun,
+				Prune:     *prune,
+				Items:     items,
+			}
+			return shared.PrintOutput(&result, *output, *pretty)
+		},
+	}
+}
+
+// scanAssetSyncDir reads the <locale>/<display-type>/<files> layout and
+// validates every file before anything is uploaded.
+func scanAssetSyncDir(dir string) ([]assetSyncLocale, error) {
+	localeDirs, err := readAssetSyncSubdirs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(localeDirs) == 0 {
+		return nil, fmt.Errorf("no locale directories found in %q", dir)
+	}
+
+	locales := make([]assetSyncLocale, 0, len(localeDirs))
+	for _, locale := range localeDirs {
+		if err := shared.ValidateBuildLocalizationLocale(locale); err != nil {
+			return nil, err
+		}
+		localeDir := filepath.Join(dir, locale)
+		typeDirs, err := readAssetSyncSubdirs(localeDir)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := assetSyncLocale{locale: locale}
+		for _, typeDir := range typeDirs {
+			sets, err := scanAssetSyncTypeDir(filepath.Join(localeDir, typeDir), typeDir)
+			if err != nil {
+				return nil, err
+			}
+			entry.sets = append(entry.sets, sets...)
+		}
+		if len(entry.sets) > 0 {
+			locales = append(locales, entry)
+		}
+	}
+	if len(locales) == 0 {
+		return nil, fmt.Errorf("no screenshots or previews found in %q", dir)
+	}
+	return locales, nil
+}
+
+func readAssetSyncSubdirs(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if !entry.IsDir() {
+			return nil, fmt.Errorf("unexpected file %q: expected <locale>/<display-type>/ directories", filepath.Join(dir, entry.Name()))
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func scanAssetSyncTypeDir(dir, name string) ([]assetSyncSet, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var screenshots, previews []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		fullPath := filepath.Join(dir, entry.Name())
+		if entry.IsDir() {
+			return nil, fmt.Errorf("unexpected directory %q", fullPath)
+		}
+		if err := asc.ValidateAssetFile(fullPath); err != nil {
+			return nil, err
+		}
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		switch {
+		case slices.Contains(screenshotFileExtensions, ext):
+			screenshots = append(screenshots, fullPath)
+		case slices.Contains(previewFileExtensions, ext):
+			previews = append(previews, fullPath)
+		default:
+			return nil, fmt.Errorf("unsupported file %q: expected %s, or %s", fullPath, strings.Join(screenshotFileExtensions, ", "), strings.Join(previewFileExtensions, ", "))
+		}
+	}
+	sort.Strings(screenshots)
+	sort.Strings(previews)
+
+	var sets []assetSyncSet
+	if len(screenshots) > 0 {
+		displayType, err := normalizeScreenshotDisplayType(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", dir, err)
+		}
+		if err := validateScreenshotDimensions(screenshots, displayType); err != nil {
+			return nil, err
+		}
+		files, err := checksumAssetSyncFiles(screenshots)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, assetSyncSet{assetType: assetTypeScreenshot, displayType: displayType, files: files})
+	}
+	if len(previews) > 0 {
+		previewType, err := normalizePreviewType(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", dir, err)
+		}
+		files, err := checksumAssetSyncFiles(previews)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, assetSyncSet{assetType: assetTypePreview, displayType: previewType, files: files})
+	}
+	return sets, nil
+}
+
+func checksumAssetSyncFiles(paths []string) ([]assetSyncFile, error) {
+	files := make([]assetSyncFile, 0, len(paths))
+	for _, path := range paths {
+		checksum, err := asc.ComputeChecksum(path, asc.ChecksumAlgorithmMD5)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, assetSyncFile{path: path, checksum: checksum.Hash})
+	}
+	return files, nil
+}
+
+// planAssetSet matches local files to remote assets by checksum, in order.
+func planAssetSet(files []assetSyncFile, remote []remoteAsset, prune bool) assetSetPlan {
+	used := make([]bool, len(remote))
+	plan := assetSetPlan{slots: make([]assetSlot, 0, len(files))}
+	for _, file := range files {
+		slot := assetSlot{file: file}
+		for i := range remote {
+			if !used[i] && remote[i].checksum != "" && strings.EqualFold(remote[i].checksum, file.checksum) {
+				used[i] = true
+				slot.remote = &remote[i]
+				break
+			}
+		}
+		plan.slots = append(plan.slots, slot)
+	}
+	for i, asset := range remote {
+		if used[i] {
+			continue
+		}
+		if prune {
+			plan.deletes = append(plan.deletes, asset)
+		} else {
+			plan.extras = append(plan.extras, asset)
+		}
+	}
+	return plan
+}
+
+// needsReorder reports whether the set order after deletes and uploads
+// differs from local file order. New uploads land at the end of a set.
+func (p assetSetPlan) needsReorder(remote []remoteAsset, slotIDs []string) bool {
+	deleted := make(map[string]bool, len(p.deletes))
+	for _, asset := range p.deletes {
+		deleted[asset.id] = true
+	}
+	current := make([]string, 0, len(slotIDs)+len(p.extras))
+	for _, asset := range remote {
+		if !deleted[asset.id] {
+			current = append(current, asset.id)
+		}
+	}
+	for i, slot := range p.slots {
+		if slot.remote == nil {
+			current = append(current, slotIDs[i])
+		}
+	}
+	return !slices.Equal(current, p.desiredOrder(slotIDs))
+}
+
+func (p assetSetPlan) desiredOrder(slotIDs []string) []string {
+	order := slices.Clone(slotIDs)
+	for _, asset := range p.extras {
+		order = append(order, asset.id)
+	}
+	return order
+}
+
+type assetSyncer struct {
+	client           assetSyncClient
+	uploadScreenshot assetUploadFunc
+	uploadPreview    assetUploadFunc
+	prune            bool
+	dryRun           bool
+}
+
+// assetSetOps adapts screenshot and preview endpoints to one shape.
+type assetSetOps struct {
+	listSets   func(ctx context.Context, localizationID string) (map[string]string, error)
+	createSet  func(ctx context.Context, localizationID, displayType string) (string, error)
+	listAssets func(ctx context.Context, setID string) ([]remoteAsset, error)
+	upload     assetUploadFunc
+	delete     func(ctx context.Context, id string) error
+	reorder    func(ctx context.Context, setID string, ids []string) error
+}
+
+func (s *assetSyncer) ops(assetType string) assetSetOps {
+	if assetType == assetTypePreview {
+		return assetSetOps{
+			listSets: func(ctx context.Context, localizationID string) (map[string]string, error) {
+				resp, err := s.client.GetAppPreviewSets(ctx, localizationID)
+				if err != nil {
+					return nil, err
+				}
+				sets := make(map[string]string, len(resp.Data))
+				for _, set := range resp.Data {
+					sets[strings.ToUpper(set.Attributes.PreviewType)] = set.ID
+				}
+				return sets, nil
+			},
+			createSet: func(ctx context.Context, localizationID, displayType string) (string, error) {
+				resp, err := s.client.CreateAppPreviewSet(ctx, localizationID, displayType)
+				if err != nil {
+					return "", err
+				}
+				return resp.Data.ID, nil
+			},
+			listAssets: func(ctx context.Context, setID string) ([]remoteAsset, error) {
+				resp, err := s.client.GetAppPreviews(ctx, setID)
+				if err != nil {
+					return nil, err
+				}
+				assets := make([]remoteAsset, 0, len(resp.Data))
+				for _, item := range resp.Data {
+					assets = append(assets, remoteAsset{id: item.ID, checksum: item.Attributes.SourceFileChecksum})
+				}
+				return assets, nil
+			},
+			upload:  s.uploadPreview,
+			delete:  s.client.DeleteAppPreview,
+			reorder: s.client.ReplaceAppPreviewSetPreviews,
+		}
+	}
+	return assetSetOps{
+		listSets: func(ctx context.Context, localizationID string) (map[string]string, error) {
+			resp, err := s.client.GetAppScreenshotSets(ctx, localizationID)
+			if err != nil {
+				return nil, err
+			}
+			sets := make(map[string]string, len(resp.Data))
+			for _, set := range resp.Data {
+				sets[strings.ToUpper(set.Attributes.ScreenshotDisplayType)] = set.ID
+			}
+			return sets, nil
+		},
+		createSet: func(ctx context.Context, localizationID, displayType string) (string, error) {
+			resp, err := s.client.CreateAppScreenshotSet(ctx, localizationID, displayType)
+			if err != nil {
+				return "", err
+			}
+			return resp.Data.ID, nil
+		},
+		listAssets: func(ctx context.Context, setID string) ([]remoteAsset, error) {
+			resp, err := s.client.GetAppScreenshots(ctx, setID)
+			if err != nil {
+				return nil, err
+			}
+			assets := make([]remoteAsset, 0, len(resp.Data))
+			for _, item := range resp.Data {
+				assets = append(assets, remoteAsset{id: item.ID, checksum: item.Attributes.SourceFileChecksum})
+			}
+			return assets, nil
+		},
+		upload:  s.uploadScreenshot,
+		delete:  s.client.DeleteAppScreenshot,
+		reorder: s.client.ReplaceAppScreenshotSetScreenshots,
+	}
+}
+
+func (s *assetSyncer) sync(ctx context.Context, versionID string, locales []assetSyncLocale, concurrency int) ([]asc.AssetSyncItem, error) {
+	resp, err := s.client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch version localizations: %w", err)
+	}
+	localizationIDs := make(map[string]string, len(resp.Data))
+	for _, item := range resp.Data {
+		localizationIDs[item.Attributes.Locale] = item.ID
+	}
+	var missing []string
+	for _, locale := range locales {
+		if localizationIDs[locale.locale] == "" {
+			missing = append(missing, locale.locale)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("version %s has no localization for %s", versionID, strings.Join(missing, ", "))
+	}
+
+	workers := max(min(len(locales), concurrency), 1)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := make(chan struct{}, workers)
+	results := make([][]asc.AssetSyncItem, len(locales))
+	errs := make(chan error, len(locales))
+	var once sync.Once
+	var wg sync.WaitGroup
+
+	for idx := range locales {
+		wg.Go(func() {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			items, err := s.syncLocale(ctx, localizationIDs[locales[idx].locale], locales[idx])
+			if err != nil {
+				once.Do(cancel)
+				errs <- fmt.Errorf("%s: %w", locales[idx].locale, err)
+				return
+			}
+			results[idx] = items
+		})
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context cancelled: %w", err)
+	}
+
+	items := make([]asc.AssetSyncItem, 0)
+	for _, localeItems := range results {
+		items = append(items, localeItems...)
+	}
+	return items, nil
+}
+
+func (s *assetSyncer) syncLocale(ctx context.Context, localizationID string, local assetSyncLocale) ([]asc.AssetSyncItem, error) {
+	remoteSets := make(map[string]map[string]string)
+	var items []asc.AssetSyncItem
+	for _, set := range local.sets {
+		ops := s.ops(set.assetType)
+		if _, ok := remoteSets[set.assetType]; !ok {
+			sets, err := ops.listSets(ctx, localizationID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch %s sets: %w", set.assetType, err)
+			}
+			remoteSets[set.assetType] = sets
+		}
+
+		setItems, err := s.syncSet(ctx, ops, localizationID, remoteSets[set.assetType][set.displayType], local.locale, set)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", set.assetType, set.displayType, err)
+		}
+		items = append(items, setItems...)
+	}
+	return items, nil
+}
+
+func (s *assetSyncer) syncSet(ctx context.Context, ops assetSetOps, localizationID, setID, locale string, set assetSyncSet) ([]asc.AssetSyncItem, error) {
+	var remote []remoteAsset
+	if setID != "" {
+		assets, err := ops.listAssets(ctx, setID)
+		if err != nil {
+			return nil, err
+		}
+		remote = assets
+	} else if !s.dryRun {
+		created, err := ops.createSet(ctx, localizationID, set.displayType)
+		if err != nil {
+			return nil, err
+		}
+		setID = created
+	}
+
+	plan := planAssetSet(set.files, remote, s.prune)
+	item := func(action, filePath, assetID string) asc.AssetSyncItem {
+		fileName := ""
+		if filePath != "" {
+			fileName = filepath.Base(filePath)
+		}
+		return asc.AssetSyncItem{
+			Locale:      locale,
+			AssetType:   set.assetType,
+			DisplayType: set.displayType,
+			Action:      action,
+			FileName:    fileName,
+			AssetID:     assetID,
+		}
+	}
+
+	items := make([]asc.AssetSyncItem, 0, len(plan.slots)+len(plan.deletes)+len(plan.extras)+1)
+	// Delete first so uploads do not run into the per-set asset limit.
+	for _, asset := range plan.deletes {
+		if !s.dryRun {
+			if err := ops.delete(ctx, asset.id); err != nil {
+				return nil, fmt.Errorf("delete %s: %w", asset.id, err)
+			}
+		}
+		items = append(items, item(assetSyncActionDelete, "", asset.id))
+	}
+
+	slotIDs := make([]string, len(plan.slots))
+	for i, slot := range plan.slots {
+		if slot.remote != nil {
+			slotIDs[i] = slot.remote.id
+			items = append(items, item(assetSyncActionUnchanged, slot.file.path, slot.remote.id))
+			continue
+		}
+		if s.dryRun {
+			slotIDs[i] = "upload:" + slot.file.path
+			items = append(items, item(assetSyncActionUpload, slot.file.path, ""))
+			continue
+		}
+		uploaded, err := ops.upload(ctx, setID, slot.file.path)
+		if err != nil {
+			return nil, fmt.Errorf("upload %s: %w", filepath.Base(slot.file.path), err)
+		}
+		slotIDs[i] = uploaded.AssetID
+		items = append(items, item(assetSyncActionUpload, slot.file.path, uploaded.AssetID))
+	}
+
+	for _, asset := range plan.extras {
+		items = append(items, item(assetSyncActionExtra, "", asset.id))
+	}
+
+	if plan.needsReorder(remote, slotIDs) {
+		if !s.dryRun {
+			if err := ops.reorder(ctx, setID, plan.desiredOrder(slotIDs)); err != nil {
+				return nil, fmt.Errorf("reorder: %w", err)
+			}
+		}
+		items = append(items, item(assetSyncActionReorder, "", ""))
+	}
+	return items, nil
+}
@@ -0,0 +1,395 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type stubAssetSyncClient struct {
+	mu            sync.Mutex
+	localizations map[string]string
+	screenshots   map[string][]remoteAsset
+	previews      map[string][]remoteAsset
+	calls         []string
+}
+
+func (c *stubAssetSyncClient) record(call string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, call)
+}
+
+func (c *stubAssetSyncClient) GetAppStoreVersionLocalizations(_ context.Context, _ string, _ ...asc.AppStoreVersionLocalizationsOption) (*asc.AppStoreVersionLocalizationsResponse, error) {
+	resp := &asc.AppStoreVersionLocalizationsResponse{}
+	for locale, id := range c.localizations {
+		item := asc.Resource[asc.AppStoreVersionLocalizationAttributes]{ID: id}
+		item.Attributes.Locale = locale
+		resp.Data = append(resp.Data, item)
+	}
+	return resp, nil
+}
+
+func (c *stubAssetSyncClient) GetAppScreenshotSets(_ context.Context, localizationID string) (*asc.AppScreenshotSetsResponse, error) {
+	resp := &asc.AppScreenshotSetsResponse{}
+	for setID := range c.screenshots {
+		if strings.HasPrefix(setID, localizationID+"/") {
+			item := asc.Resource[asc.AppScreenshotSetAttributes]{ID: setID}
+			item.Attributes.ScreenshotDisplayType = strings.TrimPrefix(setID, localizationID+"/")
+			resp.Data = append(resp.Data, item)
+		}
+	}
+	return resp, nil
+}
+
+func (c *stubAssetSyncClient) CreateAppScreenshotSet(_ context.Context, localizationID string, displayType string) (*asc.AppScreenshotSetResponse, error) {
+	c.record("create-screenshot-set " + localizationID + "/" + displayType)
+	return &asc.AppScreenshotSetResponse{Data: asc.Resource[asc.AppScreenshotSetAttributes]{ID: localizationID + "/" + displayType}}, nil
+}
+
+func (c *stubAssetSyncClient) GetAppScreenshots(_ context.Context, setID string) (*asc.AppScreenshotsResponse, error) {
+	resp := &asc.AppScreenshotsResponse{}
+	for _, asset := range c.screenshots[setID] {
+		item := asc.Resource[asc.AppScreenshotAttributes]{ID: asset.id}
+		item.Attributes.SourceFileChecksum = asset.checksum
+		resp.Data = append(resp.Data, item)
+	}
+	return resp, nil
+}
+
+func (c *stubAssetSyncClient) DeleteAppScreenshot(_ context.Context, screenshotID string) error {
+	c.record("delete-screenshot " + screenshotID)
+	return nil
+}
+
+func (c *stubAssetSyncClient) ReplaceAppScreenshotSetScreenshots(_ context.Context, setID string, screenshotIDs []string) error {
+	c.record("reorder-screenshots " + setID + " " + strings.Join(screenshotIDs, ","))
+	return nil
+}
+
+func (c *stubAssetSyncClient) GetAppPreviewSets(_ context.Context, localizationID string) (*asc.AppPreviewSetsResponse, error) {
+	resp := &asc.AppPreviewSetsResponse{}
+	for setID := range c.previews {
+		if strings.HasPrefix(setID, localizationID+"/") {
+			item := asc.Resource[asc.AppPreviewSetAttributes]{ID: setID}
+			item.Attributes.PreviewType = strings.TrimPrefix(setID, localizationID+"/")
+			resp.Data = append(resp.Data, item)
+		}
+	}
+	return resp, nil
+}
+
+func (c *stubAssetSyncClient) CreateAppPreviewSet(_ context.Context, localizationID string, previewType string) (*asc.AppPreviewSetResponse, error) {
+	c.record("create-preview-set " + localizationID + "/" + previewType)
+	return &asc.AppPreviewSetResponse{Data: asc.Resource[asc.AppPreviewSetAttributes]{ID: localizationID + "/" + previewType}}, nil
+}
+
+func (c *stubAssetSyncClient) GetAppPreviews(_ context.Context, setID string) (*asc.AppPreviewsResponse, error) {
+	resp := &asc.AppPreviewsResponse{}
+	for _, asset := range c.previews[setID] {
+		item := asc.Resource[asc.AppPreviewAttributes]{ID: asset.id}
+		item.Attributes.SourceFileChecksum = asset.checksum
+		resp.Data = append(resp.Data, item)
+	}
+	return resp, nil
+}
+
+func (c *stubAssetSyncClient) DeleteAppPreview(_ context.Context, previewID string) error {
+	c.record("delete-preview " + previewID)
+	return nil
+}
+
+func (c *stubAssetSyncClient) ReplaceAppPreviewSetPreviews(_ context.Context, setID string, previewIDs []string) error {
+	c.record("reorder-previews " + setID + " " + strings.Join(previewIDs, ","))
+	return nil
+}
+
+func newStubAssetSyncer(client *stubAssetSyncClient, prune, dryRun bool) *assetSyncer {
+	upload := func(kind string) assetUploadFunc {
+		return func(_ context.Context, setID, filePath string) (asc.AssetUploadResultItem, error) {
+			id := "new-" + filepath.Base(filePath)
+			client.record("upload-" + kind + " " + setID + " " + filepath.Base(filePath))
+			return asc.AssetUploadResultItem{FileName: filepath.Base(filePath), FilePath: filePath, AssetID: id}, nil
+		}
+	}
+	return &assetSyncer{
+		client:           client,
+		uploadScreenshot: upload("screenshot"),
+		uploadPreview:    upload("preview"),
+		prune:            prune,
+		dryRun:           dryRun,
+	}
+}
+
+func writeSyncPNG(t *testing.T, path string, width, height int, shade uint8) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	img.SetGray(0, 0, color.Gray{Y: shade})
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	defer file.Close()
+	if err := png.Encode(file, img); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+}
+
+func writeSyncFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+}
+
+func TestScanAssetSyncDir(t *testing.T) {
+	dir := t.TempDir()
+	writeSyncPNG(t, filepath.Join(dir, "en-US", "IPHONE_35", "02_detail.png"), 640, 960, 2)
+	writeSyncPNG(t, filepath.Join(dir, "en-US", "IPHONE_35", "01_home.png"), 640, 960, 1)
+	writeSyncFile(t, filepath.Join(dir, "en-US", "IPHONE_35", "01_intro.mov"), "video")
+	writeSyncFile(t, filepath.Join(dir, "en-US", "IPHONE_35", ".DS_Store"), "ignored")
+	writeSyncPNG(t, filepath.Join(dir, "de-DE", "APP_IPHONE_35", "01_home.png"), 640, 960, 3)
+
+	locales, err := scanAssetSyncDir(dir)
+	if err != nil {
+		t.Fatalf("scanAssetSyncDir() error: %v", err)
+	}
+	if len(locales) != 2 || locales[0].locale != "de-DE" || locales[1].locale != "en-US" {
+		t.Fatalf("unexpected locales: %+v", locales)
+	}
+
+	sets := locales[1].sets
+	if len(sets) != 2 {
+		t.Fatalf("expected screenshot and preview sets, got %+v", sets)
+	}
+	if sets[0].assetType != assetTypeScreenshot || sets[0].displayType != "APP_IPHONE_35" {
+		t.Fatalf("unexpected screenshot set: %+v", sets[0])
+	}
+	if filepath.Base(sets[0].files[0].path) != "01_home.png" || filepath.Base(sets[0].files[1].path) != "02_detail.png" {
+		t.Fatalf("expected files in name order, got %+v", sets[0].files)
+	}
+	if sets[0].files[0].checksum == "" || sets[0].files[0].checksum == sets[0].files[1].checksum {
+		t.Fatalf("expected distinct checksums, got %+v", sets[0].files)
+	}
+	if sets[1].assetType != assetTypePreview || sets[1].displayType != "IPHONE_35" || len(sets[1].files) != 1 {
+		t.Fatalf("unexpected preview set: %+v", sets[1])
+	}
+}
+
+func TestScanAssetSyncDirErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, dir string)
+		wantErr string
+	}{
+		{
+			name:    "empty",
+			setup:   func(t *testing.T, dir string) {},
+			wantErr: "no locale directories",
+		},
+		{
+			name: "unsupported extension",
+			setup: func(t *testing.T, dir string) {
+				writeSyncFile(t, filepath.Join(dir, "en-US", "IPHONE_35", "notes.txt"), "text")
+			},
+			wantErr: "unsupported file",
+		},
+		{
+			name: "unknown display type",
+			setup: func(t *testing.T, dir string) {
+				writeSyncPNG(t, filepath.Join(dir, "en-US", "PHONE", "01.png"), 640, 960, 1)
+			},
+			wantErr: "PHONE",
+		},
+		{
+			name: "wrong dimensions",
+			setup: func(t *testing.T, dir string) {
+				writeSyncPNG(t, filepath.Join(dir, "en-US", "IPHONE_35", "01.png"), 100, 100, 1)
+			},
+			wantErr: "100x100",
+		},
+		{
+			name: "file at locale level",
+			setup: func(t *testing.T, dir string) {
+				writeSyncPNG(t, filepath.Join(dir, "en-US", "01.png"), 640, 960, 1)
+			},
+			wantErr: "unexpected file",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			test.setup(t, dir)
+			_, err := scanAssetSyncDir(dir)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPlanAssetSet(t *testing.T) {
+	files := []assetSyncFile{
+		{path: "01.png", checksum: "aaa"},
+		{path: "02.png", checksum: "bbb"},
+		{path: "03.png", checksum: "aaa"},
+	}
+	remote := []remoteAsset{
+		{id: "r1", checksum: "BBB"},
+		{id: "r2", checksum: "aaa"},
+		{id: "r3", checksum: "zzz"},
+		{id: "r4"},
+	}
+
+	plan := planAssetSet(files, remote, false)
+	if plan.slots[0].remote == nil || plan.slots[0].remote.id != "r2" {
+		t.Fatalf("expected 01.png to match r2, got %+v", plan.slots[0])
+	}
+	if plan.slots[1].remote == nil || plan.slots[1].remote.id != "r1" {
+		t.Fatalf("expected 02.png to match r1, got %+v", plan.slots[1])
+	}
+	if plan.slots[2].remote != nil {
+		t.Fatalf("expected duplicate checksum to upload, got %+v", plan.slots[2])
+	}
+	if len(plan.deletes) != 0 || len(plan.extras) != 2 {
+		t.Fatalf("expected extras without prune, got deletes=%v extras=%v", plan.deletes, plan.extras)
+	}
+	if !plan.needsReorder(remote, []string{"r2", "r1", "new"}) {
+		t.Fatal("expected reorder when matched assets are out of order")
+	}
+
+	pruned := planAssetSet(files, remote, true)
+	if len(pruned.deletes) != 2 || len(pruned.extras) != 0 {
+		t.Fatalf("expected deletes with prune, got deletes=%v extras=%v", pruned.deletes, pruned.extras)
+	}
+
+	inOrder := planAssetSet(files[:2], []remoteAsset{{id: "r1", checksum: "aaa"}}, false)
+	if inOrder.needsReorder([]remoteAsset{{id: "r1", checksum: "aaa"}}, []string{"r1", "new"}) {
+		t.Fatal("expected appended upload to keep order")
+	}
+}
+
+func TestAssetSyncerSync(t *testing.T) {
+	locales := []assetSyncLocale{
+		{
+			locale: "de-DE",
+			sets: []assetSyncSet{
+				{assetType: assetTypeScreenshot, displayType: "APP_IPHONE_65", files: []assetSyncFile{{path: "/tmp/01.png", checksum: "aaa"}}},
+				{assetType: assetTypePreview, displayType: "IPHONE_65", files: []assetSyncFile{{path: "/tmp/01.mov", checksum: "vvv"}}},
+			},
+		},
+		{
+			locale: "en-US",
+			sets: []assetSyncSet{
+				{assetType: assetTypeScreenshot, displayType: "APP_IPHONE_65", files: []assetSyncFile{
+					{path: "/tmp/01.png", checksum: "bbb"},
+					{path: "/tmp/02.png", checksum: "ccc"},
+					{path: "/tmp/03.png", checksum: "ddd"},
+				}},
+			},
+		},
+	}
+	newClient := func() *stubAssetSyncClient {
+		return &stubAssetSyncClient{
+			localizations: map[string]string{"en-US": "LOC_EN", "de-DE": "LOC_DE"},
+			screenshots: map[string][]remoteAsset{
+				"LOC_DE/APP_IPHONE_65": {{id: "de1", checksum: "aaa"}},
+				"LOC_EN/APP_IPHONE_65": {
+					{id: "en1", checksum: "ccc"},
+					{id: "en2", checksum: "old"},
+					{id: "en3", checksum: "bbb"},
+				},
+			},
+			previews: map[string][]remoteAsset{},
+		}
+	}
+
+	t.Run("apply", func(t *testing.T) {
+		client := newClient()
+		items, err := newStubAssetSyncer(client, true, false).sync(context.Background(), "VERSION", locales, 2)
+		if err != nil {
+			t.Fatalf("sync() error: %v", err)
+		}
+
+		var got []string
+		for _, item := range items {
+			got = append(got, fmt.Sprintf("%s %s %s %s %s", item.Locale, item.DisplayType, item.Action, item.FileName, item.AssetID))
+		}
+		want := []string{
+			"de-DE APP_IPHONE_65 unchanged 01.png de1",
+			"de-DE IPHONE_65 upload 01.mov new-01.mov",
+			"en-US APP_IPHONE_65 delete  en2",
+			"en-US APP_IPHONE_65 unchanged 01.png en3",
+			"en-US APP_IPHONE_65 unchanged 02.png en1",
+			"en-US APP_IPHONE_65 upload 03.png new-03.png",
+			"en-US APP_IPHONE_65 reorder  ",
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("unexpected items:\n got %q\nwant %q", got, want)
+		}
+
+		wantCalls := map[string]bool{
+			"create-preview-set LOC_DE/IPHONE_65":                         true,
+			"upload-preview LOC_DE/IPHONE_65 01.mov":                      true,
+			"delete-screenshot en2":                                       true,
+			"upload-screenshot LOC_EN/APP_IPHONE_65 03.png":               true,
+			"reorder-screenshots LOC_EN/APP_IPHONE_65 en3,en1,new-03.png": true,
+		}
+		if len(client.calls) != len(wantCalls) {
+			t.Fatalf("unexpected calls: %q", client.calls)
+		}
+		for _, call := range client.calls {
+			if !wantCalls[call] {
+				t.Fatalf("unexpected call %q in %q", call, client.calls)
+			}
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		client := newClient()
+		items, err := newStubAssetSyncer(client, false, true).sync(context.Background(), "VERSION", locales, 1)
+		if err != nil {
+			t.Fatalf("sync() error: %v", err)
+		}
+		if len(client.calls) != 0 {
+			t.Fatalf("expected no writes in dry run, got %q", client.calls)
+		}
+		var actions []string
+		for _, item := range items {
+			actions = append(actions, item.Action)
+		}
+		want := []string{"unchanged", "upload", "unchanged", "unchanged", "upload", "extra", "reorder"}
+		if !reflect.DeepEqual(actions, want) {
+			t.Fatalf("unexpected actions: got %q want %q", actions, want)
+		}
+	})
+
+	t.Run("missing localization", func(t *testing.T) {
+		client := newClient()
+		delete(client.localizations, "de-DE")
+		_, err := newStubAssetSyncer(client, false, false).sync(context.Background(), "VERSION", locales, 2)
+		if err == nil || !strings.Contains(err.Error(), "no localization for de-DE") {
+			t.Fatalf("expected missing localization error, got %v", err)
+		}
+		if len(client.calls) != 0 {
+			t.Fatalf("expected no writes, got %q", client.calls)
+		}
+	})
+}
@@ -51,7 +51,7 @@ func BackgroundAssetsUploadFilesListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -98,7 +98,7 @@ Examples:
 					return fmt.Errorf("background-assets upload-files list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				resp, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBackgroundAssetUploadFiles(ctx, versionIDValue, asc.WithBackgroundAssetUploadFilesNextURL(nextURL))
 				})
 				if err != nil {
@@ -123,7 +123,7 @@ func BackgroundAssetsUploadFilesGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	uploadFileID := fs.String("upload-file-id", "", "Background asset upload file ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -169,7 +169,7 @@ func BackgroundAssetsUploadFilesCreateCommand() *ffcli.Command {
 	filePath := fs.String("file", "", "Path to upload file")
 	assetType := fs.String("asset-type", "", "Asset type: "+strings.Join(backgroundAssetUploadFileAssetTypeValues, ", "))
 	checksum := fs.Bool("checksum", false, "Verify source file checksums before committing")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -285,7 +285,7 @@ func BackgroundAssetsUploadFilesUpdateCommand() *ffcli.Command {
 	uploaded := fs.String("uploaded", "", "Mark upload as complete (true/false)")
 	filePath := fs.String("file", "", "Path to file for checksum verification")
 	checksum := fs.Bool("checksum", false, "Verify source file checksums before committing")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
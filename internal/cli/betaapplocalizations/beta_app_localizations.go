@@ -25,7 +25,8 @@ func BetaAppLocalizationsCommand() *ffcli.Command {
 
 Examples:
   asc beta-app-localizations list --app "APP_ID"
-  asc beta-app-localizations create --app "APP_ID" --locale "en-US" --description "Welcome testers"`,
+  asc beta-app-localizations create --app "APP_ID" --locale "en-US" --description "Welcome testers"
+  asc beta-app-localizations download --app "APP_ID" --format xliff --path "./xliff"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -35,6 +36,8 @@ Examples:
 			BetaAppLocalizationsCreateCommand(),
 			BetaAppLocalizationsUpdateCommand(),
 			BetaAppLocalizationsDeleteCommand(),
+			BetaAppLocalizationsDownloadCommand(),
+			BetaAppLocalizationsUploadCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -51,7 +54,7 @@ func BetaAppLocalizationsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -111,7 +114,7 @@ Examples:
 					return fmt.Errorf("beta-app-localizations list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				resp, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBetaAppLocalizations(ctx, asc.WithBetaAppLocalizationsNextURL(nextURL))
 				})
 				if err != nil {
@@ -135,7 +138,7 @@ func BetaAppLocalizationsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Beta app localization ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -184,7 +187,7 @@ func BetaAppLocalizationsCreateCommand() *ffcli.Command {
 	marketingURL := fs.String("marketing-url", "", "Marketing URL")
 	privacyPolicyURL := fs.String("privacy-policy-url", "", "Privacy policy URL")
 	tvOsPrivacyPolicy := fs.String("tv-os-privacy-policy", "", "tvOS privacy policy")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -195,7 +198,8 @@ func BetaAppLocalizationsCreateCommand() *ffcli.Command {
 
 Examples:
   asc beta-app-localizations create --app "APP_ID" --locale "en-US"
-  asc beta-app-localizations create --app "APP_ID" --locale "en-US" --description "Welcome testers"`,
+  asc beta-app-localizations create --app "APP_ID" --locale "en-US" --description "Welcome testers"
+  asc beta-app-localizations download --app "APP_ID" --format xliff --path "./xliff"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -262,7 +266,7 @@ func BetaAppLocalizationsUpdateCommand() *ffcli.Command {
 	marketingURL := fs.String("marketing-url", "", "Marketing URL")
 	privacyPolicyURL := fs.String("privacy-policy-url", "", "Privacy policy URL")
 	tvOsPrivacyPolicy := fs.String("tv-os-privacy-policy", "", "tvOS privacy policy")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -344,7 +348,7 @@ func BetaAppLocalizationsDeleteCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Beta app localization ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
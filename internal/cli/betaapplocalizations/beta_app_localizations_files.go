@@ -0,0 +1,180 @@
+package betaapplocalizations
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// BetaAppLocalizationsDownloadCommand returns the download subcommand.
+func BetaAppLocalizationsDownloadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("download", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
+	path := fs.String("path", "localizations", "Output path (directory or file)")
+	format := fs.String("format", shared.LocalizationFormatStrings, "File format: strings (default), xliff, json, csv")
+	sourceLocale := fs.String("source-locale", "en-US", "Source locale for XLIFF files")
+	xliffVersion := fs.String("xliff-version", shared.XLIFFVersion12, "XLIFF version: 1.2 (default) or 2.0")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "download",
+		ShortUsage: "asc beta-app-localizations download [flags]",
+		ShortHelp:  "Download beta app localizations to files.",
+		LongHelp: `Download beta app localizations to .strings, XLIFF, JSON, or CSV files.
+
+See "asc localizations download --help" for the file layout of each format.
+
+Examples:
+  asc beta-app-localizations download --app "APP_ID" --path "./beta-localizations"
+  asc beta-app-localizations download --app "APP_ID" --format xliff --locale "de-DE,ja" --path "./xliff"
+  asc beta-app-localizations download --app "APP_ID" --format csv --path "./beta.csv"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
+				return flag.ErrHelp
+			}
+
+			fileOpts, err := shared.NewLocalizationFileOptions(shared.LocalizationTypeBetaApp, *format, *sourceLocale, *xliffVersion)
+			if err != nil {
+				return fmt.Errorf("beta-app-localizations download: %w", err)
+			}
+
+			locales := shared.SplitCSV(*locale)
+			if err := shared.ValidateBuildLocalizationLocales(locales); err != nil {
+				return fmt.Errorf("beta-app-localizations download: %w", err)
+			}
+			fileOpts.TargetLocales = locales
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("beta-app-localizations download: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.BetaAppLocalizationsOption{
+				asc.WithBetaAppLocalizationAppIDs([]string{resolvedAppID}),
+				asc.WithBetaAppLocalizationsLimit(200),
+			}
+			if fetchLocales := fileOpts.FetchLocales(locales); len(fetchLocales) > 0 {
+				opts = append(opts, asc.WithBetaAppLocalizationLocales(fetchLocales))
+			}
+
+			firstPage, err := client.GetBetaAppLocalizations(requestCtx, opts...)
+			if err != nil {
+				return fmt.Errorf("beta-app-localizations download: failed to fetch: %w", err)
+			}
+			resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				return client.GetBetaAppLocalizations(ctx, asc.WithBetaAppLocalizationsNextURL(nextURL))
+			})
+			if err != nil {
+				return fmt.Errorf("beta-app-localizations download: %w", err)
+			}
+			aggregated, ok := resp.(*asc.BetaAppLocalizationsResponse)
+			if !ok {
+				return fmt.Errorf("beta-app-localizations download: unexpected pagination response type")
+			}
+
+			files, err := shared.WriteLocalizationFiles(*path, shared.BetaAppLocalizationValues(aggregated.Data), fileOpts)
+			if err != nil {
+				return fmt.Errorf("beta-app-localizations download: %w", err)
+			}
+
+			result := asc.LocalizationDownloadResult{
+				Type:       shared.LocalizationTypeBetaApp,
+				AppID:      resolvedAppID,
+				Format:     fileOpts.Format,
+				OutputPath: *path,
+				Files:      files,
+			}
+			return shared.PrintOutput(&result, *output, *pretty)
+		},
+	}
+}
+
+// BetaAppLocalizationsUploadCommand returns the upload subcommand.
+func BetaAppLocalizationsUploadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("upload", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
+	path := fs.String("path", "", "Input path (directory or file)")
+	format := fs.String("format", shared.LocalizationFormatStrings, "File format: strings (default), xliff, json, csv")
+	dryRun := fs.Bool("dry-run", false, "Validate file without uploading")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "upload",
+		ShortUsage: "asc beta-app-localizations upload [flags]",
+		ShortHelp:  "Upload beta app localizations from files.",
+		LongHelp: `Upload beta app localizations from .strings, XLIFF, JSON, or CSV files.
+
+Supported keys: description, feedbackEmail, marketingUrl, privacyPolicyUrl,
+tvOsPrivacyPolicy.
+
+Examples:
+  asc beta-app-localizations upload --app "APP_ID" --path "./beta-localizations"
+  asc beta-app-localizations upload --app "APP_ID" --format xliff --path "./xliff"
+  asc beta-app-localizations upload --app "APP_ID" --format csv --path "./beta.csv" --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if strings.TrimSpace(*path) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --path is required")
+				return flag.ErrHelp
+			}
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
+				return flag.ErrHelp
+			}
+
+			fileOpts, err := shared.NewLocalizationFileOptions(shared.LocalizationTypeBetaApp, *format, "", "")
+			if err != nil {
+				return fmt.Errorf("beta-app-localizations upload: %w", err)
+			}
+
+			valuesByLocale, err := shared.ReadLocalizationFiles(*path, shared.SplitCSV(*locale), fileOpts)
+			if err != nil {
+				return fmt.Errorf("beta-app-localizations upload: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("beta-app-localizations upload: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			results, err := shared.UploadBetaAppLocalizations(requestCtx, client, resolvedAppID, valuesByLocale, *dryRun)
+			if err != nil {
+				return fmt.Errorf("beta-app-localizations upload: %w", err)
+			}
+
+			result := asc.LocalizationUploadResult{
+				Type:    shared.LocalizationTypeBetaApp,
+				AppID:   resolvedAppID,
+				Format:  fileOpts.Format,
+				DryRun:  *dryRun,
+				Results: results,
+			}
+			return shared.PrintOutput(&result, *output, *pretty)
+		},
+	}
+}
@@ -27,7 +27,8 @@ Examples:
   asc beta-build-localizations list --build "BUILD_ID"
   asc beta-build-localizations list --global
   asc beta-build-localizations list --global --paginate
-  asc beta-build-localizations create --build "BUILD_ID" --locale "en-US" --whats-new "Test instructions"`,
+  asc beta-build-localizations create --build "BUILD_ID" --locale "en-US" --whats-new "Test instructions"
+  asc beta-build-localizations download --build "BUILD_ID" --format csv --path "./what-to-test.csv"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -37,6 +38,8 @@ Examples:
 			BetaBuildLocalizationsCreateCommand(),
 			BetaBuildLocalizationsUpdateCommand(),
 			BetaBuildLocalizationsDeleteCommand(),
+			BetaBuildLocalizationsDownloadCommand(),
+			BetaBuildLocalizationsUploadCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -54,7 +57,7 @@ func BetaBuildLocalizationsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -122,7 +125,7 @@ Examples:
 						return fmt.Errorf("beta-build-localizations list: failed to fetch: %w", err)
 					}
 
-					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					resp, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.ListBetaBuildLocalizations(ctx, asc.WithBetaBuildLocalizationsNextURL(nextURL))
 					})
 					if err != nil {
@@ -146,7 +149,7 @@ Examples:
 					return fmt.Errorf("beta-build-localizations list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				resp, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBetaBuildLocalizations(ctx, buildValue, asc.WithBetaBuildLocalizationsNextURL(nextURL))
 				})
 				if err != nil {
@@ -170,7 +173,7 @@ func BetaBuildLocalizationsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Beta build localization ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -215,7 +218,7 @@ func BetaBuildLocalizationsCreateCommand() *ffcli.Command {
 	buildID := fs.String("build", "", "Build ID")
 	locale := fs.String("locale", "", "Locale (e.g., en-US)")
 	whatsNew := fs.String("whats-new", "", "What to Test notes")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -225,7 +228,8 @@ func BetaBuildLocalizationsCreateCommand() *ffcli.Command {
 		LongHelp: `Create a beta build localization.
 
 Examples:
-  asc beta-build-localizations create --build "BUILD_ID" --locale "en-US" --whats-new "Test instructions"`,
+  asc beta-build-localizations create --build "BUILD_ID" --locale "en-US" --whats-new "Test instructions"
+  asc beta-build-localizations download --build "BUILD_ID" --format csv --path "./what-to-test.csv"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -279,7 +283,7 @@ func BetaBuildLocalizationsUpdateCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Beta build localization ID")
 	whatsNew := fs.String("whats-new", "", "What to Test notes")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -333,7 +337,7 @@ func BetaBuildLocalizationsDeleteCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Beta build localization ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -0,0 +1,177 @@
+package betabuildlocalizations
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// BetaBuildLocalizationsDownloadCommand returns the download subcommand.
+func BetaBuildLocalizationsDownloadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("download", flag.ExitOnError)
+
+	buildID := fs.String("build", "", "Build ID")
+	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
+	path := fs.String("path", "localizations", "Output path (directory or file)")
+	format := fs.String("format", shared.LocalizationFormatStrings, "File format: strings (default), xliff, json, csv")
+	sourceLocale := fs.String("source-locale", "en-US", "Source locale for XLIFF files")
+	xliffVersion := fs.String("xliff-version", shared.XLIFFVersion12, "XLIFF version: 1.2 (default) or 2.0")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "download",
+		ShortUsage: "asc beta-build-localizations download [flags]",
+		ShortHelp:  `Download "What to Test" notes to files.`,
+		LongHelp: `Download a build's "What to Test" notes to .strings, XLIFF, JSON, or CSV files.
+
+Notes are written under the whatsNew key. See "asc localizations download --help"
+for the file layout of each format.
+
+Examples:
+  asc beta-build-localizations download --build "BUILD_ID" --path "./what-to-test"
+  asc beta-build-localizations download --build "BUILD_ID" --format xliff --locale "de-DE,ja" --path "./xliff"
+  asc beta-build-localizations download --build "BUILD_ID" --format json --path "./what-to-test.json"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			build := strings.TrimSpace(*buildID)
+			if build == "" {
+				fmt.Fprintln(os.Stderr, "Error: --build is required")
+				return flag.ErrHelp
+			}
+
+			fileOpts, err := shared.NewLocalizationFileOptions(shared.LocalizationTypeBetaBuild, *format, *sourceLocale, *xliffVersion)
+			if err != nil {
+				return fmt.Errorf("beta-build-localizations download: %w", err)
+			}
+
+			locales := shared.SplitCSV(*locale)
+			if err := shared.ValidateBuildLocalizationLocales(locales); err != nil {
+				return fmt.Errorf("beta-build-localizations download: %w", err)
+			}
+			fileOpts.TargetLocales = locales
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("beta-build-localizations download: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.BetaBuildLocalizationsOption{asc.WithBetaBuildLocalizationsLimit(200)}
+			if fetchLocales := fileOpts.FetchLocales(locales); len(fetchLocales) > 0 {
+				opts = append(opts, asc.WithBetaBuildLocalizationLocales(fetchLocales))
+			}
+
+			firstPage, err := client.GetBetaBuildLocalizations(requestCtx, build, opts...)
+			if err != nil {
+				return fmt.Errorf("beta-build-localizations download: failed to fetch: %w", err)
+			}
+			resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				return client.GetBetaBuildLocalizations(ctx, build, asc.WithBetaBuildLocalizationsNextURL(nextURL))
+			})
+			if err != nil {
+				return fmt.Errorf("beta-build-localizations download: %w", err)
+			}
+			aggregated, ok := resp.(*asc.BetaBuildLocalizationsResponse)
+			if !ok {
+				return fmt.Errorf("beta-build-localizations download: unexpected pagination response type")
+			}
+
+			files, err := shared.WriteLocalizationFiles(*path, shared.BetaBuildLocalizationValues(aggregated.Data), fileOpts)
+			if err != nil {
+				return fmt.Errorf("beta-build-localizations download: %w", err)
+			}
+
+			result := asc.LocalizationDownloadResult{
+				Type:       shared.LocalizationTypeBetaBuild,
+				BuildID:    build,
+				Format:     fileOpts.Format,
+				OutputPath: *path,
+				Files:      files,
+			}
+			return shared.PrintOutput(&result, *output, *pretty)
+		},
+	}
+}
+
+// BetaBuildLocalizationsUploadCommand returns the upload subcommand.
+func BetaBuildLocalizationsUploadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("upload", flag.ExitOnError)
+
+	buildID := fs.String("build", "", "Build ID")
+	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
+	path := fs.String("path", "", "Input path (directory or file)")
+	format := fs.String("format", shared.LocalizationFormatStrings, "File format: strings (default), xliff, json, csv")
+	dryRun := fs.Bool("dry-run", false, "Validate file without uploading")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "upload",
+		ShortUsage: "asc beta-build-localizations upload [flags]",
+		ShortHelp:  `Upload "What to Test" notes from files.`,
+		LongHelp: `Upload a build's "What to Test" notes from .strings, XLIFF, JSON, or CSV files.
+
+Notes are read from the whatsNew key.
+
+Examples:
+  asc beta-build-localizations upload --build "BUILD_ID" --path "./what-to-test"
+  asc beta-build-localizations upload --build "BUILD_ID" --format xliff --path "./xliff"
+  asc beta-build-localizations upload --build "BUILD_ID" --format json --path "./what-to-test.json" --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if strings.TrimSpace(*path) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --path is required")
+				return flag.ErrHelp
+			}
+			build := strings.TrimSpace(*buildID)
+			if build == "" {
+				fmt.Fprintln(os.Stderr, "Error: --build is required")
+				return flag.ErrHelp
+			}
+
+			fileOpts, err := shared.NewLocalizationFileOptions(shared.LocalizationTypeBetaBuild, *format, "", "")
+			if err != nil {
+				return fmt.Errorf("beta-build-localizations upload: %w", err)
+			}
+
+			valuesByLocale, err := shared.ReadLocalizationFiles(*path, shared.SplitCSV(*locale), fileOpts)
+			if err != nil {
+				return fmt.Errorf("beta-build-localizations upload: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("beta-build-localizations upload: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			results, err := shared.UploadBetaBuildLocalizations(requestCtx, client, build, valuesByLocale, *dryRun)
+			if err != nil {
+				return fmt.Errorf("beta-build-localizations upload: %w", err)
+			}
+
+			result := asc.LocalizationUploadResult{
+				Type:    shared.LocalizationTypeBetaBuild,
+				BuildID: build,
+				Format:  fileOpts.Format,
+				DryRun:  *dryRun,
+				Results: results,
+			}
+			return shared.PrintOutput(&result, *output, *pretty)
+		},
+	}
+}
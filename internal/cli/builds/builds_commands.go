@@ -29,11 +29,13 @@ func BuildsUploadCommand() *ffcli.Command {
 	dryRun := fs.Bool("dry-run", false, "Reserve upload operations without uploading the file")
 	concurrency := fs.Int("concurrency", 1, "Upload concurrency (default 1)")
 	verifyChecksum := fs.Bool("checksum", false, "Verify upload checksums if provided by API")
+	resume := fs.Bool("resume", false, "Save progress to a state file and continue an interrupted upload from it")
+	stateFile := fs.String("state-file", "", "State file for --resume (default: <file>.asc-upload.json)")
 	testNotes := fs.String("test-notes", "", "What to Test notes (requires build processing)")
 	locale := fs.String("locale", "", "Locale for --test-notes (e.g., en-US)")
 	wait := fs.Bool("wait", false, "Wait for build processing to complete")
 	pollInterval := fs.Duration("poll-interval", shared.PublishDefaultPollInterval, "Polling interval for --wait and --test-notes")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -45,6 +47,12 @@ func BuildsUploadCommand() *ffcli.Command {
 By default, this command uploads the IPA/PKG to the presigned URLs and commits
 the file. Use --dry-run to only reserve the upload operations.
 
+With --resume, progress is saved to a state file next to the upload as each
+part completes. Failed parts are retried with backoff without stopping the
+others, and rerunning the same command with --resume continues the same upload
+instead of starting over. Checksums provided by the API are verified before the
+upload is committed, and the state file is removed afterwards.
+
 Use --ipa for iOS, tvOS, and visionOS apps. Use --pkg for macOS apps.
 When using --pkg, the platform is automatically set to MAC_OS.
 
@@ -52,6 +60,7 @@ Examples:
   asc builds upload --app "123456789" --ipa "path/to/app.ipa"
   asc builds upload --ipa "app.ipa" --version "1.0.0" --build-number "123"
   asc builds upload --app "123456789" --ipa "app.ipa" --dry-run
+  asc builds upload --app "123456789" --ipa "app.ipa" --concurrency 4 --resume
   asc builds upload --app "123456789" --ipa "app.ipa" --test-notes "Test flow" --locale "en-US" --wait
   asc builds upload --app "123456789" --pkg "path/to/app.pkg" --version "1.0.0" --build-number "123"`,
 		FlagSet:   fs,
@@ -135,9 +144,16 @@ Examples:
 				if *wait {
 					return fmt.Errorf("builds upload: --wait is not supported with --dry-run")
 				}
+				if *resume {
+					return fmt.Errorf("builds upload: --resume is not supported with --dry-run")
+				}
 			} else if *concurrency < 1 {
 				return fmt.Errorf("builds upload: --concurrency must be at least 1")
 			}
+			if strings.TrimSpace(*stateFile) != "" && !*resume {
+				fmt.Fprintln(os.Stderr, "Error: --state-file requires --resume")
+				return flag.ErrHelp
+			}
 
 			testNotesValue := strings.TrimSpace(*testNotes)
 			localeValue := strings.TrimSpace(*locale)
@@ -221,61 +237,67 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, timeoutValue)
 			defer cancel()
 
-			// Step 1: Create build upload record
-			uploadReq := asc.BuildUploadCreateRequest{
-				Data: asc.BuildUploadCreateData{
-					Type: asc.ResourceTypeBuildUploads,
-					Attributes: asc.BuildUploadAttributes{
-						CFBundleShortVersionString: versionValue,
-						CFBundleVersion:            buildNumberValue,
-						Platform:                   platformValue,
-					},
-					Relationships: &asc.BuildUploadRelationships{
-						App: &asc.Relationship{
-							Data: asc.ResourceData{Type: asc.ResourceTypeApps, ID: resolvedAppID},
-						},
-					},
-				},
-			}
-
-			uploadResp, err := client.CreateBuildUpload(requestCtx, uploadReq)
-			if err != nil {
-				return fmt.Errorf("builds upload: failed to create upload record: %w", err)
-			}
-
-			// Step 2: Create build upload file reservation
-			fileReq := asc.BuildUploadFileCreateRequest{
-				Data: asc.BuildUploadFileCreateData{
-					Type: asc.ResourceTypeBuildUploadFiles,
-					Attributes: asc.BuildUploadFileAttributes{
-						FileName:  fileInfo.Name(),
-						FileSize:  fileInfo.Size(),
-						UTI:       fileUTI,
-						AssetType: asc.AssetTypeAsset,
-					},
-					Relationships: &asc.BuildUploadFileRelationships{
-						BuildUpload: &asc.Relationship{
-							Data: asc.ResourceData{Type: asc.ResourceTypeBuildUploads, ID: uploadResp.Data.ID},
-						},
-					},
-				},
+			var state *asc.UploadState
+			var uploadResp *asc.BuildUploadResponse
+			var fileResp *asc.BuildUploadFileResponse
+			if *resume {
+				statePath := strings.TrimSpace(*stateFile)
+				if statePath == "" {
+					statePath = asc.DefaultUploadStatePath(filePath)
+				}
+				state, fileResp, err = loadResumableUpload(requestCtx, client, statePath, fileInfo, resolvedAppID, versionValue, buildNumberValue, platformValue)
+				if err != nil {
+					return fmt.Errorf("builds upload: %w", err)
+				}
+				if state == nil {
+					state = &asc.UploadState{
+						AppID:       resolvedAppID,
+						Version:     versionValue,
+						BuildNumber: buildNumberValue,
+						Platform:    string(platformValue),
+						FileName:    fileInfo.Name(),
+						FileSize:    fileInfo.Size(),
+						FileModTime: fileInfo.ModTime(),
+						Path:        statePath,
+					}
+				}
 			}
 
-			fileResp, err := client.CreateBuildUploadFile(requestCtx, fileReq)
-			if err != nil {
-				return fmt.Errorf("builds upload: failed to create file reservation: %w", err)
+			uploadID := ""
+			if fileResp != nil {
+				uploadID = state.UploadID
+			} else {
+				uploadResp, fileResp, err = createBuildUploadReservation(requestCtx, client, resolvedAppID, versionValue, buildNumberValue, platformValue, fileInfo, fileUTI)
+				if err != nil {
+					return fmt.Errorf("builds upload: %w", err)
+				}
+				uploadID = uploadResp.Data.ID
+				if state != nil {
+					state.UploadID = uploadResp.Data.ID
+					state.FileID = fileResp.Data.ID
+					if err := state.Save(); err != nil {
+						return fmt.Errorf("builds upload: %w", err)
+					}
+				}
 			}
 
 			// Return upload info including presigned URL operations
 			result := &asc.BuildUploadResult{
-				UploadID:   uploadResp.Data.ID,
+				UploadID:   uploadID,
 				FileID:     fileResp.Data.ID,
 				FileName:   fileResp.Data.Attributes.FileName,
 				FileSize:   fileResp.Data.Attributes.FileSize,
 				Operations: fileResp.Data.Attributes.UploadOperations,
+				Resumed:    uploadResp == nil,
 			}
 
-			if !*dryRun {
+			if !*dryRun && fileResp.Data.Attributes.Uploaded != nil && *fileResp.Data.Attributes.Uploaded {
+				// An earlier attempt committed the file but exited before
+				// removing the state file.
+				result.Uploaded = fileResp.Data.Attributes.Uploaded
+				result.Operations = nil
+				removeUploadState(state)
+			} else if !*dryRun {
 				if len(fileResp.Data.Attributes.UploadOperations) == 0 {
 					return fmt.Errorf("builds upload: no upload operations returned")
 				}
@@ -283,19 +305,28 @@ Examples:
 				uploadOpts := []asc.UploadOption{
 					asc.WithUploadConcurrency(*concurrency),
 				}
+				if state != nil {
+					uploadOpts = append(uploadOpts, asc.WithUploadState(state))
+				}
 				uploadCtx, uploadCancel := shared.ContextWithUploadTimeout(ctx)
 				err = asc.ExecuteUploadOperations(uploadCtx, filePath, fileResp.Data.Attributes.UploadOperations, uploadOpts...)
 				uploadCancel()
 				if err != nil {
+					if state != nil {
+						return fmt.Errorf("builds upload: upload failed (rerun with --resume to continue): %w", err)
+					}
 					return fmt.Errorf("builds upload: upload failed: %w", err)
 				}
 
+				// Resumable uploads always verify API checksums before committing.
 				var verifiedChecksums *asc.Checksums
 				var checksumVerified *bool
-				if *verifyChecksum {
+				if *verifyChecksum || state != nil {
 					src := fileResp.Data.Attributes.SourceFileChecksums
 					if src == nil || (src.File == nil && src.Composite == nil) {
-						fmt.Fprintln(os.Stderr, "Warning: --checksum requested but API provided no checksums to verify; skipping")
+						if *verifyChecksum {
+							fmt.Fprintln(os.Stderr, "Warning: --checksum requested but API provided no checksums to verify; skipping")
+						}
 					} else {
 						checksums, err := asc.VerifySourceFileChecksums(filePath, src)
 						if err != nil {
@@ -334,25 +365,26 @@ Examples:
 				result.ChecksumVerified = checksumVerified
 				result.SourceFileChecksums = verifiedChecksums
 				result.Operations = nil
+				removeUploadState(state)
+			}
 
-				if *wait || testNotesValue != "" {
-					buildResp, err := shared.WaitForBuildByNumber(requestCtx, client, resolvedAppID, versionValue, buildNumberValue, string(platformValue), *pollInterval)
-					if err != nil {
-						return fmt.Errorf("builds upload: %w", err)
-					}
-					if buildResp == nil {
-						return fmt.Errorf("builds upload: failed to resolve build for version %q build %q", versionValue, buildNumberValue)
-					}
+			if !*dryRun && (*wait || testNotesValue != "") {
+				buildResp, err := shared.WaitForBuildByNumber(requestCtx, client, resolvedAppID, versionValue, buildNumberValue, string(platformValue), *pollInterval)
+				if err != nil {
+					return fmt.Errorf("builds upload: %w", err)
+				}
+				if buildResp == nil {
+					return fmt.Errorf("builds upload: failed to resolve build for version %q build %q", versionValue, buildNumberValue)
+				}
 
-					buildResp, err = client.WaitForBuildProcessing(requestCtx, buildResp.Data.ID, *pollInterval)
-					if err != nil {
-						return fmt.Errorf("builds upload: %w", err)
-					}
+				buildResp, err = client.WaitForBuildProcessing(requestCtx, buildResp.Data.ID, *pollInterval)
+				if err != nil {
+					return fmt.Errorf("builds upload: %w", err)
+				}
 
-					if testNotesValue != "" {
-						if _, err := shared.UpsertBetaBuildLocalization(requestCtx, client, buildResp.Data.ID, localeValue, testNotesValue); err != nil {
-							return fmt.Errorf("builds upload: %w", err)
-						}
+				if testNotesValue != "" {
+					if _, err := shared.UpsertBetaBuildLocalization(requestCtx, client, buildResp.Data.ID, localeValue, testNotesValue); err != nil {
+						return fmt.Errorf("builds upload: %w", err)
 					}
 				}
 			}
@@ -383,6 +415,7 @@ Examples:
   asc builds info --build "BUILD_ID"
   asc builds expire --build "BUILD_ID"
   asc builds expire-all --app "123456789" --older-than 90d --dry-run
+  asc builds inspect --ipa "app.ipa" --lint
   asc builds upload --app "123456789" --ipa "app.ipa"
   asc builds upload --app "123456789" --pkg "app.pkg" --version "1.0.0" --build-number "1"
   asc builds uploads list --app "123456789"
@@ -405,6 +438,7 @@ Examples:
 			BuildsInfoCommand(),
 			BuildsExpireCommand(),
 			BuildsExpireAllCommand(),
+			BuildsInspectCommand(),
 			BuildsUploadCommand(),
 			BuildsUploadsCommand(),
 			BuildsTestNotesCommand(),
@@ -431,7 +465,7 @@ func BuildsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	sort := fs.String("sort", "", "Sort by uploadedDate or -uploadedDate")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
@@ -495,7 +529,7 @@ Examples:
 				}
 
 				// Fetch all remaining pages
-				builds, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				builds, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBuilds(ctx, resolvedAppID, asc.WithBuildsNextURL(nextURL))
 				})
 				if err != nil {
@@ -523,7 +557,7 @@ func BuildsInfoCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("builds info", flag.ExitOnError)
 
 	buildID := fs.String("build", "", "Build ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -568,7 +602,7 @@ func BuildsExpireCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	confirm := fs.Bool("confirm", false, "Confirm expiration")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
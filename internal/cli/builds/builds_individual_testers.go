@@ -48,7 +48,7 @@ func BuildsIndividualTestersListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -101,7 +101,7 @@ Examples:
 					return fmt.Errorf("builds individual-testers list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				resp, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBuildIndividualTesters(ctx, buildValue, asc.WithBuildIndividualTestersNextURL(nextURL))
 				})
 				if err != nil {
@@ -127,7 +127,7 @@ func BuildsIndividualTestersAddCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	testers := fs.String("tester", "", "Comma-separated tester IDs")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -184,7 +184,7 @@ func BuildsIndividualTestersRemoveCommand() *ffcli.Command {
 	buildID := fs.String("build", "", "Build ID")
 	testers := fs.String("tester", "", "Comma-separated tester IDs")
 	confirm := fs.Bool("confirm", false, "Confirm removal")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -0,0 +1,78 @@
+package builds
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// BuildsInspectCommand returns the builds inspect subcommand.
+func BuildsInspectCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("inspect", flag.ExitOnError)
+
+	ipaPath := fs.String("ipa", "", "Path to the .ipa file")
+	lint := fs.Bool("lint", false, "Check for common App Store rejection causes")
+	strict := fs.Bool("strict", false, "With --lint, treat warnings as blocking")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "inspect",
+		ShortUsage: "asc builds inspect --ipa app.ipa [flags]",
+		ShortHelp:  "Inspect an IPA locally before uploading.",
+		LongHelp: `Inspect an IPA locally before uploading.
+
+Lists every bundle in the IPA (app, app extensions, watch app, App Clip, and
+frameworks) with bundle IDs, versions, minimum OS versions, required device
+capabilities, Mach-O architectures, signed entitlements, the embedded
+provisioning profile, and whether a PrivacyInfo.xcprivacy manifest is present.
+
+With --lint, also checks for common upload and review rejection causes:
+development or expired signing, entitlements missing from the profile,
+extension bundle ID and version mismatches, simulator slices, missing icons,
+and a missing privacy manifest. The command exits non-zero when errors are
+found (or warnings, with --strict). No credentials are needed.
+
+Examples:
+  asc builds inspect --ipa "app.ipa"
+  asc builds inspect --ipa "app.ipa" --output table
+  asc builds inspect --ipa "app.ipa" --lint
+  asc builds inspect --ipa "app.ipa" --lint --strict && asc builds upload --app "123456789" --ipa "app.ipa"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			pathValue := strings.TrimSpace(*ipaPath)
+			if pathValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --ipa is required")
+				return flag.ErrHelp
+			}
+			if *strict && !*lint {
+				fmt.Fprintln(os.Stderr, "Error: --strict requires --lint")
+				return flag.ErrHelp
+			}
+
+			result, err := shared.InspectIPA(pathValue, time.Now())
+			if err != nil {
+				return fmt.Errorf("builds inspect: %w", err)
+			}
+			if *lint {
+				shared.LintIPA(result, *strict)
+			}
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.Summary != nil && result.Summary.Blocking > 0 {
+				return shared.NewReportedError(fmt.Errorf("builds inspect: found %d blocking issue(s)", result.Summary.Blocking))
+			}
+			return nil
+		},
+	}
+}
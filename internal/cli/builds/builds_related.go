@@ -42,7 +42,7 @@ func BuildsAppGetCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	aliasID := fs.String("id", "", "Build ID (alias of --build)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -115,7 +115,7 @@ func BuildsPreReleaseVersionGetCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	aliasID := fs.String("id", "", "Build ID (alias of --build)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -191,7 +191,7 @@ func BuildsIconsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -248,7 +248,7 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("builds icons list: failed to fetch: %w", err)
 				}
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				resp, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBuildIcons(ctx, buildValue, asc.WithBuildIconsNextURL(nextURL))
 				})
 				if err != nil {
@@ -296,7 +296,7 @@ func BuildsBetaAppReviewSubmissionGetCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	aliasID := fs.String("id", "", "Build ID (alias of --build)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -369,7 +369,7 @@ func BuildsBuildBetaDetailGetCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	aliasID := fs.String("id", "", "Build ID (alias of --build)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
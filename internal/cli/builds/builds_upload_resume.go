@@ -0,0 +1,108 @@
+package builds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// createBuildUploadReservation creates the build upload record and reserves
+// the file, returning the presigned upload operations.
+func createBuildUploadReservation(ctx context.Context, client *asc.Client, appID, version, buildNumber string, platform asc.Platform, fileInfo os.FileInfo, fileUTI asc.UTI) (*asc.BuildUploadResponse, *asc.BuildUploadFileResponse, error) {
+	uploadReq := asc.BuildUploadCreateRequest{
+		Data: asc.BuildUploadCreateData{
+			Type: asc.ResourceTypeBuildUploads,
+			Attributes: asc.BuildUploadAttributes{
+				CFBundleShortVersionString: version,
+				CFBundleVersion:            buildNumber,
+				Platform:                   platform,
+			},
+			Relationships: &asc.BuildUploadRelationships{
+				App: &asc.Relationship{
+					Data: asc.ResourceData{Type: asc.ResourceTypeApps, ID: appID},
+				},
+			},
+		},
+	}
+
+	uploadResp, err := client.CreateBuildUpload(ctx, uploadReq)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create upload record: %w", err)
+	}
+
+	fileReq := asc.BuildUploadFileCreateRequest{
+		Data: asc.BuildUploadFileCreateData{
+			Type: asc.ResourceTypeBuildUploadFiles,
+			Attributes: asc.BuildUploadFileAttributes{
+				FileName:  fileInfo.Name(),
+				FileSize:  fileInfo.Size(),
+				UTI:       fileUTI,
+				AssetType: asc.AssetTypeAsset,
+			},
+			Relationships: &asc.BuildUploadFileRelationships{
+				BuildUpload: &asc.Relationship{
+					Data: asc.ResourceData{Type: asc.ResourceTypeBuildUploads, ID: uploadResp.Data.ID},
+				},
+			},
+		},
+	}
+
+	fileResp, err := client.CreateBuildUploadFile(ctx, fileReq)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create file reservation: %w", err)
+	}
+	return uploadResp, fileResp, nil
+}
+
+// loadResumableUpload returns the saved state and a fresh copy of its file
+// reservation when an earlier upload of the same file and build can be
+// continued. It returns a nil state when a new upload should be started.
+func loadResumableUpload(ctx context.Context, client *asc.Client, statePath string, fileInfo os.FileInfo, appID, version, buildNumber string, platform asc.Platform) (*asc.UploadState, *asc.BuildUploadFileResponse, error) {
+	state, err := asc.LoadUploadState(statePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w (delete it to start a new upload)", err)
+	}
+
+	if state.AppID != appID || state.Version != version || state.BuildNumber != buildNumber ||
+		state.Platform != string(platform) || state.FileName != fileInfo.Name() || !state.MatchesFile(fileInfo) {
+		fmt.Fprintf(os.Stderr, "Warning: %s was saved for a different file or build; starting a new upload\n", statePath)
+		return nil, nil, nil
+	}
+
+	// Presigned URLs are not persisted, so fetch the reservation again.
+	fileResp, err := client.GetBuildUploadFile(ctx, state.FileID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			fmt.Fprintf(os.Stderr, "Warning: upload file %s from %s no longer exists; starting a new upload\n", state.FileID, statePath)
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to fetch upload file to resume: %w", err)
+	}
+	attrs := fileResp.Data.Attributes
+	if (attrs.Uploaded == nil || !*attrs.Uploaded) && len(attrs.UploadOperations) == 0 {
+		fmt.Fprintf(os.Stderr, "Warning: upload file %s has no upload operations left; starting a new upload\n", state.FileID)
+		return nil, nil, nil
+	}
+
+	if shared.ProgressEnabled() {
+		fmt.Fprintf(os.Stderr, "Resuming upload %s (%d of %d parts complete)\n", state.UploadID, state.CompletedParts(), len(attrs.UploadOperations))
+	}
+	return state, fileResp, nil
+}
+
+// removeUploadState deletes the state file once the upload is committed.
+func removeUploadState(state *asc.UploadState) {
+	if state == nil {
+		return
+	}
+	if err := state.Remove(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to remove upload state %s: %v\n", state.Path, err)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -59,7 +60,7 @@ func CertificatesListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -107,7 +108,7 @@ Examples:
 					return fmt.Errorf("certificates list: failed to fetch: %w", err)
 				}
 
-				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				paginated, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetCertificates(ctx, asc.WithCertificatesNextURL(nextURL))
 				})
 				if err != nil {
@@ -133,7 +134,7 @@ func CertificatesGetCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Certificate ID")
 	include := fs.String("include", "", "Include related resources: passTypeId")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -188,17 +189,31 @@ func CertificatesCreateCommand() *ffcli.Command {
 
 	certificateType := fs.String("certificate-type", "", "Certificate type (e.g., IOS_DISTRIBUTION)")
 	csrPath := fs.String("csr", "", "CSR file path")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	generateKey := fs.Bool("generate-key", false, "Generate an RSA key and CSR locally instead of using --csr, and write a .p12")
+	keySize := fs.Int("key-size", defaultKeySize, "RSA key size in bits for --generate-key")
+	commonName := fs.String("common-name", defaultCommonName, "CSR subject common name for --generate-key")
+	email := fs.String("email", "", "CSR email address for --generate-key (optional)")
+	outputDir := fs.String("output-dir", "./signing", "Directory for the generated key, CSR, certificate, and .p12")
+	p12Password := fs.String("p12-password", "", "Password for the generated .p12 (or "+p12PasswordEnvVar+" env)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "create",
-		ShortUsage: "asc certificates create --certificate-type TYPE --csr ./cert.csr",
+		ShortUsage: "asc certificates create --certificate-type TYPE (--csr ./cert.csr | --generate-key)",
 		ShortHelp:  "Create a signing certificate.",
 		LongHelp: `Create a signing certificate.
 
+With --generate-key, an RSA private key and CSR are created locally (no
+Keychain Access or openssl needed) and submitted. The key, CSR, issued
+certificate (.cer), and a password-protected .p12 are written to --output-dir.
+The key is saved before the certificate is requested and files are never
+overwritten. Prefer ` + p12PasswordEnvVar + ` over --p12-password so the password
+does not appear in the process list.
+
 Examples:
-  asc certificates create --certificate-type IOS_DISTRIBUTION --csr "./cert.csr"`,
+  asc certificates create --certificate-type IOS_DISTRIBUTION --csr "./cert.csr"
+  ` + p12PasswordEnvVar + `="$P12_PASSWORD" asc certificates create --certificate-type DISTRIBUTION --generate-key --output-dir "./signing"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -208,11 +223,26 @@ Examples:
 				return flag.ErrHelp
 			}
 			csrValue := strings.TrimSpace(*csrPath)
-			if csrValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --csr is required")
+			if *generateKey && csrValue != "" {
+				return fmt.Errorf("certificates create: --csr and --generate-key are mutually exclusive")
+			}
+			if !*generateKey && csrValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --csr is required (or use --generate-key)")
 				return flag.ErrHelp
 			}
 
+			if *generateKey {
+				password := resolveP12Password(*p12Password)
+				if password == "" {
+					fmt.Fprintln(os.Stderr, "Error: --p12-password is required with --generate-key (or set "+p12PasswordEnvVar+")")
+					return flag.ErrHelp
+				}
+				if *keySize < defaultKeySize {
+					return fmt.Errorf("certificates create: --key-size must be at least %d", defaultKeySize)
+				}
+				return createCertificateWithGeneratedKey(ctx, certificateValue, *keySize, strings.TrimSpace(*commonName), strings.TrimSpace(*email), strings.TrimSpace(*outputDir), password, *output, *pretty)
+			}
+
 			csrContent, err := readCSRContent(csrValue)
 			if err != nil {
 				return fmt.Errorf("certificates create: %w", err)
@@ -236,13 +266,63 @@ Examples:
 	}
 }
 
+// createCertificateWithGeneratedKey runs the --generate-key flow.
+func createCertificateWithGeneratedKey(ctx context.Context, certificateType string, keySize int, commonName, email, outputDir, password, output string, pretty bool) error {
+	if commonName == "" {
+		commonName = defaultCommonName
+	}
+	if outputDir == "" {
+		outputDir = "./signing"
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("certificates create: %w", err)
+	}
+
+	key, csrPEM, err := generateKeyAndCSR(keySize, commonName, email)
+	if err != nil {
+		return fmt.Errorf("certificates create: %w", err)
+	}
+	files := newGeneratedSigningFiles(outputDir, certificateType, time.Now())
+	if err := writeGeneratedKey(files, key, csrPEM); err != nil {
+		return fmt.Errorf("certificates create: %w", err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	block, _ := pem.Decode(csrPEM)
+	resp, err := client.CreateCertificate(requestCtx, base64.StdEncoding.EncodeToString(block.Bytes), certificateType)
+	if err != nil {
+		return fmt.Errorf("certificates create: failed to create: %w", err)
+	}
+	if err := writeCertificateAndP12(files, key, resp, password); err != nil {
+		return fmt.Errorf("certificates create: certificate %s was created but %w (private key saved to %s)", resp.Data.ID, err, files.key)
+	}
+
+	attrs := resp.Data.Attributes
+	result := &asc.CertificateKeyResult{
+		CertificateID:   resp.Data.ID,
+		CertificateType: certificateType,
+		Name:            attrs.Name,
+		SerialNumber:    attrs.SerialNumber,
+		ExpirationDate:  attrs.ExpirationDate,
+		KeyFile:         files.key,
+		CSRFile:         files.csr,
+		CertificateFile: files.certificate,
+		P12File:         files.p12,
+	}
+	return shared.PrintOutput(result, output, pretty)
+}
+
 // CertificatesUpdateCommand returns the certificates update subcommand.
 func CertificatesUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("update", flag.ExitOnError)
 
 	id := fs.String("id", "", "Certificate ID")
 	activated := fs.String("activated", "", "Set activated (true/false)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -298,7 +378,7 @@ func CertificatesRevokeCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Certificate ID")
 	confirm := fs.Bool("confirm", false, "Confirm revocation")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
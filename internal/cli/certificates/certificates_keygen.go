@@ -0,0 +1,134 @@
+package certificates
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared/pkcs12"
+)
+
+const (
+	defaultKeySize    = 2048
+	defaultCommonName = "App Store Connect CLI"
+	p12PasswordEnvVar = "ASC_P12_PASSWORD"
+)
+
+// generatedSigningFiles holds the paths written for a generated key.
+type generatedSigningFiles struct {
+	key         string
+	csr         string
+	certificate string
+	p12         string
+}
+
+func newGeneratedSigningFiles(dir, certificateType string, now time.Time) generatedSigningFiles {
+	base := filepath.Join(dir, strings.ToLower(certificateType)+"-"+now.UTC().Format("20060102-150405"))
+	return generatedSigningFiles{
+		key:         base + ".key",
+		csr:         base + ".csr",
+		certificate: base + ".cer",
+		p12:         base + ".p12",
+	}
+}
+
+// resolveP12Password returns the .p12 password from the flag or ASC_P12_PASSWORD.
+func resolveP12Password(value string) string {
+	if value != "" {
+		return value
+	}
+	return os.Getenv(p12PasswordEnvVar)
+}
+
+// generateKeyAndCSR creates an RSA private key and a PEM-encoded certificate
+// signing request for it.
+func generateKeyAndCSR(bits int, commonName, email string) (*rsa.PrivateKey, []byte, error) {
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generate RSA key: %w", err)
+	}
+	template := &x509.CertificateRequest{
+		Subject:            pkix.Name{CommonName: commonName},
+		SignatureAlgorithm: x509.SHA256WithRSA,
+	}
+	if email != "" {
+		template.EmailAddresses = []string{email}
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create CSR: %w", err)
+	}
+	return key, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}), nil
+}
+
+// writeGeneratedKey saves the private key and CSR before the certificate is
+// requested, so the key is never lost if a later step fails.
+func writeGeneratedKey(files generatedSigningFiles, key *rsa.PrivateKey, csrPEM []byte) error {
+	if err := os.MkdirAll(filepath.Dir(files.key), 0o700); err != nil {
+		return err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return fmt.Errorf("marshal private key: %w", err)
+	}
+	if err := writeNewFile(files.key, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})); err != nil {
+		return err
+	}
+	return writeNewFile(files.csr, csrPEM)
+}
+
+// writeCertificateAndP12 saves the issued certificate and bundles it with the
+// key into a password-protected .p12.
+func writeCertificateAndP12(files generatedSigningFiles, key *rsa.PrivateKey, resp *asc.CertificateResponse, password string) error {
+	content := strings.TrimSpace(resp.Data.Attributes.CertificateContent)
+	if content == "" {
+		return errors.New("certificate response has no certificateContent")
+	}
+	der, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return fmt.Errorf("decode certificate: %w", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return fmt.Errorf("parse certificate: %w", err)
+	}
+	if !key.PublicKey.Equal(cert.PublicKey) {
+		return errors.New("issued certificate does not match the generated key")
+	}
+	if err := writeNewFile(files.certificate, der); err != nil {
+		return err
+	}
+
+	p12, err := pkcs12.Encode(rand.Reader, key, cert, resp.Data.Attributes.Name, password)
+	if err != nil {
+		return fmt.Errorf("encode p12: %w", err)
+	}
+	return writeNewFile(files.p12, p12)
+}
+
+func writeNewFile(path string, data []byte) error {
+	file, err := shared.OpenNewFileNoFollow(path, 0o600)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("output file already exists: %w", err)
+		}
+		return err
+	}
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		return err
+	}
+	return file.Sync()
+}
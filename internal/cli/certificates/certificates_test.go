@@ -3,6 +3,7 @@ package certificates
 import (
 	"context"
 	"flag"
+	"strings"
 	"testing"
 )
 
@@ -101,3 +102,50 @@ func TestCertificatesRelationshipsPassTypeIDCommand_MissingID(t *testing.T) {
 		t.Fatalf("expected flag.ErrHelp when --id is missing, got %v", err)
 	}
 }
+
+func TestCertificatesCreateCommand_GenerateKeyValidation(t *testing.T) {
+	t.Setenv("ASC_P12_PASSWORD", "")
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantHelp bool
+		wantErr  string
+	}{
+		{
+			name:     "missing p12 password",
+			args:     []string{"--certificate-type", "DISTRIBUTION", "--generate-key"},
+			wantHelp: true,
+		},
+		{
+			name:    "csr and generate key",
+			args:    []string{"--certificate-type", "DISTRIBUTION", "--generate-key", "--csr", "./cert.csr"},
+			wantErr: "--csr and --generate-key are mutually exclusive",
+		},
+		{
+			name:    "weak key size",
+			args:    []string{"--certificate-type", "DISTRIBUTION", "--generate-key", "--p12-password", "pw", "--key-size", "1024"},
+			wantErr: "--key-size must be at least 2048",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := CertificatesCreateCommand()
+			if err := cmd.FlagSet.Parse(test.args); err != nil {
+				t.Fatalf("failed to parse flags: %v", err)
+			}
+
+			err := cmd.Exec(context.Background(), []string{})
+			if test.wantHelp {
+				if err != flag.ErrHelp {
+					t.Fatalf("expected flag.ErrHelp, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
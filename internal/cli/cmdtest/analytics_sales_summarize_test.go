@@ -0,0 +1,132 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeSalesReportFixture(t *testing.T) string {
+	t.Helper()
+
+	report := "Provider\tSKU\tTitle\tProduct Type Identifier\tUnits\tDeveloper Proceeds\tBegin Date\tCountry Code\tCurrency of Proceeds\n" +
+		"APPLE\tcom.example.app\tExample App\t1F\t3\t0.70\t01/20/2024\tUS\tUSD\n" +
+		"APPLE\tcom.example.app\tExample App\t1F\t2\t0.70\t01/20/2024\tCA\tUSD\n" +
+		"APPLE\tcom.example.pro\tPro Upgrade\tIA1\t1\t2.10\t01/20/2024\tUS\tUSD\n"
+	path := filepath.Join(t.TempDir(), "sales.tsv")
+	if err := os.WriteFile(path, []byte(report), 0o600); err != nil {
+		t.Fatalf("write report: %v", err)
+	}
+	return path
+}
+
+func TestAnalyticsSalesSummarize(t *testing.T) {
+	path := writeSalesReportFixture(t)
+
+	tests := []struct {
+		name  string
+		args  []string
+		check func(t *testing.T, stdout string)
+	}{
+		{
+			name: "json by sku",
+			args: []string{"analytics", "sales", "summarize", "--file", path},
+			check: func(t *testing.T, stdout string) {
+				var summary struct {
+					GroupBy []string `json:"groupBy"`
+					Data    []struct {
+						SKU      string  `json:"sku"`
+						Units    float64 `json:"units"`
+						Proceeds float64 `json:"proceeds"`
+					} `json:"data"`
+					TotalUnits float64 `json:"totalUnits"`
+				}
+				if err := json.Unmarshal([]byte(stdout), &summary); err != nil {
+					t.Fatalf("parse output %q: %v", stdout, err)
+				}
+				if len(summary.Data) != 2 || summary.Data[0].SKU != "com.example.app" || summary.Data[0].Units != 5 || summary.Data[0].Proceeds != 3.5 || summary.TotalUnits != 6 {
+					t.Fatalf("unexpected summary: %s", stdout)
+				}
+			},
+		},
+		{
+			name: "csv by territory",
+			args: []string{"analytics", "sales", "summarize", "--file", path, "--group-by", "territory", "--output", "csv"},
+			check: func(t *testing.T, stdout string) {
+				want := "Territory,Units,Proceeds,Currency\nCA,2,1.40,USD\nUS,4,4.20,USD\n"
+				if stdout != want {
+					t.Fatalf("unexpected csv:\n%s", stdout)
+				}
+			},
+		},
+		{
+			name: "parse rows",
+			args: []string{"analytics", "sales", "parse", "--file", path, "--output", "csv"},
+			check: func(t *testing.T, stdout string) {
+				if !strings.HasPrefix(stdout, "Begin Date,SKU,Title,Product Type,Country,Units,Developer Proceeds,Currency\n2024-01-20,com.example.app,Example App,1F,US,3,0.70,USD\n") {
+					t.Fatalf("unexpected csv:\n%s", stdout)
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, _ := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); err != nil {
+					t.Fatalf("run error: %v", err)
+				}
+			})
+			test.check(t, stdout)
+		})
+	}
+}
+
+func TestAnalyticsSalesSummarizeErrors(t *testing.T) {
+	path := writeSalesReportFixture(t)
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "unknown dimension",
+			args:    []string{"analytics", "sales", "summarize", "--file", path, "--group-by", "app"},
+			wantErr: `unsupported group-by dimension "app"`,
+		},
+		{
+			name:    "not a financial report",
+			args:    []string{"finance", "reports", "parse", "--file", path},
+			wantErr: `not a FINANCIAL report: missing column "Start Date"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAPIValidationErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantErr    string
+		wantStderr string
+	}{
+		{
+			name:       "missing path",
+			args:       []string{"api", "GET"},
+			wantStderr: "Error: <METHOD> and <path> are required",
+		},
+		{
+			name:       "unsupported method",
+			args:       []string{"api", "PUT", "/v1/apps"},
+			wantStderr: "unsupported method",
+		},
+		{
+			name:    "unknown path",
+			args:    []string{"api", "GET", "/v1/notARealResource"},
+			wantErr: "not in the OpenAPI snapshot",
+		},
+		{
+			name:    "method not allowed for path",
+			args:    []string{"api", "DELETE", "/v1/apps", "--confirm"},
+			wantErr: "allowed: GET",
+		},
+		{
+			name:       "delete without confirm",
+			args:       []string{"api", "DELETE", "/v1/betaGroups/GROUP_ID"},
+			wantStderr: "--confirm is required",
+		},
+		{
+			name:    "absolute URL",
+			args:    []string{"api", "GET", "https://example.com/v1/apps"},
+			wantErr: "path must be relative",
+		},
+		{
+			name:    "paginate with POST",
+			args:    []string{"api", "POST", "/v1/betaGroups", "--paginate"},
+			wantErr: "--paginate is only supported for GET",
+		},
+		{
+			name:    "malformed field",
+			args:    []string{"api", "PATCH", "/v1/apps/123", "--field", "nokey"},
+			wantErr: "key=value",
+		},
+		{
+			name:    "malformed typed field",
+			args:    []string{"api", "PATCH", "/v1/apps/123", "--typed-field", "nokey"},
+			wantErr: "--typed-field must be in key=value format",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if test.wantStderr != "" {
+				if !errors.Is(runErr, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", runErr)
+				}
+				if !strings.Contains(stderr, test.wantStderr) {
+					t.Fatalf("expected stderr to contain %q, got %q", test.wantStderr, stderr)
+				}
+				return
+			}
+			if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
+
+func TestAPIGetPaginatesWithQueryFields(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	const nextURL = "https://api.appstoreconnect.apple.com/v1/apps/123/builds?cursor=AQ&limit=1"
+	requestCount := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requestCount++
+		switch requestCount {
+		case 1:
+			if req.Method != http.MethodGet || req.URL.Path != "/v1/apps/123/builds" || req.URL.Query().Get("limit") != "1" {
+				t.Fatalf("unexpected first request: %s %s", req.Method, req.URL.String())
+			}
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"builds","id":"b1"}],"links":{"next":"`+nextURL+`"}}`)
+		case 2:
+			if req.URL.String() != nextURL {
+				t.Fatalf("unexpected second request: %s", req.URL.String())
+			}
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"builds","id":"b2"}],"links":{}}`)
+		default:
+			t.Fatalf("unexpected extra request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"api", "GET", "/v1/apps/123/builds", "--field", "limit=1", "--paginate"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse output %q: %v", stdout, err)
+	}
+	if len(payload.Data) != 2 || payload.Data[0].ID != "b1" || payload.Data[1].ID != "b2" {
+		t.Fatalf("expected aggregated builds b1,b2, got %+v", payload.Data)
+	}
+}
+
+func TestAPIPatchBuildsBodyFromFields(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/betaGroups/GROUP1" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		var body struct {
+			Data struct {
+				Type       string `json:"type"`
+				ID         string `json:"id"`
+				Attributes struct {
+					Name              string `json:"name"`
+					PublicLinkEnabled bool   `json:"publicLinkEnabled"`
+					PublicLinkLimit   int    `json:"publicLinkLimit"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if body.Data.Type != "betaGroups" || body.Data.ID != "GROUP1" || body.Data.Attributes.Name != "Beta" ||
+			!body.Data.Attributes.PublicLinkEnabled || body.Data.Attributes.PublicLinkLimit != 50 {
+			t.Fatalf("unexpected body: %+v", body)
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"betaGroups","id":"GROUP1","attributes":{"name":"Beta"}}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"api", "PATCH", "/v1/betaGroups/GROUP1",
+			"--field", "data.type=betaGroups",
+			"--field", "data.id=GROUP1",
+			"--field", "data.attributes.name=Beta",
+			"--typed-field", "data.attributes.publicLinkEnabled=true",
+			"--typed-field", "data.attributes.publicLinkLimit=50",
+			"--output", "table",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, "betaGroups") || !strings.Contains(stdout, "name=Beta") {
+		t.Fatalf("expected table output with resource row, got %q", stdout)
+	}
+}
+
+func TestAPIPatchFieldsStayStrings(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/apps/123" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		want := `{"data":{"attributes":{"contentRightsDeclaration":"USES_THIRD_PARTY_CONTENT"},"id":"123","type":"apps"}}`
+		if string(body) != want {
+			t.Fatalf("expected body %s, got %s", want, body)
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"123","attributes":{}}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, _ = captureOutput(t, func() {
+		// The PATCH example from the command's help text.
+		if err := root.Parse([]string{
+			"api", "PATCH", "/v1/apps/123",
+			"--field", "data.type=apps",
+			"--field", "data.id=123",
+			"--field", "data.attributes.contentRightsDeclaration=USES_THIRD_PARTY_CONTENT",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+}
+
+func TestAPIPatchTypedFieldNumbers(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		want := `{"data":{"attributes":{"count":50,"exponent":1e3,"price":0.99,"ratio":-1.5,"text":"NaN"}}}`
+		if string(body) != want {
+			t.Fatalf("expected body %s, got %s", want, body)
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"123","attributes":{}}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"api", "PATCH", "/v1/apps/123",
+			"--typed-field", "data.attributes.count=50",
+			"--typed-field", "data.attributes.price=0.99",
+			"--typed-field", "data.attributes.ratio=-1.5",
+			"--typed-field", "data.attributes.exponent=1e3",
+			"--typed-field", "data.attributes.text=NaN",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,126 @@
+package cmdtest
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"howett.net/plist"
+)
+
+func TestBuildsInspectLintReportsBlockingIssues(t *testing.T) {
+	info, err := plist.Marshal(map[string]any{
+		"CFBundleIdentifier":         "com.example.demo",
+		"CFBundleShortVersionString": "1.0",
+		"CFBundleVersion":            "1",
+		"CFBundleIcons":              map[string]any{},
+		"MinimumOSVersion":           "17.0",
+	}, plist.XMLFormat)
+	if err != nil {
+		t.Fatalf("marshal plist: %v", err)
+	}
+	ipaPath := writeTestIPA(t, map[string][]byte{"Payload/Demo.app/Info.plist": info})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "inspect", "--ipa", ipaPath, "--lint"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if _, ok := errors.AsType[ReportedError](runErr); !ok {
+		t.Fatalf("expected ReportedError, got %v", runErr)
+	}
+
+	var result struct {
+		Bundles []struct {
+			BundleID string `json:"bundleId"`
+			Kind     string `json:"kind"`
+		} `json:"bundles"`
+		Summary struct {
+			Errors   int `json:"errors"`
+			Blocking int `json:"blocking"`
+		} `json:"summary"`
+		Checks []struct {
+			ID string `json:"id"`
+		} `json:"checks"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if len(result.Bundles) != 1 || result.Bundles[0].BundleID != "com.example.demo" || result.Bundles[0].Kind != "app" {
+		t.Fatalf("unexpected bundles: %+v", result.Bundles)
+	}
+	if result.Summary.Blocking != 1 {
+		t.Fatalf("expected 1 blocking issue, got %+v", result.Summary)
+	}
+	if !strings.Contains(stdout, "ipa.profile.missing") || !strings.Contains(stdout, "ipa.privacy_manifest.missing") {
+		t.Fatalf("expected profile and privacy manifest checks, got %s", stdout)
+	}
+}
+
+func TestBuildsInspectValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "missing ipa", args: []string{"builds", "inspect"}},
+		{name: "strict without lint", args: []string{"builds", "inspect", "--ipa", "app.ipa", "--strict"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected flag.ErrHelp, got %v", runErr)
+			}
+			if !strings.Contains(stderr, "Error:") {
+				t.Fatalf("expected error message on stderr, got %q", stderr)
+			}
+		})
+	}
+}
+
+func writeTestIPA(t *testing.T, files map[string][]byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "app.ipa")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create zip: %v", err)
+	}
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	for name, data := range files {
+		entry, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("create zip entry %q: %v", name, err)
+		}
+		if _, err := entry.Write(data); err != nil {
+			t.Fatalf("write zip entry %q: %v", name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("close zip: %v", err)
+	}
+	return path
+}
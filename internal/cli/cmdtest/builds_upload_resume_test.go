@@ -0,0 +1,200 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuildsUploadResumeContinuesFromStateFile(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_MAX_RETRIES", "1")
+	t.Setenv("ASC_BASE_DELAY", "1ms")
+
+	dir := t.TempDir()
+	ipaPath := filepath.Join(dir, "app.ipa")
+	content := []byte("abcdefghij")
+	if err := os.WriteFile(ipaPath, content, 0o600); err != nil {
+		t.Fatalf("write ipa: %v", err)
+	}
+	statePath := ipaPath + ".asc-upload.json"
+	sum := md5.Sum(content)
+	fileMD5 := hex.EncodeToString(sum[:])
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	const operations = `"uploadOperations":[
+		{"method":"PUT","url":"https://upload.example.test/op0","offset":0,"length":5},
+		{"method":"PUT","url":"https://upload.example.test/op1","offset":5,"length":5}
+	]`
+	var requests []string
+	failPart := true
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/buildUploads":
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"buildUploads","id":"upload-1"}}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/buildUploadFiles":
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"buildUploadFiles","id":"file-1","attributes":{"fileName":"app.ipa","fileSize":10,`+operations+`}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/buildUploadFiles/file-1":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"buildUploadFiles","id":"file-1","attributes":{"fileName":"app.ipa","fileSize":10,`+operations+`,
+				"sourceFileChecksums":{"file":{"hash":"`+fileMD5+`","algorithm":"MD5"}}}}}`)
+		case req.Method == http.MethodPut && req.URL.Path == "/op0":
+			return jsonResponse(http.StatusOK, ``)
+		case req.Method == http.MethodPut && req.URL.Path == "/op1":
+			if failPart {
+				return jsonResponse(http.StatusForbidden, ``)
+			}
+			return jsonResponse(http.StatusOK, ``)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/buildUploadFiles/file-1":
+			var body struct {
+				Data struct {
+					Attributes struct {
+						SourceFileChecksums struct {
+							File struct {
+								Hash string `json:"hash"`
+							} `json:"file"`
+						} `json:"sourceFileChecksums"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatalf("decode commit body: %v", err)
+			}
+			if body.Data.Attributes.SourceFileChecksums.File.Hash != fileMD5 {
+				t.Fatalf("expected verified checksum in commit, got %+v", body)
+			}
+			return jsonResponse(http.StatusOK, `{"data":{"type":"buildUploadFiles","id":"file-1","attributes":{"fileName":"app.ipa","fileSize":10,"uploaded":true}}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	args := []string{"builds", "upload", "--app", "app-1", "--ipa", ipaPath, "--version", "1.0.0", "--build-number", "7", "--resume"}
+
+	run := func() (string, error) {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		var runErr error
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse(args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			runErr = root.Run(context.Background())
+		})
+		return stdout, runErr
+	}
+
+	if _, err := run(); err == nil || !strings.Contains(err.Error(), "rerun with --resume") {
+		t.Fatalf("expected resumable upload failure, got %v", err)
+	}
+	var state struct {
+		UploadID string `json:"uploadId"`
+		FileID   string `json:"fileId"`
+		Parts    []struct {
+			Completed bool `json:"completed"`
+		} `json:"parts"`
+	}
+	data, err := os.ReadFile(statePath)
+	if err != nil {
+		t.Fatalf("expected state file: %v", err)
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		t.Fatalf("parse state: %v", err)
+	}
+	if state.UploadID != "upload-1" || state.FileID != "file-1" || len(state.Parts) != 2 || !state.Parts[0].Completed || state.Parts[1].Completed {
+		t.Fatalf("unexpected state after failure: %s", data)
+	}
+
+	failPart = false
+	requests = nil
+	stdout, err := run()
+	if err != nil {
+		t.Fatalf("resume error: %v", err)
+	}
+	wantRequests := []string{"GET /v1/buildUploadFiles/file-1", "PUT /op1", "PATCH /v1/buildUploadFiles/file-1"}
+	if strings.Join(requests, "\n") != strings.Join(wantRequests, "\n") {
+		t.Fatalf("unexpected requests on resume:\n%s", strings.Join(requests, "\n"))
+	}
+
+	var result struct {
+		UploadID         string `json:"uploadId"`
+		Uploaded         bool   `json:"uploaded"`
+		ChecksumVerified bool   `json:"checksumVerified"`
+		Resumed          bool   `json:"resumed"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output %q: %v", stdout, err)
+	}
+	if result.UploadID != "upload-1" || !result.Uploaded || !result.ChecksumVerified || !result.Resumed {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+	if _, err := os.Stat(statePath); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected state file to be removed, got %v", err)
+	}
+}
+
+func TestBuildsUploadResumeValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantErr    string
+		wantStderr string
+	}{
+		{
+			name:    "resume with dry run",
+			args:    []string{"builds", "upload", "--app", "app-1", "--ipa", "app.ipa", "--dry-run", "--resume"},
+			wantErr: "--resume is not supported with --dry-run",
+		},
+		{
+			name:       "state file without resume",
+			args:       []string{"builds", "upload", "--app", "app-1", "--ipa", "app.ipa", "--state-file", "state.json"},
+			wantStderr: "--state-file requires --resume",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "app.ipa"), []byte("ipa"), 0o600); err != nil {
+				t.Fatalf("write ipa: %v", err)
+			}
+			t.Chdir(dir)
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if test.wantStderr != "" {
+				if !errors.Is(runErr, flag.ErrHelp) || !strings.Contains(stderr, test.wantStderr) {
+					t.Fatalf("expected ErrHelp with %q, got %v (stderr %q)", test.wantStderr, runErr, stderr)
+				}
+				return
+			}
+			if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCertificatesCreateGenerateKeyWritesP12(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_P12_PASSWORD", "s3cret")
+
+	issuerKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate issuer key: %v", err)
+	}
+	issuer := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test WWDR"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/certificates" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		var body struct {
+			Data struct {
+				Attributes struct {
+					CertificateType string `json:"certificateType"`
+					CSRContent      string `json:"csrContent"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		csrDER, err := base64.StdEncoding.DecodeString(body.Data.Attributes.CSRContent)
+		if err != nil {
+			t.Fatalf("decode csr: %v", err)
+		}
+		csr, err := x509.ParseCertificateRequest(csrDER)
+		if err != nil || csr.CheckSignature() != nil {
+			t.Fatalf("invalid csr: %v", err)
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(42),
+			Subject:      pkix.Name{CommonName: "Apple Distribution: Example"},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		certDER, err := x509.CreateCertificate(rand.Reader, template, issuer, csr.PublicKey, issuerKey)
+		if err != nil {
+			t.Fatalf("issue certificate: %v", err)
+		}
+		return jsonResponse(http.StatusCreated, `{"data":{"type":"certificates","id":"cert-1","attributes":{"name":"Apple Distribution: Example","certificateType":"`+
+			body.Data.Attributes.CertificateType+`","serialNumber":"2A","certificateContent":"`+base64.StdEncoding.EncodeToString(certDER)+`"}}}`)
+	})
+
+	dir := filepath.Join(t.TempDir(), "signing")
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"certificates", "create", "--certificate-type", "DISTRIBUTION", "--generate-key", "--output-dir", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		CertificateID   string `json:"certificateId"`
+		KeyFile         string `json:"keyFile"`
+		CertificateFile string `json:"certificateFile"`
+		P12File         string `json:"p12File"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output %q: %v", stdout, err)
+	}
+	if result.CertificateID != "cert-1" {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+
+	keyPEM, err := os.ReadFile(result.KeyFile)
+	if err != nil {
+		t.Fatalf("read key: %v", err)
+	}
+	block, _ := pem.Decode(keyPEM)
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatalf("parse key: %v", err)
+	}
+	certDER, err := os.ReadFile(result.CertificateFile)
+	if err != nil {
+		t.Fatalf("read certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	if !key.(*rsa.PrivateKey).PublicKey.Equal(cert.PublicKey) {
+		t.Fatalf("certificate does not match the generated key")
+	}
+	for _, path := range []string{result.KeyFile, result.P12File} {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("stat %s: %v", path, err)
+		}
+		if info.Mode().Perm() != 0o600 {
+			t.Fatalf("%s mode = %v, want 0600", path, info.Mode().Perm())
+		}
+	}
+}
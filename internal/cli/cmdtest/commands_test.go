@@ -1061,6 +1061,21 @@ func TestIAPValidationErrors(t *testing.T) {
 			args:    []string{"iap", "submit", "--iap-id", "IAP_ID"},
 			wantErr: "--confirm is required",
 		},
+		{
+			name:    "iap storekit export missing app",
+			args:    []string{"iap", "storekit", "export"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "iap storekit export empty storefront",
+			args:    []string{"iap", "storekit", "export", "--app", "APP_ID", "--storefront", " "},
+			wantErr: "--storefront must not be empty",
+		},
+		{
+			name:    "iap storekit import missing file",
+			args:    []string{"iap", "storekit", "import", "--app", "APP_ID"},
+			wantErr: "--file is required",
+		},
 	}
 
 	for _, test := range tests {
@@ -2692,6 +2707,40 @@ func TestPerformanceValidationErrors(t *testing.T) {
 			args:    []string{"performance", "download", "--app", "APP_ID", "--build", "BUILD_ID"},
 			wantErr: "mutually exclusive",
 		},
+		{
+			name:     "performance compare missing base",
+			args:     []string{"performance", "compare", "--head", "BUILD_B"},
+			wantErr:  "--base is required",
+			wantHelp: true,
+		},
+		{
+			name:     "performance compare missing head",
+			args:     []string{"performance", "compare", "--base", "BUILD_A"},
+			wantErr:  "--head is required",
+			wantHelp: true,
+		},
+		{
+			name:    "performance compare negative threshold",
+			args:    []string{"performance", "compare", "--base", "BUILD_A", "--head", "BUILD_B", "--threshold", "-1"},
+			wantErr: "--threshold must be 0 or greater",
+		},
+		{
+			name:     "performance compare versions without app",
+			args:     []string{"performance", "compare", "--base-version", "2.3", "--head-version", "2.4"},
+			wantErr:  "--app is required with --base-version and --head-version",
+			wantHelp: true,
+		},
+		{
+			name:     "performance compare missing head version",
+			args:     []string{"performance", "compare", "--app", "APP_ID", "--base-version", "2.3"},
+			wantErr:  "--head-version is required with --base-version",
+			wantHelp: true,
+		},
+		{
+			name:    "performance compare builds and versions",
+			args:    []string{"performance", "compare", "--app", "APP_ID", "--base", "BUILD_A", "--base-version", "2.3", "--head-version", "2.4"},
+			wantErr: "mutually exclusive",
+		},
 	}
 
 	for _, test := range tests {
@@ -2902,6 +2951,71 @@ func TestTestFlightSyncValidationErrors(t *testing.T) {
 			args:    []string{"testflight", "sync", "pull", "--app", "APP_ID", "--output", "./testflight.yaml", "--tester", "tester@example.com"},
 			wantErr: "--tester requires --include-testers",
 		},
+		{
+			name:    "testflight sync push missing file",
+			args:    []string{"testflight", "sync", "push", "--app", "APP_ID"},
+			wantErr: "--file is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestMetadataValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "metadata pull missing app",
+			args:    []string{"metadata", "pull", "--version", "1.0", "--output", "./metadata.yaml"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "metadata pull missing version",
+			args:    []string{"metadata", "pull", "--app", "APP_ID", "--output", "./metadata.yaml"},
+			wantErr: "--version or --version-id is required",
+		},
+		{
+			name:    "metadata pull missing output",
+			args:    []string{"metadata", "pull", "--app", "APP_ID", "--version", "1.0"},
+			wantErr: "--output is required",
+		},
+		{
+			name:    "metadata plan missing file",
+			args:    []string{"metadata", "plan", "--app", "APP_ID"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "metadata apply missing file",
+			args:    []string{"metadata", "apply", "--app", "APP_ID"},
+			wantErr: "--file is required",
+		},
 	}
 
 	for _, test := range tests {
@@ -3024,6 +3138,26 @@ func TestLocalizationsValidationErrors(t *testing.T) {
 			args:    []string{"localizations", "upload", "--type", "app-info", "--path", "localizations"},
 			wantErr: "--app is required",
 		},
+		{
+			name:    "beta-app-localizations download missing app",
+			args:    []string{"beta-app-localizations", "download"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "beta-app-localizations upload missing path",
+			args:    []string{"beta-app-localizations", "upload", "--app", "APP_ID"},
+			wantErr: "--path is required",
+		},
+		{
+			name:    "beta-build-localizations download missing build",
+			args:    []string{"beta-build-localizations", "download"},
+			wantErr: "--build is required",
+		},
+		{
+			name:    "beta-build-localizations upload missing build",
+			args:    []string{"beta-build-localizations", "upload", "--path", "notes.json"},
+			wantErr: "--build is required",
+		},
 	}
 
 	for _, test := range tests {
@@ -3117,6 +3251,16 @@ func TestAssetsValidationErrors(t *testing.T) {
 			args:    []string{"assets", "previews", "delete", "--id", "PREVIEW_ID"},
 			wantErr: "--confirm is required to delete",
 		},
+		{
+			name:    "assets sync missing version",
+			args:    []string{"assets", "sync", "--dir", "./screenshots"},
+			wantErr: "--version is required",
+		},
+		{
+			name:    "assets sync missing dir",
+			args:    []string{"assets", "sync", "--version", "VERSION_ID"},
+			wantErr: "--dir is required",
+		},
 	}
 
 	for _, test := range tests {
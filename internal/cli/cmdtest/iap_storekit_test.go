@@ -0,0 +1,240 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func storeKitExportTransport(t *testing.T) roundTripFunc {
+	t.Helper()
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		switch req.URL.Path {
+		case "/v1/apps/app-1/inAppPurchasesV2":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Lifetime","productId":"com.example.lifetime","inAppPurchaseType":"NON_CONSUMABLE","familySharable":true}}],"links":{}}`)
+		case "/v2/inAppPurchases/iap-1/inAppPurchaseLocalizations":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"inAppPurchaseLocalizations","id":"loc-1","attributes":{"name":"Lifetime Unlock","locale":"en-US","description":"Unlock everything"}}],"links":{}}`)
+		case "/v2/inAppPurchases/iap-1/iapPriceSchedule":
+			return jsonResponse(http.StatusOK, `{
+				"data":{"type":"inAppPurchasePriceSchedules","id":"schedule-1","relationships":{"baseTerritory":{"data":{"type":"territories","id":"USA"}}}},
+				"included":[
+					{"type":"inAppPurchasePrices","id":"price-1","attributes":{"startDate":"2024-01-01","manual":true},"relationships":{"territory":{"data":{"type":"territories","id":"USA"}},"inAppPurchasePricePoint":{"data":{"type":"inAppPurchasePricePoints","id":"pp-1"}}}}
+				]
+			}`)
+		case "/v2/inAppPurchases/iap-1/pricePoints":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"inAppPurchasePricePoints","id":"pp-1","attributes":{"customerPrice":"9.99","proceeds":"8.49"}}],"links":{}}`)
+		case "/v1/apps/app-1/subscriptionGroups":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionGroups","id":"group-1","attributes":{"referenceName":"Premium"}}],"links":{}}`)
+		case "/v1/subscriptionGroups/group-1/subscriptionGroupLocalizations":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionGroupLocalizations","id":"gloc-1","attributes":{"name":"Premium","locale":"en-US"}}],"links":{}}`)
+		case "/v1/subscriptionGroups/group-1/subscriptions":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptions","id":"sub-1","attributes":{"name":"Monthly","productId":"com.example.monthly","subscriptionPeriod":"ONE_MONTH","groupLevel":1}}],"links":{}}`)
+		case "/v1/subscriptions/sub-1/subscriptionLocalizations":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionLocalizations","id":"sloc-1","attributes":{"name":"Monthly","locale":"en-GB"}}],"links":{}}`)
+		case "/v1/subscriptions/sub-1/prices":
+			if query.Get("filter[territory]") != "USA" || query.Get("include") != "subscriptionPricePoint" {
+				t.Fatalf("unexpected prices query: %s", req.URL.RawQuery)
+			}
+			return jsonResponse(http.StatusOK, `{
+				"data":[{"type":"subscriptionPrices","id":"sp-1","attributes":{"startDate":"2024-01-01"},"relationships":{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"spp-1"}}}}],
+				"included":[{"type":"subscriptionPricePoints","id":"spp-1","attributes":{"customerPrice":"4.99"}}],
+				"links":{}
+			}`)
+		case "/v1/subscriptions/sub-1/introductoryOffers":
+			if query.Get("filter[territory]") != "USA" {
+				t.Fatalf("unexpected introductory offers query: %s", req.URL.RawQuery)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionIntroductoryOffers","id":"intro-1","attributes":{"duration":"ONE_WEEK","offerMode":"FREE_TRIAL","numberOfPeriods":1}}],"links":{}}`)
+		case "/v1/subscriptions/sub-1/promotionalOffers":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionPromotionalOffers","id":"promo-1","attributes":{"name":"Winback","offerCode":"WINBACK","duration":"THREE_MONTHS","offerMode":"PAY_UP_FRONT","numberOfPeriods":1}}],"links":{}}`)
+		case "/v1/subscriptionPromotionalOffers/promo-1/prices":
+			return jsonResponse(http.StatusOK, `{
+				"data":[{"type":"subscriptionPromotionalOfferPrices","id":"pop-1","relationships":{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"spp-2"}}}}],
+				"included":[{"type":"subscriptionPricePoints","id":"spp-2","attributes":{"customerPrice":"9.99"}}],
+				"links":{}
+			}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+}
+
+func TestIAPStoreKitExportWritesFile(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = storeKitExportTransport(t)
+
+	path := filepath.Join(t.TempDir(), "Products.storekit")
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "storekit", "export", "--app", "app-1", "--out", path}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	for _, want := range []string{`"products":1`, `"subscriptionGroups":1`, `"subscriptions":1`, `"missingPrices":0`} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected summary to contain %s, got %q", want, stdout)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	var config struct {
+		Products []struct {
+			DisplayPrice    string `json:"displayPrice"`
+			FamilyShareable bool   `json:"familyShareable"`
+			ProductID       string `json:"productID"`
+			Type            string `json:"type"`
+			Localizations   []struct {
+				DisplayName string `json:"displayName"`
+				Locale      string `json:"locale"`
+			} `json:"localizations"`
+		} `json:"products"`
+		Settings struct {
+			Storefront string `json:"_storefront"`
+		} `json:"settings"`
+		SubscriptionGroups []struct {
+			Name          string `json:"name"`
+			Subscriptions []struct {
+				DisplayPrice                string `json:"displayPrice"`
+				RecurringSubscriptionPeriod string `json:"recurringSubscriptionPeriod"`
+				SubscriptionGroupID         string `json:"subscriptionGroupID"`
+				IntroductoryOffer           *struct {
+					PaymentMode        string `json:"paymentMode"`
+					SubscriptionPeriod string `json:"subscriptionPeriod"`
+				} `json:"introductoryOffer"`
+				AdHocOffers []struct {
+					DisplayPrice string `json:"displayPrice"`
+					OfferID      string `json:"offerID"`
+					PaymentMode  string `json:"paymentMode"`
+				} `json:"adHocOffers"`
+				Localizations []struct {
+					Locale string `json:"locale"`
+				} `json:"localizations"`
+			} `json:"subscriptions"`
+		} `json:"subscriptionGroups"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		t.Fatalf("parse export: %v\n%s", err, data)
+	}
+
+	product := config.Products[0]
+	if product.ProductID != "com.example.lifetime" || product.Type != "NonConsumable" || product.DisplayPrice != "9.99" || !product.FamilyShareable {
+		t.Fatalf("unexpected product: %+v", product)
+	}
+	if product.Localizations[0].Locale != "en_US" || product.Localizations[0].DisplayName != "Lifetime Unlock" {
+		t.Fatalf("unexpected product localizations: %+v", product.Localizations)
+	}
+	if config.Settings.Storefront != "USA" {
+		t.Fatalf("unexpected storefront %q", config.Settings.Storefront)
+	}
+
+	sub := config.SubscriptionGroups[0].Subscriptions[0]
+	if sub.DisplayPrice != "4.99" || sub.RecurringSubscriptionPeriod != "P1M" || sub.SubscriptionGroupID != "group-1" || sub.Localizations[0].Locale != "en_GB" {
+		t.Fatalf("unexpected subscription: %+v", sub)
+	}
+	if sub.IntroductoryOffer == nil || sub.IntroductoryOffer.PaymentMode != "free" || sub.IntroductoryOffer.SubscriptionPeriod != "P1W" {
+		t.Fatalf("unexpected introductory offer: %+v", sub.IntroductoryOffer)
+	}
+	if len(sub.AdHocOffers) != 1 || sub.AdHocOffers[0].OfferID != "WINBACK" || sub.AdHocOffers[0].DisplayPrice != "9.99" || sub.AdHocOffers[0].PaymentMode != "payUpFront" {
+		t.Fatalf("unexpected promotional offers: %+v", sub.AdHocOffers)
+	}
+}
+
+func TestIAPStoreKitImportDryRun(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("dry run must not write, got %s %s", req.Method, req.URL.Path)
+		}
+		switch req.URL.Path {
+		case "/v1/apps/app-1/inAppPurchasesV2":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"inAppPurchases","id":"iap-1","attributes":{"productId":"com.example.lifetime"}}],"links":{}}`)
+		case "/v1/apps/app-1/subscriptionGroups":
+			return jsonResponse(http.StatusOK, `{"data":[],"links":{}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	path := filepath.Join(t.TempDir(), "Products.storekit")
+	body := `{
+		"identifier":"ABC",
+		"products":[
+			{"productID":"com.example.lifetime","referenceName":"Lifetime","type":"NonConsumable"},
+			{"productID":"com.example.coins","referenceName":"Coins","type":"Consumable","localizations":[{"displayName":"Coins","locale":"en_US"}]}
+		],
+		"settings":{"_applicationInternalID":"app-1"},
+		"subscriptionGroups":[{"name":"Premium","subscriptions":[{"productID":"com.example.monthly","referenceName":"Monthly","recurringSubscriptionPeriod":"P1M","type":"RecurringSubscription"}]}],
+		"version":{"major":3,"minor":0}
+	}`
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "storekit", "import", "--file", path, "--dry-run"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	var result struct {
+		AppID   string `json:"appId"`
+		DryRun  bool   `json:"dryRun"`
+		Actions []struct {
+			Action    string `json:"action"`
+			ProductID string `json:"productId"`
+			Status    string `json:"status"`
+		} `json:"actions"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v\n%s", err, stdout)
+	}
+	if result.AppID != "app-1" || !result.DryRun || len(result.Actions) != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	want := []string{"create-iap com.example.coins planned", "create-group  planned", "create-subscription com.example.monthly planned"}
+	for i, action := range result.Actions {
+		if got := action.Action + " " + action.ProductID + " " + action.Status; got != want[i] {
+			t.Fatalf("action %d = %q, want %q", i, got, want[i])
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/mockserver"
+)
+
+func newMockAPIServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	fixture, err := mockserver.DefaultFixture()
+	if err != nil {
+		t.Fatalf("DefaultFixture() error: %v", err)
+	}
+	server := httptest.NewServer(mockserver.New(mockserver.Options{
+		Fixtures: []*mockserver.Fixture{fixture},
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestPublishTestFlightAgainstMockServer(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	server := newMockAPIServer(t)
+	t.Setenv("ASC_BASE_URL", server.URL)
+
+	ipaPath := filepath.Join(t.TempDir(), "app.ipa")
+	if err := os.WriteFile(ipaPath, []byte("mock ipa payload"), 0o600); err != nil {
+		t.Fatalf("write ipa: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"publish", "testflight",
+			"--app", "1234567890",
+			"--ipa", ipaPath,
+			"--version", "1.0.0",
+			"--build-number", "7",
+			"--group", "Internal Testers",
+			"--poll-interval", "10ms",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		BuildID         string   `json:"buildId"`
+		BuildNumber     string   `json:"buildNumber"`
+		GroupIDs        []string `json:"groupIds"`
+		Uploaded        bool     `json:"uploaded"`
+		ProcessingState string   `json:"processingState"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output %q: %v", stdout, err)
+	}
+	if result.BuildID == "" || result.BuildNumber != "7" || !result.Uploaded {
+		t.Fatalf("unexpected publish result: %+v", result)
+	}
+	if strings.Join(result.GroupIDs, ",") != "GROUP1" {
+		t.Fatalf("expected build to be added to GROUP1, got %v", result.GroupIDs)
+	}
+}
+
+func TestSubmitCreateAgainstMockServer(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	server := newMockAPIServer(t)
+	t.Setenv("ASC_BASE_URL", server.URL)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"submit", "create",
+			"--app", "1234567890",
+			"--version", "1.0.0",
+			"--build", "BUILD1",
+			"--confirm",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		SubmissionID string `json:"submissionId"`
+		VersionID    string `json:"versionId"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output %q: %v", stdout, err)
+	}
+	if result.SubmissionID == "" || result.VersionID != "VERSION1" {
+		t.Fatalf("unexpected submit result: %+v", result)
+	}
+}
@@ -0,0 +1,192 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuildsLatestOutputFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{
+			name: "template after subcommand",
+			args: []string{"builds", "latest", "--app", "app-1", "--template", "{{.Data.ID}}"},
+			want: "build-1",
+		},
+		{
+			name: "template before subcommand",
+			args: []string{"--template", "{{.Data.Attributes.Version}}", "builds", "latest", "--app", "app-1"},
+			want: "42",
+		},
+		{
+			name: "query prints raw strings",
+			args: []string{"builds", "latest", "--app", "app-1", "--query", ".data.id, .data.attributes.processingState"},
+			want: "build-1\nVALID",
+		},
+		{
+			name: "query prints non-strings as json",
+			args: []string{"builds", "latest", "--app", "app-1", "--query", ".data.attributes | select(.processingState == \"VALID\") | keys"},
+			want: `["processingState","uploadedDate","version"]`,
+		},
+		{
+			name: "fields trims json attributes",
+			args: []string{"builds", "latest", "--app", "app-1", "--fields", "version"},
+			want: `{"data":{"attributes":{"version":"42"},"id":"build-1","type":"builds"}}`,
+		},
+		{
+			name: "fields selects table columns",
+			args: []string{"builds", "latest", "--app", "app-1", "--fields", "version,processing", "--output", "csv"},
+			want: "Version,Processing\n42,VALID",
+		},
+		{
+			name:    "fields matching no columns",
+			args:    []string{"builds", "latest", "--app", "app-1", "--fields", "nope", "--output", "table"},
+			wantErr: "--fields matched no columns",
+		},
+		{
+			name:    "invalid query",
+			args:    []string{"builds", "latest", "--app", "app-1", "--query", ".data["},
+			wantErr: "--query: expected index",
+		},
+		{
+			name:    "invalid template",
+			args:    []string{"builds", "latest", "--app", "app-1", "--template", "{{.Nope}}"},
+			wantErr: "--template:",
+		},
+		{
+			name:    "template with query",
+			args:    []string{"builds", "latest", "--app", "app-1", "--template", "{{.Data.ID}}", "--query", ".data.id"},
+			wantErr: "--template cannot be combined with --query or --fields",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setupAuth(t)
+			t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+			originalTransport := http.DefaultTransport
+			t.Cleanup(func() {
+				http.DefaultTransport = originalTransport
+			})
+
+			http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				if req.Method != http.MethodGet || req.URL.Path != "/v1/builds" {
+					t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+				}
+				return jsonResponse(http.StatusOK, `{"data":[{"type":"builds","id":"build-1","attributes":{"version":"42","processingState":"VALID","expired":false}}]}`)
+			})
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			stdout, _ := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if test.wantErr != "" {
+				if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", test.wantErr, runErr)
+				}
+				return
+			}
+			if runErr != nil {
+				t.Fatalf("run error: %v", runErr)
+			}
+			if got := strings.TrimSpace(stdout); got != test.want {
+				t.Fatalf("unexpected output:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestBetaGroupsListStreamsFieldsAsNDJSON(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	const firstURL = "https://api.appstoreconnect.apple.com/v1/apps/app-1/betaGroups?cursor=AQ&limit=200"
+	const secondURL = "https://api.appstoreconnect.apple.com/v1/apps/app-1/betaGroups?cursor=BQ&limit=200"
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.String() {
+		case firstURL:
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"betaGroups","id":"group-1","attributes":{"name":"Alpha","isInternalGroup":true}}],"links":{"next":"`+secondURL+`"}}`)
+		case secondURL:
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"betaGroups","id":"group-2","attributes":{"name":"Beta","isInternalGroup":false}}],"links":{}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "beta-groups", "list", "--paginate", "--next", firstURL, "--output", "ndjson", "--fields", "name"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	want := `{"attributes":{"name":"Alpha"},"id":"group-1","type":"betaGroups"}` + "\n" +
+		`{"attributes":{"name":"Beta"},"id":"group-2","type":"betaGroups"}`
+	if got := strings.TrimSpace(stdout); got != want {
+		t.Fatalf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestActorsListSelectFiltersOutputAlongsideAPIFields(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/actors" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		if got := req.URL.Query().Get("fields[actors]"); got != "actorType,userEmail" {
+			t.Fatalf("expected --fields to select API fields, got fields[actors]=%q", got)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"actors","id":"actor-1","attributes":{"actorType":"USER","userEmail":"dev@example.com"}}]}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"actors", "list", "--id", "actor-1", "--fields", "actorType,userEmail", "--select", "userEmail"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	want := `{"data":[{"attributes":{"userEmail":"dev@example.com"},"id":"actor-1","type":"actors"}]}`
+	if got := strings.TrimSpace(stdout); got != want {
+		t.Fatalf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,119 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBetaGroupsListPaginatedOutputFormats(t *testing.T) {
+	const firstURL = "https://api.appstoreconnect.apple.com/v1/apps/app-1/betaGroups?cursor=AQ&limit=200"
+	const secondURL = "https://api.appstoreconnect.apple.com/v1/apps/app-1/betaGroups?cursor=BQ&limit=200"
+
+	tests := []struct {
+		name   string
+		output string
+		want   []string
+	}{
+		{
+			name:   "ndjson streams one group per line",
+			output: "ndjson",
+			want: []string{
+				`{"type":"betaGroups","id":"group-1","attributes":{"name":"Alpha"}}`,
+				`{"type":"betaGroups","id":"group-2","attributes":{"name":"Beta, External"}}`,
+			},
+		},
+		{
+			name:   "csv uses table columns",
+			output: "csv",
+			want:   []string{"group-1,Alpha,", `group-2,"Beta, External",`},
+		},
+		{
+			name:   "yaml",
+			output: "yaml",
+			want:   []string{"data:", "  - type: betaGroups", "    id: group-2", "      name: Beta, External"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setupAuth(t)
+			t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+			originalTransport := http.DefaultTransport
+			t.Cleanup(func() {
+				http.DefaultTransport = originalTransport
+			})
+
+			http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				switch req.URL.String() {
+				case firstURL:
+					return jsonResponse(http.StatusOK, `{"data":[{"type":"betaGroups","id":"group-1","attributes":{"name":"Alpha"}}],"links":{"next":"`+secondURL+`"}}`)
+				case secondURL:
+					return jsonResponse(http.StatusOK, `{"data":[{"type":"betaGroups","id":"group-2","attributes":{"name":"Beta, External"}}],"links":{}}`)
+				default:
+					t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+					return nil, nil
+				}
+			})
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, _ := captureOutput(t, func() {
+				if err := root.Parse([]string{"testflight", "beta-groups", "list", "--paginate", "--next", firstURL, "--output", test.output}); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); err != nil {
+					t.Fatalf("run error: %v", err)
+				}
+			})
+
+			if test.output == "ndjson" {
+				if got := strings.TrimSpace(stdout); got != strings.Join(test.want, "\n") {
+					t.Fatalf("unexpected ndjson output:\n%s", stdout)
+				}
+				return
+			}
+			for _, want := range test.want {
+				if !strings.Contains(stdout, want) {
+					t.Fatalf("expected %q in output, got:\n%s", want, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestOutputPrettyRejectedForNonJSONFormats(t *testing.T) {
+	for _, format := range []string{"csv", "tsv", "yaml", "ndjson"} {
+		t.Run(format, func(t *testing.T) {
+			setupAuth(t)
+			t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+			originalTransport := http.DefaultTransport
+			t.Cleanup(func() {
+				http.DefaultTransport = originalTransport
+			})
+			http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				return jsonResponse(http.StatusOK, `{"data":{"type":"betaGroups","id":"group-1","attributes":{"name":"Alpha"}}}`)
+			})
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, _ = captureOutput(t, func() {
+				if err := root.Parse([]string{"testflight", "beta-groups", "get", "--id", "group-1", "--output", format, "--pretty"}); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if runErr == nil || !strings.Contains(runErr.Error(), "--pretty is only valid with JSON output") {
+				t.Fatalf("expected --pretty error, got %v", runErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func perfMetricsPayload(launch, hang float64) string {
+	return `{"version":"1.0","productData":[{"platform":"IOS","metricCategories":[` +
+		`{"identifier":"LAUNCH","metrics":[{"identifier":"launchTime","unit":{"identifier":"ms","displayName":"Milliseconds"},"datasets":[` +
+		`{"filterCriteria":{"percentile":"percentile.fifty","device":"all_iphones","deviceMarketingName":"All iPhones"},"points":[{"version":"1.0","value":900},{"version":"1.1","value":` + jsonNumber(launch) + `}]}` +
+		`]}]},` +
+		`{"identifier":"HANG","metrics":[{"identifier":"hangRate","unit":{"identifier":"s/hr","displayName":"Seconds per hour"},"datasets":[` +
+		`{"filterCriteria":{"percentile":"percentile.ninety","device":"iPhone15,2","deviceMarketingName":"iPhone 14 Pro"},"points":[{"version":"1.1","value":` + jsonNumber(hang) + `}]}` +
+		`]}]}` +
+		`]}]}`
+}
+
+func jsonNumber(value float64) string {
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+func TestPerformanceCompareFlagsRegressions(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_NO_UPDATE", "1")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		switch req.URL.Path {
+		case "/v1/builds/BUILD_A/perfPowerMetrics":
+			return jsonResponse(http.StatusOK, perfMetricsPayload(1000, 2))
+		case "/v1/builds/BUILD_B/perfPowerMetrics":
+			return jsonResponse(http.StatusOK, perfMetricsPayload(1250, 1.5))
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	// --app does not change what --base and --head refer to.
+	reportPath := filepath.Join(t.TempDir(), "perf.xml")
+	stdout, _ := captureOutput(t, func() {
+		code := cmd.Run([]string{"--report", "junit", "--report-file", reportPath, "performance", "compare", "--app", "APP_1", "--base", "BUILD_A", "--head", "BUILD_B"}, "1.2.3")
+		if code != cmd.ExitError {
+			t.Fatalf("expected exit code %d for a regression, got %d", cmd.ExitError, code)
+		}
+	})
+
+	var result struct {
+		Regressions  int `json:"regressions"`
+		Improvements int `json:"improvements"`
+		Metrics      []struct {
+			Category     string   `json:"category"`
+			Metric       string   `json:"metric"`
+			Device       string   `json:"device"`
+			Percentile   string   `json:"percentile"`
+			Base         *float64 `json:"base"`
+			Head         *float64 `json:"head"`
+			DeltaPercent *float64 `json:"deltaPercent"`
+			Status       string   `json:"status"`
+		} `json:"metrics"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout)
+	}
+	if result.Regressions != 1 || result.Improvements != 1 || len(result.Metrics) != 2 {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+	hang, launch := result.Metrics[0], result.Metrics[1]
+	if hang.Metric != "hangRate" || hang.Device != "iPhone 14 Pro" || hang.Percentile != "p90" || hang.Status != "improved" {
+		t.Fatalf("unexpected hang metric: %+v", hang)
+	}
+	if launch.Metric != "launchTime" || *launch.Base != 1000 || *launch.Head != 1250 || *launch.DeltaPercent != 25 || launch.Status != "regressed" {
+		t.Fatalf("expected the latest launch point to regress: %+v", launch)
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("read report: %v", err)
+	}
+	report := string(data)
+	if strings.Contains(report, `tests="1"`) {
+		t.Fatalf("expected the command's own test cases, got:\n%s", report)
+	}
+	for _, want := range []string{`tests="2"`, `failures="1"`, "launchTime (All iPhones, p50)", "performance.launch", "1000 ms -&gt; 1250 ms (+25.0%) (threshold 10%)"} {
+		if !strings.Contains(report, want) {
+			t.Fatalf("expected report to contain %q, got:\n%s", want, report)
+		}
+	}
+}
+
+func TestPerformanceCompareAppVersions(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/apps/APP_1/perfPowerMetrics" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return jsonResponse(http.StatusOK, perfMetricsPayload(950, 2))
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"performance", "compare", "--app", "APP_1", "--base-version", "1.0", "--head-version", "1.1", "--output", "table"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("expected no regression within the threshold, got %v", runErr)
+	}
+	for _, want := range []string{"launchTime", "900 ms", "950 ms", "+5.6%", "unchanged", "hangRate", "added"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected output to contain %q, got:\n%s", want, stdout)
+		}
+	}
+}
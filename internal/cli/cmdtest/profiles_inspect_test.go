@@ -0,0 +1,224 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"howett.net/plist"
+)
+
+func TestProfilesInspectByIDCrossChecksCertificates(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	listed := newProfileTestCertificate(t, "Apple Development: Listed", 10)
+	revoked := newProfileTestCertificate(t, "Apple Development: Revoked", 11)
+	profile := buildProfilePlist(t, listed.Raw, revoked.Raw)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/PROFILE_1":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"profiles","id":"PROFILE_1","attributes":{"name":"Demo","profileContent":"`+
+				base64.StdEncoding.EncodeToString(profile)+`"}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/certificates":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"certificates","id":"CERT_1","attributes":{"name":"Listed","certificateType":"DEVELOPMENT","certificateContent":"`+
+				base64.StdEncoding.EncodeToString(listed.Raw)+`"}}],"links":{}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "inspect", "--id", "PROFILE_1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		TeamID             string   `json:"teamId"`
+		BundleID           string   `json:"bundleId"`
+		ProfileType        string   `json:"profileType"`
+		ProvisionedDevices []string `json:"provisionedDevices"`
+		Certificates       []struct {
+			CommonName        string `json:"commonName"`
+			SHA256            string `json:"sha256"`
+			CertificateID     string `json:"certificateId"`
+			InAppStoreConnect *bool  `json:"inAppStoreConnect"`
+		} `json:"certificates"`
+		Warnings []string `json:"warnings"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.TeamID != "TEAM123456" || result.BundleID != "com.example.demo" || result.ProfileType != "development" {
+		t.Fatalf("unexpected profile fields: %+v", result)
+	}
+	if len(result.ProvisionedDevices) != 1 {
+		t.Fatalf("expected 1 device, got %v", result.ProvisionedDevices)
+	}
+	if len(result.Certificates) != 2 {
+		t.Fatalf("expected 2 certificates, got %+v", result.Certificates)
+	}
+	if result.Certificates[0].CertificateID != "CERT_1" || !*result.Certificates[0].InAppStoreConnect {
+		t.Fatalf("expected first certificate to match CERT_1, got %+v", result.Certificates[0])
+	}
+	if *result.Certificates[1].InAppStoreConnect || result.Certificates[1].SHA256 == "" {
+		t.Fatalf("expected second certificate to be missing from ASC, got %+v", result.Certificates[1])
+	}
+	if len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], "Revoked") {
+		t.Fatalf("expected revoked warning, got %v", result.Warnings)
+	}
+}
+
+func TestProfilesInspectOfflineFile(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	cert := newProfileTestCertificate(t, "Apple Development: Offline", 12)
+	path := filepath.Join(t.TempDir(), "demo.mobileprovision")
+	if err := os.WriteFile(path, buildProfilePlist(t, cert.Raw), 0o600); err != nil {
+		t.Fatalf("write profile: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "inspect", "--file", path, "--offline", "--output", "table"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	for _, want := range []string{"TEAM123456", "Apple Development: Offline", "unchecked", "get-task-allow", "00008030-AAAA"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected output to contain %q, got:\n%s", want, stdout)
+		}
+	}
+}
+
+func TestProfilesInspectValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name: "missing source",
+			args: []string{"profiles", "inspect"},
+		},
+		{
+			name:    "file and id",
+			args:    []string{"profiles", "inspect", "--file", "a.mobileprovision", "--id", "PROFILE_1"},
+			wantErr: "mutually exclusive",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if test.wantErr == "" {
+				if !errors.Is(runErr, flag.ErrHelp) {
+					t.Fatalf("expected flag.ErrHelp, got %v", runErr)
+				}
+				return
+			}
+			if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
+
+func newProfileTestCertificate(t *testing.T, commonName string, serial int64) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(365 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return cert
+}
+
+// buildProfilePlist returns an unsigned profile plist; the parser accepts
+// these as well as CMS-wrapped profiles.
+func buildProfilePlist(t *testing.T, certs ...[]byte) []byte {
+	t.Helper()
+	data, err := plist.Marshal(map[string]any{
+		"Name":                        "Demo Development",
+		"UUID":                        "11111111-2222-3333-4444-555555555555",
+		"TeamIdentifier":              []string{"TEAM123456"},
+		"TeamName":                    "Demo Team",
+		"ApplicationIdentifierPrefix": []string{"TEAM123456"},
+		"ExpirationDate":              time.Now().Add(30 * 24 * time.Hour),
+		"ProvisionedDevices":          []string{"00008030-AAAA"},
+		"DeveloperCertificates":       certs,
+		"Entitlements": map[string]any{
+			"application-identifier": "TEAM123456.com.example.demo",
+			"get-task-allow":         true,
+		},
+	}, plist.XMLFormat)
+	if err != nil {
+		t.Fatalf("marshal plist: %v", err)
+	}
+	return data
+}
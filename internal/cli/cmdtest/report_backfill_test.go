@@ -0,0 +1,158 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestAnalyticsSalesBackfillArchivesRange(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	archive := t.TempDir()
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var mu sync.Mutex
+	var requested []string
+	available := map[string]bool{"2024-01-20": true, "2024-01-22": true}
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/salesReports" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		date := req.URL.Query().Get("filter[reportDate]")
+		mu.Lock()
+		requested = append(requested, date)
+		ok := available[date]
+		mu.Unlock()
+		if !ok {
+			return jsonResponse(http.StatusNotFound, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"Report is not available yet."}]}`)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/a-gzip"}},
+			Body:       io.NopCloser(strings.NewReader("report-" + date)),
+		}, nil
+	})
+
+	args := []string{"analytics", "sales", "--vendor", "12345678", "--type", "SALES", "--subtype", "SUMMARY", "--frequency", "DAILY",
+		"--from", "2024-01-20", "--to", "2024-01-22", "--archive-dir", archive}
+
+	type backfillResult struct {
+		Downloaded  int `json:"downloaded"`
+		Skipped     int `json:"skipped"`
+		Unavailable int `json:"unavailable"`
+		Failed      int `json:"failed"`
+	}
+	run := func() backfillResult {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse(args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+		var result backfillResult
+		if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+			t.Fatalf("parse output %q: %v", stdout, err)
+		}
+		return result
+	}
+
+	if got := run(); got != (backfillResult{Downloaded: 2, Unavailable: 1}) {
+		t.Fatalf("unexpected first run result: %+v", got)
+	}
+	data, err := os.ReadFile(filepath.Join(archive, "sales", "12345678", "SALES_SUMMARY_DAILY_1_0", "2024-01-20.tsv.gz"))
+	if err != nil || string(data) != "report-2024-01-20" {
+		t.Fatalf("expected archived report, got %q (%v)", data, err)
+	}
+
+	var manifest struct {
+		Reports []struct {
+			Period   string `json:"period"`
+			Status   string `json:"status"`
+			Attempts int    `json:"attempts"`
+			SHA256   string `json:"sha256"`
+		} `json:"reports"`
+	}
+	manifestData, err := os.ReadFile(filepath.Join(archive, "manifest.json"))
+	if err != nil {
+		t.Fatalf("read manifest: %v", err)
+	}
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		t.Fatalf("parse manifest: %v", err)
+	}
+	if len(manifest.Reports) != 3 || manifest.Reports[1].Period != "2024-01-21" || manifest.Reports[1].Status != "unavailable" ||
+		manifest.Reports[0].Status != "downloaded" || manifest.Reports[0].SHA256 == "" {
+		t.Fatalf("unexpected manifest: %s", manifestData)
+	}
+
+	mu.Lock()
+	requested = nil
+	available["2024-01-21"] = true
+	mu.Unlock()
+
+	if got := run(); got != (backfillResult{Downloaded: 1, Skipped: 2}) {
+		t.Fatalf("unexpected second run result: %+v", got)
+	}
+	sort.Strings(requested)
+	if strings.Join(requested, ",") != "2024-01-21" {
+		t.Fatalf("expected only the unavailable period to be retried, got %v", requested)
+	}
+}
+
+func TestReportBackfillValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "sales date with range",
+			args:    []string{"analytics", "sales", "--vendor", "1", "--type", "SALES", "--subtype", "SUMMARY", "--frequency", "DAILY", "--date", "2024-01-01", "--from", "2024-01-01", "--to", "2024-01-02", "--archive-dir", "out"},
+			wantErr: "--date cannot be combined with --from/--to",
+		},
+		{
+			name:    "sales archive without range",
+			args:    []string{"analytics", "sales", "--vendor", "1", "--type", "SALES", "--subtype", "SUMMARY", "--frequency", "DAILY", "--date", "2024-01-01", "--archive-dir", "out"},
+			wantErr: "--archive-dir requires --from and --to",
+		},
+		{
+			name:    "finance output with range",
+			args:    []string{"finance", "reports", "--vendor", "1", "--report-type", "FINANCIAL", "--region", "US", "--from", "2025-01", "--to", "2025-02", "--archive-dir", "out", "--output", "x.tsv.gz"},
+			wantErr: "--output cannot be combined with --from/--to",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
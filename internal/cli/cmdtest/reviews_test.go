@@ -5,6 +5,8 @@ import (
 	"errors"
 	"flag"
 	"io"
+	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -29,3 +31,54 @@ func TestReviewsGetRequiresID(t *testing.T) {
 		t.Fatalf("expected stderr output")
 	}
 }
+
+func TestReviewsListPaginateStreamsNDJSON(t *testing.T) {
+	setupAuth(t)
+
+	const nextURL = "https://api.appstoreconnect.apple.com/v1/apps/app-1/customerReviews?cursor=AQ&limit=200"
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	requestCount := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requestCount++
+		switch requestCount {
+		case 1:
+			if req.URL.Path != "/v1/apps/app-1/customerReviews" {
+				t.Fatalf("unexpected first request: %s %s", req.Method, req.URL.String())
+			}
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"customerReviews","id":"review-1","attributes":{"rating":5}}],"links":{"next":"`+nextURL+`"}}`)
+		case 2:
+			if req.URL.String() != nextURL {
+				t.Fatalf("unexpected second request: %s %s", req.Method, req.URL.String())
+			}
+			return jsonResponse(http.StatusInternalServerError, `{"errors":[{"status":"500","title":"Server Error","detail":"page 2 failed"}]}`)
+		default:
+			t.Fatalf("unexpected extra request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"reviews", "list", "--app", "app-1", "--paginate", "--output", "ndjson"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "page 2 failed") {
+		t.Fatalf("expected second page failure, got %v", runErr)
+	}
+	// The first page is written before the second page is fetched.
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 1 || !strings.Contains(lines[0], `"id":"review-1"`) {
+		t.Fatalf("expected the first page to be streamed before the failure, got %q", stdout)
+	}
+}
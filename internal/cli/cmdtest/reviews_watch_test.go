@@ -0,0 +1,79 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestReviewsWatchValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("ASC_SLACK_WEBHOOK", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "reviews watch missing app",
+			args:    []string{"reviews", "watch"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "reviews watch notify slack missing webhook",
+			args:    []string{"reviews", "watch", "--app", "123", "--notify", "slack"},
+			wantErr: "--webhook is required for --notify slack",
+		},
+		{
+			name:    "reviews watch unknown notify target",
+			args:    []string{"reviews", "watch", "--app", "123", "--notify", "pager"},
+			wantErr: "--notify must be one of: slack",
+		},
+		{
+			name:    "reviews watch non-slack webhook",
+			args:    []string{"reviews", "watch", "--app", "123", "--notify", "slack", "--webhook", "https://example.com/hook"},
+			wantErr: "--webhook must target hooks.slack.com",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestReviewsWatchStarsValidation(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	if err := root.Parse([]string{"reviews", "watch", "--app", "123", "--min-stars", "4", "--max-stars", "2"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	err := root.Run(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "--min-stars cannot be greater than --max-stars") {
+		t.Fatalf("expected stars range error, got %v", err)
+	}
+}
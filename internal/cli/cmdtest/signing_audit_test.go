@@ -0,0 +1,101 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestSigningAuditWritesJUnitReport(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_NO_UPDATE", "1")
+
+	soon := time.Now().UTC().Add(5 * 24 * time.Hour).Format(time.RFC3339)
+	later := time.Now().UTC().Add(400 * 24 * time.Hour).Format(time.RFC3339)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		switch req.URL.Path {
+		case "/v1/certificates":
+			return jsonResponse(http.StatusOK, `{"data":[`+
+				`{"type":"certificates","id":"CERT_SOON","attributes":{"name":"Apple Distribution: Soon","certificateType":"DISTRIBUTION","expirationDate":"`+soon+`"}},`+
+				`{"type":"certificates","id":"CERT_OK","attributes":{"name":"Apple Distribution: OK","certificateType":"DISTRIBUTION","expirationDate":"`+later+`"}}`+
+				`],"links":{}}`)
+		case "/v1/profiles":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"profiles","id":"PROFILE_1","attributes":{"name":"Store","profileType":"IOS_APP_STORE","profileState":"INVALID","expirationDate":"`+later+`"}}],"links":{}}`)
+		case "/v1/bundleIds":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"bundleIds","id":"BUNDLE_1","attributes":{"name":"App","identifier":"com.example.app","platform":"IOS"}}],"links":{}}`)
+		case "/v1/devices":
+			return jsonResponse(http.StatusOK, `{"data":[],"links":{}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	reportPath := filepath.Join(t.TempDir(), "signing-audit.xml")
+	stdout, _ := captureOutput(t, func() {
+		code := cmd.Run([]string{"--report", "junit", "--report-file", reportPath, "signing", "audit", "--days", "30"}, "1.2.3")
+		if code != cmd.ExitError {
+			t.Fatalf("expected exit code %d for blocking issues, got %d", cmd.ExitError, code)
+		}
+	})
+	for _, want := range []string{"signing.certificate.expiring", "signing.profile.invalid", `"blocking":2`} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected output to contain %q, got %s", want, stdout)
+		}
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("read report: %v", err)
+	}
+	report := string(data)
+	for _, want := range []string{`tests="4"`, `failures="2"`, "Apple Distribution: Soon (CERT_SOON)", "signing.bundleId"} {
+		if !strings.Contains(report, want) {
+			t.Fatalf("expected report to contain %q, got:\n%s", want, report)
+		}
+	}
+}
+
+func TestSigningAuditValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "negative days", args: []string{"signing", "audit", "--days", "-1"}, wantErr: "--days must be 0 or greater"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
@@ -343,8 +343,8 @@ func TestSubscriptionsOfferCodesListOutputErrors(t *testing.T) {
 	}{
 		{
 			name:    "unsupported output",
-			args:    []string{"subscriptions", "offer-codes", "list", "--subscription-id", "sub-1", "--output", "yaml"},
-			wantErr: "unsupported format: yaml",
+			args:    []string{"subscriptions", "offer-codes", "list", "--subscription-id", "sub-1", "--output", "xml"},
+			wantErr: "unsupported format: xml",
 		},
 		{
 			name:    "pretty with markdown",
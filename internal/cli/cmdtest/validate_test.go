@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -26,6 +27,11 @@ type validateFixture struct {
 	ageRating        string
 	screenshotSets   map[string]string
 	screenshotsBySet map[string]string
+	iaps             string
+	iapsStatus       int
+	subGroups        string
+	// extra maps additional request paths to response bodies.
+	extra map[string]string
 }
 
 func newValidateTestClient(t *testing.T, fixture validateFixture) *asc.Client {
@@ -54,6 +60,13 @@ func newValidateTestClient(t *testing.T, fixture validateFixture) *asc.Client {
 			return jsonResponse(http.StatusOK, fixture.versionLocs)
 		case path == "/v1/appStoreVersions/ver-1/ageRatingDeclaration":
 			return jsonResponse(http.StatusOK, fixture.ageRating)
+		case path == "/v1/apps/app-1/inAppPurchasesV2":
+			if fixture.iapsStatus != 0 {
+				return jsonResponse(fixture.iapsStatus, fixture.iaps)
+			}
+			return jsonResponse(http.StatusOK, fixture.iaps)
+		case path == "/v1/apps/app-1/subscriptionGroups":
+			return jsonResponse(http.StatusOK, fixture.subGroups)
 		case strings.HasPrefix(path, "/v1/appStoreVersionLocalizations/") && strings.HasSuffix(path, "/appScreenshotSets"):
 			localizationID := strings.TrimSuffix(strings.TrimPrefix(path, "/v1/appStoreVersionLocalizations/"), "/appScreenshotSets")
 			if body, ok := fixture.screenshotSets[localizationID]; ok {
@@ -65,8 +78,11 @@ func newValidateTestClient(t *testing.T, fixture validateFixture) *asc.Client {
 				return jsonResponse(http.StatusOK, body)
 			}
 		}
+		if body, ok := fixture.extra[path]; ok {
+			return jsonResponse(http.StatusOK, body)
+		}
 
-		return jsonResponse(http.StatusNotFound, `{"errors":[{"status":404}]}`)
+		return jsonResponse(http.StatusNotFound, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist"}]}`)
 	})
 
 	httpClient := &http.Client{Transport: transport}
@@ -123,6 +139,8 @@ func validValidateFixture() validateFixture {
 		screenshotsBySet: map[string]string{
 			"set-1": `{"data":[{"type":"appScreenshots","id":"shot-1","attributes":{"fileName":"shot.png","fileSize":1024,"imageAsset":{"width":1242,"height":2688}}}]}`,
 		},
+		iaps:      `{"data":[]}`,
+		subGroups: `{"data":[]}`,
 	}
 }
 
@@ -276,3 +294,206 @@ func TestValidateMixedWarningAndError(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateMonetizationChecks(t *testing.T) {
+	fixture := validValidateFixture()
+	fixture.iaps = `{"data":[
+		{"type":"inAppPurchases","id":"iap-ready","attributes":{"name":"Gems","productId":"com.example.gems","inAppPurchaseType":"CONSUMABLE","state":"READY_TO_SUBMIT"}},
+		{"type":"inAppPurchases","id":"iap-attached","attributes":{"name":"Coins","productId":"com.example.coins","inAppPurchaseType":"CONSUMABLE","state":"READY_TO_SUBMIT"}},
+		{"type":"inAppPurchases","id":"iap-live","attributes":{"name":"Pro","productId":"com.example.pro","inAppPurchaseType":"NON_CONSUMABLE","state":"APPROVED"}}
+	]}`
+	fixture.subGroups = `{"data":[{"type":"subscriptionGroups","id":"group-1","attributes":{"referenceName":"Premium"}}]}`
+	fixture.extra = map[string]string{
+		"/v2/inAppPurchases/iap-ready/inAppPurchaseLocalizations":     `{"data":[{"type":"inAppPurchaseLocalizations","id":"iap-loc-1","attributes":{"name":"Gems","locale":"en-US"}}]}`,
+		"/v2/inAppPurchases/iap-ready/appStoreReviewScreenshot":       `{"data":{"type":"inAppPurchaseAppStoreReviewScreenshots","id":"iap-shot-1","attributes":{}}}`,
+		"/v2/inAppPurchases/iap-attached/inAppPurchaseLocalizations":  `{"data":[{"type":"inAppPurchaseLocalizations","id":"iap-loc-2","attributes":{"name":"Coins","locale":"en-US"}}]}`,
+		"/v2/inAppPurchases/iap-attached/appStoreReviewScreenshot":    `{"data":{"type":"inAppPurchaseAppStoreReviewScreenshots","id":"iap-shot-2","attributes":{}}}`,
+		"/v1/apps/app-1/reviewSubmissions":                            `{"data":[{"type":"reviewSubmissions","id":"rs-1","attributes":{"platform":"IOS","state":"READY_FOR_REVIEW"}}]}`,
+		"/v1/reviewSubmissions/rs-1/items":                            `{"data":[{"type":"reviewSubmissionItems","id":"item-1","relationships":{"inAppPurchaseV2":{"data":{"type":"inAppPurchases","id":"iap-attached"}}}}]}`,
+		"/v1/subscriptionGroups/group-1/subscriptions":                `{"data":[{"type":"subscriptions","id":"sub-1","attributes":{"name":"Monthly","productId":"com.example.monthly","state":"MISSING_METADATA"}}]}`,
+		"/v1/subscriptions/sub-1/subscriptionLocalizations":           `{"data":[]}`,
+		"/v1/subscriptions/sub-1/subscriptionAvailability":            `{"data":{"type":"subscriptionAvailabilities","id":"avail-1","attributes":{"availableInNewTerritories":false}}}`,
+		"/v1/subscriptionAvailabilities/avail-1/availableTerritories": `{"data":[{"type":"territories","id":"USA"},{"type":"territories","id":"GBR"}]}`,
+		"/v1/subscriptions/sub-1/prices":                              `{"data":[{"type":"subscriptionPrices","id":"price-1","attributes":{},"relationships":{"territory":{"data":{"type":"territories","id":"USA"}}}}]}`,
+	}
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if _, ok := errors.AsType[ReportedError](runErr); !ok {
+		t.Fatalf("expected ReportedError, got %v", runErr)
+	}
+
+	var report validation.Report
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	got := map[string]string{}
+	for _, check := range report.Checks {
+		got[check.ID] = check.ResourceID
+	}
+	want := map[string]string{
+		"monetization.iap.not_attached":               "iap-ready",
+		"monetization.subscription.missing_metadata":  "sub-1",
+		"monetization.subscription.review_screenshot": "sub-1",
+		"monetization.subscription.localizations":     "sub-1",
+		"monetization.subscription.prices":            "sub-1",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected checks %v, got %+v", want, report.Checks)
+	}
+	for id, resourceID := range want {
+		if got[id] != resourceID {
+			t.Fatalf("expected %s for %s, got %+v", id, resourceID, report.Checks)
+		}
+	}
+	if report.Summary.Errors != 3 || report.Summary.Warnings != 2 {
+		t.Fatalf("unexpected summary: %+v", report.Summary)
+	}
+}
+
+func TestValidateMonetizationFetchErrorIsWarning(t *testing.T) {
+	fixture := validValidateFixture()
+	fixture.iapsStatus = http.StatusForbidden
+	fixture.iaps = `{"errors":[{"status":"403","code":"FORBIDDEN_ERROR","title":"This request is forbidden for security reasons"}]}`
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("expected monetization fetch errors not to fail validate, got %v", runErr)
+	}
+
+	var report validation.Report
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if len(report.Checks) != 1 || report.Checks[0].ID != "monetization.unavailable" || report.Checks[0].Severity != validation.SeverityWarning {
+		t.Fatalf("expected a single monetization.unavailable warning, got %+v", report.Checks)
+	}
+}
+
+func TestValidateRulesFileAndSARIF(t *testing.T) {
+	rulesPath := filepath.Join(t.TempDir(), "validate.yaml")
+	rules := `
+custom:
+  - id: custom.banned_words
+    pattern: (?i)\bpromo\b
+    fields: [promotionalText]
+    severity: error
+suppressions:
+  - id: custom.banned_words
+    locale: fr-FR
+    justification: French copy is approved separately
+`
+	if err := os.WriteFile(rulesPath, []byte(rules), 0o600); err != nil {
+		t.Fatalf("write rules: %v", err)
+	}
+
+	fixture := validValidateFixture()
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1", "--rules", rulesPath, "--format", "sarif"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if _, ok := errors.AsType[ReportedError](runErr); !ok {
+		t.Fatalf("expected ReportedError, got %v", runErr)
+	}
+
+	var sarif struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Results []struct {
+				RuleID string `json:"ruleId"`
+				Level  string `json:"level"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &sarif); err != nil {
+		t.Fatalf("failed to parse SARIF output: %v\n%s", err, stdout)
+	}
+	if sarif.Version != "2.1.0" || len(sarif.Runs) != 1 || len(sarif.Runs[0].Results) != 1 {
+		t.Fatalf("unexpected SARIF output: %s", stdout)
+	}
+	if result := sarif.Runs[0].Results[0]; result.RuleID != "custom.banned_words" || result.Level != "error" {
+		t.Fatalf("unexpected SARIF result: %+v", result)
+	}
+}
+
+func TestValidateRejectsInvalidRulesAndFormat(t *testing.T) {
+	rulesPath := filepath.Join(t.TempDir(), "validate.yaml")
+	if err := os.WriteFile(rulesPath, []byte("suppressions:\n  - id: metadata.length.keywords\n"), 0o600); err != nil {
+		t.Fatalf("write rules: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "unsupported format",
+			args:    []string{"validate", "--app", "app-1", "--version-id", "ver-1", "--format", "xml"},
+			wantErr: `unsupported --format "xml"`,
+		},
+		{
+			name:    "invalid rules",
+			args:    []string{"validate", "--app", "app-1", "--version-id", "ver-1", "--rules", rulesPath},
+			wantErr: "needs a justification",
+		},
+		{
+			name:    "missing rules file",
+			args:    []string{"validate", "--app", "app-1", "--version-id", "ver-1", "--rules", filepath.Join(t.TempDir(), "missing.yaml")},
+			wantErr: "read rules",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, _ = captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
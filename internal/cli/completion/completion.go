@@ -11,6 +11,7 @@ import (
 	"github.com/peterbourgon/ff/v3/ffcli"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/openapi"
 )
 
 // CompletionCommand prints shell completion scripts to stdout.
@@ -82,8 +83,26 @@ func rootCommandNames(rootSubcommands []*ffcli.Command) []string {
 	return names
 }
 
+// apiMethods are the HTTP methods completed after `asc api`.
+var apiMethods = []string{"GET", "POST", "PATCH", "DELETE"}
+
+// apiPathsByMethod returns OpenAPI snapshot path templates for each method,
+// used to complete `asc api <METHOD> <path>`.
+func apiPathsByMethod() map[string][]string {
+	paths := make(map[string][]string, len(apiMethods))
+	for _, op := range openapi.Operations() {
+		paths[op.Method] = append(paths[op.Method], op.Path)
+	}
+	return paths
+}
+
 func bashScript(subcommands []string) string {
 	words := strings.Join(subcommands, " ")
+	paths := apiPathsByMethod()
+	var cases strings.Builder
+	for _, method := range apiMethods {
+		fmt.Fprintf(&cases, "        %s|%s) COMPREPLY=( $(compgen -W \"%s\" -- \"$cur\") ) ;;\n", method, strings.ToLower(method), strings.Join(paths[method], " "))
+	}
 	return fmt.Sprintf(`# bash completion for asc
 _asc_completions() {
   local cur
@@ -94,26 +113,63 @@ _asc_completions() {
     COMPREPLY=( $(compgen -W "%s" -- "$cur") )
     return 0
   fi
+
+  if [[ "${COMP_WORDS[1]}" == "api" ]]; then
+    if [[ $COMP_CWORD -eq 2 ]]; then
+      COMPREPLY=( $(compgen -W "%s" -- "$cur") )
+    elif [[ $COMP_CWORD -eq 3 ]]; then
+      case "${COMP_WORDS[2]}" in
+%s      esac
+    fi
+    return 0
+  fi
 }
 
 complete -F _asc_completions asc
-`, words)
+`, words, strings.Join(apiMethods, " "), cases.String())
 }
 
 func zshScript(subcommands []string) string {
 	// zsh _arguments wants a space-separated list inside ((...))
 	words := strings.Join(subcommands, " ")
+	paths := apiPathsByMethod()
+	var cases strings.Builder
+	for _, method := range apiMethods {
+		fmt.Fprintf(&cases, "          %s|%s) compadd -Q -- %s ;;\n", method, strings.ToLower(method), strings.Join(paths[method], " "))
+	}
 	return fmt.Sprintf(`#compdef asc
 
+local state
 _arguments \
   '1:command:(%s)' \
   '*::arg:->args'
-`, words)
+
+if [[ $state == args && $words[1] == api ]]; then
+  case $CURRENT in
+    2) compadd -- %s ;;
+    3)
+      case $words[2] in
+%s      esac
+      ;;
+  esac
+fi
+`, words, strings.Join(apiMethods, " "), cases.String())
 }
 
 func fishScript(subcommands []string) string {
 	words := strings.Join(subcommands, " ")
+	paths := apiPathsByMethod()
+	var lines strings.Builder
+	for _, method := range apiMethods {
+		fmt.Fprintf(&lines, "complete -c asc -f -n '__asc_api_path %s' -a '%s'\n", method, strings.Join(paths[method], " "))
+	}
 	return fmt.Sprintf(`# fish completion for asc
-complete -c asc -f -a '%s'
-`, words)
+function __asc_api_path
+  set -l tokens (commandline -opc)
+  test (count $tokens) -eq 3; and test "$tokens[2]" = api; and test (string upper -- $tokens[3]) = $argv[1]
+end
+
+complete -c asc -f -n '__fish_use_subcommand' -a '%s'
+complete -c asc -f -n '__fish_seen_subcommand_from api; and test (count (commandline -opc)) -eq 2' -a '%s'
+%s`, words, strings.Join(apiMethods, " "), lines.String())
 }
@@ -80,6 +80,22 @@ func TestCompletionScriptHelpers(t *testing.T) {
 	}
 }
 
+func TestCompletionScriptsIncludeAPIPaths(t *testing.T) {
+	scripts := map[string]string{
+		"bash": bashScript([]string{"api"}),
+		"zsh":  zshScript([]string{"api"}),
+		"fish": fishScript([]string{"api"}),
+	}
+	for shell, script := range scripts {
+		if !strings.Contains(script, "/v1/apps/{id}/builds") {
+			t.Fatalf("%s script missing API path completions", shell)
+		}
+		if !strings.Contains(script, "PATCH") {
+			t.Fatalf("%s script missing API method completions", shell)
+		}
+	}
+}
+
 func captureStdout(t *testing.T, fn func() error) string {
 	t.Helper()
 
@@ -18,7 +18,7 @@ func CrashesCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("crashes", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	deviceModel := fs.String("device-model", "", "Filter by device model(s), comma-separated")
 	osVersion := fs.String("os-version", "", "Filter by OS version(s), comma-separated")
@@ -34,12 +34,14 @@ func CrashesCommand() *ffcli.Command {
 
 	return &ffcli.Command{
 		Name:       "crashes",
-		ShortUsage: "asc crashes [flags]",
+		ShortUsage: "asc crashes [flags] | asc crashes <subcommand> [flags]",
 		ShortHelp:  "List and export TestFlight crash reports.",
 		LongHelp: `List and export TestFlight crash reports.
 
 This command fetches crash reports submitted by TestFlight beta testers,
-helping you identify and fix issues in your app.
+helping you identify and fix issues in your app. Use the symbolicate
+subcommand to resolve crash logs against your dSYMs, and triage to group
+recent crashes by signature.
 
 Examples:
   asc crashes --app "123456789"
@@ -47,9 +49,15 @@ Examples:
   asc crashes --app "123456789" --device-model "iPhone15,3" --os-version "17.2"
   asc crashes --app "123456789" --sort -createdDate --limit 5
   asc crashes --next "<links.next>"
-  asc crashes --app "123456789" --paginate`,
+  asc crashes --app "123456789" --paginate
+  asc crashes symbolicate --dsym MyApp.app.dSYM --id "CRASH_ID"
+  asc crashes triage --app "123456789" --since 7d`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			CrashesSymbolicateCommand(),
+			CrashesTriageCommand(),
+		},
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
 				return fmt.Errorf("crashes: --limit must be between 1 and 200")
@@ -99,7 +107,7 @@ Examples:
 				}
 
 				// Fetch all remaining pages
-				crashes, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				crashes, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetCrashes(ctx, resolvedAppID, asc.WithCrashNextURL(nextURL))
 				})
 				if err != nil {
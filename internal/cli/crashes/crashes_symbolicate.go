@@ -0,0 +1,169 @@
+package crashes
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/crashlog"
+)
+
+// CrashesSymbolicateCommand returns the crashes symbolicate subcommand.
+func CrashesSymbolicateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("crashes symbolicate", flag.ExitOnError)
+
+	dsym := fs.String("dsym", "", "dSYM bundle(s), directories of dSYMs, or Mach-O files, comma-separated")
+	id := fs.String("id", "", "Crash submission ID to fetch the crash log for")
+	file := fs.String("file", "", "Crash report file (.ips or text), or - for stdin")
+	out := fs.String("out", "", "Write the symbolicated crash report to this path")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "symbolicate",
+		ShortUsage: "asc crashes symbolicate --dsym PATH [--id CRASH_ID | --file PATH] [flags]",
+		ShortHelp:  "Symbolicate a crash log offline with dSYMs.",
+		LongHelp: `Symbolicate a crash log offline with dSYMs.
+
+Reads .ips (JSON) and legacy text crash reports, matches binary images to
+dSYMs by UUID, and resolves addresses to function, file, and line from the
+DWARF debug information. No Xcode tools are needed, so this runs on Linux.
+
+Frames of images without a matching dSYM keep the symbols already in the
+report; images that have none are listed under "missing".
+
+Examples:
+  asc crashes symbolicate --dsym MyApp.app.dSYM --file crash.ips
+  asc crashes symbolicate --dsym MyApp.app.dSYM --id "CRASH_ID" --output table
+  asc crashes symbolicate --dsym ./dSYMs --file crash.ips --out crash-symbolicated.ips
+  asc crashes symbolicate --dsym "MyApp.app.dSYM,MyKit.framework.dSYM" --file - < crash.crash`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			dsymPaths := shared.SplitCSV(*dsym)
+			if len(dsymPaths) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --dsym is required")
+				return flag.ErrHelp
+			}
+			crashID := strings.TrimSpace(*id)
+			filePath := strings.TrimSpace(*file)
+			if crashID == "" && filePath == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id or --file is required")
+				return flag.ErrHelp
+			}
+			if crashID != "" && filePath != "" {
+				fmt.Fprintln(os.Stderr, "Error: --id and --file are mutually exclusive")
+				return flag.ErrHelp
+			}
+
+			symbols, err := crashlog.LoadSymbols(dsymPaths...)
+			if err != nil {
+				return fmt.Errorf("crashes symbolicate: load dSYMs: %w", err)
+			}
+
+			var data []byte
+			source := filePath
+			if crashID != "" {
+				source = crashID
+				data, err = fetchCrashLog(ctx, crashID)
+			} else {
+				data, err = readCrashLogFile(filePath)
+			}
+			if err != nil {
+				return fmt.Errorf("crashes symbolicate: %w", err)
+			}
+
+			report, err := crashlog.Parse(data)
+			if err != nil {
+				return fmt.Errorf("crashes symbolicate: %w", err)
+			}
+			result := buildSymbolicationResult(source, report, crashlog.Symbolicate(report, symbols))
+
+			if outPath := strings.TrimSpace(*out); outPath != "" {
+				rendered, err := report.Render()
+				if err != nil {
+					return fmt.Errorf("crashes symbolicate: %w", err)
+				}
+				if _, err := shared.WriteStreamToFile(outPath, bytes.NewReader(rendered)); err != nil {
+					return fmt.Errorf("crashes symbolicate: write --out: %w", err)
+				}
+				result.OutputFile = outPath
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+func fetchCrashLog(ctx context.Context, crashID string) ([]byte, error) {
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return nil, err
+	}
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	resp, err := client.GetBetaFeedbackCrashSubmissionCrashLog(requestCtx, crashID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch crash log: %w", err)
+	}
+	if strings.TrimSpace(resp.Data.Attributes.LogText) == "" {
+		return nil, fmt.Errorf("crash %s has no crash log", crashID)
+	}
+	return []byte(resp.Data.Attributes.LogText), nil
+}
+
+func readCrashLogFile(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+func buildSymbolicationResult(source string, report *crashlog.Report, summary crashlog.Result) *asc.CrashSymbolicationResult {
+	result := &asc.CrashSymbolicationResult{
+		Source:       source,
+		Format:       string(report.Format),
+		Symbolicated: summary.Resolved,
+		Frames:       []asc.CrashSymbolicationFrame{},
+	}
+	for _, image := range summary.Missing {
+		result.Missing = append(result.Missing, asc.CrashSymbolicationImage{Name: image.Name, UUID: image.UUID})
+	}
+	for _, thread := range report.Threads {
+		label := thread.Name
+		if thread.Number >= 0 {
+			label = strconv.Itoa(thread.Number)
+		}
+		for _, frame := range thread.Frames {
+			item := asc.CrashSymbolicationFrame{
+				Thread:  label,
+				Crashed: thread.Crashed,
+				Frame:   frame.Index,
+				Address: fmt.Sprintf("0x%016x", frame.Address),
+				Symbol:  frame.Symbol,
+			}
+			if image := report.Image(frame); image != nil {
+				item.Image = image.Name
+			}
+			if frame.Location != nil {
+				item.Symbol = frame.Location.Function
+				item.Offset = frame.Location.Offset
+				item.File = frame.Location.File
+				item.Line = frame.Location.Line
+				item.Symbolicated = true
+			}
+			result.Frames = append(result.Frames, item)
+		}
+	}
+	return result
+}
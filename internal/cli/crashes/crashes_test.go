@@ -2,9 +2,19 @@ package crashes
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"flag"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/crashlog"
 )
 
 func TestCrashesCommand_MissingApp(t *testing.T) {
@@ -48,3 +58,303 @@ func TestCommandWrapper(t *testing.T) {
 		t.Fatal("expected Command wrapper to return a command")
 	}
 }
+
+func TestCrashesSymbolicateCommand_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "missing dsym", args: []string{"--file", "crash.ips"}},
+		{name: "missing source", args: []string{"--dsym", "App.dSYM"}},
+		{name: "id and file", args: []string{"--dsym", "App.dSYM", "--id", "CRASH", "--file", "crash.ips"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := CrashesSymbolicateCommand()
+			if err := cmd.FlagSet.Parse(test.args); err != nil {
+				t.Fatalf("failed to parse flags: %v", err)
+			}
+			if err := cmd.Exec(context.Background(), nil); !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected flag.ErrHelp, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCrashesSymbolicateCommand_MissingDSYM(t *testing.T) {
+	cmd := CrashesSymbolicateCommand()
+	args := []string{"--dsym", filepath.Join(t.TempDir(), "Missing.dSYM"), "--file", "crash.ips"}
+	if err := cmd.FlagSet.Parse(args); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+	err := cmd.Exec(context.Background(), nil)
+	if err == nil || !strings.Contains(err.Error(), "crashes symbolicate: load dSYMs") {
+		t.Fatalf("expected dSYM load error, got %v", err)
+	}
+}
+
+func TestBuildSymbolicationResult(t *testing.T) {
+	report, err := crashlog.Parse([]byte(`Thread 0 Crashed:
+0   Demo                          	0x0000000100a8f2c8 0x100a8c000 + 13000
+1   libdyld.dylib                 	0x00000001a1001000 start + 4
+
+Binary Images:
+       0x100a8c000 -        0x100a93fff Demo arm64  <b8f392e4a5ae3cf0936e437531307ff7> /private/var/Demo.app/Demo
+`))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	report.Threads[0].Frames[0].Location = &crashlog.Location{Function: "Demo.crash()", Offset: 20, File: "/src/Crash.swift", Line: 12}
+
+	result := buildSymbolicationResult("crash.crash", report, crashlog.Result{
+		Resolved: 1,
+		Missing:  []crashlog.Image{{Name: "Other", UUID: "ABC"}},
+	})
+	if result.Format != "text" || result.Symbolicated != 1 || len(result.Missing) != 1 || result.Missing[0].Name != "Other" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	want := []asc.CrashSymbolicationFrame{
+		{Thread: "0", Crashed: true, Frame: 0, Image: "Demo", Address: "0x0000000100a8f2c8", Symbol: "Demo.crash()", Offset: 20, File: "/src/Crash.swift", Line: 12, Symbolicated: true},
+		{Thread: "0", Crashed: true, Frame: 1, Address: "0x00000001a1001000", Symbol: "start"},
+	}
+	if !reflect.DeepEqual(result.Frames, want) {
+		t.Fatalf("unexpected frames:\n got %+v\nwant %+v", result.Frames, want)
+	}
+}
+
+func TestCrashesTriageCommand_Validation(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	cmd := CrashesTriageCommand()
+	if err := cmd.FlagSet.Parse([]string{}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+	if err := cmd.Exec(context.Background(), nil); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected flag.ErrHelp, got %v", err)
+	}
+
+	for _, args := range [][]string{
+		{"--app", "123", "--group-by", "device"},
+		{"--app", "123", "--frames", "0"},
+		{"--app", "123", "--concurrency", "0"},
+		{"--app", "123", "--since", "soon"},
+	} {
+		cmd := CrashesTriageCommand()
+		if err := cmd.FlagSet.Parse(args); err != nil {
+			t.Fatalf("failed to parse flags: %v", err)
+		}
+		err := cmd.Exec(context.Background(), nil)
+		if err == nil || errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected validation error for %v, got %v", args, err)
+		}
+	}
+}
+
+type stubCrashTriageClient struct {
+	pages       []*asc.CrashesResponse
+	logs        map[string]string
+	appVersions map[string]string
+
+	mu       sync.Mutex
+	requests int
+	fetched  []string
+}
+
+func (c *stubCrashTriageClient) GetCrashes(ctx context.Context, appID string, opts ...asc.CrashOption) (*asc.CrashesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.requests >= len(c.pages) {
+		return nil, fmt.Errorf("unexpected request %d", c.requests)
+	}
+	page := c.pages[c.requests]
+	c.requests++
+	return page, nil
+}
+
+func (c *stubCrashTriageClient) GetBetaFeedbackCrashSubmissionCrashLog(ctx context.Context, submissionID string) (*asc.BetaCrashLogResponse, error) {
+	c.mu.Lock()
+	c.fetched = append(c.fetched, submissionID)
+	c.mu.Unlock()
+	logText, ok := c.logs[submissionID]
+	if !ok {
+		return nil, asc.ErrNotFound
+	}
+	resp := &asc.BetaCrashLogResponse{}
+	resp.Data.Attributes.LogText = logText
+	return resp, nil
+}
+
+func (c *stubCrashTriageClient) GetBuildPreReleaseVersion(ctx context.Context, buildID string) (*asc.PreReleaseVersionResponse, error) {
+	version, ok := c.appVersions[buildID]
+	if !ok {
+		return nil, asc.ErrNotFound
+	}
+	resp := &asc.PreReleaseVersionResponse{}
+	resp.Data.Attributes.Version = version
+	return resp, nil
+}
+
+func triageCrashLog(symbol string) string {
+	return `Exception Type:  EXC_CRASH (SIGABRT)
+
+Thread 0 Crashed:
+0   libsystem_kernel.dylib        	0x00000001a1001000 __pthread_kill + 8
+1   Demo                          	0x0000000100a8f2c8 ` + symbol + ` + 20
+
+Binary Images:
+       0x100a8c000 -        0x100a93fff Demo arm64  <b8f392e4a5ae3cf0936e437531307ff7> /private/var/Demo.app/Demo
+       0x1a1000000 -        0x1a100ffff libsystem_kernel.dylib arm64e  <11111111222233334444555555555555> /usr/lib/system/libsystem_kernel.dylib
+`
+}
+
+func triageCrash(id, created, buildID, osVersion string) asc.Resource[asc.CrashAttributes] {
+	return asc.Resource[asc.CrashAttributes]{
+		ID:            id,
+		Attributes:    asc.CrashAttributes{CreatedDate: created, OSVersion: osVersion, DeviceModel: "iPhone15,3", Email: id + "@example.com"},
+		Relationships: json.RawMessage(`{"build":{"data":{"type":"builds","id":"` + buildID + `"}}}`),
+	}
+}
+
+func TestCrashTriagerRun(t *testing.T) {
+	embedded := triageCrash("c3", "2026-03-04T12:00:00Z", "b2", "17.3")
+	embedded.Attributes.CrashLog = triageCrashLog("Demo.crashB()")
+
+	client := &stubCrashTriageClient{
+		pages: []*asc.CrashesResponse{
+			{
+				Data: []asc.Resource[asc.CrashAttributes]{
+					triageCrash("c1", "2026-03-05T00:00:00Z", "b2", "17.2"),
+					triageCrash("c2", "2026-03-04T00:00:00Z", "b1", "17.2"),
+					embedded,
+				},
+				Included: json.RawMessage(`[
+					{"type":"builds","id":"b1","attributes":{"version":"1","uploadedDate":"2026-03-01T00:00:00Z"}},
+					{"type":"builds","id":"b2","attributes":{"version":"2","uploadedDate":"2026-03-03T00:00:00Z"}}
+				]`),
+				Links: asc.Links{Next: "https://api.appstoreconnect.apple.com/v1/apps/123/betaFeedbackCrashSubmissions?cursor=2"},
+			},
+			{
+				Data: []asc.Resource[asc.CrashAttributes]{
+					triageCrash("c4", "2026-03-04T10:00:00Z", "b2", "17.3"),
+					triageCrash("c5", "2026-02-20T00:00:00Z", "b1", "17.2"),
+					triageCrash("c6", "2026-02-19T00:00:00Z", "b1", "17.2"),
+				},
+				Links: asc.Links{Next: "https://api.appstoreconnect.apple.com/v1/apps/123/betaFeedbackCrashSubmissions?cursor=3"},
+			},
+		},
+		logs: map[string]string{
+			"c1": triageCrashLog("Demo.crashA()"),
+			"c2": triageCrashLog("Demo.crashA()"),
+		},
+	}
+
+	triager := &crashTriager{
+		client:      client,
+		appID:       "123",
+		cutoff:      time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		groupBy:     triageGroupByFrames,
+		frames:      1,
+		concurrency: 2,
+	}
+	result, err := triager.run(context.Background())
+	if err != nil {
+		t.Fatalf("run() error: %v", err)
+	}
+
+	if client.requests != 2 {
+		t.Fatalf("expected paging to stop at the cutoff after 2 requests, got %d", client.requests)
+	}
+	if len(client.fetched) != 3 {
+		t.Fatalf("expected logs to be fetched only for crashes without one, got %v", client.fetched)
+	}
+	if result.Total != 4 || result.LatestBuild != "2" || result.PreviousBuild != "1" {
+		t.Fatalf("unexpected result summary: %+v", result)
+	}
+
+	var signatures []string
+	for _, group := range result.Groups {
+		signatures = append(signatures, group.Signature)
+	}
+	wantSignatures := []string{"Demo`Demo.crashA()", "Demo`Demo.crashB()", "(no crash log)"}
+	if !reflect.DeepEqual(signatures, wantSignatures) {
+		t.Fatalf("unexpected signatures: %v", signatures)
+	}
+
+	known := result.Groups[0]
+	if known.Count != 2 || known.New || known.Exception != "EXC_CRASH (SIGABRT)" || known.FirstSeen != "2026-03-04T00:00:00Z" || known.LastSeen != "2026-03-05T00:00:00Z" {
+		t.Fatalf("unexpected known group: %+v", known)
+	}
+	if want := []asc.TriageCount{{Value: "1", Count: 1}, {Value: "2", Count: 1}}; !reflect.DeepEqual(known.Builds, want) {
+		t.Fatalf("unexpected builds: %+v", known.Builds)
+	}
+	if want := []string{"c1@example.com", "c2@example.com"}; !reflect.DeepEqual(known.Testers, want) {
+		t.Fatalf("unexpected testers: %v", known.Testers)
+	}
+	if !result.Groups[1].New || !reflect.DeepEqual(result.Groups[1].CrashIDs, []string{"c3"}) {
+		t.Fatalf("expected the crash only seen in the latest build to be new: %+v", result.Groups[1])
+	}
+}
+
+func TestCrashTriagerRun_BuildsSharingANumber(t *testing.T) {
+	client := &stubCrashTriageClient{
+		pages: []*asc.CrashesResponse{{
+			Data: []asc.Resource[asc.CrashAttributes]{
+				triageCrash("c1", "2026-03-05T00:00:00Z", "b2", "17.2"),
+				triageCrash("c2", "2026-03-04T00:00:00Z", "b1", "17.2"),
+				triageCrash("c3", "2026-03-04T12:00:00Z", "b2", "17.2"),
+			},
+			Included: json.RawMessage(`[
+				{"type":"builds","id":"b1","attributes":{"version":"5","uploadedDate":"2026-03-01T00:00:00Z"}},
+				{"type":"builds","id":"b2","attributes":{"version":"5","uploadedDate":"2026-03-03T00:00:00Z"}}
+			]`),
+		}},
+		logs: map[string]string{
+			"c1": triageCrashLog("Demo.crashA()"),
+			"c2": triageCrashLog("Demo.crashA()"),
+			"c3": triageCrashLog("Demo.crashB()"),
+		},
+		appVersions: map[string]string{"b1": "1.2", "b2": "1.3"},
+	}
+
+	triager := &crashTriager{
+		client:      client,
+		appID:       "123",
+		cutoff:      time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		groupBy:     triageGroupByFrames,
+		frames:      1,
+		concurrency: 1,
+	}
+	result, err := triager.run(context.Background())
+	if err != nil {
+		t.Fatalf("run() error: %v", err)
+	}
+
+	if result.LatestBuild != "1.3 (5)" || result.PreviousBuild != "1.2 (5)" {
+		t.Fatalf("unexpected latest/previous builds: %q %q", result.LatestBuild, result.PreviousBuild)
+	}
+	known := result.Groups[0]
+	if known.New {
+		t.Fatalf("expected a crash seen in both builds not to be new: %+v", known)
+	}
+	if want := []asc.TriageCount{{Value: "1.2 (5)", Count: 1}, {Value: "1.3 (5)", Count: 1}}; !reflect.DeepEqual(known.Builds, want) {
+		t.Fatalf("unexpected builds: %+v", known.Builds)
+	}
+	if !result.Groups[1].New {
+		t.Fatalf("expected the crash only seen in the latest build to be new: %+v", result.Groups[1])
+	}
+}
+
+func TestCrashTriagerSignature_GroupByException(t *testing.T) {
+	triager := &crashTriager{groupBy: triageGroupByException, frames: 3}
+
+	signature, exception, frames := triager.signature(triageCrashLog("Demo.crashA()"))
+	if signature != "EXC_CRASH (SIGABRT)" || exception != signature {
+		t.Fatalf("unexpected exception signature %q (%q)", signature, exception)
+	}
+	if !reflect.DeepEqual(frames, []string{"Demo`Demo.crashA()"}) {
+		t.Fatalf("unexpected frames: %v", frames)
+	}
+	if signature, _, _ := triager.signature("not a crash log"); signature != "(unreadable crash log)" {
+		t.Fatalf("unexpected signature for unreadable log: %q", signature)
+	}
+}
@@ -0,0 +1,328 @@
+package crashes
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/crashlog"
+)
+
+const (
+	triageGroupByFrames    = "frames"
+	triageGroupByException = "exception"
+)
+
+type crashSubmission = asc.Resource[asc.CrashAttributes]
+
+type crashTriageClient interface {
+	GetCrashes(ctx context.Context, appID string, opts ...asc.CrashOption) (*asc.CrashesResponse, error)
+	GetBetaFeedbackCrashSubmissionCrashLog(ctx context.Context, submissionID string) (*asc.BetaCrashLogResponse, error)
+	GetBuildPreReleaseVersion(ctx context.Context, buildID string) (*asc.PreReleaseVersionResponse, error)
+}
+
+// CrashesTriageCommand returns the crashes triage subcommand.
+func CrashesTriageCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("crashes triage", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	since := fs.String("since", "7d", "Only include crashes since this duration (7d, 2w, 24h), date, or RFC 3339 timestamp")
+	groupBy := fs.String("group-by", triageGroupByFrames, "Group by: frames (top frames of the crashing thread) or exception (exception type)")
+	frames := fs.Int("frames", 3, "Number of top frames in a frames signature")
+	buildID := fs.String("build", "", "Filter by build ID(s), comma-separated")
+	dsym := fs.String("dsym", "", "dSYM bundle(s) or directories to symbolicate app frames with, comma-separated")
+	concurrency := fs.Int("concurrency", 4, "Number of crash logs to fetch in parallel")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "triage",
+		ShortUsage: "asc crashes triage --app APP_ID [--since 7d] [flags]",
+		ShortHelp:  "Group recent crashes by signature.",
+		LongHelp: `Group recent crashes by signature.
+
+Fetches every crash since --since along with its crash log, and groups them
+by the normalized top frames of the crashing thread (or the last exception
+backtrace), or by exception type. Leading frames in system libraries common
+to most crashes, such as abort and pthread_kill, are skipped.
+
+Each group lists counts per build (as "1.2 (5)"), OS version, and device
+model, and the affected testers. A group is marked new when it has crashes
+from the latest build but none from the build before it within the same
+window. Builds are told apart by ID, since build numbers repeat across app
+versions.
+
+App frames are only stable across builds once symbolicated, so pass --dsym
+with the dSYMs of the builds in the window for the best grouping.
+
+Examples:
+  asc crashes triage --app "123456789"
+  asc crashes triage --app "123456789" --since 14d --output table
+  asc crashes triage --app "123456789" --group-by exception
+  asc crashes triage --app "123456789" --dsym ./dSYMs --frames 5`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
+				return flag.ErrHelp
+			}
+			grouping := strings.ToLower(strings.TrimSpace(*groupBy))
+			if grouping != triageGroupByFrames && grouping != triageGroupByException {
+				return fmt.Errorf("crashes triage: --group-by must be frames or exception")
+			}
+			if *frames < 1 {
+				return fmt.Errorf("crashes triage: --frames must be at least 1")
+			}
+			if *concurrency < 1 {
+				return fmt.Errorf("crashes triage: --concurrency must be at least 1")
+			}
+			cutoff, err := shared.ParseSince(*since, time.Now())
+			if err != nil {
+				return fmt.Errorf("crashes triage: %w", err)
+			}
+
+			var symbols *crashlog.Symbols
+			if paths := shared.SplitCSV(*dsym); len(paths) > 0 {
+				symbols, err = crashlog.LoadSymbols(paths...)
+				if err != nil {
+					return fmt.Errorf("crashes triage: load dSYMs: %w", err)
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("crashes triage: %w", err)
+			}
+
+			triager := &crashTriager{
+				client:      client,
+				appID:       resolvedAppID,
+				cutoff:      cutoff,
+				buildIDs:    shared.SplitCSV(*buildID),
+				groupBy:     grouping,
+				frames:      *frames,
+				symbols:     symbols,
+				concurrency: *concurrency,
+			}
+			result, err := triager.run(ctx)
+			if err != nil {
+				return fmt.Errorf("crashes triage: %w", err)
+			}
+			result.Since = cutoff.UTC().Format(time.RFC3339)
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+type crashTriager struct {
+	client      crashTriageClient
+	appID       string
+	cutoff      time.Time
+	buildIDs    []string
+	groupBy     string
+	frames      int
+	symbols     *crashlog.Symbols
+	concurrency int
+}
+
+func (t *crashTriager) run(ctx context.Context) (*asc.CrashTriageResult, error) {
+	crashes, builds, err := shared.FetchCrashesSince(ctx, t.client, t.appID, t.cutoff, t.buildIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := shared.ResolveBuildAppVersions(ctx, t.client, builds); err != nil {
+		return nil, err
+	}
+	logs, err := t.fetchLogs(ctx, crashes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &asc.CrashTriageResult{AppID: t.appID, GroupBy: t.groupBy, Total: len(crashes), Groups: []asc.CrashTriageGroup{}}
+	latest, previous := latestBuilds(crashes, builds)
+	if latest.ID != "" {
+		result.LatestBuild = latest.Label()
+	}
+	if previous.ID != "" {
+		result.PreviousBuild = previous.Label()
+	}
+
+	type group struct {
+		group asc.CrashTriageGroup
+		tally *shared.TriageTally
+	}
+	groups := map[string]*group{}
+	var order []string
+	for i, crash := range crashes {
+		signature, exception, frames := t.signature(logs[i])
+		entry, ok := groups[signature]
+		if !ok {
+			entry = &group{
+				group: asc.CrashTriageGroup{Signature: signature, Exception: exception, Frames: frames},
+				tally: shared.NewTriageTally(),
+			}
+			groups[signature] = entry
+			order = append(order, signature)
+		}
+		entry.tally.Add(shared.TriageSubmission{
+			ID:          crash.ID,
+			CreatedDate: crash.Attributes.CreatedDate,
+			Build:       shared.SubmissionBuild(builds, crash.Relationships),
+			OSVersion:   crash.Attributes.OSVersion,
+			DeviceModel: crash.Attributes.DeviceModel,
+			Tester:      crash.Attributes.Email,
+		})
+	}
+
+	entries := make([]*group, 0, len(order))
+	for _, signature := range order {
+		entry := groups[signature]
+		tally := entry.tally
+		entry.group.Count = tally.Count
+		entry.group.FirstSeen = tally.FirstSeen
+		entry.group.LastSeen = tally.LastSeen
+		entry.group.Builds = tally.Builds()
+		entry.group.OSVersions = tally.OSVersions()
+		entry.group.DeviceModels = tally.DeviceModels()
+		entry.group.Testers = tally.Testers()
+		entry.group.CrashIDs = tally.IDs
+		entry.group.New = previous.ID != "" && tally.HasBuild(latest.ID) && !tally.HasBuild(previous.ID)
+		entries = append(entries, entry)
+	}
+	slices.SortStableFunc(entries, func(a, b *group) int {
+		if a.tally.Count != b.tally.Count {
+			return b.tally.Count - a.tally.Count
+		}
+		return b.tally.Last().Compare(a.tally.Last())
+	})
+	for _, entry := range entries {
+		result.Groups = append(result.Groups, entry.group)
+	}
+	return result, nil
+}
+
+// fetchLogs returns the crash log text of each crash, fetching the ones not
+// embedded in the submission in parallel.
+func (t *crashTriager) fetchLogs(ctx context.Context, crashes []crashSubmission) ([]string, error) {
+	logs := make([]string, len(crashes))
+	if len(crashes) == 0 {
+		return logs, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := make(chan struct{}, max(min(len(crashes), t.concurrency), 1))
+	errs := make(chan error, len(crashes))
+	var once sync.Once
+	var wg sync.WaitGroup
+
+	for idx := range crashes {
+		if crashes[idx].Attributes.CrashLog != "" {
+			logs[idx] = crashes[idx].Attributes.CrashLog
+			continue
+		}
+		wg.Go(func() {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			requestCtx, requestCancel := shared.ContextWithTimeout(ctx)
+			defer requestCancel()
+			resp, err := t.client.GetBetaFeedbackCrashSubmissionCrashLog(requestCtx, crashes[idx].ID)
+			if err != nil {
+				if asc.IsNotFound(err) {
+					return
+				}
+				once.Do(cancel)
+				errs <- fmt.Errorf("fetch crash log for %s: %w", crashes[idx].ID, err)
+				return
+			}
+			logs[idx] = resp.Data.Attributes.LogText
+		})
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context cancelled: %w", err)
+	}
+	return logs, nil
+}
+
+// signature returns the group key of a crash log, its exception type, and
+// its normalized top frames.
+func (t *crashTriager) signature(logText string) (string, string, []string) {
+	if strings.TrimSpace(logText) == "" {
+		return "(no crash log)", "", nil
+	}
+	report, err := crashlog.Parse([]byte(logText))
+	if err != nil {
+		return "(unreadable crash log)", "", nil
+	}
+	if t.symbols != nil {
+		crashlog.Symbolicate(report, t.symbols)
+	}
+
+	exception := report.Exception
+	frames := report.TopFrames(t.frames)
+	if t.groupBy == triageGroupByFrames && len(frames) > 0 {
+		return strings.Join(frames, " | "), exception, frames
+	}
+	if exception == "" {
+		return "(unknown exception)", exception, frames
+	}
+	return exception, exception, frames
+}
+
+// latestBuilds returns the newest build with crashes and the one before it,
+// ordered by upload date.
+func latestBuilds(crashes []crashSubmission, builds map[string]shared.TriageBuild) (shared.TriageBuild, shared.TriageBuild) {
+	seen := map[string]bool{}
+	var ordered []shared.TriageBuild
+	for _, crash := range crashes {
+		build := shared.SubmissionBuild(builds, crash.Relationships)
+		if build.ID == "" || seen[build.ID] {
+			continue
+		}
+		seen[build.ID] = true
+		ordered = append(ordered, build)
+	}
+	uploaded := func(build shared.TriageBuild) time.Time {
+		parsed, _ := time.Parse(time.RFC3339, build.UploadedDate)
+		return parsed
+	}
+	slices.SortStableFunc(ordered, func(a, b shared.TriageBuild) int {
+		return uploaded(b).Compare(uploaded(a))
+	})
+
+	var latest, previous shared.TriageBuild
+	if len(ordered) > 0 {
+		latest = ordered[0]
+	}
+	if len(ordered) > 1 {
+		previous = ordered[1]
+	}
+	return latest, previous
+}
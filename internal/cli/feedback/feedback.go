@@ -18,7 +18,7 @@ func FeedbackCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("feedback", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	includeScreenshots := fs.Bool("include-screenshots", false, "Include screenshot URLs in feedback output")
 	deviceModel := fs.String("device-model", "", "Filter by device model(s), comma-separated")
@@ -35,11 +35,13 @@ func FeedbackCommand() *ffcli.Command {
 
 	return &ffcli.Command{
 		Name:       "feedback",
-		ShortUsage: "asc feedback [flags]",
+		ShortUsage: "asc feedback [flags] | asc feedback <subcommand> [flags]",
 		ShortHelp:  "List TestFlight feedback from beta testers.",
 		LongHelp: `List TestFlight feedback from beta testers.
 
 This command fetches beta feedback screenshot submissions and comments.
+Use the triage subcommand to group recent feedback by similar comments, and
+the export subcommand to download screenshots and crash logs for offline review.
 
 Examples:
   asc feedback --app "123456789"
@@ -47,9 +49,15 @@ Examples:
   asc feedback --app "123456789" --device-model "iPhone15,3" --os-version "17.2"
   asc feedback --app "123456789" --sort -createdDate --limit 5
   asc feedback --next "<links.next>"
-  asc feedback --app "123456789" --paginate`,
+  asc feedback --app "123456789" --paginate
+  asc feedback triage --app "123456789" --since 7d
+  asc feedback export --app "123456789" --dir ./feedback`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			FeedbackTriageCommand(),
+			FeedbackExportCommand(),
+		},
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
 				return fmt.Errorf("feedback: --limit must be between 1 and 200")
@@ -102,7 +110,7 @@ Examples:
 				}
 
 				// Fetch all remaining pages
-				feedback, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				feedback, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetFeedback(ctx, resolvedAppID, asc.WithFeedbackNextURL(nextURL))
 				})
 				if err != nil {
@@ -0,0 +1,575 @@
+package feedback
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+const (
+	feedbackExportIndexHTML     = "html"
+	feedbackExportIndexMarkdown = "markdown"
+
+	feedbackExportSidecar = "submission.json"
+
+	feedbackExportKindScreenshot = "screenshot"
+	feedbackExportKindCrashLog   = "crashLog"
+)
+
+type crashSubmission = asc.Resource[asc.CrashAttributes]
+
+type feedbackExportClient interface {
+	GetFeedback(ctx context.Context, appID string, opts ...asc.FeedbackOption) (*asc.FeedbackResponse, error)
+	GetCrashes(ctx context.Context, appID string, opts ...asc.CrashOption) (*asc.CrashesResponse, error)
+	GetBetaFeedbackCrashSubmissionCrashLog(ctx context.Context, submissionID string) (*asc.BetaCrashLogResponse, error)
+	DownloadBetaFeedbackScreenshot(ctx context.Context, screenshotURL string) (*asc.ReportDownload, error)
+}
+
+// FeedbackExportCommand returns the feedback export subcommand.
+func FeedbackExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("feedback export", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	dir := fs.String("dir", "", "Directory to export feedback into")
+	since := fs.String("since", "", "Only export submissions since this duration (7d, 2w, 24h), date, or RFC 3339 timestamp")
+	buildID := fs.String("build", "", "Filter by build ID(s), comma-separated")
+	index := fs.String("index", feedbackExportIndexHTML, "Index format: html or markdown")
+	concurrency := fs.Int("concurrency", 4, "Number of files to download in parallel")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc feedback export --app APP_ID --dir ./feedback [flags]",
+		ShortHelp:  "Download feedback screenshots and crash logs into a browsable directory.",
+		LongHelp: `Download feedback screenshots and crash logs into a browsable directory.
+
+Exports every feedback and crash submission (or those since --since) into
+--dir, one directory per submission:
+
+  feedback/<id>/submission.json, screenshot-1.png, ...
+  crashes/<id>/submission.json, crash.ips (or crash.crash)
+  index.html (or index.md with --index markdown)
+
+Each submission.json sidecar records the tester, device, OS, build, and
+comment. Screenshots and crash logs that already exist in --dir are skipped,
+so re-running an export only downloads new submissions. Files that fail to
+download are listed in the output and the command exits non-zero.
+
+Examples:
+  asc feedback export --app "123456789" --dir ./feedback
+  asc feedback export --app "123456789" --dir ./feedback --since 14d --index markdown
+  asc feedback export --app "123456789" --dir ./feedback --build "BUILD_ID" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
+				return flag.ErrHelp
+			}
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+			indexFormat := strings.ToLower(strings.TrimSpace(*index))
+			if indexFormat != feedbackExportIndexHTML && indexFormat != feedbackExportIndexMarkdown {
+				return fmt.Errorf("feedback export: --index must be html or markdown")
+			}
+			if *concurrency < 1 {
+				return fmt.Errorf("feedback export: --concurrency must be at least 1")
+			}
+			var cutoff time.Time
+			if strings.TrimSpace(*since) != "" {
+				parsed, err := shared.ParseSince(*since, time.Now())
+				if err != nil {
+					return fmt.Errorf("feedback export: %w", err)
+				}
+				cutoff = parsed
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("feedback export: %w", err)
+			}
+
+			exporter := &feedbackExporter{
+				client:      client,
+				appID:       resolvedAppID,
+				dir:         dirValue,
+				cutoff:      cutoff,
+				buildIDs:    shared.SplitCSV(*buildID),
+				index:       indexFormat,
+				concurrency: *concurrency,
+			}
+			result, err := exporter.run(ctx)
+			if err != nil {
+				return fmt.Errorf("feedback export: %w", err)
+			}
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return fmt.Errorf("feedback export: %d of %d files failed", result.Failed, len(result.Files))
+			}
+			return nil
+		},
+	}
+}
+
+// feedbackExportRecord is the submission.json sidecar of an exported
+// submission. File names are relative to the submission directory.
+type feedbackExportRecord struct {
+	ID             string   `json:"id"`
+	Type           string   `json:"type"`
+	CreatedDate    string   `json:"createdDate"`
+	Tester         string   `json:"tester,omitempty"`
+	DeviceModel    string   `json:"deviceModel,omitempty"`
+	OSVersion      string   `json:"osVersion,omitempty"`
+	AppPlatform    string   `json:"appPlatform,omitempty"`
+	DevicePlatform string   `json:"devicePlatform,omitempty"`
+	Build          string   `json:"build,omitempty"`
+	BuildID        string   `json:"buildId,omitempty"`
+	Comment        string   `json:"comment,omitempty"`
+	Screenshots    []string `json:"screenshots,omitempty"`
+	CrashLog       string   `json:"crashLog,omitempty"`
+
+	dir string
+}
+
+// feedbackExportTask downloads one screenshot or crash log of a record. The
+// name of a crash log depends on its format, so it is only known once the log
+// is fetched.
+type feedbackExportTask struct {
+	record   *feedbackExportRecord
+	kind     string
+	name     string
+	url      string
+	crashLog string
+}
+
+type feedbackExporter struct {
+	client      feedbackExportClient
+	appID       string
+	dir         string
+	cutoff      time.Time
+	buildIDs    []string
+	index       string
+	concurrency int
+}
+
+func (e *feedbackExporter) run(ctx context.Context) (*asc.FeedbackExportResult, error) {
+	feedback, feedbackBuilds, err := fetchFeedbackSince(ctx, e.client, e.appID, e.cutoff,
+		asc.WithFeedbackIncludeScreenshots(),
+		asc.WithFeedbackBuildIDs(e.buildIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	crashes, crashBuilds, err := shared.FetchCrashesSince(ctx, e.client, e.appID, e.cutoff, e.buildIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*feedbackExportRecord, 0, len(feedback)+len(crashes))
+	var tasks []feedbackExportTask
+	for _, item := range feedback {
+		record := newFeedbackExportRecord("feedback", item.ID, item.Relationships, feedbackBuilds)
+		record.CreatedDate = item.Attributes.CreatedDate
+		record.Tester = item.Attributes.Email
+		record.DeviceModel = item.Attributes.DeviceModel
+		record.OSVersion = item.Attributes.OSVersion
+		record.AppPlatform = item.Attributes.AppPlatform
+		record.DevicePlatform = item.Attributes.DevicePlatform
+		record.Comment = item.Attributes.Comment
+		records = append(records, record)
+		for i, screenshot := range item.Attributes.Screenshots {
+			tasks = append(tasks, feedbackExportTask{
+				record: record,
+				kind:   feedbackExportKindScreenshot,
+				name:   fmt.Sprintf("screenshot-%d%s", i+1, screenshotExtension(screenshot.URL)),
+				url:    screenshot.URL,
+			})
+		}
+	}
+	for _, item := range crashes {
+		record := newFeedbackExportRecord("crash", item.ID, item.Relationships, crashBuilds)
+		record.CreatedDate = item.Attributes.CreatedDate
+		record.Tester = item.Attributes.Email
+		record.DeviceModel = item.Attributes.DeviceModel
+		record.OSVersion = item.Attributes.OSVersion
+		record.AppPlatform = item.Attributes.AppPlatform
+		record.DevicePlatform = item.Attributes.DevicePlatform
+		record.Comment = item.Attributes.Comment
+		records = append(records, record)
+		tasks = append(tasks, feedbackExportTask{
+			record:   record,
+			kind:     feedbackExportKindCrashLog,
+			crashLog: item.Attributes.CrashLog,
+		})
+	}
+
+	if err := os.MkdirAll(e.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create export directory: %w", err)
+	}
+	files, err := e.download(ctx, tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &asc.FeedbackExportResult{
+		AppID:    e.appID,
+		Dir:      e.dir,
+		Feedback: len(feedback),
+		Crashes:  len(crashes),
+		Files:    files,
+	}
+	if !e.cutoff.IsZero() {
+		result.Since = e.cutoff.UTC().Format(time.RFC3339)
+	}
+	for i, file := range files {
+		switch file.Status {
+		case asc.FeedbackExportDownloaded:
+			result.Downloaded++
+		case asc.FeedbackExportSkipped:
+			result.Skipped++
+		case asc.FeedbackExportFailed:
+			result.Failed++
+			continue
+		default:
+			continue
+		}
+		record := tasks[i].record
+		if file.Kind == feedbackExportKindScreenshot {
+			record.Screenshots = append(record.Screenshots, filepath.Base(file.Path))
+		} else {
+			record.CrashLog = filepath.Base(file.Path)
+		}
+	}
+
+	for _, record := range records {
+		data, err := json.MarshalIndent(record, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		path := filepath.Join(e.dir, filepath.FromSlash(record.dir), feedbackExportSidecar)
+		if err := writeFeedbackExportFile(path, append(data, '\n')); err != nil {
+			return nil, fmt.Errorf("write %s: %w", path, err)
+		}
+	}
+
+	sortFeedbackExportRecords(records)
+	indexName, indexData, err := renderFeedbackExportIndex(e.index, e.appID, records)
+	if err != nil {
+		return nil, err
+	}
+	result.Index = filepath.Join(e.dir, indexName)
+	if err := writeFeedbackExportFile(result.Index, indexData); err != nil {
+		return nil, fmt.Errorf("write %s: %w", result.Index, err)
+	}
+	return result, nil
+}
+
+// download runs the tasks with bounded concurrency. Files that already exist
+// are skipped, and failed downloads are reported rather than stopping the
+// export.
+func (e *feedbackExporter) download(ctx context.Context, tasks []feedbackExportTask) ([]asc.FeedbackExportFile, error) {
+	files := make([]asc.FeedbackExportFile, len(tasks))
+	if len(tasks) == 0 {
+		return files, nil
+	}
+
+	sem := make(chan struct{}, max(min(len(tasks), e.concurrency), 1))
+	var wg sync.WaitGroup
+	for idx := range tasks {
+		wg.Go(func() {
+			task := tasks[idx]
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				files[idx] = asc.FeedbackExportFile{SubmissionID: task.record.ID, Kind: task.kind, Status: asc.FeedbackExportFailed, Error: ctx.Err().Error()}
+				return
+			}
+			defer func() { <-sem }()
+
+			files[idx] = e.downloadFile(ctx, task)
+		})
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context cancelled: %w", err)
+	}
+	return files, nil
+}
+
+func (e *feedbackExporter) downloadFile(ctx context.Context, task feedbackExportTask) asc.FeedbackExportFile {
+	dir := filepath.Join(e.dir, filepath.FromSlash(task.record.dir))
+	file := asc.FeedbackExportFile{SubmissionID: task.record.ID, Kind: task.kind}
+
+	candidates := []string{task.name}
+	if task.kind == feedbackExportKindCrashLog {
+		candidates = []string{"crash.ips", "crash.crash"}
+	}
+	for _, name := range candidates {
+		path := filepath.Join(dir, name)
+		if info, err := os.Lstat(path); err == nil && info.Mode().IsRegular() {
+			file.Status = asc.FeedbackExportSkipped
+			file.Path = path
+			file.Size = info.Size()
+			return file
+		}
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	var data []byte
+	name := task.name
+	switch {
+	case task.kind == feedbackExportKindScreenshot:
+		file.Path = filepath.Join(dir, name)
+		download, err := e.client.DownloadBetaFeedbackScreenshot(requestCtx, task.url)
+		if err != nil {
+			return failedFeedbackExportFile(file, err)
+		}
+		defer download.Body.Close()
+		data, err = io.ReadAll(download.Body)
+		if err != nil {
+			return failedFeedbackExportFile(file, err)
+		}
+	case task.crashLog != "":
+		data = []byte(task.crashLog)
+	default:
+		resp, err := e.client.GetBetaFeedbackCrashSubmissionCrashLog(requestCtx, task.record.ID)
+		if err != nil {
+			if asc.IsNotFound(err) {
+				file.Status = asc.FeedbackExportUnavailable
+				return file
+			}
+			return failedFeedbackExportFile(file, err)
+		}
+		data = []byte(resp.Data.Attributes.LogText)
+	}
+	if task.kind == feedbackExportKindCrashLog {
+		name = "crash" + crashLogExtension(string(data))
+	}
+	file.Path = filepath.Join(dir, name)
+
+	if err := writeFeedbackExportFile(file.Path, data); err != nil {
+		return failedFeedbackExportFile(file, err)
+	}
+	file.Status = asc.FeedbackExportDownloaded
+	file.Size = int64(len(data))
+	return file
+}
+
+func failedFeedbackExportFile(file asc.FeedbackExportFile, err error) asc.FeedbackExportFile {
+	file.Status = asc.FeedbackExportFailed
+	file.Error = err.Error()
+	return file
+}
+
+func newFeedbackExportRecord(kind, id string, relationships json.RawMessage, builds map[string]shared.TriageBuild) *feedbackExportRecord {
+	build := shared.SubmissionBuild(builds, relationships)
+	parent := "feedback"
+	if kind == "crash" {
+		parent = "crashes"
+	}
+	return &feedbackExportRecord{
+		ID:      id,
+		Type:    kind,
+		Build:   build.Version,
+		BuildID: build.ID,
+		dir:     path.Join(parent, safeExportName(id)),
+	}
+}
+
+// safeExportName keeps a submission ID usable as a directory name.
+func safeExportName(id string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, strings.TrimSpace(id))
+	if name == "" {
+		return "_"
+	}
+	return name
+}
+
+func screenshotExtension(rawURL string) string {
+	ext := strings.ToLower(path.Ext(strings.SplitN(rawURL, "?", 2)[0]))
+	switch ext {
+	case ".png", ".jpg", ".jpeg", ".heic":
+		return ext
+	default:
+		return ".png"
+	}
+}
+
+func crashLogExtension(logText string) string {
+	if strings.HasPrefix(strings.TrimSpace(logText), "{") {
+		return ".ips"
+	}
+	return ".crash"
+}
+
+// writeFeedbackExportFile writes a file atomically so an interrupted export
+// never leaves a partial file that a later run would skip.
+func writeFeedbackExportFile(path string, data []byte) error {
+	return fileutil.WriteFileAtomic(path, data, 0o600)
+}
+
+// sortFeedbackExportRecords orders feedback and crashes together, newest
+// first.
+func sortFeedbackExportRecords(records []*feedbackExportRecord) {
+	created := func(record *feedbackExportRecord) time.Time {
+		parsed, _ := time.Parse(time.RFC3339, record.CreatedDate)
+		return parsed
+	}
+	slices.SortStableFunc(records, func(a, b *feedbackExportRecord) int {
+		return created(b).Compare(created(a))
+	})
+}
+
+// feedbackExportEntry is a record as shown in the index, with paths relative
+// to the export directory.
+type feedbackExportEntry struct {
+	*feedbackExportRecord
+	Title       string
+	Details     string
+	Sidecar     string
+	Screenshots []string
+	CrashLog    string
+}
+
+func feedbackExportEntries(records []*feedbackExportRecord) []feedbackExportEntry {
+	entries := make([]feedbackExportEntry, 0, len(records))
+	for _, record := range records {
+		kind := "Feedback"
+		if record.Type == "crash" {
+			kind = "Crash"
+		}
+		title := kind
+		if record.Tester != "" {
+			title += " from " + record.Tester
+		}
+		if record.CreatedDate != "" {
+			title = record.CreatedDate + " " + title
+		}
+
+		var details []string
+		if record.Build != "" || record.BuildID != "" {
+			details = append(details, "Build "+shared.TriageBuild{ID: record.BuildID, Version: record.Build}.Label())
+		}
+		for _, value := range []string{record.DeviceModel, record.OSVersion, record.AppPlatform} {
+			if value != "" {
+				details = append(details, value)
+			}
+		}
+
+		entry := feedbackExportEntry{
+			feedbackExportRecord: record,
+			Title:                title,
+			Details:              strings.Join(details, " · "),
+			Sidecar:              path.Join(record.dir, feedbackExportSidecar),
+		}
+		for _, name := range record.Screenshots {
+			entry.Screenshots = append(entry.Screenshots, path.Join(record.dir, name))
+		}
+		if record.CrashLog != "" {
+			entry.CrashLog = path.Join(record.dir, record.CrashLog)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+var feedbackExportHTML = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>TestFlight feedback for app {{.AppID}}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, sans-serif; margin: 2rem; color: #1d1d1f; }
+section { border-top: 1px solid #d2d2d7; padding: 1rem 0; }
+h2 { font-size: 1.1rem; margin: 0 0 0.25rem; }
+.details { color: #6e6e73; margin: 0 0 0.5rem; }
+blockquote { margin: 0.5rem 0; padding-left: 1rem; border-left: 3px solid #d2d2d7; white-space: pre-wrap; }
+img { max-height: 320px; margin: 0.25rem 0.5rem 0.25rem 0; border: 1px solid #d2d2d7; }
+</style>
+</head>
+<body>
+<h1>TestFlight feedback for app {{.AppID}}</h1>
+<p>{{len .Entries}} submissions</p>
+{{range .Entries}}<section>
+<h2>{{.Title}}</h2>
+{{if .Details}}<p class="details">{{.Details}}</p>
+{{end}}{{if .Comment}}<blockquote>{{.Comment}}</blockquote>
+{{end}}{{range .Screenshots}}<a href="{{.}}"><img src="{{.}}" alt="Screenshot"></a>
+{{end}}<p>{{if .CrashLog}}<a href="{{.CrashLog}}">Crash log</a> · {{end}}<a href="{{.Sidecar}}">Details</a></p>
+</section>
+{{end}}</body>
+</html>
+`))
+
+func renderFeedbackExportIndex(format, appID string, records []*feedbackExportRecord) (string, []byte, error) {
+	entries := feedbackExportEntries(records)
+	if format == feedbackExportIndexHTML {
+		var buf bytes.Buffer
+		data := struct {
+			AppID   string
+			Entries []feedbackExportEntry
+		}{AppID: appID, Entries: entries}
+		if err := feedbackExportHTML.Execute(&buf, data); err != nil {
+			return "", nil, fmt.Errorf("render index: %w", err)
+		}
+		return "index.html", buf.Bytes(), nil
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# TestFlight feedback for app %s\n\n%d submissions\n", appID, len(entries))
+	for _, entry := range entries {
+		fmt.Fprintf(&buf, "\n## %s\n\n", entry.Title)
+		if entry.Details != "" {
+			fmt.Fprintf(&buf, "%s\n\n", entry.Details)
+		}
+		if comment := strings.TrimSpace(entry.Comment); comment != "" {
+			fmt.Fprintf(&buf, "> %s\n\n", strings.ReplaceAll(comment, "\n", "\n> "))
+		}
+		for i, screenshot := range entry.Screenshots {
+			fmt.Fprintf(&buf, "![Screenshot %d](%s)\n", i+1, screenshot)
+		}
+		if len(entry.Screenshots) > 0 {
+			buf.WriteString("\n")
+		}
+		if entry.CrashLog != "" {
+			fmt.Fprintf(&buf, "[Crash log](%s) · ", entry.CrashLog)
+		}
+		fmt.Fprintf(&buf, "[Details](%s)\n", entry.Sidecar)
+	}
+	return "index.md", buf.Bytes(), nil
+}
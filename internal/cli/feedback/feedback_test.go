@@ -2,9 +2,20 @@ package feedback
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
 func TestFeedbackCommand_MissingApp(t *testing.T) {
@@ -48,3 +59,289 @@ func TestCommandWrapper(t *testing.T) {
 		t.Fatal("expected Command wrapper to return a command")
 	}
 }
+
+func TestFeedbackTriageCommand_Validation(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	cmd := FeedbackTriageCommand()
+	if err := cmd.FlagSet.Parse([]string{}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+	if err := cmd.Exec(context.Background(), nil); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected flag.ErrHelp, got %v", err)
+	}
+
+	for _, args := range [][]string{
+		{"--app", "123", "--similarity", "0"},
+		{"--app", "123", "--similarity", "1.5"},
+		{"--app", "123", "--since", "yesterday"},
+	} {
+		cmd := FeedbackTriageCommand()
+		if err := cmd.FlagSet.Parse(args); err != nil {
+			t.Fatalf("failed to parse flags: %v", err)
+		}
+		err := cmd.Exec(context.Background(), nil)
+		if err == nil || errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected validation error for %v, got %v", args, err)
+		}
+	}
+}
+
+func triageFeedback(id, created, comment string) feedbackSubmission {
+	return feedbackSubmission{
+		ID:            id,
+		Attributes:    asc.FeedbackAttributes{CreatedDate: created, Comment: comment, Email: id + "@example.com", OSVersion: "17.2"},
+		Relationships: json.RawMessage(`{"build":{"data":{"type":"builds","id":"b1"}}}`),
+	}
+}
+
+func TestGroupFeedback(t *testing.T) {
+	items := []feedbackSubmission{
+		triageFeedback("f1", "2026-03-05T00:00:00Z", "The app crashes when uploading a photo"),
+		triageFeedback("f2", "2026-03-04T00:00:00Z", "Dark mode colors look wrong in settings"),
+		triageFeedback("f3", "2026-03-03T00:00:00Z", "Crashed while uploading photos!"),
+		triageFeedback("f4", "2026-03-02T00:00:00Z", ""),
+		triageFeedback("f5", "2026-03-01T00:00:00Z", "Crash uploading photo again"),
+	}
+	builds := map[string]shared.TriageBuild{"b1": {ID: "b1", Version: "42"}}
+
+	result := groupFeedback(items, builds, 0.4)
+	if result.Total != 5 || len(result.Groups) != 3 {
+		t.Fatalf("unexpected groups: %+v", result)
+	}
+
+	upload := result.Groups[0]
+	if upload.Count != 3 || !reflect.DeepEqual(upload.FeedbackIDs, []string{"f1", "f3", "f5"}) {
+		t.Fatalf("unexpected upload group: %+v", upload)
+	}
+	if !reflect.DeepEqual(upload.Keywords, []string{"crash", "photo", "upload"}) {
+		t.Fatalf("unexpected keywords: %v", upload.Keywords)
+	}
+	if upload.Sample != "The app crashes when uploading a photo" || upload.FirstSeen != "2026-03-01T00:00:00Z" || upload.LastSeen != "2026-03-05T00:00:00Z" {
+		t.Fatalf("unexpected upload group details: %+v", upload)
+	}
+	if want := []asc.TriageCount{{Value: "42", Count: 3}}; !reflect.DeepEqual(upload.Builds, want) {
+		t.Fatalf("unexpected builds: %+v", upload.Builds)
+	}
+
+	if result.Groups[1].Sample != "Dark mode colors look wrong in settings" || result.Groups[2].Sample != "(no comment)" {
+		t.Fatalf("unexpected group order: %+v", result.Groups)
+	}
+
+	if strict := groupFeedback(items, builds, 1); len(strict.Groups) != 5 {
+		t.Fatalf("expected every comment in its own group at similarity 1, got %d", len(strict.Groups))
+	}
+}
+
+func TestStemWord(t *testing.T) {
+	tests := map[string]string{
+		"crashes":   "crash",
+		"crashed":   "crash",
+		"crashing":  "crash",
+		"crash":     "crash",
+		"photos":    "photo",
+		"batteries": "battery",
+		"boxes":     "box",
+		"glass":     "glass",
+		"bus":       "bus",
+	}
+	for word, want := range tests {
+		if got := stemWord(word); got != want {
+			t.Errorf("stemWord(%q) = %q, want %q", word, got, want)
+		}
+	}
+}
+
+func TestFeedbackExportCommand_Validation(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	for _, args := range [][]string{
+		{"--dir", "out"},
+		{"--app", "123"},
+	} {
+		cmd := FeedbackExportCommand()
+		if err := cmd.FlagSet.Parse(args); err != nil {
+			t.Fatalf("failed to parse flags: %v", err)
+		}
+		if err := cmd.Exec(context.Background(), nil); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected flag.ErrHelp for %v, got %v", args, err)
+		}
+	}
+
+	for _, args := range [][]string{
+		{"--app", "123", "--dir", "out", "--index", "pdf"},
+		{"--app", "123", "--dir", "out", "--concurrency", "0"},
+		{"--app", "123", "--dir", "out", "--since", "later"},
+	} {
+		cmd := FeedbackExportCommand()
+		if err := cmd.FlagSet.Parse(args); err != nil {
+			t.Fatalf("failed to parse flags: %v", err)
+		}
+		err := cmd.Exec(context.Background(), nil)
+		if err == nil || errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected validation error for %v, got %v", args, err)
+		}
+	}
+}
+
+type stubFeedbackExportClient struct {
+	feedback *asc.FeedbackResponse
+	crashes  *asc.CrashesResponse
+	logs     map[string]string
+
+	mu          sync.Mutex
+	downloads   []string
+	logRequests []string
+}
+
+func (c *stubFeedbackExportClient) GetFeedback(ctx context.Context, appID string, opts ...asc.FeedbackOption) (*asc.FeedbackResponse, error) {
+	return c.feedback, nil
+}
+
+func (c *stubFeedbackExportClient) GetCrashes(ctx context.Context, appID string, opts ...asc.CrashOption) (*asc.CrashesResponse, error) {
+	return c.crashes, nil
+}
+
+func (c *stubFeedbackExportClient) GetBetaFeedbackCrashSubmissionCrashLog(ctx context.Context, submissionID string) (*asc.BetaCrashLogResponse, error) {
+	c.mu.Lock()
+	c.logRequests = append(c.logRequests, submissionID)
+	c.mu.Unlock()
+	logText, ok := c.logs[submissionID]
+	if !ok {
+		return nil, asc.ErrNotFound
+	}
+	resp := &asc.BetaCrashLogResponse{}
+	resp.Data.Attributes.LogText = logText
+	return resp, nil
+}
+
+func (c *stubFeedbackExportClient) DownloadBetaFeedbackScreenshot(ctx context.Context, screenshotURL string) (*asc.ReportDownload, error) {
+	c.mu.Lock()
+	c.downloads = append(c.downloads, screenshotURL)
+	c.mu.Unlock()
+	if strings.Contains(screenshotURL, "expired") {
+		return nil, fmt.Errorf("API request failed with status 403")
+	}
+	return &asc.ReportDownload{Body: io.NopCloser(strings.NewReader("image:" + screenshotURL))}, nil
+}
+
+func newStubFeedbackExportClient() *stubFeedbackExportClient {
+	feedback := triageFeedback("f1", "2026-03-05T00:00:00Z", "Button <b>overlaps</b> the header")
+	feedback.Attributes.DeviceModel = "iPhone15,3"
+	feedback.Attributes.Screenshots = []asc.FeedbackScreenshotImage{
+		{URL: "https://tf-feedback.itunes.apple.com/eimg/one.jpg?sig=1"},
+		{URL: "https://tf-feedback.itunes.apple.com/eimg/two?sig=2"},
+	}
+	broken := triageFeedback("f/2", "2026-03-02T00:00:00Z", "Second report")
+	broken.Attributes.Screenshots = []asc.FeedbackScreenshotImage{{URL: "https://tf-feedback.itunes.apple.com/eimg/expired.png"}}
+
+	embedded := asc.Resource[asc.CrashAttributes]{ID: "c1", Attributes: asc.CrashAttributes{CreatedDate: "2026-03-04T00:00:00Z", Email: "c1@example.com", CrashLog: "Incident Identifier: A1B2\n"}}
+	fetched := asc.Resource[asc.CrashAttributes]{ID: "c2", Attributes: asc.CrashAttributes{CreatedDate: "2026-03-03T00:00:00Z", Comment: "It crashed"}}
+	missing := asc.Resource[asc.CrashAttributes]{ID: "c3", Attributes: asc.CrashAttributes{CreatedDate: "2026-03-01T00:00:00Z"}}
+
+	return &stubFeedbackExportClient{
+		feedback: &asc.FeedbackResponse{
+			Data:     []feedbackSubmission{feedback, broken},
+			Included: json.RawMessage(`[{"type":"builds","id":"b1","attributes":{"version":"42"}}]`),
+		},
+		crashes: &asc.CrashesResponse{Data: []asc.Resource[asc.CrashAttributes]{embedded, fetched, missing}},
+		logs:    map[string]string{"c2": `{"app_name":"Demo"}` + "\n{}"},
+	}
+}
+
+func TestFeedbackExporterRun(t *testing.T) {
+	dir := t.TempDir()
+	client := newStubFeedbackExportClient()
+	exporter := &feedbackExporter{client: client, appID: "123", dir: dir, index: feedbackExportIndexHTML, concurrency: 2}
+
+	result, err := exporter.run(context.Background())
+	if err != nil {
+		t.Fatalf("run() error: %v", err)
+	}
+	if result.Feedback != 2 || result.Crashes != 3 || result.Downloaded != 4 || result.Skipped != 0 || result.Failed != 1 {
+		t.Fatalf("unexpected result counts: %+v", result)
+	}
+	if result.Index != filepath.Join(dir, "index.html") {
+		t.Fatalf("unexpected index path %q", result.Index)
+	}
+
+	for _, name := range []string{
+		"feedback/f1/screenshot-1.jpg",
+		"feedback/f1/screenshot-2.png",
+		"crashes/c1/crash.crash",
+		"crashes/c2/crash.ips",
+		"crashes/c3/submission.json",
+		"feedback/f_2/submission.json",
+	} {
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name))); err != nil {
+			t.Fatalf("expected %s to be exported: %v", name, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "feedback", "f_2", "screenshot-1.png")); !os.IsNotExist(err) {
+		t.Fatalf("expected failed screenshot not to be written, got %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "feedback", "f1", "submission.json"))
+	if err != nil {
+		t.Fatalf("read sidecar: %v", err)
+	}
+	var sidecar feedbackExportRecord
+	if err := json.Unmarshal(data, &sidecar); err != nil {
+		t.Fatalf("decode sidecar: %v", err)
+	}
+	want := feedbackExportRecord{
+		ID:          "f1",
+		Type:        "feedback",
+		CreatedDate: "2026-03-05T00:00:00Z",
+		Tester:      "f1@example.com",
+		DeviceModel: "iPhone15,3",
+		OSVersion:   "17.2",
+		Build:       "42",
+		BuildID:     "b1",
+		Comment:     "Button <b>overlaps</b> the header",
+		Screenshots: []string{"screenshot-1.jpg", "screenshot-2.png"},
+	}
+	if !reflect.DeepEqual(sidecar, want) {
+		t.Fatalf("unexpected sidecar:\n got %+v\nwant %+v", sidecar, want)
+	}
+
+	index, err := os.ReadFile(result.Index)
+	if err != nil {
+		t.Fatalf("read index: %v", err)
+	}
+	html := string(index)
+	for _, want := range []string{
+		`<img src="feedback/f1/screenshot-1.jpg"`,
+		`<a href="crashes/c2/crash.ips">Crash log</a>`,
+		"Button &lt;b&gt;overlaps&lt;/b&gt; the header",
+		"Build 42 · iPhone15,3 · 17.2",
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected index to contain %q, got:\n%s", want, html)
+		}
+	}
+	if strings.Index(html, "2026-03-05") > strings.Index(html, "2026-03-04") {
+		t.Fatal("expected newest submissions first")
+	}
+
+	// A second run skips everything already on disk and refetches nothing.
+	client.downloads, client.logRequests = nil, nil
+	exporter.index = feedbackExportIndexMarkdown
+	result, err = exporter.run(context.Background())
+	if err != nil {
+		t.Fatalf("second run() error: %v", err)
+	}
+	if result.Downloaded != 0 || result.Skipped != 4 || result.Failed != 1 {
+		t.Fatalf("unexpected second run counts: %+v", result)
+	}
+	if len(client.downloads) != 1 || len(client.logRequests) != 1 {
+		t.Fatalf("expected only the failed and missing files to be retried, got %v %v", client.downloads, client.logRequests)
+	}
+	markdown, err := os.ReadFile(filepath.Join(dir, "index.md"))
+	if err != nil {
+		t.Fatalf("read markdown index: %v", err)
+	}
+	if !strings.Contains(string(markdown), "![Screenshot 2](feedback/f1/screenshot-2.png)") || !strings.Contains(string(markdown), "> It crashed") {
+		t.Fatalf("unexpected markdown index:\n%s", markdown)
+	}
+}
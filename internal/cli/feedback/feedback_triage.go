@@ -0,0 +1,280 @@
+package feedback
+
+import (
+	"cmp"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const feedbackTriageKeywords = 3
+
+type feedbackSubmission = asc.Resource[asc.FeedbackAttributes]
+
+type feedbackTriageClient interface {
+	GetFeedback(ctx context.Context, appID string, opts ...asc.FeedbackOption) (*asc.FeedbackResponse, error)
+}
+
+// FeedbackTriageCommand returns the feedback triage subcommand.
+func FeedbackTriageCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("feedback triage", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	since := fs.String("since", "7d", "Only include feedback since this duration (7d, 2w, 24h), date, or RFC 3339 timestamp")
+	similarity := fs.Float64("similarity", 0.4, "Minimum word overlap (0-1) for comments to share a group")
+	buildID := fs.String("build", "", "Filter by build ID(s), comma-separated")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "triage",
+		ShortUsage: "asc feedback triage --app APP_ID [--since 7d] [flags]",
+		ShortHelp:  "Group recent feedback by similar comments.",
+		LongHelp: `Group recent feedback by similar comments.
+
+Fetches every feedback submission since --since and groups comments that
+share enough significant words (after lowercasing and dropping common words),
+measured as the Jaccard overlap with the first comment of each group. Raise
+--similarity for tighter groups.
+
+Each group lists its top keywords, a sample comment, counts per build, OS
+version, and device model, and the testers who sent it.
+
+Examples:
+  asc feedback triage --app "123456789"
+  asc feedback triage --app "123456789" --since 14d --output table
+  asc feedback triage --app "123456789" --similarity 0.6`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
+				return flag.ErrHelp
+			}
+			if *similarity <= 0 || *similarity > 1 {
+				return fmt.Errorf("feedback triage: --similarity must be greater than 0 and at most 1")
+			}
+			cutoff, err := shared.ParseSince(*since, time.Now())
+			if err != nil {
+				return fmt.Errorf("feedback triage: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("feedback triage: %w", err)
+			}
+
+			items, builds, err := fetchFeedbackSince(ctx, client, resolvedAppID, cutoff, asc.WithFeedbackBuildIDs(shared.SplitCSV(*buildID)))
+			if err != nil {
+				return fmt.Errorf("feedback triage: %w", err)
+			}
+			result := groupFeedback(items, builds, *similarity)
+			result.AppID = resolvedAppID
+			result.Since = cutoff.UTC().Format(time.RFC3339)
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// fetchFeedbackSince pages through feedback newest first until a submission
+// is older than the cutoff, along with the builds it was sent from.
+func fetchFeedbackSince(ctx context.Context, client feedbackTriageClient, appID string, cutoff time.Time, extra ...asc.FeedbackOption) ([]feedbackSubmission, map[string]shared.TriageBuild, error) {
+	opts := append([]asc.FeedbackOption{
+		asc.WithFeedbackSort("-createdDate"),
+		asc.WithFeedbackLimit(200),
+		asc.WithFeedbackIncludeBuild(),
+	}, extra...)
+	var items []feedbackSubmission
+	builds := map[string]shared.TriageBuild{}
+	for {
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		resp, err := client.GetFeedback(requestCtx, appID, opts...)
+		cancel()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch feedback: %w", err)
+		}
+		included, err := shared.IncludedBuilds(resp.Included)
+		if err != nil {
+			return nil, nil, err
+		}
+		for id, build := range included {
+			builds[id] = build
+		}
+
+		for _, item := range resp.Data {
+			created, err := time.Parse(time.RFC3339, item.Attributes.CreatedDate)
+			if err == nil && created.Before(cutoff) {
+				return items, builds, nil
+			}
+			items = append(items, item)
+		}
+		if resp.Links.Next == "" {
+			return items, builds, nil
+		}
+		opts = []asc.FeedbackOption{asc.WithFeedbackNextURL(resp.Links.Next)}
+	}
+}
+
+type feedbackGroup struct {
+	seed   map[string]bool
+	words  map[string]int
+	sample string
+	tally  *shared.TriageTally
+}
+
+// groupFeedback clusters comments greedily: each joins the most similar
+// group whose first comment overlaps by at least threshold, or starts one.
+func groupFeedback(items []feedbackSubmission, builds map[string]shared.TriageBuild, threshold float64) *asc.FeedbackTriageResult {
+	var groups []*feedbackGroup
+	var empty *feedbackGroup
+	for _, item := range items {
+		words := commentWords(item.Attributes.Comment)
+
+		var target *feedbackGroup
+		if len(words) == 0 {
+			if empty == nil {
+				empty = &feedbackGroup{sample: "(no comment)", words: map[string]int{}, tally: shared.NewTriageTally()}
+				groups = append(groups, empty)
+			}
+			target = empty
+		} else {
+			best := 0.0
+			for _, group := range groups {
+				if group == empty {
+					continue
+				}
+				if score := jaccard(words, group.seed); score >= threshold && score > best {
+					target, best = group, score
+				}
+			}
+			if target == nil {
+				target = &feedbackGroup{seed: words, words: map[string]int{}, sample: strings.TrimSpace(item.Attributes.Comment), tally: shared.NewTriageTally()}
+				groups = append(groups, target)
+			}
+		}
+
+		for word := range words {
+			target.words[word]++
+		}
+		target.tally.Add(shared.TriageSubmission{
+			ID:          item.ID,
+			CreatedDate: item.Attributes.CreatedDate,
+			Build:       shared.SubmissionBuild(builds, item.Relationships),
+			OSVersion:   item.Attributes.OSVersion,
+			DeviceModel: item.Attributes.DeviceModel,
+			Tester:      item.Attributes.Email,
+		})
+	}
+
+	slices.SortStableFunc(groups, func(a, b *feedbackGroup) int {
+		if a.tally.Count != b.tally.Count {
+			return b.tally.Count - a.tally.Count
+		}
+		return b.tally.Last().Compare(a.tally.Last())
+	})
+
+	result := &asc.FeedbackTriageResult{Total: len(items), Groups: make([]asc.FeedbackTriageGroup, 0, len(groups))}
+	for _, group := range groups {
+		result.Groups = append(result.Groups, asc.FeedbackTriageGroup{
+			Keywords:     topWords(group.words, feedbackTriageKeywords),
+			Sample:       group.sample,
+			Count:        group.tally.Count,
+			FirstSeen:    group.tally.FirstSeen,
+			LastSeen:     group.tally.LastSeen,
+			Builds:       group.tally.Builds(),
+			OSVersions:   group.tally.OSVersions(),
+			DeviceModels: group.tally.DeviceModels(),
+			Testers:      group.tally.Testers(),
+			FeedbackIDs:  group.tally.IDs,
+		})
+	}
+	return result
+}
+
+var feedbackStopwords = map[string]bool{
+	"the": true, "and": true, "for": true, "are": true, "but": true, "not": true, "you": true,
+	"all": true, "any": true, "can": true, "had": true, "her": true, "was": true, "one": true,
+	"our": true, "out": true, "has": true, "have": true, "his": true, "how": true, "its": true,
+	"just": true, "that": true, "this": true, "with": true, "from": true, "they": true, "will": true,
+	"would": true, "there": true, "their": true, "what": true, "when": true, "which": true,
+	"into": true, "than": true, "then": true, "them": true, "these": true, "some": true, "very": true,
+	"been": true, "were": true, "also": true, "only": true, "after": true, "before": true,
+	"does": true, "doesn": true, "didn": true, "don": true, "isn": true, "wasn": true,
+	"app": true, "please": true, "thanks": true, "thank": true, "get": true, "got": true,
+}
+
+// commentWords returns the significant, lightly stemmed words of a comment.
+func commentWords(comment string) map[string]bool {
+	words := map[string]bool{}
+	fields := strings.FieldsFunc(strings.ToLower(comment), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, field := range fields {
+		if len([]rune(field)) < 3 || feedbackStopwords[field] {
+			continue
+		}
+		words[stemWord(field)] = true
+	}
+	return words
+}
+
+// stemWord strips common English suffixes so "crashes", "crashed", and
+// "crashing" group together.
+func stemWord(word string) string {
+	switch {
+	case strings.HasSuffix(word, "ies") && len(word) > 4:
+		return strings.TrimSuffix(word, "ies") + "y"
+	case strings.HasSuffix(word, "sses"), strings.HasSuffix(word, "shes"), strings.HasSuffix(word, "ches"), strings.HasSuffix(word, "xes"):
+		return strings.TrimSuffix(word, "es")
+	case strings.HasSuffix(word, "ing") && len(word) > 5:
+		return strings.TrimSuffix(word, "ing")
+	case strings.HasSuffix(word, "ed") && len(word) > 4:
+		return strings.TrimSuffix(word, "ed")
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") && len(word) > 3:
+		return strings.TrimSuffix(word, "s")
+	default:
+		return word
+	}
+}
+
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	common := 0
+	for word := range a {
+		if b[word] {
+			common++
+		}
+	}
+	return float64(common) / float64(len(a)+len(b)-common)
+}
+
+func topWords(counts map[string]int, n int) []string {
+	words := make([]string, 0, len(counts))
+	for word := range counts {
+		words = append(words, word)
+	}
+	slices.SortFunc(words, func(a, b string) int {
+		if counts[a] != counts[b] {
+			return counts[b] - counts[a]
+		}
+		return cmp.Compare(a, b)
+	})
+	if len(words) > n {
+		words = words[:n]
+	}
+	return words
+}
@@ -49,14 +49,18 @@ func FinanceReportsCommand() *ffcli.Command {
 	reportType := fs.String("report-type", "", "Report type: FINANCIAL or FINANCE_DETAIL (see help for UI mapping)")
 	region := fs.String("region", "", "Region code (e.g., US, ZZ, Z1; see 'asc finance regions')")
 	date := fs.String("date", "", "Report date (YYYY-MM, Apple fiscal month)")
+	from := fs.String("from", "", "Range start (YYYY-MM); downloads every fiscal month through --to")
+	to := fs.String("to", "", "Range end, inclusive (YYYY-MM)")
+	archiveDir := fs.String("archive-dir", "", "Archive directory for --from/--to downloads (keeps manifest.json)")
+	concurrency := fs.Int("concurrency", shared.DefaultReportBackfillConcurrency, "Parallel downloads for --from/--to")
 	output := fs.String("output", "", "Output file path (default: finance_report_{date}_{type}_{region}.tsv.gz)")
 	decompress := fs.Bool("decompress", false, "Decompress gzip output to .tsv")
-	outputFormat := fs.String("output-format", "json", "Output format for metadata: json (default), table, markdown")
+	outputFormat := fs.String("output-format", "json", "Output format for metadata: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "reports",
-		ShortUsage: "asc finance reports [flags]",
+		ShortUsage: "asc finance reports [flags] | asc finance reports parse --file FILE",
 		ShortHelp:  "Download financial reports from App Store Connect.",
 		LongHelp: `Download financial reports from App Store Connect.
 
@@ -89,6 +93,13 @@ REGION CODES:
 
   Run 'asc finance regions' for the complete list.
 
+DATE RANGES:
+
+  Use --from and --to instead of --date to backfill a range of fiscal months
+  into --archive-dir. Months already in the archive are skipped, manifest.json
+  records what was fetched and when, and months that are not available yet are
+  recorded as "unavailable" and retried on the next run.
+
 Examples:
   # Download single consolidated report (all regions)
   asc finance reports --vendor "12345678" --report-type FINANCIAL --region "ZZ" --date "2025-12"
@@ -100,9 +111,18 @@ Examples:
   asc finance reports --vendor "12345678" --report-type FINANCE_DETAIL --region "Z1" --date "2025-12" --decompress
 
   # Save to custom path
-  asc finance reports --vendor "12345678" --report-type FINANCIAL --region "US" --date "2025-12" --output "reports/finance.tsv.gz"`,
+  asc finance reports --vendor "12345678" --report-type FINANCIAL --region "US" --date "2025-12" --output "reports/finance.tsv.gz"
+
+  # Backfill a year of monthly reports into a local archive
+  asc finance reports --vendor "12345678" --report-type FINANCIAL --region "ZZ" --from "2025-01" --to "2025-12" --archive-dir "reports"
+
+  # Parse a downloaded FINANCIAL report
+  asc finance reports parse --file finance_report_2025-12_FINANCIAL_US.tsv.gz --output table`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			FinanceReportsParseCommand(),
+		},
 		Exec: func(ctx context.Context, args []string) error {
 			vendorNumber := shared.ResolveVendorNumber(*vendor)
 			if vendorNumber == "" {
@@ -117,23 +137,54 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --region is required")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*date) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --date is required")
+			rangeMode := strings.TrimSpace(*from) != "" || strings.TrimSpace(*to) != ""
+			if rangeMode {
+				if strings.TrimSpace(*date) != "" {
+					return fmt.Errorf("finance reports: --date cannot be combined with --from/--to")
+				}
+				if strings.TrimSpace(*from) == "" || strings.TrimSpace(*to) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --from and --to are both required for a date range")
+					return flag.ErrHelp
+				}
+				if strings.TrimSpace(*archiveDir) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --archive-dir is required with --from/--to")
+					return flag.ErrHelp
+				}
+				if strings.TrimSpace(*output) != "" {
+					return fmt.Errorf("finance reports: --output cannot be combined with --from/--to; files are written to --archive-dir")
+				}
+				if *concurrency < 1 {
+					return fmt.Errorf("finance reports: --concurrency must be at least 1")
+				}
+			} else if strings.TrimSpace(*date) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --date is required (or use --from and --to)")
 				return flag.ErrHelp
+			} else if strings.TrimSpace(*archiveDir) != "" {
+				return fmt.Errorf("finance reports: --archive-dir requires --from and --to")
 			}
 
 			normalizedReportType, err := normalizeFinanceReportType(*reportType)
 			if err != nil {
 				return fmt.Errorf("finance reports: %w", err)
 			}
-			reportDate, err := normalizeFinanceReportDate(*date)
+			regionCode, err := normalizeFinanceReportRegion(normalizedReportType, *region)
 			if err != nil {
 				return fmt.Errorf("finance reports: %w", err)
 			}
-			regionCode, err := normalizeFinanceReportRegion(normalizedReportType, *region)
+			params := asc.FinanceReportParams{
+				VendorNumber: vendorNumber,
+				ReportType:   normalizedReportType,
+				RegionCode:   regionCode,
+			}
+			if rangeMode {
+				return runFinanceReportBackfill(ctx, params, *from, *to, *archiveDir, *concurrency, *decompress, *outputFormat, *pretty)
+			}
+
+			reportDate, err := normalizeFinanceReportDate(*date)
 			if err != nil {
 				return fmt.Errorf("finance reports: %w", err)
 			}
+			params.ReportDate = reportDate
 			defaultOutput := fmt.Sprintf("finance_report_%s_%s_%s.tsv.gz", reportDate, string(normalizedReportType), regionCode)
 			compressedPath, decompressedPath := shared.ResolveReportOutputPaths(*output, defaultOutput, ".tsv", *decompress)
 
@@ -145,12 +196,7 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			download, err := client.DownloadFinanceReport(requestCtx, asc.FinanceReportParams{
-				VendorNumber: vendorNumber,
-				ReportType:   normalizedReportType,
-				RegionCode:   regionCode,
-				ReportDate:   reportDate,
-			})
+			download, err := client.DownloadFinanceReport(requestCtx, params)
 			if err != nil {
 				return fmt.Errorf("finance reports: failed to download report: %w", err)
 			}
@@ -190,7 +236,7 @@ Examples:
 func FinanceRegionsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("regions", flag.ExitOnError)
 
-	outputFormat := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	outputFormat := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -0,0 +1,56 @@
+package finance
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// runFinanceReportBackfill downloads every fiscal month from..to into the
+// archive directory and prints the per-month outcome.
+func runFinanceReportBackfill(ctx context.Context, params asc.FinanceReportParams, from, to, archiveDir string, concurrency int, decompress bool, outputFormat string, pretty bool) error {
+	periods, err := shared.ReportPeriods(strings.TrimSpace(from), strings.TrimSpace(to), asc.SalesReportFrequencyMonthly)
+	if err != nil {
+		return fmt.Errorf("finance reports: %w", err)
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("finance reports: %w", err)
+	}
+
+	series := fmt.Sprintf("%s_%s", params.ReportType, params.RegionCode)
+	result, err := shared.RunReportBackfill(ctx, shared.ReportBackfillOptions{
+		ArchiveDir: archiveDir,
+		Subdir:     path.Join("finance", params.VendorNumber, series),
+		Template: shared.ReportArchiveEntry{
+			Kind:         "finance",
+			VendorNumber: params.VendorNumber,
+			ReportType:   string(params.ReportType),
+			RegionCode:   params.RegionCode,
+		},
+		Periods:     periods,
+		Concurrency: concurrency,
+		Decompress:  decompress,
+		Download: func(ctx context.Context, period string) (*asc.ReportDownload, error) {
+			request := params
+			request.ReportDate = period
+			return client.DownloadFinanceReport(ctx, request)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("finance reports: %w", err)
+	}
+
+	if err := shared.PrintOutput(result, outputFormat, pretty); err != nil {
+		return err
+	}
+	if result.Failed > 0 {
+		return fmt.Errorf("finance reports: %d of %d report periods failed", result.Failed, len(result.Data))
+	}
+	return nil
+}
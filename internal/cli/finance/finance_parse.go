@@ -0,0 +1,52 @@
+package finance
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// FinanceReportsParseCommand parses downloaded FINANCIAL reports into typed rows.
+func FinanceReportsParseCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("parse", flag.ExitOnError)
+
+	files := fs.String("file", "", "FINANCIAL report file(s) to parse, comma-separated (.tsv or .tsv.gz)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "parse",
+		ShortUsage: "asc finance reports parse --file FILE [flags]",
+		ShortHelp:  "Parse downloaded FINANCIAL reports into typed rows.",
+		LongHelp: `Parse downloaded FINANCIAL reports into typed rows.
+
+Reads reports saved by "asc finance reports" (gzip-compressed or not). The
+Total_Rows, Total_Amount, and Total_Units trailer lines are skipped, as are
+section headers repeated in consolidated (ZZ) reports.
+
+Examples:
+  asc finance reports parse --file finance_report_2025-12_FINANCIAL_US.tsv.gz
+  asc finance reports parse --file "us.tsv.gz,eu.tsv.gz" --output csv`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			paths := shared.SplitCSV(*files)
+			if len(paths) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			rows, err := shared.ParseReportFiles(paths, asc.ParseFinancialReport)
+			if err != nil {
+				return fmt.Errorf("finance reports parse: %w", err)
+			}
+			return shared.PrintOutput(&asc.FinancialReportRows{Data: rows}, *output, *pretty)
+		},
+	}
+}
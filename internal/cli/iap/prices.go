@@ -74,7 +74,7 @@ func IAPPricesCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	iapID := fs.String("iap-id", "", "In-app purchase ID")
 	territory := fs.String("territory", "", "Territory filter (e.g., USA)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -955,44 +955,28 @@ func dedupePriceEntries(entries []iapPriceEntry) []iapPriceEntry {
 }
 
 func printIAPPricesResult(result *iapPricesResult, format string, pretty bool) error {
-	switch strings.ToLower(strings.TrimSpace(format)) {
-	case "json":
-		return shared.PrintOutput(result, "json", pretty)
+	format = strings.ToLower(strings.TrimSpace(format))
+	var render func([]string, [][]string)
+	switch format {
 	case "table":
-		if pretty {
-			return fmt.Errorf("--pretty is only valid with JSON output")
-		}
-		return printIAPPricesTable(result)
+		render = asc.RenderTable
 	case "markdown", "md":
-		if pretty {
-			return fmt.Errorf("--pretty is only valid with JSON output")
-		}
-		return printIAPPricesMarkdown(result)
+		render = asc.RenderMarkdown
+	case "csv":
+		render = asc.RenderCSV
+	case "tsv":
+		render = asc.RenderTSV
 	default:
-		return fmt.Errorf("unsupported format: %s", format)
+		return shared.PrintOutput(result, format, pretty)
 	}
-}
-
-func printIAPPricesTable(result *iapPricesResult) error {
-	headers := []string{"ID", "Name", "Product ID", "Type", "Base Territory", "Current Price", "Estimated Proceeds", "Scheduled Changes"}
-	rows := make([][]string, 0, len(result.IAPs))
-	for _, item := range result.IAPs {
-		rows = append(rows, []string{
-			item.ID,
-			compactIAPText(item.Name),
-			item.ProductID,
-			item.Type,
-			item.BaseTerritory,
-			formatIAPMoney(item.CurrentPrice),
-			formatIAPMoney(item.EstimatedProceeds),
-			formatScheduledChanges(item.ScheduledChanges),
-		})
+	if pretty {
+		return fmt.Errorf("--pretty is only valid with JSON output")
 	}
-	asc.RenderTable(headers, rows)
+	render(iapPricesRows(result))
 	return nil
 }
 
-func printIAPPricesMarkdown(result *iapPricesResult) error {
+func iapPricesRows(result *iapPricesResult) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Product ID", "Type", "Base Territory", "Current Price", "Estimated Proceeds", "Scheduled Changes"}
 	rows := make([][]string, 0, len(result.IAPs))
 	for _, item := range result.IAPs {
@@ -1007,8 +991,7 @@ func printIAPPricesMarkdown(result *iapPricesResult) error {
 			formatScheduledChanges(item.ScheduledChanges),
 		})
 	}
-	asc.RenderMarkdown(headers, rows)
-	return nil
+	return headers, rows
 }
 
 func formatIAPMoney(value *iapMoney) string {
@@ -0,0 +1,185 @@
+package iap
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	storeKitTypeConsumable              = "Consumable"
+	storeKitTypeNonConsumable           = "NonConsumable"
+	storeKitTypeNonRenewingSubscription = "NonRenewingSubscription"
+	storeKitTypeRecurringSubscription   = "RecurringSubscription"
+
+	storeKitPaymentModeFree       = "free"
+	storeKitPaymentModePayAsYouGo = "payAsYouGo"
+	storeKitPaymentModePayUpFront = "payUpFront"
+
+	storeKitVersionMajor = 3
+	storeKitVersionMinor = 0
+)
+
+// storeKitConfig is the JSON document Xcode reads from a .storekit file.
+// Field names and casing follow the file Xcode writes.
+type storeKitConfig struct {
+	Identifier               string                      `json:"identifier"`
+	NonRenewingSubscriptions []storeKitProduct           `json:"nonRenewingSubscriptions"`
+	Products                 []storeKitProduct           `json:"products"`
+	Settings                 storeKitSettings            `json:"settings"`
+	SubscriptionGroups       []storeKitSubscriptionGroup `json:"subscriptionGroups"`
+	Version                  storeKitVersion             `json:"version"`
+}
+
+type storeKitSettings struct {
+	ApplicationInternalID string `json:"_applicationInternalID,omitempty"`
+	Locale                string `json:"_locale,omitempty"`
+	Storefront            string `json:"_storefront,omitempty"`
+}
+
+type storeKitVersion struct {
+	Major int `json:"major"`
+	Minor int `json:"minor"`
+}
+
+type storeKitProduct struct {
+	DisplayPrice    string                 `json:"displayPrice"`
+	FamilyShareable bool                   `json:"familyShareable"`
+	InternalID      string                 `json:"internalID"`
+	Localizations   []storeKitLocalization `json:"localizations"`
+	ProductID       string                 `json:"productID"`
+	ReferenceName   string                 `json:"referenceName"`
+	Type            string                 `json:"type"`
+}
+
+type storeKitLocalization struct {
+	Description string `json:"description"`
+	DisplayName string `json:"displayName"`
+	Locale      string `json:"locale"`
+}
+
+type storeKitSubscriptionGroup struct {
+	ID            string                      `json:"id"`
+	Localizations []storeKitGroupLocalization `json:"localizations"`
+	Name          string                      `json:"name"`
+	Subscriptions []storeKitSubscription      `json:"subscriptions"`
+}
+
+type storeKitGroupLocalization struct {
+	CustomAppName string `json:"customAppName,omitempty"`
+	DisplayName   string `json:"displayName"`
+	Locale        string `json:"locale"`
+}
+
+type storeKitSubscription struct {
+	AdHocOffers                 []storeKitOffer        `json:"adHocOffers"`
+	CodeOffers                  []storeKitOffer        `json:"codeOffers"`
+	DisplayPrice                string                 `json:"displayPrice"`
+	FamilyShareable             bool                   `json:"familyShareable"`
+	GroupNumber                 int                    `json:"groupNumber"`
+	InternalID                  string                 `json:"internalID"`
+	IntroductoryOffer           *storeKitOffer         `json:"introductoryOffer"`
+	Localizations               []storeKitLocalization `json:"localizations"`
+	ProductID                   string                 `json:"productID"`
+	RecurringSubscriptionPeriod string                 `json:"recurringSubscriptionPeriod"`
+	ReferenceName               string                 `json:"referenceName"`
+	SubscriptionGroupID         string                 `json:"subscriptionGroupID"`
+	Type                        string                 `json:"type"`
+}
+
+// storeKitOffer is an introductory offer or, with an offer ID and reference
+// name, a promotional ("ad hoc") offer.
+type storeKitOffer struct {
+	DisplayPrice       string `json:"displayPrice,omitempty"`
+	InternalID         string `json:"internalID"`
+	NumberOfPeriods    int    `json:"numberOfPeriods"`
+	OfferID            string `json:"offerID,omitempty"`
+	PaymentMode        string `json:"paymentMode"`
+	ReferenceName      string `json:"referenceName,omitempty"`
+	SubscriptionPeriod string `json:"subscriptionPeriod"`
+}
+
+// storeKitPeriods maps App Store Connect subscription and offer durations to
+// the ISO 8601 periods used in StoreKit configuration files.
+var storeKitPeriods = map[string]string{
+	string(asc.SubscriptionOfferDurationThreeDays):   "P3D",
+	string(asc.SubscriptionOfferDurationOneWeek):     "P1W",
+	string(asc.SubscriptionOfferDurationTwoWeeks):    "P2W",
+	string(asc.SubscriptionOfferDurationOneMonth):    "P1M",
+	string(asc.SubscriptionOfferDurationTwoMonths):   "P2M",
+	string(asc.SubscriptionOfferDurationThreeMonths): "P3M",
+	string(asc.SubscriptionOfferDurationSixMonths):   "P6M",
+	string(asc.SubscriptionOfferDurationOneYear):     "P1Y",
+}
+
+var storeKitPaymentModes = map[string]string{
+	string(asc.SubscriptionOfferModeFreeTrial):  storeKitPaymentModeFree,
+	string(asc.SubscriptionOfferModePayAsYouGo): storeKitPaymentModePayAsYouGo,
+	string(asc.SubscriptionOfferModePayUpFront): storeKitPaymentModePayUpFront,
+}
+
+var storeKitProductTypes = map[string]string{
+	string(asc.InAppPurchaseTypeConsumable):              storeKitTypeConsumable,
+	string(asc.InAppPurchaseTypeNonConsumable):           storeKitTypeNonConsumable,
+	string(asc.InAppPurchaseTypeNonRenewingSubscription): storeKitTypeNonRenewingSubscription,
+}
+
+// IAPStoreKitCommand returns the StoreKit configuration file command group.
+func IAPStoreKitCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("storekit", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "storekit",
+		ShortUsage: "asc iap storekit <subcommand> [flags]",
+		ShortHelp:  "Export and import StoreKit configuration files.",
+		LongHelp: `Export and import StoreKit configuration files.
+
+Examples:
+  asc iap storekit export --app "APP_ID" --out "./Products.storekit"
+  asc iap storekit import --app "APP_ID" --file "./Products.storekit" --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			IAPStoreKitExportCommand(),
+			IAPStoreKitImportCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// storeKitPeriod converts an App Store Connect duration such as ONE_MONTH to
+// a StoreKit period such as P1M.
+func storeKitPeriod(duration string) string {
+	return storeKitPeriods[strings.ToUpper(strings.TrimSpace(duration))]
+}
+
+// ascDuration converts a StoreKit period back to an App Store Connect
+// duration, or returns "" when there is no equivalent.
+func ascDuration(period string) string {
+	period = strings.ToUpper(strings.TrimSpace(period))
+	for duration, value := range storeKitPeriods {
+		if value == period {
+			return duration
+		}
+	}
+	return ""
+}
+
+// storeKitLocale converts an App Store Connect locale (en-US) to the form
+// StoreKit configuration files use (en_US).
+func storeKitLocale(locale string) string {
+	return strings.ReplaceAll(strings.TrimSpace(locale), "-", "_")
+}
+
+// ascLocale converts a StoreKit configuration locale back to the form App
+// Store Connect uses.
+func ascLocale(locale string) string {
+	return strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
+}
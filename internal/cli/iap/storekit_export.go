@@ -0,0 +1,627 @@
+package iap
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+const defaultStoreKitExportWorkers = 4
+
+// IAPStoreKitExportCommand returns the StoreKit configuration export subcommand.
+func IAPStoreKitExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	storefront := fs.String("storefront", "USA", "Territory used for display prices (e.g., USA)")
+	out := fs.String("out", "", "Path to write the .storekit file (default: stdout)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format for the --out summary: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc iap storekit export --app APP_ID [flags]",
+		ShortHelp:  "Export products as a StoreKit configuration file.",
+		LongHelp: `Export products as a StoreKit configuration file.
+
+Builds a .storekit file for Xcode from the app's in-app purchases,
+subscription groups, and subscriptions, with their localizations,
+introductory offers, and promotional offers. Display prices are the
+current prices in --storefront. Products without a price there are
+exported with an empty display price and counted as missing prices.
+
+Without --out the file is written to stdout. With --out it is written to
+that path and a summary is printed.
+
+Examples:
+  asc iap storekit export --app "APP_ID" > Products.storekit
+  asc iap storekit export --app "APP_ID" --out "./Products.storekit"
+  asc iap storekit export --app "APP_ID" --storefront "GBR" --out "./UK.storekit" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			territory := strings.ToUpper(strings.TrimSpace(*storefront))
+			if territory == "" {
+				fmt.Fprintln(os.Stderr, "Error: --storefront must not be empty")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("iap storekit export: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			exporter := &storeKitExporter{
+				client:     client,
+				appID:      resolvedAppID,
+				storefront: territory,
+				now:        time.Now().UTC(),
+			}
+			config, err := exporter.export(requestCtx)
+			if err != nil {
+				return fmt.Errorf("iap storekit export: %w", err)
+			}
+
+			data, err := json.MarshalIndent(config, "", "  ")
+			if err != nil {
+				return fmt.Errorf("iap storekit export: encode configuration: %w", err)
+			}
+			data = append(data, '\n')
+
+			outPath := strings.TrimSpace(*out)
+			if outPath == "" {
+				if _, err := os.Stdout.Write(data); err != nil {
+					return fmt.Errorf("iap storekit export: %w", err)
+				}
+				return nil
+			}
+			if err := writeStoreKitFile(outPath, data); err != nil {
+				return fmt.Errorf("iap storekit export: write %s: %w", outPath, err)
+			}
+
+			return shared.PrintOutput(storeKitExportSummary(config, resolvedAppID, filepath.Clean(outPath)), *output, *pretty)
+		},
+	}
+}
+
+type storeKitExporter struct {
+	client     *asc.Client
+	appID      string
+	storefront string
+	now        time.Time
+}
+
+func (e *storeKitExporter) export(ctx context.Context) (*storeKitConfig, error) {
+	iaps, err := e.fetchIAPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	products := make([]storeKitProduct, len(iaps))
+	err = forEachStoreKitItem(ctx, len(iaps), func(ctx context.Context, idx int) error {
+		product, err := e.product(ctx, iaps[idx])
+		if err != nil {
+			return fmt.Errorf("in-app purchase %s: %w", iaps[idx].Attributes.ProductID, err)
+		}
+		products[idx] = product
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := e.fetchGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildStoreKitConfig(e.appID, e.storefront, products, groups), nil
+}
+
+func (e *storeKitExporter) fetchIAPs(ctx context.Context) ([]asc.Resource[asc.InAppPurchaseV2Attributes], error) {
+	firstPage, err := e.client.GetInAppPurchasesV2(ctx, e.appID, asc.WithIAPLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("fetch in-app purchases: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return e.client.GetInAppPurchasesV2(ctx, e.appID, asc.WithIAPNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate in-app purchases: %w", err)
+	}
+	resp, ok := paginated.(*asc.InAppPurchasesV2Response)
+	if !ok {
+		return nil, fmt.Errorf("unexpected in-app purchases response type %T", paginated)
+	}
+	return resp.Data, nil
+}
+
+func (e *storeKitExporter) product(ctx context.Context, iap asc.Resource[asc.InAppPurchaseV2Attributes]) (storeKitProduct, error) {
+	locs, err := e.client.GetInAppPurchaseLocalizations(ctx, iap.ID, asc.WithIAPLocalizationsLimit(200))
+	if err != nil {
+		return storeKitProduct{}, fmt.Errorf("fetch localizations: %w", err)
+	}
+	summary, err := resolveIAPPriceSummary(ctx, e.client, iap, e.storefront, e.now)
+	if err != nil {
+		return storeKitProduct{}, err
+	}
+	price := ""
+	if summary.CurrentPrice != nil {
+		price = summary.CurrentPrice.Amount
+	}
+	return storeKitProductFromIAP(iap, locs.Data, price), nil
+}
+
+func (e *storeKitExporter) fetchGroups(ctx context.Context) ([]storeKitSubscriptionGroup, error) {
+	firstPage, err := e.client.GetSubscriptionGroups(ctx, e.appID, asc.WithSubscriptionGroupsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("fetch subscription groups: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return e.client.GetSubscriptionGroups(ctx, e.appID, asc.WithSubscriptionGroupsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate subscription groups: %w", err)
+	}
+	resp, ok := paginated.(*asc.SubscriptionGroupsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected subscription groups response type %T", paginated)
+	}
+
+	groups := make([]storeKitSubscriptionGroup, 0, len(resp.Data))
+	for _, group := range resp.Data {
+		locs, err := e.client.GetSubscriptionGroupLocalizations(ctx, group.ID, asc.WithSubscriptionGroupLocalizationsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("subscription group %s: fetch localizations: %w", group.ID, err)
+		}
+		subs, err := e.fetchSubscriptions(ctx, group.ID)
+		if err != nil {
+			return nil, fmt.Errorf("subscription group %s: %w", group.ID, err)
+		}
+
+		exported := make([]storeKitSubscription, len(subs))
+		err = forEachStoreKitItem(ctx, len(subs), func(ctx context.Context, idx int) error {
+			sub, err := e.subscription(ctx, group.ID, subs[idx])
+			if err != nil {
+				return fmt.Errorf("subscription %s: %w", subs[idx].Attributes.ProductID, err)
+			}
+			exported[idx] = sub
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, storeKitGroupFromASC(group, locs.Data, exported))
+	}
+	return groups, nil
+}
+
+func (e *storeKitExporter) fetchSubscriptions(ctx context.Context, groupID string) ([]asc.Resource[asc.SubscriptionAttributes], error) {
+	firstPage, err := e.client.GetSubscriptions(ctx, groupID, asc.WithSubscriptionsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("fetch subscriptions: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return e.client.GetSubscriptions(ctx, groupID, asc.WithSubscriptionsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate subscriptions: %w", err)
+	}
+	resp, ok := paginated.(*asc.SubscriptionsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected subscriptions response type %T", paginated)
+	}
+	return resp.Data, nil
+}
+
+func (e *storeKitExporter) subscription(ctx context.Context, groupID string, sub asc.Resource[asc.SubscriptionAttributes]) (storeKitSubscription, error) {
+	locs, err := e.client.GetSubscriptionLocalizations(ctx, sub.ID, asc.WithSubscriptionLocalizationsLimit(200))
+	if err != nil {
+		return storeKitSubscription{}, fmt.Errorf("fetch localizations: %w", err)
+	}
+
+	prices, err := e.client.GetSubscriptionPrices(
+		ctx,
+		sub.ID,
+		asc.WithSubscriptionPricesTerritory(e.storefront),
+		asc.WithSubscriptionPricesInclude([]string{"subscriptionPricePoint"}),
+		asc.WithSubscriptionPricesPricePointFields([]string{"customerPrice"}),
+		asc.WithSubscriptionPricesLimit(200),
+	)
+	if err != nil {
+		return storeKitSubscription{}, fmt.Errorf("fetch prices: %w", err)
+	}
+
+	introOffers, err := e.client.GetSubscriptionIntroductoryOffers(
+		ctx,
+		sub.ID,
+		asc.WithSubscriptionIntroductoryOffersTerritory(e.storefront),
+		asc.WithSubscriptionIntroductoryOffersInclude([]string{"subscriptionPricePoint"}),
+		asc.WithSubscriptionIntroductoryOffersPricePointFields([]string{"customerPrice"}),
+		asc.WithSubscriptionIntroductoryOffersLimit(200),
+	)
+	if err != nil {
+		return storeKitSubscription{}, fmt.Errorf("fetch introductory offers: %w", err)
+	}
+
+	promoOffers, err := e.client.GetSubscriptionPromotionalOffers(ctx, sub.ID, asc.WithSubscriptionPromotionalOffersLimit(200))
+	if err != nil {
+		return storeKitSubscription{}, fmt.Errorf("fetch promotional offers: %w", err)
+	}
+	adHocOffers := make([]storeKitOffer, 0, len(promoOffers.Data))
+	for _, offer := range promoOffers.Data {
+		offerPrices, err := e.client.GetSubscriptionPromotionalOfferPrices(
+			ctx,
+			offer.ID,
+			asc.WithSubscriptionPromotionalOfferPricesTerritory(e.storefront),
+			asc.WithSubscriptionPromotionalOfferPricesInclude([]string{"subscriptionPricePoint"}),
+			asc.WithSubscriptionPromotionalOfferPricesPricePointFields([]string{"customerPrice"}),
+			asc.WithSubscriptionPromotionalOfferPricesLimit(200),
+		)
+		if err != nil {
+			return storeKitSubscription{}, fmt.Errorf("fetch promotional offer %s prices: %w", offer.ID, err)
+		}
+		adHocOffers = append(adHocOffers, storeKitPromotionalOffer(offer, firstStoreKitOfferPrice(offerPrices)))
+	}
+
+	return storeKitSubscription{
+		AdHocOffers:                 adHocOffers,
+		CodeOffers:                  []storeKitOffer{},
+		DisplayPrice:                currentStoreKitSubscriptionPrice(prices, e.now),
+		FamilyShareable:             sub.Attributes.FamilySharable,
+		GroupNumber:                 sub.Attributes.GroupLevel,
+		InternalID:                  sub.ID,
+		IntroductoryOffer:           activeStoreKitIntroductoryOffer(introOffers, e.now),
+		Localizations:               storeKitLocalizationsFromSubscription(locs.Data),
+		ProductID:                   sub.Attributes.ProductID,
+		RecurringSubscriptionPeriod: storeKitPeriod(sub.Attributes.SubscriptionPeriod),
+		ReferenceName:               sub.Attributes.Name,
+		SubscriptionGroupID:         groupID,
+		Type:                        storeKitTypeRecurringSubscription,
+	}, nil
+}
+
+// forEachStoreKitItem runs fn for every index on a small worker pool and
+// returns the first error, cancelling the remaining work.
+func forEachStoreKitItem(ctx context.Context, n int, fn func(context.Context, int) error) error {
+	if n == 0 {
+		return nil
+	}
+	workers := max(min(n, defaultStoreKitExportWorkers), 1)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := make(chan struct{}, workers)
+	errs := make(chan error, n)
+	var once sync.Once
+	var wg sync.WaitGroup
+
+	for idx := range n {
+		wg.Go(func() {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			if err := fn(ctx, idx); err != nil {
+				once.Do(cancel)
+				errs <- err
+			}
+		})
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context cancelled: %w", err)
+	}
+	return nil
+}
+
+// buildStoreKitConfig assembles the file, splitting non-renewing
+// subscriptions out of the products list the way Xcode does and sorting
+// everything so repeated exports diff cleanly.
+func buildStoreKitConfig(appID, storefront string, products []storeKitProduct, groups []storeKitSubscriptionGroup) *storeKitConfig {
+	config := &storeKitConfig{
+		Identifier:               fmt.Sprintf("%08X", crc32.ChecksumIEEE([]byte(appID))),
+		NonRenewingSubscriptions: []storeKitProduct{},
+		Products:                 []storeKitProduct{},
+		Settings: storeKitSettings{
+			ApplicationInternalID: appID,
+			Storefront:            storefront,
+		},
+		SubscriptionGroups: groups,
+		Version:            storeKitVersion{Major: storeKitVersionMajor, Minor: storeKitVersionMinor},
+	}
+	for _, product := range products {
+		if product.Type == storeKitTypeNonRenewingSubscription {
+			config.NonRenewingSubscriptions = append(config.NonRenewingSubscriptions, product)
+			continue
+		}
+		config.Products = append(config.Products, product)
+	}
+	sortStoreKitProducts(config.Products)
+	sortStoreKitProducts(config.NonRenewingSubscriptions)
+
+	if config.SubscriptionGroups == nil {
+		config.SubscriptionGroups = []storeKitSubscriptionGroup{}
+	}
+	sort.SliceStable(config.SubscriptionGroups, func(i, j int) bool {
+		return config.SubscriptionGroups[i].Name < config.SubscriptionGroups[j].Name
+	})
+	for _, group := range config.SubscriptionGroups {
+		sort.SliceStable(group.Subscriptions, func(i, j int) bool {
+			if group.Subscriptions[i].GroupNumber != group.Subscriptions[j].GroupNumber {
+				return group.Subscriptions[i].GroupNumber < group.Subscriptions[j].GroupNumber
+			}
+			return group.Subscriptions[i].ProductID < group.Subscriptions[j].ProductID
+		})
+	}
+	return config
+}
+
+func sortStoreKitProducts(products []storeKitProduct) {
+	sort.SliceStable(products, func(i, j int) bool {
+		return products[i].ProductID < products[j].ProductID
+	})
+}
+
+func storeKitProductFromIAP(
+	iap asc.Resource[asc.InAppPurchaseV2Attributes],
+	locs []asc.Resource[asc.InAppPurchaseLocalizationAttributes],
+	price string,
+) storeKitProduct {
+	localizations := make([]storeKitLocalization, 0, len(locs))
+	for _, loc := range locs {
+		localizations = append(localizations, storeKitLocalization{
+			Description: loc.Attributes.Description,
+			DisplayName: loc.Attributes.Name,
+			Locale:      storeKitLocale(loc.Attributes.Locale),
+		})
+	}
+	sortStoreKitLocalizations(localizations)
+
+	productType := storeKitProductTypes[strings.ToUpper(iap.Attributes.InAppPurchaseType)]
+	if productType == "" {
+		productType = iap.Attributes.InAppPurchaseType
+	}
+	return storeKitProduct{
+		DisplayPrice:    price,
+		FamilyShareable: iap.Attributes.FamilySharable,
+		InternalID:      iap.ID,
+		Localizations:   localizations,
+		ProductID:       iap.Attributes.ProductID,
+		ReferenceName:   iap.Attributes.Name,
+		Type:            productType,
+	}
+}
+
+func storeKitLocalizationsFromSubscription(locs []asc.Resource[asc.SubscriptionLocalizationAttributes]) []storeKitLocalization {
+	localizations := make([]storeKitLocalization, 0, len(locs))
+	for _, loc := range locs {
+		localizations = append(localizations, storeKitLocalization{
+			Description: loc.Attributes.Description,
+			DisplayName: loc.Attributes.Name,
+			Locale:      storeKitLocale(loc.Attributes.Locale),
+		})
+	}
+	sortStoreKitLocalizations(localizations)
+	return localizations
+}
+
+func sortStoreKitLocalizations(localizations []storeKitLocalization) {
+	sort.SliceStable(localizations, func(i, j int) bool {
+		return localizations[i].Locale < localizations[j].Locale
+	})
+}
+
+func storeKitGroupFromASC(
+	group asc.Resource[asc.SubscriptionGroupAttributes],
+	locs []asc.Resource[asc.SubscriptionGroupLocalizationAttributes],
+	subs []storeKitSubscription,
+) storeKitSubscriptionGroup {
+	localizations := make([]storeKitGroupLocalization, 0, len(locs))
+	for _, loc := range locs {
+		localizations = append(localizations, storeKitGroupLocalization{
+			CustomAppName: loc.Attributes.CustomAppName,
+			DisplayName:   loc.Attributes.Name,
+			Locale:        storeKitLocale(loc.Attributes.Locale),
+		})
+	}
+	sort.SliceStable(localizations, func(i, j int) bool {
+		return localizations[i].Locale < localizations[j].Locale
+	})
+	return storeKitSubscriptionGroup{
+		ID:            group.ID,
+		Localizations: localizations,
+		Name:          group.Attributes.ReferenceName,
+		Subscriptions: subs,
+	}
+}
+
+// currentStoreKitSubscriptionPrice returns the customer price of the price
+// in effect at now. Prices without a start date are the original price and
+// only apply when no dated price has started yet.
+func currentStoreKitSubscriptionPrice(resp *asc.SubscriptionPricesResponse, now time.Time) string {
+	pricePoints := storeKitPricePointPrices(resp.Included)
+	asOf := dateOnlyUTC(now)
+
+	current := ""
+	var currentStart *time.Time
+	for _, price := range resp.Data {
+		pricePointID, err := relationshipID(price.Relationships, "subscriptionPricePoint")
+		if err != nil {
+			continue
+		}
+		value, ok := pricePoints[pricePointID]
+		if !ok {
+			continue
+		}
+		start := parseScheduleDate(price.Attributes.StartDate)
+		switch {
+		case start != nil && start.After(asOf):
+			continue
+		case start == nil && current != "":
+			continue
+		case start != nil && currentStart != nil && !start.After(*currentStart):
+			continue
+		}
+		current = value
+		currentStart = start
+	}
+	return current
+}
+
+// activeStoreKitIntroductoryOffer returns the introductory offer running at
+// now, preferring the most recently started one.
+func activeStoreKitIntroductoryOffer(resp *asc.SubscriptionIntroductoryOffersResponse, now time.Time) *storeKitOffer {
+	pricePoints := storeKitPricePointPrices(resp.Included)
+	asOf := dateOnlyUTC(now)
+
+	var active *storeKitOffer
+	var activeStart *time.Time
+	for _, offer := range resp.Data {
+		start := parseScheduleDate(offer.Attributes.StartDate)
+		end := parseScheduleDate(offer.Attributes.EndDate)
+		if (start != nil && start.After(asOf)) || (end != nil && end.Before(asOf)) {
+			continue
+		}
+		if active != nil && (start == nil || (activeStart != nil && !start.After(*activeStart))) {
+			continue
+		}
+
+		converted := storeKitOffer{
+			InternalID:         offer.ID,
+			NumberOfPeriods:    offer.Attributes.NumberOfPeriods,
+			PaymentMode:        storeKitPaymentModes[string(offer.Attributes.OfferMode)],
+			SubscriptionPeriod: storeKitPeriod(string(offer.Attributes.Duration)),
+		}
+		if converted.PaymentMode != storeKitPaymentModeFree {
+			if pricePointID, err := relationshipID(offer.Relationships, "subscriptionPricePoint"); err == nil {
+				converted.DisplayPrice = pricePoints[pricePointID]
+			}
+		}
+		active = &converted
+		activeStart = start
+	}
+	return active
+}
+
+func storeKitPromotionalOffer(offer asc.Resource[asc.SubscriptionPromotionalOfferAttributes], price string) storeKitOffer {
+	converted := storeKitOffer{
+		InternalID:         offer.ID,
+		NumberOfPeriods:    offer.Attributes.NumberOfPeriods,
+		OfferID:            offer.Attributes.OfferCode,
+		PaymentMode:        storeKitPaymentModes[string(offer.Attributes.OfferMode)],
+		ReferenceName:      offer.Attributes.Name,
+		SubscriptionPeriod: storeKitPeriod(string(offer.Attributes.Duration)),
+	}
+	if converted.PaymentMode != storeKitPaymentModeFree {
+		converted.DisplayPrice = price
+	}
+	return converted
+}
+
+func firstStoreKitOfferPrice(resp *asc.SubscriptionPromotionalOfferPricesResponse) string {
+	pricePoints := storeKitPricePointPrices(resp.Included)
+	for _, price := range resp.Data {
+		pricePointID, err := relationshipID(price.Relationships, "subscriptionPricePoint")
+		if err != nil {
+			continue
+		}
+		if value, ok := pricePoints[pricePointID]; ok {
+			return value
+		}
+	}
+	return ""
+}
+
+// storeKitPricePointPrices maps included subscription price point IDs to
+// their customer prices.
+func storeKitPricePointPrices(raw json.RawMessage) map[string]string {
+	prices := make(map[string]string)
+	if len(raw) == 0 {
+		return prices
+	}
+
+	var included []struct {
+		Type       string `json:"type"`
+		ID         string `json:"id"`
+		Attributes struct {
+			CustomerPrice string `json:"customerPrice"`
+		} `json:"attributes"`
+	}
+	if err := json.Unmarshal(raw, &included); err != nil {
+		return prices
+	}
+	for _, item := range included {
+		if item.Type != string(asc.ResourceTypeSubscriptionPricePoints) {
+			continue
+		}
+		if value := strings.TrimSpace(item.Attributes.CustomerPrice); value != "" {
+			prices[item.ID] = value
+		}
+	}
+	return prices
+}
+
+func storeKitExportSummary(config *storeKitConfig, appID, path string) *asc.StoreKitExportResult {
+	result := &asc.StoreKitExportResult{
+		AppID:                    appID,
+		Storefront:               config.Settings.Storefront,
+		Path:                     path,
+		Products:                 len(config.Products),
+		NonRenewingSubscriptions: len(config.NonRenewingSubscriptions),
+		SubscriptionGroups:       len(config.SubscriptionGroups),
+	}
+	for _, products := range [][]storeKitProduct{config.Products, config.NonRenewingSubscriptions} {
+		for _, product := range products {
+			if product.DisplayPrice == "" {
+				result.MissingPrices++
+			}
+		}
+	}
+	for _, group := range config.SubscriptionGroups {
+		result.Subscriptions += len(group.Subscriptions)
+		for _, sub := range group.Subscriptions {
+			if sub.DisplayPrice == "" {
+				result.MissingPrices++
+			}
+		}
+	}
+	return result
+}
+
+func writeStoreKitFile(path string, data []byte) error {
+	return fileutil.WriteFileAtomic(path, data, 0o600)
+}
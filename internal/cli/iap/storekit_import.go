@@ -0,0 +1,472 @@
+package iap
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	storeKitActionCreateIAP          = "create-iap"
+	storeKitActionCreateGroup        = "create-group"
+	storeKitActionCreateSubscription = "create-subscription"
+)
+
+// storeKitSubscriptionPeriods lists the durations App Store Connect accepts
+// for auto-renewable subscriptions; offers allow a few shorter ones.
+var storeKitSubscriptionPeriods = map[string]bool{
+	string(asc.SubscriptionPeriodOneWeek):     true,
+	string(asc.SubscriptionPeriodOneMonth):    true,
+	string(asc.SubscriptionPeriodTwoMonths):   true,
+	string(asc.SubscriptionPeriodThreeMonths): true,
+	string(asc.SubscriptionPeriodSixMonths):   true,
+	string(asc.SubscriptionPeriodOneYear):     true,
+}
+
+type storeKitImportClient interface {
+	GetInAppPurchasesV2(ctx context.Context, appID string, opts ...asc.IAPOption) (*asc.InAppPurchasesV2Response, error)
+	GetSubscriptionGroups(ctx context.Context, appID string, opts ...asc.SubscriptionGroupsOption) (*asc.SubscriptionGroupsResponse, error)
+	GetSubscriptions(ctx context.Context, groupID string, opts ...asc.SubscriptionsOption) (*asc.SubscriptionsResponse, error)
+	CreateInAppPurchaseV2(ctx context.Context, appID string, attrs asc.InAppPurchaseV2CreateAttributes) (*asc.InAppPurchaseV2Response, error)
+	CreateInAppPurchaseLocalization(ctx context.Context, iapID string, attrs asc.InAppPurchaseLocalizationCreateAttributes) (*asc.InAppPurchaseLocalizationResponse, error)
+	CreateSubscriptionGroup(ctx context.Context, appID string, attrs asc.SubscriptionGroupCreateAttributes) (*asc.SubscriptionGroupResponse, error)
+	CreateSubscriptionGroupLocalization(ctx context.Context, groupID string, attrs asc.SubscriptionGroupLocalizationCreateAttributes) (*asc.SubscriptionGroupLocalizationResponse, error)
+	CreateSubscription(ctx context.Context, groupID string, attrs asc.SubscriptionCreateAttributes) (*asc.SubscriptionResponse, error)
+	CreateSubscriptionLocalization(ctx context.Context, subscriptionID string, attrs asc.SubscriptionLocalizationCreateAttributes) (*asc.SubscriptionLocalizationResponse, error)
+}
+
+type storeKitImportStep struct {
+	action       string
+	product      storeKitProduct
+	group        *shared.PlannedResource[storeKitSubscriptionGroup]
+	subscription storeKitSubscription
+}
+
+// IAPStoreKitImportCommand returns the StoreKit configuration import subcommand.
+func IAPStoreKitImportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env; defaults to _applicationInternalID in the file)")
+	file := fs.String("file", "", "Path to a .storekit file (required)")
+	dryRun := fs.Bool("dry-run", false, "Print the plan without creating anything")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "import",
+		ShortUsage: "asc iap storekit import --file ./Products.storekit [flags]",
+		ShortHelp:  "Create missing products from a StoreKit configuration file.",
+		LongHelp: `Create missing products from a StoreKit configuration file.
+
+Compares a .storekit file with the app's products, prints the plan, and
+creates what is missing:
+  - consumable, non-consumable, and non-renewing subscription in-app
+    purchases, with their localizations
+  - subscription groups, matched by name, with their localizations
+  - auto-renewable subscriptions, with their localizations
+
+Products match by product ID and existing products are never changed.
+Prices and offers are not imported; set them with "asc iap price-schedules
+create" and the "asc subscriptions" commands once the products exist.
+
+Examples:
+  asc iap storekit import --app "APP_ID" --file "./Products.storekit" --dry-run
+  asc iap storekit import --app "APP_ID" --file "./Products.storekit"
+  asc iap storekit import --file "./Products.storekit" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			fileValue := strings.TrimSpace(*file)
+			if fileValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			config, err := readStoreKitConfig(fileValue)
+			if err != nil {
+				return fmt.Errorf("iap storekit import: %w", err)
+			}
+
+			resolvedAppID := shared.ResolveAppID(*appID)
+			fileAppID := strings.TrimSpace(config.Settings.ApplicationInternalID)
+			if resolvedAppID == "" {
+				resolvedAppID = fileAppID
+			}
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID or _applicationInternalID in the file)")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("iap storekit import: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			steps, err := planStoreKitImport(requestCtx, client, resolvedAppID, config)
+			if err != nil {
+				return fmt.Errorf("iap storekit import: %w", err)
+			}
+
+			var applyErr error
+			actions := storeKitImportActions(steps)
+			if !*dryRun {
+				applyErr = applyStoreKitImport(requestCtx, client, resolvedAppID, steps, actions)
+			}
+
+			result := &asc.StoreKitImportResult{
+				File:    filepath.Clean(fileValue),
+				AppID:   resolvedAppID,
+				DryRun:  *dryRun,
+				Actions: actions,
+			}
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if applyErr != nil {
+				return fmt.Errorf("iap storekit import: %w", applyErr)
+			}
+			return nil
+		},
+	}
+}
+
+func readStoreKitConfig(path string) (*storeKitConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read configuration: %w", err)
+	}
+	var config storeKitConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("parse configuration %s: %w", path, err)
+	}
+	if err := validateStoreKitConfig(&config); err != nil {
+		return nil, fmt.Errorf("invalid configuration %s: %w", path, err)
+	}
+	return &config, nil
+}
+
+func validateStoreKitConfig(config *storeKitConfig) error {
+	productIDs := make(map[string]struct{})
+	checkProductID := func(productID string) error {
+		productID = strings.TrimSpace(productID)
+		if productID == "" {
+			return fmt.Errorf("a product is missing its productID")
+		}
+		if _, ok := productIDs[productID]; ok {
+			return fmt.Errorf("product %q is listed more than once", productID)
+		}
+		productIDs[productID] = struct{}{}
+		return nil
+	}
+
+	for _, products := range [][]storeKitProduct{config.Products, config.NonRenewingSubscriptions} {
+		for _, product := range products {
+			if err := checkProductID(product.ProductID); err != nil {
+				return err
+			}
+			if strings.TrimSpace(product.ReferenceName) == "" {
+				return fmt.Errorf("product %q is missing its referenceName", product.ProductID)
+			}
+			if _, err := ascIAPType(product.Type); err != nil {
+				return fmt.Errorf("product %q: %w", product.ProductID, err)
+			}
+		}
+	}
+
+	groupNames := make(map[string]struct{}, len(config.SubscriptionGroups))
+	for _, group := range config.SubscriptionGroups {
+		name := strings.TrimSpace(group.Name)
+		if name == "" {
+			return fmt.Errorf("a subscription group is missing its name")
+		}
+		key := strings.ToLower(name)
+		if _, ok := groupNames[key]; ok {
+			return fmt.Errorf("subscription group %q is listed more than once", name)
+		}
+		groupNames[key] = struct{}{}
+
+		for _, sub := range group.Subscriptions {
+			if err := checkProductID(sub.ProductID); err != nil {
+				return err
+			}
+			if strings.TrimSpace(sub.ReferenceName) == "" {
+				return fmt.Errorf("subscription %q is missing its referenceName", sub.ProductID)
+			}
+			if !storeKitSubscriptionPeriods[ascDuration(sub.RecurringSubscriptionPeriod)] {
+				return fmt.Errorf("subscription %q has unsupported recurringSubscriptionPeriod %q", sub.ProductID, sub.RecurringSubscriptionPeriod)
+			}
+		}
+	}
+	return nil
+}
+
+// ascIAPType converts a StoreKit product type to an App Store Connect
+// in-app purchase type.
+func ascIAPType(productType string) (string, error) {
+	for ascType, value := range storeKitProductTypes {
+		if value == strings.TrimSpace(productType) {
+			return ascType, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported product type %q", productType)
+}
+
+// planStoreKitImport lists the app's live products and returns a step for
+// every product and group in the file that does not exist yet.
+func planStoreKitImport(ctx context.Context, client storeKitImportClient, appID string, config *storeKitConfig) ([]*storeKitImportStep, error) {
+	live := make(map[string]struct{})
+
+	firstIAPs, err := client.GetInAppPurchasesV2(ctx, appID, asc.WithIAPLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("fetch in-app purchases: %w", err)
+	}
+	paginatedIAPs, err := asc.PaginateAll(ctx, firstIAPs, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetInAppPurchasesV2(ctx, appID, asc.WithIAPNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate in-app purchases: %w", err)
+	}
+	iaps, ok := paginatedIAPs.(*asc.InAppPurchasesV2Response)
+	if !ok {
+		return nil, fmt.Errorf("unexpected in-app purchases response type %T", paginatedIAPs)
+	}
+	for _, iap := range iaps.Data {
+		live[iap.Attributes.ProductID] = struct{}{}
+	}
+
+	firstGroups, err := client.GetSubscriptionGroups(ctx, appID, asc.WithSubscriptionGroupsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("fetch subscription groups: %w", err)
+	}
+	paginatedGroups, err := asc.PaginateAll(ctx, firstGroups, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetSubscriptionGroups(ctx, appID, asc.WithSubscriptionGroupsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate subscription groups: %w", err)
+	}
+	liveGroups, ok := paginatedGroups.(*asc.SubscriptionGroupsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected subscription groups response type %T", paginatedGroups)
+	}
+	groupIDs := make(map[string]string, len(liveGroups.Data))
+	for _, group := range liveGroups.Data {
+		groupIDs[strings.ToLower(strings.TrimSpace(group.Attributes.ReferenceName))] = group.ID
+
+		firstSubs, err := client.GetSubscriptions(ctx, group.ID, asc.WithSubscriptionsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("fetch subscriptions for group %s: %w", group.ID, err)
+		}
+		paginatedSubs, err := asc.PaginateAll(ctx, firstSubs, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return client.GetSubscriptions(ctx, group.ID, asc.WithSubscriptionsNextURL(nextURL))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("paginate subscriptions for group %s: %w", group.ID, err)
+		}
+		subs, ok := paginatedSubs.(*asc.SubscriptionsResponse)
+		if !ok {
+			return nil, fmt.Errorf("unexpected subscriptions response type %T", paginatedSubs)
+		}
+		for _, sub := range subs.Data {
+			live[sub.Attributes.ProductID] = struct{}{}
+		}
+	}
+
+	var steps []*storeKitImportStep
+	for _, products := range [][]storeKitProduct{config.Products, config.NonRenewingSubscriptions} {
+		for _, product := range products {
+			if _, ok := live[strings.TrimSpace(product.ProductID)]; ok {
+				continue
+			}
+			steps = append(steps, &storeKitImportStep{action: storeKitActionCreateIAP, product: product})
+		}
+	}
+
+	for _, groupConfig := range config.SubscriptionGroups {
+		group := &shared.PlannedResource[storeKitSubscriptionGroup]{
+			Config: groupConfig,
+			ID:     groupIDs[strings.ToLower(strings.TrimSpace(groupConfig.Name))],
+		}
+		if group.ID == "" {
+			steps = append(steps, &storeKitImportStep{action: storeKitActionCreateGroup, group: group})
+		}
+		for _, sub := range groupConfig.Subscriptions {
+			if _, ok := live[strings.TrimSpace(sub.ProductID)]; ok {
+				continue
+			}
+			steps = append(steps, &storeKitImportStep{action: storeKitActionCreateSubscription, group: group, subscription: sub})
+		}
+	}
+	return steps, nil
+}
+
+func storeKitImportActions(steps []*storeKitImportStep) []asc.StoreKitImportAction {
+	actions := make([]asc.StoreKitImportAction, 0, len(steps))
+	for _, step := range steps {
+		action := asc.StoreKitImportAction{
+			Action: step.action,
+			Status: shared.StepStatusPlanned,
+		}
+		switch step.action {
+		case storeKitActionCreateIAP:
+			action.ProductID = step.product.ProductID
+			action.Name = step.product.ReferenceName
+			action.Details = storeKitImportDetails(step.product.Type, len(storeKitNamedLocalizations(step.product.Localizations)))
+		case storeKitActionCreateGroup:
+			action.Name = step.group.Config.Name
+			action.Details = storeKitImportDetails("", len(storeKitNamedGroupLocalizations(step.group.Config.Localizations)))
+		case storeKitActionCreateSubscription:
+			action.ProductID = step.subscription.ProductID
+			action.Name = step.subscription.ReferenceName
+			action.Details = storeKitImportDetails(
+				fmt.Sprintf("%s in %q", step.subscription.RecurringSubscriptionPeriod, step.group.Config.Name),
+				len(storeKitNamedLocalizations(step.subscription.Localizations)),
+			)
+		}
+		actions = append(actions, action)
+	}
+	return actions
+}
+
+func storeKitImportDetails(prefix string, localizations int) string {
+	details := fmt.Sprintf("%d localizations", localizations)
+	if localizations == 1 {
+		details = "1 localization"
+	}
+	if prefix == "" {
+		return details
+	}
+	return prefix + ", " + details
+}
+
+// applyStoreKitImport runs steps in order and records each outcome in
+// actions. It stops at the first failure and marks the remaining steps as
+// skipped.
+func applyStoreKitImport(ctx context.Context, client storeKitImportClient, appID string, steps []*storeKitImportStep, actions []asc.StoreKitImportAction) error {
+	failed, err := shared.ApplySteps(len(steps), func(i int) error {
+		id, err := applyStoreKitImportStep(ctx, client, appID, steps[i])
+		actions[i].ID = id
+		return err
+	}, func(i int, status string, err error) {
+		actions[i].Status = status
+		if err != nil {
+			actions[i].Error = err.Error()
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("%s %q: %w", steps[failed].action, actions[failed].Name, err)
+	}
+	return nil
+}
+
+// applyStoreKitImportStep creates one resource and its localizations and
+// returns the new resource ID, which is also set when only a localization
+// failed.
+func applyStoreKitImportStep(ctx context.Context, client storeKitImportClient, appID string, step *storeKitImportStep) (string, error) {
+	switch step.action {
+	case storeKitActionCreateIAP:
+		iapType, err := ascIAPType(step.product.Type)
+		if err != nil {
+			return "", err
+		}
+		resp, err := client.CreateInAppPurchaseV2(ctx, appID, asc.InAppPurchaseV2CreateAttributes{
+			Name:              strings.TrimSpace(step.product.ReferenceName),
+			ProductID:         strings.TrimSpace(step.product.ProductID),
+			InAppPurchaseType: iapType,
+			FamilySharable:    step.product.FamilyShareable,
+		})
+		if err != nil {
+			return "", err
+		}
+		for _, loc := range storeKitNamedLocalizations(step.product.Localizations) {
+			if _, err := client.CreateInAppPurchaseLocalization(ctx, resp.Data.ID, asc.InAppPurchaseLocalizationCreateAttributes{
+				Name:        strings.TrimSpace(loc.DisplayName),
+				Locale:      ascLocale(loc.Locale),
+				Description: strings.TrimSpace(loc.Description),
+			}); err != nil {
+				return resp.Data.ID, fmt.Errorf("create localization %s: %w", ascLocale(loc.Locale), err)
+			}
+		}
+		return resp.Data.ID, nil
+	case storeKitActionCreateGroup:
+		resp, err := client.CreateSubscriptionGroup(ctx, appID, asc.SubscriptionGroupCreateAttributes{
+			ReferenceName: strings.TrimSpace(step.group.Config.Name),
+		})
+		if err != nil {
+			return "", err
+		}
+		step.group.ID = resp.Data.ID
+		for _, loc := range storeKitNamedGroupLocalizations(step.group.Config.Localizations) {
+			if _, err := client.CreateSubscriptionGroupLocalization(ctx, resp.Data.ID, asc.SubscriptionGroupLocalizationCreateAttributes{
+				Name:          strings.TrimSpace(loc.DisplayName),
+				CustomAppName: strings.TrimSpace(loc.CustomAppName),
+				Locale:        ascLocale(loc.Locale),
+			}); err != nil {
+				return resp.Data.ID, fmt.Errorf("create localization %s: %w", ascLocale(loc.Locale), err)
+			}
+		}
+		return resp.Data.ID, nil
+	case storeKitActionCreateSubscription:
+		sub := step.subscription
+		attrs := asc.SubscriptionCreateAttributes{
+			Name:               strings.TrimSpace(sub.ReferenceName),
+			ProductID:          strings.TrimSpace(sub.ProductID),
+			SubscriptionPeriod: ascDuration(sub.RecurringSubscriptionPeriod),
+		}
+		if sub.FamilyShareable {
+			attrs.FamilySharable = &sub.FamilyShareable
+		}
+		if sub.GroupNumber > 0 {
+			attrs.GroupLevel = &sub.GroupNumber
+		}
+		resp, err := client.CreateSubscription(ctx, step.group.ID, attrs)
+		if err != nil {
+			return "", err
+		}
+		for _, loc := range storeKitNamedLocalizations(sub.Localizations) {
+			if _, err := client.CreateSubscriptionLocalization(ctx, resp.Data.ID, asc.SubscriptionLocalizationCreateAttributes{
+				Name:        strings.TrimSpace(loc.DisplayName),
+				Locale:      ascLocale(loc.Locale),
+				Description: strings.TrimSpace(loc.Description),
+			}); err != nil {
+				return resp.Data.ID, fmt.Errorf("create localization %s: %w", ascLocale(loc.Locale), err)
+			}
+		}
+		return resp.Data.ID, nil
+	default:
+		return "", fmt.Errorf("unknown action %q", step.action)
+	}
+}
+
+// storeKitNamedLocalizations drops localizations without a display name,
+// which App Store Connect requires.
+func storeKitNamedLocalizations(locs []storeKitLocalization) []storeKitLocalization {
+	named := make([]storeKitLocalization, 0, len(locs))
+	for _, loc := range locs {
+		if strings.TrimSpace(loc.DisplayName) != "" && strings.TrimSpace(loc.Locale) != "" {
+			named = append(named, loc)
+		}
+	}
+	return named
+}
+
+func storeKitNamedGroupLocalizations(locs []storeKitGroupLocalization) []storeKitGroupLocalization {
+	named := make([]storeKitGroupLocalization, 0, len(locs))
+	for _, loc := range locs {
+		if strings.TrimSpace(loc.DisplayName) != "" && strings.TrimSpace(loc.Locale) != "" {
+			named = append(named, loc)
+		}
+	}
+	return named
+}
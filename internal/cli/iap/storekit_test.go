@@ -0,0 +1,356 @@
+package iap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func TestStoreKitConversions(t *testing.T) {
+	for duration, period := range map[string]string{"ONE_MONTH": "P1M", "three_days": "P3D", "ONE_YEAR": "P1Y", "UNKNOWN": ""} {
+		if got := storeKitPeriod(duration); got != period {
+			t.Errorf("storeKitPeriod(%q) = %q, want %q", duration, got, period)
+		}
+	}
+	if got := ascDuration("p2w"); got != "TWO_WEEKS" {
+		t.Fatalf("ascDuration(p2w) = %q", got)
+	}
+	if got := ascDuration("P5D"); got != "" {
+		t.Fatalf("expected no duration for P5D, got %q", got)
+	}
+	if storeKitLocale("en-US") != "en_US" || ascLocale("zh_Hans") != "zh-Hans" {
+		t.Fatal("unexpected locale conversion")
+	}
+	if got, err := ascIAPType("NonRenewingSubscription"); err != nil || got != "NON_RENEWING_SUBSCRIPTION" {
+		t.Fatalf("ascIAPType() = %q, %v", got, err)
+	}
+	if _, err := ascIAPType("RecurringSubscription"); err == nil {
+		t.Fatal("expected error for recurring subscription type")
+	}
+}
+
+func TestBuildStoreKitConfig(t *testing.T) {
+	products := []storeKitProduct{
+		storeKitProductFromIAP(
+			asc.Resource[asc.InAppPurchaseV2Attributes]{ID: "iap-2", Attributes: asc.InAppPurchaseV2Attributes{Name: "Gems", ProductID: "com.example.gems", InAppPurchaseType: "CONSUMABLE"}},
+			[]asc.Resource[asc.InAppPurchaseLocalizationAttributes]{
+				{Attributes: asc.InAppPurchaseLocalizationAttributes{Name: "Gemmes", Locale: "fr-FR"}},
+				{Attributes: asc.InAppPurchaseLocalizationAttributes{Name: "Gems", Locale: "en-US", Description: "A pile of gems"}},
+			},
+			"1.99",
+		),
+		storeKitProductFromIAP(
+			asc.Resource[asc.InAppPurchaseV2Attributes]{ID: "iap-1", Attributes: asc.InAppPurchaseV2Attributes{Name: "Coins", ProductID: "com.example.coins", InAppPurchaseType: "CONSUMABLE"}},
+			nil,
+			"0.99",
+		),
+		storeKitProductFromIAP(
+			asc.Resource[asc.InAppPurchaseV2Attributes]{ID: "iap-3", Attributes: asc.InAppPurchaseV2Attributes{Name: "Season", ProductID: "com.example.season", InAppPurchaseType: "NON_RENEWING_SUBSCRIPTION"}},
+			nil,
+			"",
+		),
+	}
+	groups := []storeKitSubscriptionGroup{{
+		ID:   "group-1",
+		Name: "Premium",
+		Subscriptions: []storeKitSubscription{
+			{ProductID: "com.example.yearly", GroupNumber: 2, DisplayPrice: "49.99"},
+			{ProductID: "com.example.monthly", GroupNumber: 1},
+		},
+	}}
+
+	config := buildStoreKitConfig("123456", "USA", products, groups)
+	if config.Settings.ApplicationInternalID != "123456" || config.Settings.Storefront != "USA" || config.Version.Major != storeKitVersionMajor {
+		t.Fatalf("unexpected settings: %+v %+v", config.Settings, config.Version)
+	}
+	if len(config.Products) != 2 || config.Products[0].ProductID != "com.example.coins" {
+		t.Fatalf("expected products sorted by product ID, got %+v", config.Products)
+	}
+	if locs := config.Products[1].Localizations; len(locs) != 2 || locs[0].Locale != "en_US" || locs[0].Description != "A pile of gems" {
+		t.Fatalf("unexpected localizations: %+v", locs)
+	}
+	if len(config.NonRenewingSubscriptions) != 1 || config.NonRenewingSubscriptions[0].Type != storeKitTypeNonRenewingSubscription {
+		t.Fatalf("expected non-renewing subscriptions split out, got %+v", config.NonRenewingSubscriptions)
+	}
+	if subs := config.SubscriptionGroups[0].Subscriptions; subs[0].ProductID != "com.example.monthly" {
+		t.Fatalf("expected subscriptions sorted by level, got %+v", subs)
+	}
+	if again := buildStoreKitConfig("123456", "USA", nil, nil); again.Identifier != config.Identifier {
+		t.Fatalf("expected a stable identifier, got %q and %q", config.Identifier, again.Identifier)
+	}
+
+	summary := storeKitExportSummary(config, "123456", "Products.storekit")
+	if summary.Products != 2 || summary.NonRenewingSubscriptions != 1 || summary.Subscriptions != 2 || summary.MissingPrices != 2 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+}
+
+func TestCurrentStoreKitSubscriptionPrice(t *testing.T) {
+	var resp asc.SubscriptionPricesResponse
+	body := `{
+		"data":[
+			{"type":"subscriptionPrices","id":"p1","attributes":{},"relationships":{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"pp-1"}}}},
+			{"type":"subscriptionPrices","id":"p2","attributes":{"startDate":"2026-01-01"},"relationships":{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"pp-2"}}}},
+			{"type":"subscriptionPrices","id":"p3","attributes":{"startDate":"2030-01-01"},"relationships":{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"pp-3"}}}}
+		],
+		"included":[
+			{"type":"subscriptionPricePoints","id":"pp-1","attributes":{"customerPrice":"4.99"}},
+			{"type":"subscriptionPricePoints","id":"pp-2","attributes":{"customerPrice":"5.99"}},
+			{"type":"subscriptionPricePoints","id":"pp-3","attributes":{"customerPrice":"6.99"}}
+		]
+	}`
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if got := currentStoreKitSubscriptionPrice(&resp, time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)); got != "5.99" {
+		t.Fatalf("expected the latest started price, got %q", got)
+	}
+	if got := currentStoreKitSubscriptionPrice(&resp, time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)); got != "4.99" {
+		t.Fatalf("expected the undated price before any dated price starts, got %q", got)
+	}
+}
+
+func TestActiveStoreKitIntroductoryOffer(t *testing.T) {
+	var resp asc.SubscriptionIntroductoryOffersResponse
+	body := `{
+		"data":[
+			{"type":"subscriptionIntroductoryOffers","id":"old","attributes":{"endDate":"2025-12-31","duration":"ONE_WEEK","offerMode":"FREE_TRIAL","numberOfPeriods":1}},
+			{"type":"subscriptionIntroductoryOffers","id":"current","attributes":{"startDate":"2026-01-01","duration":"ONE_MONTH","offerMode":"PAY_AS_YOU_GO","numberOfPeriods":3},"relationships":{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"pp-1"}}}}
+		],
+		"included":[{"type":"subscriptionPricePoints","id":"pp-1","attributes":{"customerPrice":"1.99"}}]
+	}`
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	offer := activeStoreKitIntroductoryOffer(&resp, time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC))
+	if offer == nil {
+		t.Fatal("expected an active offer")
+	}
+	want := storeKitOffer{DisplayPrice: "1.99", InternalID: "current", NumberOfPeriods: 3, PaymentMode: storeKitPaymentModePayAsYouGo, SubscriptionPeriod: "P1M"}
+	if *offer != want {
+		t.Fatalf("activeStoreKitIntroductoryOffer() = %+v, want %+v", *offer, want)
+	}
+
+	if offer := activeStoreKitIntroductoryOffer(&resp, time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)); offer == nil || offer.PaymentMode != storeKitPaymentModeFree || offer.DisplayPrice != "" {
+		t.Fatalf("expected the free trial without a price, got %+v", offer)
+	}
+}
+
+func TestReadStoreKitConfig(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, body string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+		return path
+	}
+
+	valid := write("valid.storekit", `{
+		"identifier":"ABC","products":[{"productID":"com.example.coins","referenceName":"Coins","type":"Consumable"}],
+		"settings":{"_applicationInternalID":"123","_storeKitErrors":[]},
+		"subscriptionGroups":[{"name":"Premium","subscriptions":[{"productID":"com.example.monthly","referenceName":"Monthly","recurringSubscriptionPeriod":"P1M","type":"RecurringSubscription"}]}],
+		"version":{"major":3,"minor":0}
+	}`)
+	config, err := readStoreKitConfig(valid)
+	if err != nil {
+		t.Fatalf("readStoreKitConfig() error: %v", err)
+	}
+	if config.Settings.ApplicationInternalID != "123" || len(config.SubscriptionGroups[0].Subscriptions) != 1 {
+		t.Fatalf("unexpected config: %+v", config)
+	}
+
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{"duplicate product", `{"products":[{"productID":"a","referenceName":"A","type":"Consumable"}],"nonRenewingSubscriptions":[{"productID":"a","referenceName":"A","type":"NonRenewingSubscription"}]}`, `product "a" is listed more than once`},
+		{"bad type", `{"products":[{"productID":"a","referenceName":"A","type":"Widget"}]}`, `unsupported product type "Widget"`},
+		{"bad period", `{"subscriptionGroups":[{"name":"G","subscriptions":[{"productID":"s","referenceName":"S","recurringSubscriptionPeriod":"P3D"}]}]}`, `unsupported recurringSubscriptionPeriod "P3D"`},
+		{"unnamed group", `{"subscriptionGroups":[{"name":" "}]}`, "missing its name"},
+		{"not json", `products: []`, "parse configuration"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := readStoreKitConfig(write(strings.ReplaceAll(test.name, " ", "-")+".storekit", test.body))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+type storeKitImportStub struct {
+	iaps       []string
+	groups     map[string][]string
+	failAction string
+	calls      []string
+}
+
+func (s *storeKitImportStub) GetInAppPurchasesV2(ctx context.Context, appID string, opts ...asc.IAPOption) (*asc.InAppPurchasesV2Response, error) {
+	resp := &asc.InAppPurchasesV2Response{}
+	for _, productID := range s.iaps {
+		resp.Data = append(resp.Data, asc.Resource[asc.InAppPurchaseV2Attributes]{ID: "iap-" + productID, Attributes: asc.InAppPurchaseV2Attributes{ProductID: productID}})
+	}
+	return resp, nil
+}
+
+func (s *storeKitImportStub) GetSubscriptionGroups(ctx context.Context, appID string, opts ...asc.SubscriptionGroupsOption) (*asc.SubscriptionGroupsResponse, error) {
+	resp := &asc.SubscriptionGroupsResponse{}
+	for name := range s.groups {
+		resp.Data = append(resp.Data, asc.Resource[asc.SubscriptionGroupAttributes]{ID: "group-" + name, Attributes: asc.SubscriptionGroupAttributes{ReferenceName: name}})
+	}
+	return resp, nil
+}
+
+func (s *storeKitImportStub) GetSubscriptions(ctx context.Context, groupID string, opts ...asc.SubscriptionsOption) (*asc.SubscriptionsResponse, error) {
+	resp := &asc.SubscriptionsResponse{}
+	for _, productID := range s.groups[strings.TrimPrefix(groupID, "group-")] {
+		resp.Data = append(resp.Data, asc.Resource[asc.SubscriptionAttributes]{ID: "sub-" + productID, Attributes: asc.SubscriptionAttributes{ProductID: productID}})
+	}
+	return resp, nil
+}
+
+func (s *storeKitImportStub) CreateInAppPurchaseV2(ctx context.Context, appID string, attrs asc.InAppPurchaseV2CreateAttributes) (*asc.InAppPurchaseV2Response, error) {
+	s.calls = append(s.calls, "create-iap "+attrs.ProductID+" "+attrs.InAppPurchaseType)
+	if s.failAction == "create-iap" {
+		return nil, errors.New("boom")
+	}
+	return &asc.InAppPurchaseV2Response{Data: asc.Resource[asc.InAppPurchaseV2Attributes]{ID: "new-" + attrs.ProductID}}, nil
+}
+
+func (s *storeKitImportStub) CreateInAppPurchaseLocalization(ctx context.Context, iapID string, attrs asc.InAppPurchaseLocalizationCreateAttributes) (*asc.InAppPurchaseLocalizationResponse, error) {
+	s.calls = append(s.calls, "create-iap-localization "+iapID+" "+attrs.Locale+" "+attrs.Name)
+	return &asc.InAppPurchaseLocalizationResponse{}, nil
+}
+
+func (s *storeKitImportStub) CreateSubscriptionGroup(ctx context.Context, appID string, attrs asc.SubscriptionGroupCreateAttributes) (*asc.SubscriptionGroupResponse, error) {
+	s.calls = append(s.calls, "create-group "+attrs.ReferenceName)
+	return &asc.SubscriptionGroupResponse{Data: asc.Resource[asc.SubscriptionGroupAttributes]{ID: "new-group-" + attrs.ReferenceName}}, nil
+}
+
+func (s *storeKitImportStub) CreateSubscriptionGroupLocalization(ctx context.Context, groupID string, attrs asc.SubscriptionGroupLocalizationCreateAttributes) (*asc.SubscriptionGroupLocalizationResponse, error) {
+	s.calls = append(s.calls, "create-group-localization "+groupID+" "+attrs.Locale+" "+attrs.Name)
+	return &asc.SubscriptionGroupLocalizationResponse{}, nil
+}
+
+func (s *storeKitImportStub) CreateSubscription(ctx context.Context, groupID string, attrs asc.SubscriptionCreateAttributes) (*asc.SubscriptionResponse, error) {
+	level := 0
+	if attrs.GroupLevel != nil {
+		level = *attrs.GroupLevel
+	}
+	s.calls = append(s.calls, "create-subscription "+groupID+" "+attrs.ProductID+" "+attrs.SubscriptionPeriod+" "+strings.Repeat("*", level))
+	return &asc.SubscriptionResponse{Data: asc.Resource[asc.SubscriptionAttributes]{ID: "new-" + attrs.ProductID}}, nil
+}
+
+func (s *storeKitImportStub) CreateSubscriptionLocalization(ctx context.Context, subscriptionID string, attrs asc.SubscriptionLocalizationCreateAttributes) (*asc.SubscriptionLocalizationResponse, error) {
+	s.calls = append(s.calls, "create-subscription-localization "+subscriptionID+" "+attrs.Locale+" "+attrs.Name)
+	return &asc.SubscriptionLocalizationResponse{}, nil
+}
+
+func storeKitImportTestConfig() *storeKitConfig {
+	return &storeKitConfig{
+		Products: []storeKitProduct{
+			{ProductID: "com.example.coins", ReferenceName: "Coins", Type: storeKitTypeConsumable},
+			{ProductID: "com.example.unlock", ReferenceName: "Unlock", Type: storeKitTypeNonConsumable, Localizations: []storeKitLocalization{
+				{DisplayName: "Unlock", Locale: "en_US"},
+				{DisplayName: "", Locale: "fr_FR"},
+			}},
+		},
+		SubscriptionGroups: []storeKitSubscriptionGroup{
+			{Name: "Premium", Subscriptions: []storeKitSubscription{
+				{ProductID: "com.example.monthly", ReferenceName: "Monthly", RecurringSubscriptionPeriod: "P1M", GroupNumber: 2},
+				{ProductID: "com.example.yearly", ReferenceName: "Yearly", RecurringSubscriptionPeriod: "P1Y", GroupNumber: 1},
+			}},
+			{Name: "Extras", Localizations: []storeKitGroupLocalization{{DisplayName: "Extras", Locale: "en_US"}}, Subscriptions: []storeKitSubscription{
+				{ProductID: "com.example.extra", ReferenceName: "Extra", RecurringSubscriptionPeriod: "P1W", Localizations: []storeKitLocalization{{DisplayName: "Extra", Locale: "en_US"}}},
+			}},
+		},
+	}
+}
+
+func TestPlanAndApplyStoreKitImport(t *testing.T) {
+	stub := &storeKitImportStub{
+		iaps:   []string{"com.example.coins"},
+		groups: map[string][]string{"premium": {"com.example.yearly"}},
+	}
+
+	steps, err := planStoreKitImport(context.Background(), stub, "app-1", storeKitImportTestConfig())
+	if err != nil {
+		t.Fatalf("planStoreKitImport() error: %v", err)
+	}
+	actions := storeKitImportActions(steps)
+	var planned []string
+	for _, action := range actions {
+		planned = append(planned, action.Action+" "+action.Name+" ("+action.Details+")")
+		if action.Status != shared.StepStatusPlanned {
+			t.Fatalf("expected planned status, got %+v", action)
+		}
+	}
+	wantPlan := []string{
+		"create-iap Unlock (NonConsumable, 1 localization)",
+		`create-subscription Monthly (P1M in "Premium", 0 localizations)`,
+		"create-group Extras (1 localization)",
+		`create-subscription Extra (P1W in "Extras", 1 localization)`,
+	}
+	if strings.Join(planned, "\n") != strings.Join(wantPlan, "\n") {
+		t.Fatalf("unexpected plan:\n%s\nwant:\n%s", strings.Join(planned, "\n"), strings.Join(wantPlan, "\n"))
+	}
+
+	if err := applyStoreKitImport(context.Background(), stub, "app-1", steps, actions); err != nil {
+		t.Fatalf("applyStoreKitImport() error: %v", err)
+	}
+	wantCalls := []string{
+		"create-iap com.example.unlock NON_CONSUMABLE",
+		"create-iap-localization new-com.example.unlock en-US Unlock",
+		"create-subscription group-premium com.example.monthly ONE_MONTH **",
+		"create-group Extras",
+		"create-group-localization new-group-Extras en-US Extras",
+		"create-subscription new-group-Extras com.example.extra ONE_WEEK ",
+		"create-subscription-localization new-com.example.extra en-US Extra",
+	}
+	if strings.Join(stub.calls, "\n") != strings.Join(wantCalls, "\n") {
+		t.Fatalf("unexpected calls:\n%s\nwant:\n%s", strings.Join(stub.calls, "\n"), strings.Join(wantCalls, "\n"))
+	}
+	for _, action := range actions {
+		if action.Status != shared.StepStatusApplied || action.ID == "" {
+			t.Fatalf("expected applied action with an ID, got %+v", action)
+		}
+	}
+}
+
+func TestApplyStoreKitImportStopsOnFailure(t *testing.T) {
+	stub := &storeKitImportStub{failAction: "create-iap"}
+	steps, err := planStoreKitImport(context.Background(), stub, "app-1", storeKitImportTestConfig())
+	if err != nil {
+		t.Fatalf("planStoreKitImport() error: %v", err)
+	}
+	actions := storeKitImportActions(steps)
+
+	err = applyStoreKitImport(context.Background(), stub, "app-1", steps, actions)
+	if err == nil || !strings.Contains(err.Error(), `create-iap "Coins": boom`) {
+		t.Fatalf("expected create-iap failure, got %v", err)
+	}
+	if actions[0].Status != shared.StepStatusFailed || actions[0].Error != "boom" {
+		t.Fatalf("unexpected first action: %+v", actions[0])
+	}
+	for _, action := range actions[1:] {
+		if action.Status != shared.StepStatusSkipped {
+			t.Fatalf("expected remaining actions skipped, got %+v", action)
+		}
+	}
+	if len(stub.calls) != 1 {
+		t.Fatalf("expected a single call, got %v", stub.calls)
+	}
+}
@@ -59,7 +59,7 @@ func LocalizationsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -122,7 +122,7 @@ Examples:
 					}
 
 					// Fetch all remaining pages
-					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					resp, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppStoreVersionLocalizations(ctx, strings.TrimSpace(*versionID), asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
 					})
 					if err != nil {
@@ -173,7 +173,7 @@ Examples:
 					}
 
 					// Fetch all remaining pages
-					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					resp, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppInfoLocalizations(ctx, appInfo, asc.WithAppInfoLocalizationsNextURL(nextURL))
 					})
 					if err != nil {
@@ -203,24 +203,37 @@ func LocalizationsDownloadCommand() *ffcli.Command {
 	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
 	locType := fs.String("type", shared.LocalizationTypeVersion, "Localization type: version (default) or app-info")
 	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
-	path := fs.String("path", "localizations", "Output path (directory or .strings file)")
+	path := fs.String("path", "localizations", "Output path (directory or file)")
+	format := fs.String("format", shared.LocalizationFormatStrings, "File format: strings (default), xliff, json, csv")
+	sourceLocale := fs.String("source-locale", "en-US", "Source locale for XLIFF files")
+	xliffVersion := fs.String("xliff-version", shared.XLIFFVersion12, "XLIFF version: 1.2 (default) or 2.0")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "download",
 		ShortUsage: "asc localizations download [flags]",
-		ShortHelp:  "Download localizations to .strings files.",
-		LongHelp: `Download localizations to .strings files.
+		ShortHelp:  "Download localizations to .strings, XLIFF, JSON, or CSV files.",
+		LongHelp: `Download localizations to .strings, XLIFF, JSON, or CSV files.
+
+Formats:
+  strings  One <locale>.strings file per locale
+  xliff    One <locale>.xliff file per target locale, with --source-locale as the source text
+  json     One localizations.json file with every locale
+  csv      One localizations.csv file with a column per locale
+
+Files are annotated with App Store character limits where they apply.
 
 Examples:
   asc localizations download --version "VERSION_ID" --path "./localizations"
   asc localizations download --app "APP_ID" --type app-info --path "./localizations"
   asc localizations download --version "VERSION_ID" --locale "en-US" --path "en-US.strings"
-  asc localizations download --version "VERSION_ID" --paginate --path "./localizations"`,
+  asc localizations download --version "VERSION_ID" --paginate --path "./localizations"
+  asc localizations download --version "VERSION_ID" --format xliff --source-locale "en-US" --locale "de-DE,fr-FR" --path "./xliff"
+  asc localizations download --version "VERSION_ID" --format csv --path "./metadata.csv"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -236,7 +249,14 @@ Examples:
 				return fmt.Errorf("localizations download: %w", err)
 			}
 
+			fileOpts, err := shared.NewLocalizationFileOptions(normalizedType, *format, *sourceLocale, *xliffVersion)
+			if err != nil {
+				return fmt.Errorf("localizations download: %w", err)
+			}
+
 			locales := shared.SplitCSV(*locale)
+			fileOpts.TargetLocales = locales
+			fetchLocales := fileOpts.FetchLocales(locales)
 
 			switch normalizedType {
 			case shared.LocalizationTypeVersion:
@@ -257,8 +277,8 @@ Examples:
 					asc.WithAppStoreVersionLocalizationsLimit(*limit),
 					asc.WithAppStoreVersionLocalizationsNextURL(*next),
 				}
-				if len(locales) > 0 {
-					opts = append(opts, asc.WithAppStoreVersionLocalizationLocales(locales))
+				if len(fetchLocales) > 0 {
+					opts = append(opts, asc.WithAppStoreVersionLocalizationLocales(fetchLocales))
 				}
 
 				if *paginate {
@@ -280,7 +300,7 @@ Examples:
 						return fmt.Errorf("localizations download: unexpected pagination response type")
 					}
 
-					files, err := shared.WriteVersionLocalizationStrings(*path, aggregated.Data)
+					files, err := shared.WriteLocalizationFiles(*path, shared.VersionLocalizationValues(aggregated.Data), fileOpts)
 					if err != nil {
 						return fmt.Errorf("localizations download: %w", err)
 					}
@@ -288,6 +308,7 @@ Examples:
 					result := asc.LocalizationDownloadResult{
 						Type:       normalizedType,
 						VersionID:  strings.TrimSpace(*versionID),
+						Format:     fileOpts.Format,
 						OutputPath: *path,
 						Files:      files,
 					}
@@ -300,7 +321,7 @@ Examples:
 					return fmt.Errorf("localizations download: failed to fetch: %w", err)
 				}
 
-				files, err := shared.WriteVersionLocalizationStrings(*path, resp.Data)
+				files, err := shared.WriteLocalizationFiles(*path, shared.VersionLocalizationValues(resp.Data), fileOpts)
 				if err != nil {
 					return fmt.Errorf("localizations download: %w", err)
 				}
@@ -308,6 +329,7 @@ Examples:
 				result := asc.LocalizationDownloadResult{
 					Type:       normalizedType,
 					VersionID:  strings.TrimSpace(*versionID),
+					Format:     fileOpts.Format,
 					OutputPath: *path,
 					Files:      files,
 				}
@@ -337,8 +359,8 @@ Examples:
 					asc.WithAppInfoLocalizationsLimit(*limit),
 					asc.WithAppInfoLocalizationsNextURL(*next),
 				}
-				if len(locales) > 0 {
-					opts = append(opts, asc.WithAppInfoLocalizationLocales(locales))
+				if len(fetchLocales) > 0 {
+					opts = append(opts, asc.WithAppInfoLocalizationLocales(fetchLocales))
 				}
 
 				if *paginate {
@@ -360,7 +382,7 @@ Examples:
 						return fmt.Errorf("localizations download: unexpected pagination response type")
 					}
 
-					files, err := shared.WriteAppInfoLocalizationStrings(*path, aggregated.Data)
+					files, err := shared.WriteLocalizationFiles(*path, shared.AppInfoLocalizationValues(aggregated.Data), fileOpts)
 					if err != nil {
 						return fmt.Errorf("localizations download: %w", err)
 					}
@@ -369,6 +391,7 @@ Examples:
 						Type:       normalizedType,
 						AppID:      resolvedAppID,
 						AppInfoID:  appInfo,
+						Format:     fileOpts.Format,
 						OutputPath: *path,
 						Files:      files,
 					}
@@ -381,7 +404,7 @@ Examples:
 					return fmt.Errorf("localizations download: failed to fetch: %w", err)
 				}
 
-				files, err := shared.WriteAppInfoLocalizationStrings(*path, resp.Data)
+				files, err := shared.WriteLocalizationFiles(*path, shared.AppInfoLocalizationValues(resp.Data), fileOpts)
 				if err != nil {
 					return fmt.Errorf("localizations download: %w", err)
 				}
@@ -390,6 +413,7 @@ Examples:
 					Type:       normalizedType,
 					AppID:      resolvedAppID,
 					AppInfoID:  appInfo,
+					Format:     fileOpts.Format,
 					OutputPath: *path,
 					Files:      files,
 				}
@@ -411,22 +435,28 @@ func LocalizationsUploadCommand() *ffcli.Command {
 	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
 	locType := fs.String("type", shared.LocalizationTypeVersion, "Localization type: version (default) or app-info")
 	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
-	path := fs.String("path", "", "Input path (directory or .strings file)")
+	path := fs.String("path", "", "Input path (directory or file)")
+	format := fs.String("format", shared.LocalizationFormatStrings, "File format: strings (default), xliff, json, csv")
 	dryRun := fs.Bool("dry-run", false, "Validate file without uploading")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "upload",
 		ShortUsage: "asc localizations upload [flags]",
-		ShortHelp:  "Upload localizations from .strings files.",
-		LongHelp: `Upload localizations from .strings files.
+		ShortHelp:  "Upload localizations from .strings, XLIFF, JSON, or CSV files.",
+		LongHelp: `Upload localizations from .strings, XLIFF, JSON, or CSV files.
+
+XLIFF uploads use each file's target language and skip units without a
+translation. Empty CSV cells leave the field unchanged.
 
 Examples:
   asc localizations upload --version "VERSION_ID" --path "./localizations"
   asc localizations upload --app "APP_ID" --type app-info --path "./localizations"
   asc localizations upload --version "VERSION_ID" --locale "en-US" --path "en-US.strings"
-  asc localizations upload --version "VERSION_ID" --path "./localizations" --dry-run`,
+  asc localizations upload --version "VERSION_ID" --path "./localizations" --dry-run
+  asc localizations upload --version "VERSION_ID" --format xliff --path "./xliff"
+  asc localizations upload --version "VERSION_ID" --format csv --path "./metadata.csv"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -440,6 +470,11 @@ Examples:
 				return fmt.Errorf("localizations upload: %w", err)
 			}
 
+			fileOpts, err := shared.NewLocalizationFileOptions(normalizedType, *format, "", "")
+			if err != nil {
+				return fmt.Errorf("localizations upload: %w", err)
+			}
+
 			locales := shared.SplitCSV(*locale)
 
 			switch normalizedType {
@@ -457,7 +492,7 @@ Examples:
 				requestCtx, cancel := shared.ContextWithTimeout(ctx)
 				defer cancel()
 
-				valuesByLocale, err := shared.ReadLocalizationStrings(*path, locales)
+				valuesByLocale, err := shared.ReadLocalizationFiles(*path, locales, fileOpts)
 				if err != nil {
 					return fmt.Errorf("localizations upload: %w", err)
 				}
@@ -470,6 +505,7 @@ Examples:
 				result := asc.LocalizationUploadResult{
 					Type:      normalizedType,
 					VersionID: strings.TrimSpace(*versionID),
+					Format:    fileOpts.Format,
 					DryRun:    *dryRun,
 					Results:   results,
 				}
@@ -495,7 +531,7 @@ Examples:
 					return fmt.Errorf("localizations upload: %w", err)
 				}
 
-				valuesByLocale, err := shared.ReadLocalizationStrings(*path, locales)
+				valuesByLocale, err := shared.ReadLocalizationFiles(*path, locales, fileOpts)
 				if err != nil {
 					return fmt.Errorf("localizations upload: %w", err)
 				}
@@ -509,6 +545,7 @@ Examples:
 					Type:      normalizedType,
 					AppID:     resolvedAppID,
 					AppInfoID: appInfo,
+					Format:    fileOpts.Format,
 					DryRun:    *dryRun,
 					Results:   results,
 				}
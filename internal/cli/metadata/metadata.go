@@ -0,0 +1,622 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+// MetadataConfig is the YAML schema for App Store listing metadata.
+type MetadataConfig struct {
+	App           MetadataAppConfig                     `yaml:"app"`
+	Copyright     string                                `yaml:"copyright,omitempty"`
+	Categories    *MetadataCategoriesConfig             `yaml:"categories,omitempty"`
+	Localizations map[string]MetadataLocalizationConfig `yaml:"localizations,omitempty"`
+	AgeRating     map[string]any                        `yaml:"ageRating,omitempty"`
+	Review        *MetadataReviewConfig                 `yaml:"review,omitempty"`
+	Availability  *MetadataAvailabilityConfig           `yaml:"availability,omitempty"`
+	Pricing       *MetadataPricingConfig                `yaml:"pricing,omitempty"`
+}
+
+// MetadataAppConfig identifies the app and App Store version.
+type MetadataAppConfig struct {
+	ID        string `yaml:"id"`
+	VersionID string `yaml:"versionId,omitempty"`
+	Version   string `yaml:"version,omitempty"`
+	Platform  string `yaml:"platform,omitempty"`
+}
+
+// MetadataCategoriesConfig describes the app's primary and secondary categories.
+type MetadataCategoriesConfig struct {
+	Primary   string `yaml:"primary,omitempty"`
+	Secondary string `yaml:"secondary,omitempty"`
+}
+
+// MetadataLocalizationConfig combines app info and version localization
+// fields for one locale.
+type MetadataLocalizationConfig struct {
+	Name              string `yaml:"name,omitempty"`
+	Subtitle          string `yaml:"subtitle,omitempty"`
+	PrivacyPolicyURL  string `yaml:"privacyPolicyUrl,omitempty"`
+	PrivacyChoicesURL string `yaml:"privacyChoicesUrl,omitempty"`
+	PrivacyPolicyText string `yaml:"privacyPolicyText,omitempty"`
+	Description       string `yaml:"description,omitempty"`
+	Keywords          string `yaml:"keywords,omitempty"`
+	WhatsNew          string `yaml:"whatsNew,omitempty"`
+	PromotionalText   string `yaml:"promotionalText,omitempty"`
+	SupportURL        string `yaml:"supportUrl,omitempty"`
+	MarketingURL      string `yaml:"marketingUrl,omitempty"`
+}
+
+// MetadataReviewConfig describes the App Review contact and demo account.
+type MetadataReviewConfig struct {
+	ContactFirstName    string `yaml:"contactFirstName,omitempty"`
+	ContactLastName     string `yaml:"contactLastName,omitempty"`
+	ContactPhone        string `yaml:"contactPhone,omitempty"`
+	ContactEmail        string `yaml:"contactEmail,omitempty"`
+	DemoAccountRequired *bool  `yaml:"demoAccountRequired,omitempty"`
+	DemoAccountName     string `yaml:"demoAccountName,omitempty"`
+	DemoAccountPassword string `yaml:"demoAccountPassword,omitempty"`
+	Notes               string `yaml:"notes,omitempty"`
+}
+
+// MetadataAvailabilityConfig lists the territories the app is available in.
+type MetadataAvailabilityConfig struct {
+	AvailableInNewTerritories *bool    `yaml:"availableInNewTerritories,omitempty"`
+	Territories               []string `yaml:"territories"`
+}
+
+// MetadataPricingConfig describes the current price in the base territory.
+type MetadataPricingConfig struct {
+	BaseTerritory string `yaml:"baseTerritory"`
+	Price         string `yaml:"price"`
+}
+
+type metadataPullSummary struct {
+	File          string `json:"file"`
+	AppID         string `json:"appId"`
+	VersionID     string `json:"versionId"`
+	Localizations int    `json:"localizations"`
+	Territories   int    `json:"territories"`
+}
+
+type metadataClient interface {
+	GetAppStoreVersion(ctx context.Context, versionID string, opts ...asc.AppStoreVersionOption) (*asc.AppStoreVersionResponse, error)
+	UpdateAppStoreVersion(ctx context.Context, versionID string, attrs asc.AppStoreVersionUpdateAttributes) (*asc.AppStoreVersionResponse, error)
+	GetAppStoreVersionLocalizations(ctx context.Context, versionID string, opts ...asc.AppStoreVersionLocalizationsOption) (*asc.AppStoreVersionLocalizationsResponse, error)
+	CreateAppStoreVersionLocalization(ctx context.Context, versionID string, attributes asc.AppStoreVersionLocalizationAttributes) (*asc.AppStoreVersionLocalizationResponse, error)
+	UpdateAppStoreVersionLocalization(ctx context.Context, localizationID string, attributes asc.AppStoreVersionLocalizationAttributes) (*asc.AppStoreVersionLocalizationResponse, error)
+	GetAppInfos(ctx context.Context, appID string) (*asc.AppInfosResponse, error)
+	GetAppInfo(ctx context.Context, appInfoID string, opts ...asc.AppInfoOption) (*asc.AppInfoResponse, error)
+	UpdateAppInfoCategories(ctx context.Context, appInfoID string, primaryCategoryID, secondaryCategoryID string) (*asc.AppInfoResponse, error)
+	GetAppInfoLocalizations(ctx context.Context, appInfoID string, opts ...asc.AppInfoLocalizationsOption) (*asc.AppInfoLocalizationsResponse, error)
+	CreateAppInfoLocalization(ctx context.Context, appInfoID string, attributes asc.AppInfoLocalizationAttributes) (*asc.AppInfoLocalizationResponse, error)
+	UpdateAppInfoLocalization(ctx context.Context, localizationID string, attributes asc.AppInfoLocalizationAttributes) (*asc.AppInfoLocalizationResponse, error)
+	GetAgeRatingDeclarationForAppInfo(ctx context.Context, appInfoID string) (*asc.AgeRatingDeclarationResponse, error)
+	UpdateAgeRatingDeclaration(ctx context.Context, declarationID string, attributes asc.AgeRatingDeclarationAttributes) (*asc.AgeRatingDeclarationResponse, error)
+	GetAppStoreReviewDetailForVersion(ctx context.Context, versionID string) (*asc.AppStoreReviewDetailResponse, error)
+	CreateAppStoreReviewDetail(ctx context.Context, versionID string, attrs *asc.AppStoreReviewDetailCreateAttributes) (*asc.AppStoreReviewDetailResponse, error)
+	UpdateAppStoreReviewDetail(ctx context.Context, detailID string, attrs asc.AppStoreReviewDetailUpdateAttributes) (*asc.AppStoreReviewDetailResponse, error)
+	GetAppAvailabilityV2(ctx context.Context, appID string) (*asc.AppAvailabilityV2Response, error)
+	GetTerritoryAvailabilities(ctx context.Context, availabilityID string, opts ...asc.TerritoryAvailabilitiesOption) (*asc.TerritoryAvailabilitiesResponse, error)
+	CreateAppAvailabilityV2(ctx context.Context, appID string, attrs asc.AppAvailabilityV2CreateAttributes) (*asc.AppAvailabilityV2Response, error)
+	GetAppPriceSchedule(ctx context.Context, appID string) (*asc.AppPriceScheduleResponse, error)
+	GetAppPriceScheduleBaseTerritory(ctx context.Context, scheduleID string) (*asc.TerritoryResponse, error)
+	GetAppPriceScheduleManualPrices(ctx context.Context, scheduleID string, opts ...asc.AppPricesOption) (*asc.AppPricesResponse, error)
+	GetAppPricePoints(ctx context.Context, appID string, opts ...asc.PricePointsOption) (*asc.AppPricePointsV3Response, error)
+	CreateAppPriceSchedule(ctx context.Context, appID string, attrs asc.AppPriceScheduleCreateAttributes) (*asc.AppPriceScheduleResponse, error)
+}
+
+// metadataState is the live listing state for one app and version.
+type metadataState struct {
+	appID   string
+	version asc.Resource[asc.AppStoreVersionAttributes]
+
+	appInfoID         string
+	primaryCategory   string
+	secondaryCategory string
+
+	versionLocalizations map[string]asc.Resource[asc.AppStoreVersionLocalizationAttributes]
+	appInfoLocalizations map[string]asc.Resource[asc.AppInfoLocalizationAttributes]
+
+	ageRatingID string
+	ageRating   map[string]any
+
+	review *asc.Resource[asc.AppStoreReviewDetailAttributes]
+
+	availabilityID            string
+	availableInNewTerritories bool
+	territories               map[string]bool
+
+	priceScheduleID string
+	baseTerritory   string
+	price           string
+}
+
+// MetadataCommand returns the metadata command group.
+func MetadataCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "metadata",
+		ShortUsage: "asc metadata <subcommand> [flags]",
+		ShortHelp:  "Manage App Store listing metadata as code.",
+		LongHelp: `Manage App Store listing metadata as code.
+
+Pull writes the listing for an App Store version to one YAML document:
+version and app info localizations, categories, age rating, App Review
+contact, availability, price, and copyright. Plan shows a field-level diff
+between the file and live state, and apply makes only those changes.
+
+Examples:
+  asc metadata pull --app "APP_ID" --version "1.2.0" --output "./metadata.yaml"
+  asc metadata plan --file "./metadata.yaml"
+  asc metadata apply --file "./metadata.yaml"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MetadataPullCommand(),
+			MetadataPlanCommand(),
+			MetadataApplyCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// MetadataPullCommand exports listing metadata to YAML.
+func MetadataPullCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("pull", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	versionID := fs.String("version-id", "", "App Store version ID")
+	version := fs.String("version", "", "App Store version string (e.g., 1.2.0)")
+	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	output := fs.String("output", "", "Output file path for YAML (required)")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "pull",
+		ShortUsage: "asc metadata pull --app APP_ID --version VERSION --output FILE [flags]",
+		ShortHelp:  "Export App Store listing metadata to YAML.",
+		LongHelp: `Export App Store listing metadata to YAML.
+
+The demo account password is never written to the file.
+
+Examples:
+  asc metadata pull --app "APP_ID" --version "1.2.0" --output "./metadata.yaml"
+  asc metadata pull --app "APP_ID" --version "1.2.0" --platform MAC_OS --output "./metadata.yaml"
+  asc metadata pull --app "APP_ID" --version-id "VERSION_ID" --output "./metadata.yaml"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*versionID) == "" && strings.TrimSpace(*version) == "" {
+				fmt.Fprintf(os.Stderr, "Error: --version or --version-id is required\n\n")
+				return flag.ErrHelp
+			}
+			outputValue := strings.TrimSpace(*output)
+			if outputValue == "" {
+				fmt.Fprintf(os.Stderr, "Error: --output is required\n\n")
+				return flag.ErrHelp
+			}
+
+			resolvedOutputPath, err := resolveMetadataOutputPath(outputValue)
+			if err != nil {
+				return fmt.Errorf("metadata pull: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("metadata pull: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resolvedVersionID, err := resolveMetadataVersionID(requestCtx, client, resolvedAppID, *versionID, *version, *platform)
+			if err != nil {
+				return fmt.Errorf("metadata pull: %w", err)
+			}
+
+			state, err := fetchMetadataState(requestCtx, client, resolvedAppID, resolvedVersionID)
+			if err != nil {
+				return fmt.Errorf("metadata pull: %w", err)
+			}
+			config := metadataConfigFromState(state)
+
+			if err := writeMetadataConfigYAML(resolvedOutputPath, config); err != nil {
+				return fmt.Errorf("metadata pull: %w", err)
+			}
+
+			summary := metadataPullSummary{
+				File:          filepath.Clean(outputValue),
+				AppID:         resolvedAppID,
+				VersionID:     resolvedVersionID,
+				Localizations: len(config.Localizations),
+			}
+			if config.Availability != nil {
+				summary.Territories = len(config.Availability.Territories)
+			}
+			if *pretty {
+				return asc.PrintPrettyJSON(summary)
+			}
+			return asc.PrintJSON(summary)
+		},
+	}
+}
+
+// resolveMetadataVersionID returns versionID when set, otherwise looks the
+// version up by version string and platform.
+func resolveMetadataVersionID(ctx context.Context, client *asc.Client, appID, versionID, version, platform string) (string, error) {
+	if id := strings.TrimSpace(versionID); id != "" {
+		return id, nil
+	}
+	normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(platform)
+	if err != nil {
+		return "", err
+	}
+	return shared.ResolveAppStoreVersionID(ctx, client, appID, strings.TrimSpace(version), normalizedPlatform)
+}
+
+func fetchMetadataState(ctx context.Context, client metadataClient, appID, versionID string) (*metadataState, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+	state := &metadataState{appID: appID}
+
+	versionResp, err := client.GetAppStoreVersion(ctx, versionID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch app store version: %w", err)
+	}
+	state.version = versionResp.Data
+
+	versionLocs, err := client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("fetch version localizations: %w", err)
+	}
+	state.versionLocalizations = make(map[string]asc.Resource[asc.AppStoreVersionLocalizationAttributes], len(versionLocs.Data))
+	for _, loc := range versionLocs.Data {
+		state.versionLocalizations[loc.Attributes.Locale] = loc
+	}
+
+	if err := fetchMetadataAppInfo(ctx, client, state); err != nil {
+		return nil, err
+	}
+
+	review, err := client.GetAppStoreReviewDetailForVersion(ctx, versionID)
+	switch {
+	case err == nil:
+		state.review = &review.Data
+	case !asc.IsNotFound(err):
+		return nil, fmt.Errorf("fetch review details: %w", err)
+	}
+
+	if err := fetchMetadataAvailability(ctx, client, state); err != nil {
+		return nil, err
+	}
+	if err := fetchMetadataPricing(ctx, client, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+type appInfoCategoryRelationships struct {
+	PrimaryCategory   *asc.Relationship `json:"primaryCategory"`
+	SecondaryCategory *asc.Relationship `json:"secondaryCategory"`
+}
+
+func fetchMetadataAppInfo(ctx context.Context, client metadataClient, state *metadataState) error {
+	appInfos, err := client.GetAppInfos(ctx, state.appID)
+	if err != nil {
+		return fmt.Errorf("fetch app infos: %w", err)
+	}
+	state.appInfoID = shared.SelectBestAppInfoID(appInfos)
+	if state.appInfoID == "" {
+		return fmt.Errorf("no app info found for app %q", state.appID)
+	}
+
+	appInfo, err := client.GetAppInfo(ctx, state.appInfoID, asc.WithAppInfoInclude([]string{"primaryCategory", "secondaryCategory"}))
+	if err != nil {
+		return fmt.Errorf("fetch app info: %w", err)
+	}
+	if len(appInfo.Data.Relationships) > 0 {
+		var relationships appInfoCategoryRelationships
+		if err := json.Unmarshal(appInfo.Data.Relationships, &relationships); err != nil {
+			return fmt.Errorf("decode app info categories: %w", err)
+		}
+		if relationships.PrimaryCategory != nil {
+			state.primaryCategory = relationships.PrimaryCategory.Data.ID
+		}
+		if relationships.SecondaryCategory != nil {
+			state.secondaryCategory = relationships.SecondaryCategory.Data.ID
+		}
+	}
+
+	appInfoLocs, err := client.GetAppInfoLocalizations(ctx, state.appInfoID, asc.WithAppInfoLocalizationsLimit(200))
+	if err != nil {
+		return fmt.Errorf("fetch app info localizations: %w", err)
+	}
+	state.appInfoLocalizations = make(map[string]asc.Resource[asc.AppInfoLocalizationAttributes], len(appInfoLocs.Data))
+	for _, loc := range appInfoLocs.Data {
+		state.appInfoLocalizations[loc.Attributes.Locale] = loc
+	}
+
+	declaration, err := client.GetAgeRatingDeclarationForAppInfo(ctx, state.appInfoID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("fetch age rating declaration: %w", err)
+	}
+	state.ageRatingID = declaration.Data.ID
+	state.ageRating, err = ageRatingValues(declaration.Data.Attributes)
+	if err != nil {
+		return fmt.Errorf("decode age rating declaration: %w", err)
+	}
+	return nil
+}
+
+// ageRatingValues flattens a declaration to its set fields, keyed by API name.
+func ageRatingValues(attrs asc.AgeRatingDeclarationAttributes) (map[string]any, error) {
+	data, err := json.Marshal(attrs)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]any{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func fetchMetadataAvailability(ctx context.Context, client metadataClient, state *metadataState) error {
+	availability, err := client.GetAppAvailabilityV2(ctx, state.appID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("fetch app availability: %w", err)
+	}
+	state.availabilityID = availability.Data.ID
+	state.availableInNewTerritories = availability.Data.Attributes.AvailableInNewTerritories
+
+	firstPage, err := client.GetTerritoryAvailabilities(ctx, state.availabilityID, asc.WithTerritoryAvailabilitiesLimit(200))
+	if err != nil {
+		return fmt.Errorf("fetch territory availabilities: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetTerritoryAvailabilities(ctx, state.availabilityID, asc.WithTerritoryAvailabilitiesNextURL(nextURL))
+	})
+	if err != nil {
+		return fmt.Errorf("fetch territory availabilities: %w", err)
+	}
+	resp, ok := paginated.(*asc.TerritoryAvailabilitiesResponse)
+	if !ok {
+		return fmt.Errorf("unexpected territory availabilities response type %T", paginated)
+	}
+	ids, err := shared.MapTerritoryAvailabilityIDs(resp)
+	if err != nil {
+		return err
+	}
+	available := make(map[string]bool, len(resp.Data))
+	for _, item := range resp.Data {
+		available[item.ID] = item.Attributes.Available
+	}
+	state.territories = make(map[string]bool, len(ids))
+	for territory, id := range ids {
+		state.territories[territory] = available[id]
+	}
+	return nil
+}
+
+type appPriceRelationships struct {
+	AppPricePoint asc.Relationship `json:"appPricePoint"`
+	Territory     asc.Relationship `json:"territory"`
+}
+
+func fetchMetadataPricing(ctx context.Context, client metadataClient, state *metadataState) error {
+	schedule, err := client.GetAppPriceSchedule(ctx, state.appID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("fetch app price schedule: %w", err)
+	}
+	state.priceScheduleID = schedule.Data.ID
+
+	baseTerritory, err := client.GetAppPriceScheduleBaseTerritory(ctx, state.priceScheduleID)
+	if err != nil {
+		return fmt.Errorf("fetch base territory: %w", err)
+	}
+	state.baseTerritory = strings.ToUpper(strings.TrimSpace(baseTerritory.Data.ID))
+
+	prices, err := client.GetAppPriceScheduleManualPrices(ctx, state.priceScheduleID,
+		asc.WithAppPricesInclude([]string{"appPricePoint", "territory"}),
+		asc.WithAppPricesLimit(200),
+	)
+	if err != nil {
+		return fmt.Errorf("fetch manual prices: %w", err)
+	}
+	state.price, err = currentBasePrice(prices, state.baseTerritory, time.Now().UTC().Format("2006-01-02"))
+	if err != nil {
+		return fmt.Errorf("decode manual prices: %w", err)
+	}
+	return nil
+}
+
+// currentBasePrice returns the customer price of the manual price in effect
+// today for the base territory, or "" when there is none.
+func currentBasePrice(prices *asc.AppPricesResponse, baseTerritory, today string) (string, error) {
+	customerPrices := map[string]string{}
+	if len(prices.Included) > 0 {
+		var included []asc.Resource[asc.AppPricePointV3Attributes]
+		if err := json.Unmarshal(prices.Included, &included); err != nil {
+			return "", err
+		}
+		for _, item := range included {
+			if item.Type == asc.ResourceTypeAppPricePoints {
+				customerPrices[item.ID] = item.Attributes.CustomerPrice
+			}
+		}
+	}
+
+	price, startDate := "", ""
+	for _, item := range prices.Data {
+		var relationships appPriceRelationships
+		if len(item.Relationships) > 0 {
+			if err := json.Unmarshal(item.Relationships, &relationships); err != nil {
+				return "", err
+			}
+		}
+		if territory := relationships.Territory.Data.ID; territory != "" && !strings.EqualFold(territory, baseTerritory) {
+			continue
+		}
+		attrs := item.Attributes
+		if attrs.StartDate != "" && attrs.StartDate > today {
+			continue
+		}
+		if attrs.EndDate != "" && attrs.EndDate <= today {
+			continue
+		}
+		if price != "" && attrs.StartDate < startDate {
+			continue
+		}
+		price, startDate = customerPrices[relationships.AppPricePoint.Data.ID], attrs.StartDate
+	}
+	return price, nil
+}
+
+// metadataConfigFromState converts live state to the YAML document.
+func metadataConfigFromState(state *metadataState) *MetadataConfig {
+	config := &MetadataConfig{
+		App: MetadataAppConfig{
+			ID:        state.appID,
+			VersionID: state.version.ID,
+			Version:   state.version.Attributes.VersionString,
+			Platform:  string(state.version.Attributes.Platform),
+		},
+		Copyright: state.version.Attributes.Copyright,
+		AgeRating: state.ageRating,
+	}
+	if state.primaryCategory != "" || state.secondaryCategory != "" {
+		config.Categories = &MetadataCategoriesConfig{
+			Primary:   state.primaryCategory,
+			Secondary: state.secondaryCategory,
+		}
+	}
+
+	locales := make(map[string]MetadataLocalizationConfig)
+	for locale, loc := range state.appInfoLocalizations {
+		cfg := locales[locale]
+		cfg.Name = loc.Attributes.Name
+		cfg.Subtitle = loc.Attributes.Subtitle
+		cfg.PrivacyPolicyURL = loc.Attributes.PrivacyPolicyURL
+		cfg.PrivacyChoicesURL = loc.Attributes.PrivacyChoicesURL
+		cfg.PrivacyPolicyText = loc.Attributes.PrivacyPolicyText
+		locales[locale] = cfg
+	}
+	for locale, loc := range state.versionLocalizations {
+		cfg := locales[locale]
+		cfg.Description = loc.Attributes.Description
+		cfg.Keywords = loc.Attributes.Keywords
+		cfg.WhatsNew = loc.Attributes.WhatsNew
+		cfg.PromotionalText = loc.Attributes.PromotionalText
+		cfg.SupportURL = loc.Attributes.SupportURL
+		cfg.MarketingURL = loc.Attributes.MarketingURL
+		locales[locale] = cfg
+	}
+	if len(locales) > 0 {
+		config.Localizations = locales
+	}
+
+	if state.review != nil {
+		attrs := state.review.Attributes
+		required := attrs.DemoAccountRequired
+		config.Review = &MetadataReviewConfig{
+			ContactFirstName:    attrs.ContactFirstName,
+			ContactLastName:     attrs.ContactLastName,
+			ContactPhone:        attrs.ContactPhone,
+			ContactEmail:        attrs.ContactEmail,
+			DemoAccountRequired: &required,
+			DemoAccountName:     attrs.DemoAccountName,
+			Notes:               attrs.Notes,
+		}
+	}
+
+	if state.availabilityID != "" {
+		availableInNew := state.availableInNewTerritories
+		territories := make([]string, 0, len(state.territories))
+		for territory, available := range state.territories {
+			if available {
+				territories = append(territories, territory)
+			}
+		}
+		sort.Strings(territories)
+		config.Availability = &MetadataAvailabilityConfig{
+			AvailableInNewTerritories: &availableInNew,
+			Territories:               territories,
+		}
+	}
+
+	if state.baseTerritory != "" && state.price != "" {
+		config.Pricing = &MetadataPricingConfig{
+			BaseTerritory: state.baseTerritory,
+			Price:         state.price,
+		}
+	}
+	return config
+}
+
+func resolveMetadataOutputPath(outputPath string) (string, error) {
+	trimmed := strings.TrimSpace(outputPath)
+	if strings.HasSuffix(trimmed, string(filepath.Separator)) {
+		return "", fmt.Errorf("output path must be a file")
+	}
+	resolved, err := filepath.Abs(trimmed)
+	if err != nil {
+		return "", fmt.Errorf("resolve output path: %w", err)
+	}
+	return resolved, nil
+}
+
+func writeMetadataConfigYAML(outputPath string, config *MetadataConfig) error {
+	if config == nil {
+		return fmt.Errorf("config is required")
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteFileAtomic(outputPath, data, 0o600)
+}
+
+// samePrice compares customer prices numerically so "0.99" matches "0.990".
+func samePrice(a, b string) bool {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	if a == b {
+		return true
+	}
+	left, errA := strconv.ParseFloat(a, 64)
+	right, errB := strconv.ParseFloat(b, 64)
+	return errA == nil && errB == nil && left == right
+}
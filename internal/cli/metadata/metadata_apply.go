@@ -0,0 +1,722 @@
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	metadataActionCreateVersionLocalization = "create-version-localization"
+	metadataActionUpdateVersionLocalization = "update-version-localization"
+	metadataActionCreateAppInfoLocalization = "create-app-info-localization"
+	metadataActionUpdateAppInfoLocalization = "update-app-info-localization"
+	metadataActionUpdateCopyright           = "update-copyright"
+	metadataActionUpdateCategories          = "update-categories"
+	metadataActionUpdateAgeRating           = "update-age-rating"
+	metadataActionCreateReviewDetail        = "create-review-detail"
+	metadataActionUpdateReviewDetail        = "update-review-detail"
+	metadataActionUpdateAvailability        = "update-availability"
+	metadataActionUpdatePrice               = "update-price"
+)
+
+// metadataFieldChange is one field that differs between the file and live state.
+type metadataFieldChange struct {
+	field string
+	from  string
+	to    string
+}
+
+// metadataStep is one API call that applies a group of field changes.
+type metadataStep struct {
+	action  string
+	locale  string
+	id      string
+	changes []metadataFieldChange
+
+	versionLocalization *asc.AppStoreVersionLocalizationAttributes
+	appInfoLocalization *asc.AppInfoLocalizationAttributes
+	copyright           string
+	primaryCategory     string
+	secondaryCategory   string
+	ageRating           *asc.AgeRatingDeclarationAttributes
+	review              *MetadataReviewConfig
+	availability        *asc.AppAvailabilityV2CreateAttributes
+	price               *asc.AppPriceScheduleCreateAttributes
+}
+
+// MetadataPlanCommand shows the changes needed to match a metadata file.
+func MetadataPlanCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("plan", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env; defaults to app.id in the file)")
+	versionID := fs.String("version-id", "", "App Store version ID (defaults to app.versionId in the file)")
+	file := fs.String("file", "", "Path to metadata YAML (required)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "plan",
+		ShortUsage: "asc metadata plan --file ./metadata.yaml [flags]",
+		ShortHelp:  "Show a field-level diff between a metadata file and live state.",
+		LongHelp: `Show a field-level diff between a metadata file and live state.
+
+Fields and sections left out of the file are not managed and never show up
+as changes. Nothing is modified; use "asc metadata apply" to make the changes.
+
+Examples:
+  asc metadata plan --file "./metadata.yaml"
+  asc metadata plan --file "./metadata.yaml" --output table
+  asc metadata plan --app "APP_ID" --version-id "VERSION_ID" --file "./metadata.yaml"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			return runMetadataPlan(ctx, "metadata plan", *appID, *versionID, *file, *output, *pretty, false)
+		},
+	}
+}
+
+// MetadataApplyCommand applies a metadata file to App Store Connect.
+func MetadataApplyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("apply", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env; defaults to app.id in the file)")
+	versionID := fs.String("version-id", "", "App Store version ID (defaults to app.versionId in the file)")
+	file := fs.String("file", "", "Path to metadata YAML (required)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "apply",
+		ShortUsage: "asc metadata apply --file ./metadata.yaml [flags]",
+		ShortHelp:  "Apply a metadata file to App Store Connect.",
+		LongHelp: `Apply a metadata file to App Store Connect.
+
+Computes the same plan as "asc metadata plan" and makes only those changes,
+one request per localization or resource. Missing localizations and the
+App Review detail are created. Availability changes only the listed
+territories and the price change creates a schedule starting today.
+
+Apply stops at the first failed request and reports the remaining changes
+as skipped.
+
+Examples:
+  asc metadata apply --file "./metadata.yaml"
+  asc metadata apply --file "./metadata.yaml" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			return runMetadataPlan(ctx, "metadata apply", *appID, *versionID, *file, *output, *pretty, true)
+		},
+	}
+}
+
+func runMetadataPlan(ctx context.Context, name, appID, versionID, file, output string, pretty, apply bool) error {
+	fileValue := strings.TrimSpace(file)
+	if fileValue == "" {
+		fmt.Fprintf(os.Stderr, "Error: --file is required\n\n")
+		return flag.ErrHelp
+	}
+
+	config, err := readMetadataConfigYAML(fileValue)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	resolvedAppID := shared.ResolveAppID(appID)
+	fileAppID := strings.TrimSpace(config.App.ID)
+	switch {
+	case resolvedAppID == "":
+		resolvedAppID = fileAppID
+	case fileAppID != "" && fileAppID != resolvedAppID:
+		return fmt.Errorf("%s: app %q does not match app.id %q in %s", name, resolvedAppID, fileAppID, fileValue)
+	}
+	if resolvedAppID == "" {
+		fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID or app.id in the file)\n\n")
+		return flag.ErrHelp
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	resolvedVersionID := strings.TrimSpace(versionID)
+	if resolvedVersionID == "" {
+		resolvedVersionID, err = resolveMetadataVersionID(requestCtx, client, resolvedAppID, config.App.VersionID, config.App.Version, config.App.Platform)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	state, err := fetchMetadataState(requestCtx, client, resolvedAppID, resolvedVersionID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	steps, err := planMetadataChanges(requestCtx, client, config, state)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	changes := metadataChanges(steps)
+	var applyErr error
+	if apply {
+		applyErr = applyMetadataChanges(requestCtx, client, state, steps, changes)
+	}
+
+	result := &asc.MetadataPlanResult{
+		File:      filepath.Clean(fileValue),
+		AppID:     resolvedAppID,
+		VersionID: resolvedVersionID,
+		Applied:   apply,
+		Changes:   changes,
+	}
+	if err := shared.PrintOutput(result, output, pretty); err != nil {
+		return err
+	}
+	if applyErr != nil {
+		return fmt.Errorf("%s: %w", name, applyErr)
+	}
+	return nil
+}
+
+func readMetadataConfigYAML(path string) (*MetadataConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read metadata: %w", err)
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var config MetadataConfig
+	if err := decoder.Decode(&config); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("metadata %s is empty", path)
+		}
+		return nil, fmt.Errorf("parse metadata %s: %w", path, err)
+	}
+	if err := validateMetadataConfig(&config); err != nil {
+		return nil, fmt.Errorf("invalid metadata %s: %w", path, err)
+	}
+	return &config, nil
+}
+
+func validateMetadataConfig(config *MetadataConfig) error {
+	kinds := ageRatingFieldKinds()
+	for key, value := range config.AgeRating {
+		kind, ok := kinds[key]
+		if !ok {
+			return fmt.Errorf("ageRating: unknown field %q", key)
+		}
+		switch kind {
+		case reflect.Bool:
+			if _, ok := value.(bool); !ok {
+				return fmt.Errorf("ageRating.%s must be true or false", key)
+			}
+		case reflect.String:
+			if _, ok := value.(string); !ok {
+				return fmt.Errorf("ageRating.%s must be a string", key)
+			}
+		}
+	}
+	if config.Availability != nil {
+		if config.Availability.Territories == nil {
+			return fmt.Errorf("availability needs a territories list")
+		}
+		for i, territory := range config.Availability.Territories {
+			if strings.TrimSpace(territory) == "" {
+				return fmt.Errorf("availability.territories[%d] is empty", i)
+			}
+		}
+	}
+	if config.Pricing != nil {
+		if strings.TrimSpace(config.Pricing.BaseTerritory) == "" {
+			return fmt.Errorf("pricing needs a baseTerritory")
+		}
+		if _, err := strconv.ParseFloat(strings.TrimSpace(config.Pricing.Price), 64); err != nil {
+			return fmt.Errorf("pricing.price %q is not a number", config.Pricing.Price)
+		}
+	}
+	return nil
+}
+
+// ageRatingFieldKinds maps age rating API field names to their value kind.
+func ageRatingFieldKinds() map[string]reflect.Kind {
+	t := reflect.TypeOf(asc.AgeRatingDeclarationAttributes{})
+	kinds := make(map[string]reflect.Kind, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		kinds[name] = field.Type.Elem().Kind()
+	}
+	return kinds
+}
+
+// planMetadataChanges diffs the file against live state and returns one step
+// per request needed to converge. Empty fields in the file are not managed.
+func planMetadataChanges(ctx context.Context, client metadataClient, config *MetadataConfig, state *metadataState) ([]*metadataStep, error) {
+	var steps []*metadataStep
+
+	if copyright := strings.TrimSpace(config.Copyright); copyright != "" && copyright != state.version.Attributes.Copyright {
+		steps = append(steps, &metadataStep{
+			action:    metadataActionUpdateCopyright,
+			id:        state.version.ID,
+			copyright: copyright,
+			changes:   []metadataFieldChange{{field: "copyright", from: state.version.Attributes.Copyright, to: copyright}},
+		})
+	}
+
+	if config.Categories != nil {
+		step := &metadataStep{action: metadataActionUpdateCategories, id: state.appInfoID}
+		if primary := strings.TrimSpace(config.Categories.Primary); primary != "" && primary != state.primaryCategory {
+			step.primaryCategory = primary
+			step.changes = append(step.changes, metadataFieldChange{field: "categories.primary", from: state.primaryCategory, to: primary})
+		}
+		if secondary := strings.TrimSpace(config.Categories.Secondary); secondary != "" && secondary != state.secondaryCategory {
+			step.secondaryCategory = secondary
+			step.changes = append(step.changes, metadataFieldChange{field: "categories.secondary", from: state.secondaryCategory, to: secondary})
+		}
+		if len(step.changes) > 0 {
+			steps = append(steps, step)
+		}
+	}
+
+	localeSteps, err := planLocalizationSteps(config.Localizations, state)
+	if err != nil {
+		return nil, err
+	}
+	steps = append(steps, localeSteps...)
+
+	if len(config.AgeRating) > 0 {
+		step, err := planAgeRatingStep(config.AgeRating, state)
+		if err != nil {
+			return nil, err
+		}
+		if step != nil {
+			steps = append(steps, step)
+		}
+	}
+
+	if config.Review != nil {
+		if step := planReviewStep(config.Review, state); step != nil {
+			steps = append(steps, step)
+		}
+	}
+
+	if config.Availability != nil {
+		if step := planAvailabilityStep(config.Availability, state); step != nil {
+			steps = append(steps, step)
+		}
+	}
+
+	if config.Pricing != nil {
+		step, err := planPriceStep(ctx, client, config.Pricing, state)
+		if err != nil {
+			return nil, err
+		}
+		if step != nil {
+			steps = append(steps, step)
+		}
+	}
+	return steps, nil
+}
+
+// diffString records a change when want is set and differs from live.
+func diffString(changes []metadataFieldChange, field, live, want string) ([]metadataFieldChange, bool) {
+	if want == "" || want == live {
+		return changes, false
+	}
+	return append(changes, metadataFieldChange{field: field, from: live, to: want}), true
+}
+
+func planLocalizationSteps(locales map[string]MetadataLocalizationConfig, state *metadataState) ([]*metadataStep, error) {
+	names := make([]string, 0, len(locales))
+	for locale := range locales {
+		names = append(names, locale)
+	}
+	sort.Strings(names)
+
+	var steps []*metadataStep
+	for _, locale := range names {
+		cfg := locales[locale]
+
+		live, exists := state.appInfoLocalizations[locale]
+		attrs := asc.AppInfoLocalizationAttributes{}
+		var changes []metadataFieldChange
+		var changed bool
+		if changes, changed = diffString(changes, "name", live.Attributes.Name, cfg.Name); changed {
+			attrs.Name = cfg.Name
+		}
+		if changes, changed = diffString(changes, "subtitle", live.Attributes.Subtitle, cfg.Subtitle); changed {
+			attrs.Subtitle = cfg.Subtitle
+		}
+		if changes, changed = diffString(changes, "privacyPolicyUrl", live.Attributes.PrivacyPolicyURL, cfg.PrivacyPolicyURL); changed {
+			attrs.PrivacyPolicyURL = cfg.PrivacyPolicyURL
+		}
+		if changes, changed = diffString(changes, "privacyChoicesUrl", live.Attributes.PrivacyChoicesURL, cfg.PrivacyChoicesURL); changed {
+			attrs.PrivacyChoicesURL = cfg.PrivacyChoicesURL
+		}
+		if changes, changed = diffString(changes, "privacyPolicyText", live.Attributes.PrivacyPolicyText, cfg.PrivacyPolicyText); changed {
+			attrs.PrivacyPolicyText = cfg.PrivacyPolicyText
+		}
+		if len(changes) > 0 {
+			step := &metadataStep{action: metadataActionUpdateAppInfoLocalization, locale: locale, id: live.ID, changes: changes, appInfoLocalization: &attrs}
+			if !exists {
+				if cfg.Name == "" {
+					return nil, fmt.Errorf("localization %q: name is required to create an app info localization", locale)
+				}
+				step.action = metadataActionCreateAppInfoLocalization
+				attrs.Locale = locale
+			}
+			steps = append(steps, step)
+		}
+
+		liveVersion, versionExists := state.versionLocalizations[locale]
+		versionAttrs := asc.AppStoreVersionLocalizationAttributes{}
+		changes = nil
+		if changes, changed = diffString(changes, "description", liveVersion.Attributes.Description, cfg.Description); changed {
+			versionAttrs.Description = cfg.Description
+		}
+		if changes, changed = diffString(changes, "keywords", liveVersion.Attributes.Keywords, cfg.Keywords); changed {
+			versionAttrs.Keywords = cfg.Keywords
+		}
+		if changes, changed = diffString(changes, "whatsNew", liveVersion.Attributes.WhatsNew, cfg.WhatsNew); changed {
+			versionAttrs.WhatsNew = cfg.WhatsNew
+		}
+		if changes, changed = diffString(changes, "promotionalText", liveVersion.Attributes.PromotionalText, cfg.PromotionalText); changed {
+			versionAttrs.PromotionalText = cfg.PromotionalText
+		}
+		if changes, changed = diffString(changes, "supportUrl", liveVersion.Attributes.SupportURL, cfg.SupportURL); changed {
+			versionAttrs.SupportURL = cfg.SupportURL
+		}
+		if changes, changed = diffString(changes, "marketingUrl", liveVersion.Attributes.MarketingURL, cfg.MarketingURL); changed {
+			versionAttrs.MarketingURL = cfg.MarketingURL
+		}
+		if len(changes) > 0 {
+			step := &metadataStep{action: metadataActionUpdateVersionLocalization, locale: locale, id: liveVersion.ID, changes: changes, versionLocalization: &versionAttrs}
+			if !versionExists {
+				step.action = metadataActionCreateVersionLocalization
+				versionAttrs.Locale = locale
+			}
+			steps = append(steps, step)
+		}
+	}
+	return steps, nil
+}
+
+func planAgeRatingStep(desired map[string]any, state *metadataState) (*metadataStep, error) {
+	if state.ageRatingID == "" {
+		return nil, fmt.Errorf("ageRating: app info has no age rating declaration")
+	}
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	update := map[string]any{}
+	var changes []metadataFieldChange
+	for _, key := range keys {
+		want, live := desired[key], state.ageRating[key]
+		if live != nil && fmt.Sprint(live) == fmt.Sprint(want) {
+			continue
+		}
+		update[key] = want
+		from := ""
+		if live != nil {
+			from = fmt.Sprint(live)
+		}
+		changes = append(changes, metadataFieldChange{field: "ageRating." + key, from: from, to: fmt.Sprint(want)})
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(update)
+	if err != nil {
+		return nil, fmt.Errorf("ageRating: %w", err)
+	}
+	var attrs asc.AgeRatingDeclarationAttributes
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		return nil, fmt.Errorf("ageRating: %w", err)
+	}
+	return &metadataStep{action: metadataActionUpdateAgeRating, id: state.ageRatingID, changes: changes, ageRating: &attrs}, nil
+}
+
+func planReviewStep(desired *MetadataReviewConfig, state *metadataState) *metadataStep {
+	live := asc.AppStoreReviewDetailAttributes{}
+	step := &metadataStep{action: metadataActionCreateReviewDetail, review: &MetadataReviewConfig{}}
+	if state.review != nil {
+		live = state.review.Attributes
+		step.action = metadataActionUpdateReviewDetail
+		step.id = state.review.ID
+	}
+
+	var changed bool
+	if step.changes, changed = diffString(step.changes, "review.contactFirstName", live.ContactFirstName, desired.ContactFirstName); changed {
+		step.review.ContactFirstName = desired.ContactFirstName
+	}
+	if step.changes, changed = diffString(step.changes, "review.contactLastName", live.ContactLastName, desired.ContactLastName); changed {
+		step.review.ContactLastName = desired.ContactLastName
+	}
+	if step.changes, changed = diffString(step.changes, "review.contactPhone", live.ContactPhone, desired.ContactPhone); changed {
+		step.review.ContactPhone = desired.ContactPhone
+	}
+	if step.changes, changed = diffString(step.changes, "review.contactEmail", live.ContactEmail, desired.ContactEmail); changed {
+		step.review.ContactEmail = desired.ContactEmail
+	}
+	if desired.DemoAccountRequired != nil && (state.review == nil || *desired.DemoAccountRequired != live.DemoAccountRequired) {
+		step.review.DemoAccountRequired = desired.DemoAccountRequired
+		step.changes = append(step.changes, metadataFieldChange{
+			field: "review.demoAccountRequired",
+			from:  strconv.FormatBool(live.DemoAccountRequired),
+			to:    strconv.FormatBool(*desired.DemoAccountRequired),
+		})
+	}
+	if step.changes, changed = diffString(step.changes, "review.demoAccountName", live.DemoAccountName, desired.DemoAccountName); changed {
+		step.review.DemoAccountName = desired.DemoAccountName
+	}
+	if desired.DemoAccountPassword != "" && desired.DemoAccountPassword != live.DemoAccountPassword {
+		step.review.DemoAccountPassword = desired.DemoAccountPassword
+		step.changes = append(step.changes, metadataFieldChange{field: "review.demoAccountPassword", from: "(hidden)", to: "(hidden)"})
+	}
+	if step.changes, changed = diffString(step.changes, "review.notes", live.Notes, desired.Notes); changed {
+		step.review.Notes = desired.Notes
+	}
+	if len(step.changes) == 0 {
+		return nil
+	}
+	return step
+}
+
+func planAvailabilityStep(desired *MetadataAvailabilityConfig, state *metadataState) *metadataStep {
+	wanted := make(map[string]bool, len(desired.Territories))
+	for _, territory := range desired.Territories {
+		wanted[strings.ToUpper(strings.TrimSpace(territory))] = true
+	}
+
+	territories := make(map[string]struct{}, len(wanted)+len(state.territories))
+	for territory := range wanted {
+		territories[territory] = struct{}{}
+	}
+	for territory := range state.territories {
+		territories[territory] = struct{}{}
+	}
+	names := make([]string, 0, len(territories))
+	for territory := range territories {
+		names = append(names, territory)
+	}
+	sort.Strings(names)
+
+	attrs := &asc.AppAvailabilityV2CreateAttributes{}
+	step := &metadataStep{action: metadataActionUpdateAvailability, id: state.availabilityID, availability: attrs}
+	for _, territory := range names {
+		if wanted[territory] == state.territories[territory] {
+			continue
+		}
+		attrs.TerritoryAvailabilities = append(attrs.TerritoryAvailabilities, asc.TerritoryAvailabilityCreate{
+			TerritoryID: territory,
+			Available:   wanted[territory],
+		})
+		step.changes = append(step.changes, metadataFieldChange{
+			field: "availability." + territory,
+			from:  strconv.FormatBool(state.territories[territory]),
+			to:    strconv.FormatBool(wanted[territory]),
+		})
+	}
+
+	availableInNew := state.availableInNewTerritories
+	if desired.AvailableInNewTerritories != nil {
+		availableInNew = *desired.AvailableInNewTerritories
+		if state.availabilityID == "" || availableInNew != state.availableInNewTerritories {
+			step.changes = append(step.changes, metadataFieldChange{
+				field: "availability.availableInNewTerritories",
+				from:  strconv.FormatBool(state.availableInNewTerritories),
+				to:    strconv.FormatBool(availableInNew),
+			})
+		}
+	}
+	attrs.AvailableInNewTerritories = &availableInNew
+
+	if len(step.changes) == 0 {
+		return nil
+	}
+	return step
+}
+
+func planPriceStep(ctx context.Context, client metadataClient, desired *MetadataPricingConfig, state *metadataState) (*metadataStep, error) {
+	baseTerritory := strings.ToUpper(strings.TrimSpace(desired.BaseTerritory))
+	price := strings.TrimSpace(desired.Price)
+
+	var changes []metadataFieldChange
+	if baseTerritory != state.baseTerritory {
+		changes = append(changes, metadataFieldChange{field: "pricing.baseTerritory", from: state.baseTerritory, to: baseTerritory})
+	}
+	if !samePrice(price, state.price) {
+		changes = append(changes, metadataFieldChange{field: "pricing.price", from: state.price, to: price})
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	pricePointID, err := findAppPricePoint(ctx, client, state.appID, baseTerritory, price)
+	if err != nil {
+		return nil, err
+	}
+	return &metadataStep{
+		action:  metadataActionUpdatePrice,
+		id:      state.priceScheduleID,
+		changes: changes,
+		price: &asc.AppPriceScheduleCreateAttributes{
+			PricePointID:    pricePointID,
+			StartDate:       time.Now().UTC().Format("2006-01-02"),
+			BaseTerritoryID: baseTerritory,
+		},
+	}, nil
+}
+
+// findAppPricePoint returns the price point with the given customer price in
+// a territory.
+func findAppPricePoint(ctx context.Context, client metadataClient, appID, territory, price string) (string, error) {
+	firstPage, err := client.GetAppPricePoints(ctx, appID, asc.WithPricePointsTerritory(territory), asc.WithPricePointsLimit(200))
+	if err != nil {
+		return "", fmt.Errorf("fetch price points: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppPricePoints(ctx, appID, asc.WithPricePointsNextURL(nextURL))
+	})
+	if err != nil {
+		return "", fmt.Errorf("fetch price points: %w", err)
+	}
+	resp, ok := paginated.(*asc.AppPricePointsV3Response)
+	if !ok {
+		return "", fmt.Errorf("unexpected price points response type %T", paginated)
+	}
+	for _, point := range resp.Data {
+		if samePrice(point.Attributes.CustomerPrice, price) {
+			return point.ID, nil
+		}
+	}
+	return "", fmt.Errorf("pricing: no %s price point with customer price %s", territory, price)
+}
+
+// metadataChanges flattens steps into one planned row per field.
+func metadataChanges(steps []*metadataStep) []asc.MetadataChange {
+	changes := make([]asc.MetadataChange, 0, len(steps))
+	for _, step := range steps {
+		for _, change := range step.changes {
+			changes = append(changes, asc.MetadataChange{
+				Action: step.action,
+				Locale: step.locale,
+				Field:  change.field,
+				From:   change.from,
+				To:     change.to,
+				Status: shared.StepStatusPlanned,
+			})
+		}
+	}
+	return changes
+}
+
+// applyMetadataChanges runs steps in order and records each outcome on the
+// step's rows in changes. It stops at the first failure and marks the
+// remaining rows as skipped.
+func applyMetadataChanges(ctx context.Context, client metadataClient, state *metadataState, steps []*metadataStep, changes []asc.MetadataChange) error {
+	offsets := make([]int, len(steps)+1)
+	for i, step := range steps {
+		offsets[i+1] = offsets[i] + len(step.changes)
+	}
+	failed, err := shared.ApplySteps(len(steps), func(i int) error {
+		return applyMetadataStep(ctx, client, state, steps[i])
+	}, func(i int, status string, err error) {
+		for row := offsets[i]; row < offsets[i+1]; row++ {
+			changes[row].Status = status
+			if err != nil {
+				changes[row].Error = err.Error()
+			}
+		}
+	})
+	if err != nil {
+		subject := steps[failed].action
+		if steps[failed].locale != "" {
+			subject += " " + steps[failed].locale
+		}
+		return fmt.Errorf("%s: %w", subject, err)
+	}
+	return nil
+}
+
+func applyMetadataStep(ctx context.Context, client metadataClient, state *metadataState, step *metadataStep) error {
+	var err error
+	switch step.action {
+	case metadataActionCreateVersionLocalization:
+		_, err = client.CreateAppStoreVersionLocalization(ctx, state.version.ID, *step.versionLocalization)
+	case metadataActionUpdateVersionLocalization:
+		_, err = client.UpdateAppStoreVersionLocalization(ctx, step.id, *step.versionLocalization)
+	case metadataActionCreateAppInfoLocalization:
+		_, err = client.CreateAppInfoLocalization(ctx, state.appInfoID, *step.appInfoLocalization)
+	case metadataActionUpdateAppInfoLocalization:
+		_, err = client.UpdateAppInfoLocalization(ctx, step.id, *step.appInfoLocalization)
+	case metadataActionUpdateCopyright:
+		_, err = client.UpdateAppStoreVersion(ctx, step.id, asc.AppStoreVersionUpdateAttributes{Copyright: &step.copyright})
+	case metadataActionUpdateCategories:
+		_, err = client.UpdateAppInfoCategories(ctx, step.id, step.primaryCategory, step.secondaryCategory)
+	case metadataActionUpdateAgeRating:
+		_, err = client.UpdateAgeRatingDeclaration(ctx, step.id, *step.ageRating)
+	case metadataActionCreateReviewDetail:
+		_, err = client.CreateAppStoreReviewDetail(ctx, state.version.ID, &asc.AppStoreReviewDetailCreateAttributes{
+			ContactFirstName:    optionalString(step.review.ContactFirstName),
+			ContactLastName:     optionalString(step.review.ContactLastName),
+			ContactPhone:        optionalString(step.review.ContactPhone),
+			ContactEmail:        optionalString(step.review.ContactEmail),
+			DemoAccountName:     optionalString(step.review.DemoAccountName),
+			DemoAccountPassword: optionalString(step.review.DemoAccountPassword),
+			DemoAccountRequired: step.review.DemoAccountRequired,
+			Notes:               optionalString(step.review.Notes),
+		})
+	case metadataActionUpdateReviewDetail:
+		_, err = client.UpdateAppStoreReviewDetail(ctx, step.id, asc.AppStoreReviewDetailUpdateAttributes{
+			ContactFirstName:    optionalString(step.review.ContactFirstName),
+			ContactLastName:     optionalString(step.review.ContactLastName),
+			ContactPhone:        optionalString(step.review.ContactPhone),
+			ContactEmail:        optionalString(step.review.ContactEmail),
+			DemoAccountName:     optionalString(step.review.DemoAccountName),
+			DemoAccountPassword: optionalString(step.review.DemoAccountPassword),
+			DemoAccountRequired: step.review.DemoAccountRequired,
+			Notes:               optionalString(step.review.Notes),
+		})
+	case metadataActionUpdateAvailability:
+		_, err = client.CreateAppAvailabilityV2(ctx, state.appID, *step.availability)
+	case metadataActionUpdatePrice:
+		_, err = client.CreateAppPriceSchedule(ctx, state.appID, *step.price)
+	default:
+		err = fmt.Errorf("unknown action %q", step.action)
+	}
+	return err
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,339 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type metadataStub struct {
+	metadataClient
+	pricePoints []asc.Resource[asc.AppPricePointV3Attributes]
+	failAction  string
+	calls       []string
+}
+
+func (s *metadataStub) record(call string) error {
+	s.calls = append(s.calls, call)
+	if s.failAction != "" && strings.HasPrefix(call, s.failAction) {
+		return errors.New("boom")
+	}
+	return nil
+}
+
+func (s *metadataStub) GetAppPricePoints(ctx context.Context, appID string, opts ...asc.PricePointsOption) (*asc.AppPricePointsV3Response, error) {
+	return &asc.AppPricePointsV3Response{Data: s.pricePoints}, nil
+}
+
+func (s *metadataStub) UpdateAppStoreVersion(ctx context.Context, versionID string, attrs asc.AppStoreVersionUpdateAttributes) (*asc.AppStoreVersionResponse, error) {
+	return nil, s.record("update-copyright " + versionID + " " + *attrs.Copyright)
+}
+
+func (s *metadataStub) CreateAppStoreVersionLocalization(ctx context.Context, versionID string, attributes asc.AppStoreVersionLocalizationAttributes) (*asc.AppStoreVersionLocalizationResponse, error) {
+	return nil, s.record("create-version-localization " + attributes.Locale + " " + attributes.Description)
+}
+
+func (s *metadataStub) UpdateAppStoreVersionLocalization(ctx context.Context, localizationID string, attributes asc.AppStoreVersionLocalizationAttributes) (*asc.AppStoreVersionLocalizationResponse, error) {
+	data, _ := json.Marshal(attributes)
+	return nil, s.record("update-version-localization " + localizationID + " " + string(data))
+}
+
+func (s *metadataStub) CreateAppInfoLocalization(ctx context.Context, appInfoID string, attributes asc.AppInfoLocalizationAttributes) (*asc.AppInfoLocalizationResponse, error) {
+	return nil, s.record("create-app-info-localization " + attributes.Locale + " " + attributes.Name)
+}
+
+func (s *metadataStub) UpdateAppInfoLocalization(ctx context.Context, localizationID string, attributes asc.AppInfoLocalizationAttributes) (*asc.AppInfoLocalizationResponse, error) {
+	data, _ := json.Marshal(attributes)
+	return nil, s.record("update-app-info-localization " + localizationID + " " + string(data))
+}
+
+func (s *metadataStub) UpdateAppInfoCategories(ctx context.Context, appInfoID string, primaryCategoryID, secondaryCategoryID string) (*asc.AppInfoResponse, error) {
+	return nil, s.record("update-categories " + primaryCategoryID + "/" + secondaryCategoryID)
+}
+
+func (s *metadataStub) UpdateAgeRatingDeclaration(ctx context.Context, declarationID string, attributes asc.AgeRatingDeclarationAttributes) (*asc.AgeRatingDeclarationResponse, error) {
+	data, _ := json.Marshal(attributes)
+	return nil, s.record("update-age-rating " + string(data))
+}
+
+func (s *metadataStub) UpdateAppStoreReviewDetail(ctx context.Context, detailID string, attrs asc.AppStoreReviewDetailUpdateAttributes) (*asc.AppStoreReviewDetailResponse, error) {
+	data, _ := json.Marshal(attrs)
+	return nil, s.record("update-review-detail " + string(data))
+}
+
+func (s *metadataStub) CreateAppAvailabilityV2(ctx context.Context, appID string, attrs asc.AppAvailabilityV2CreateAttributes) (*asc.AppAvailabilityV2Response, error) {
+	parts := make([]string, 0, len(attrs.TerritoryAvailabilities))
+	for _, territory := range attrs.TerritoryAvailabilities {
+		if territory.Available {
+			parts = append(parts, "+"+territory.TerritoryID)
+		} else {
+			parts = append(parts, "-"+territory.TerritoryID)
+		}
+	}
+	return nil, s.record("update-availability " + strings.Join(parts, ","))
+}
+
+func (s *metadataStub) CreateAppPriceSchedule(ctx context.Context, appID string, attrs asc.AppPriceScheduleCreateAttributes) (*asc.AppPriceScheduleResponse, error) {
+	return nil, s.record("update-price " + attrs.BaseTerritoryID + " " + attrs.PricePointID)
+}
+
+func newMetadataState() *metadataState {
+	return &metadataState{
+		appID: "app-1",
+		version: asc.Resource[asc.AppStoreVersionAttributes]{
+			ID:         "version-1",
+			Attributes: asc.AppStoreVersionAttributes{VersionString: "1.2.0", Platform: asc.Platform("IOS"), Copyright: "2025 Example"},
+		},
+		appInfoID:         "info-1",
+		primaryCategory:   "GAMES",
+		secondaryCategory: "ENTERTAINMENT",
+		versionLocalizations: map[string]asc.Resource[asc.AppStoreVersionLocalizationAttributes]{
+			"en-US": {ID: "vloc-en", Attributes: asc.AppStoreVersionLocalizationAttributes{Locale: "en-US", Description: "Old", Keywords: "a,b"}},
+		},
+		appInfoLocalizations: map[string]asc.Resource[asc.AppInfoLocalizationAttributes]{
+			"en-US": {ID: "iloc-en", Attributes: asc.AppInfoLocalizationAttributes{Locale: "en-US", Name: "Example", Subtitle: "Old subtitle"}},
+		},
+		ageRatingID: "age-1",
+		ageRating:   map[string]any{"gambling": false, "violenceRealistic": "NONE"},
+		review: &asc.Resource[asc.AppStoreReviewDetailAttributes]{
+			ID:         "review-1",
+			Attributes: asc.AppStoreReviewDetailAttributes{ContactEmail: "old@example.com", DemoAccountPassword: "secret"},
+		},
+		availabilityID:            "avail-1",
+		availableInNewTerritories: true,
+		territories:               map[string]bool{"USA": true, "GBR": true, "FRA": false},
+		priceScheduleID:           "schedule-1",
+		baseTerritory:             "USA",
+		price:                     "0.99",
+	}
+}
+
+func TestMetadataConfigFromState(t *testing.T) {
+	config := metadataConfigFromState(newMetadataState())
+
+	if config.App.VersionID != "version-1" || config.App.Version != "1.2.0" || config.App.Platform != "IOS" {
+		t.Fatalf("unexpected app section: %+v", config.App)
+	}
+	if config.Copyright != "2025 Example" || config.Categories.Primary != "GAMES" {
+		t.Fatalf("unexpected copyright or categories: %+v", config)
+	}
+	loc := config.Localizations["en-US"]
+	if loc.Name != "Example" || loc.Description != "Old" || loc.Keywords != "a,b" {
+		t.Fatalf("expected merged localization, got %+v", loc)
+	}
+	if config.Review.DemoAccountPassword != "" {
+		t.Fatal("expected demo account password to be left out")
+	}
+	if got := strings.Join(config.Availability.Territories, ","); got != "GBR,USA" {
+		t.Fatalf("expected sorted available territories, got %q", got)
+	}
+	if config.Pricing.BaseTerritory != "USA" || config.Pricing.Price != "0.99" {
+		t.Fatalf("unexpected pricing: %+v", config.Pricing)
+	}
+
+	if steps, err := planMetadataChanges(context.Background(), &metadataStub{}, config, newMetadataState()); err != nil || len(steps) != 0 {
+		t.Fatalf("expected a pulled config to plan no changes, got %v %v", steps, err)
+	}
+}
+
+func TestPlanMetadataChanges(t *testing.T) {
+	required := true
+	availableInNew := true
+	config := &MetadataConfig{
+		Copyright:  "2026 Example",
+		Categories: &MetadataCategoriesConfig{Primary: "GAMES", Secondary: "PUZZLE"},
+		Localizations: map[string]MetadataLocalizationConfig{
+			"en-US": {Name: "Example", Subtitle: "New subtitle", Description: "New", Keywords: "a,b"},
+			"de-DE": {Name: "Beispiel", Description: "Neu"},
+		},
+		AgeRating:    map[string]any{"gambling": false, "violenceRealistic": "INFREQUENT_OR_MILD"},
+		Review:       &MetadataReviewConfig{ContactEmail: "new@example.com", DemoAccountRequired: &required, DemoAccountPassword: "secret"},
+		Availability: &MetadataAvailabilityConfig{AvailableInNewTerritories: &availableInNew, Territories: []string{"usa", "FRA"}},
+		Pricing:      &MetadataPricingConfig{BaseTerritory: "USA", Price: "1.99"},
+	}
+	stub := &metadataStub{pricePoints: []asc.Resource[asc.AppPricePointV3Attributes]{
+		{ID: "pp-099", Attributes: asc.AppPricePointV3Attributes{CustomerPrice: "0.99"}},
+		{ID: "pp-199", Attributes: asc.AppPricePointV3Attributes{CustomerPrice: "1.990"}},
+	}}
+
+	steps, err := planMetadataChanges(context.Background(), stub, config, newMetadataState())
+	if err != nil {
+		t.Fatalf("planMetadataChanges() error: %v", err)
+	}
+
+	var got []string
+	for _, change := range metadataChanges(steps) {
+		subject := strings.Join(strings.Fields(change.Action+" "+change.Locale+" "+change.Field), " ")
+		got = append(got, subject+": "+change.From+" -> "+change.To)
+	}
+	want := []string{
+		"update-copyright copyright: 2025 Example -> 2026 Example",
+		"update-categories categories.secondary: ENTERTAINMENT -> PUZZLE",
+		"create-app-info-localization de-DE name:  -> Beispiel",
+		"create-version-localization de-DE description:  -> Neu",
+		"update-app-info-localization en-US subtitle: Old subtitle -> New subtitle",
+		"update-version-localization en-US description: Old -> New",
+		"update-age-rating ageRating.violenceRealistic: NONE -> INFREQUENT_OR_MILD",
+		"update-review-detail review.contactEmail: old@example.com -> new@example.com",
+		"update-review-detail review.demoAccountRequired: false -> true",
+		"update-availability availability.FRA: false -> true",
+		"update-availability availability.GBR: true -> false",
+		"update-price pricing.price: 0.99 -> 1.99",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected plan:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if err := applyMetadataChanges(context.Background(), stub, newMetadataState(), steps, metadataChanges(steps)); err != nil {
+		t.Fatalf("applyMetadataChanges() error: %v", err)
+	}
+	wantCalls := []string{
+		"update-copyright version-1 2026 Example",
+		"update-categories /PUZZLE",
+		"create-app-info-localization de-DE Beispiel",
+		"create-version-localization de-DE Neu",
+		`update-app-info-localization iloc-en {"subtitle":"New subtitle"}`,
+		`update-version-localization vloc-en {"description":"New"}`,
+		`update-age-rating {"violenceRealistic":"INFREQUENT_OR_MILD"}`,
+		`update-review-detail {"contactEmail":"new@example.com","demoAccountRequired":true}`,
+		"update-availability +FRA,-GBR",
+		"update-price USA pp-199",
+	}
+	if strings.Join(stub.calls, "\n") != strings.Join(wantCalls, "\n") {
+		t.Fatalf("unexpected calls:\n%s", strings.Join(stub.calls, "\n"))
+	}
+}
+
+func TestPlanMetadataChanges_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *MetadataConfig
+		wantErr string
+	}{
+		{
+			name:    "new app info localization without name",
+			config:  &MetadataConfig{Localizations: map[string]MetadataLocalizationConfig{"fr-FR": {Subtitle: "Sous-titre"}}},
+			wantErr: `localization "fr-FR": name is required`,
+		},
+		{
+			name:    "unknown price",
+			config:  &MetadataConfig{Pricing: &MetadataPricingConfig{BaseTerritory: "USA", Price: "3.33"}},
+			wantErr: "no USA price point with customer price 3.33",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := planMetadataChanges(context.Background(), &metadataStub{}, test.config, newMetadataState())
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestApplyMetadataChanges_StopsAtFirstFailure(t *testing.T) {
+	config := &MetadataConfig{
+		Copyright:  "2026 Example",
+		Categories: &MetadataCategoriesConfig{Primary: "BOOKS"},
+		Localizations: map[string]MetadataLocalizationConfig{
+			"en-US": {Subtitle: "New subtitle"},
+		},
+	}
+	stub := &metadataStub{failAction: "update-categories"}
+
+	steps, err := planMetadataChanges(context.Background(), stub, config, newMetadataState())
+	if err != nil {
+		t.Fatalf("planMetadataChanges() error: %v", err)
+	}
+	changes := metadataChanges(steps)
+	err = applyMetadataChanges(context.Background(), stub, newMetadataState(), steps, changes)
+	if err == nil || !strings.Contains(err.Error(), "update-categories: boom") {
+		t.Fatalf("expected update-categories failure, got %v", err)
+	}
+
+	statuses := make([]string, 0, len(changes))
+	for _, change := range changes {
+		statuses = append(statuses, change.Status)
+	}
+	if got := strings.Join(statuses, ","); got != "applied,failed,skipped" {
+		t.Fatalf("unexpected statuses: %s", got)
+	}
+	if changes[1].Error != "boom" {
+		t.Fatalf("expected failure recorded on change, got %+v", changes[1])
+	}
+}
+
+func TestReadMetadataConfigYAML(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{name: "empty", yaml: "", wantErr: "is empty"},
+		{name: "unknown key", yaml: "app:\n  id: app-1\ntitle: x\n", wantErr: "field title not found"},
+		{name: "unknown age rating field", yaml: "ageRating:\n  casinos: true\n", wantErr: `ageRating: unknown field "casinos"`},
+		{name: "wrong age rating type", yaml: "ageRating:\n  gambling: \"yes\"\n", wantErr: "ageRating.gambling must be true or false"},
+		{name: "availability without territories", yaml: "availability:\n  availableInNewTerritories: true\n", wantErr: "needs a territories list"},
+		{name: "pricing without number", yaml: "pricing:\n  baseTerritory: USA\n  price: free\n", wantErr: `pricing.price "free" is not a number`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "metadata.yaml")
+			if err := os.WriteFile(path, []byte(test.yaml), 0o600); err != nil {
+				t.Fatalf("write file: %v", err)
+			}
+			_, err := readMetadataConfigYAML(path)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestMetadataConfigRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metadata.yaml")
+	if err := writeMetadataConfigYAML(path, metadataConfigFromState(newMetadataState())); err != nil {
+		t.Fatalf("writeMetadataConfigYAML() error: %v", err)
+	}
+	config, err := readMetadataConfigYAML(path)
+	if err != nil {
+		t.Fatalf("readMetadataConfigYAML() error: %v", err)
+	}
+	if config.AgeRating["violenceRealistic"] != "NONE" || config.Localizations["en-US"].Subtitle != "Old subtitle" {
+		t.Fatalf("unexpected round trip: %+v", config)
+	}
+}
+
+func TestCurrentBasePrice(t *testing.T) {
+	prices := &asc.AppPricesResponse{
+		Data: []asc.Resource[asc.AppPriceAttributes]{
+			{ID: "p-old", Attributes: asc.AppPriceAttributes{StartDate: "2025-01-01", EndDate: "2026-01-01"}, Relationships: json.RawMessage(`{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-1"}},"territory":{"data":{"type":"territories","id":"USA"}}}`)},
+			{ID: "p-now", Attributes: asc.AppPriceAttributes{StartDate: "2026-01-01"}, Relationships: json.RawMessage(`{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-2"}},"territory":{"data":{"type":"territories","id":"USA"}}}`)},
+			{ID: "p-next", Attributes: asc.AppPriceAttributes{StartDate: "2027-01-01"}, Relationships: json.RawMessage(`{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-3"}},"territory":{"data":{"type":"territories","id":"USA"}}}`)},
+			{ID: "p-gbr", Attributes: asc.AppPriceAttributes{}, Relationships: json.RawMessage(`{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-4"}},"territory":{"data":{"type":"territories","id":"GBR"}}}`)},
+		},
+		Included: json.RawMessage(`[
+			{"type":"appPricePoints","id":"pp-1","attributes":{"customerPrice":"0.99"}},
+			{"type":"appPricePoints","id":"pp-2","attributes":{"customerPrice":"1.99"}},
+			{"type":"appPricePoints","id":"pp-3","attributes":{"customerPrice":"2.99"}},
+			{"type":"appPricePoints","id":"pp-4","attributes":{"customerPrice":"1.49"}},
+			{"type":"territories","id":"USA","attributes":{"currency":"USD"}}
+		]`),
+	}
+
+	price, err := currentBasePrice(prices, "USA", "2026-10-17")
+	if err != nil {
+		t.Fatalf("currentBasePrice() error: %v", err)
+	}
+	if price != "1.99" {
+		t.Fatalf("expected current USA price 1.99, got %q", price)
+	}
+}
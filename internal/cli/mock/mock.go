@@ -0,0 +1,171 @@
+package mock
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/mockserver"
+)
+
+const defaultMockAddr = "127.0.0.1:8787"
+
+// MockCommand returns the mock command group.
+func MockCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("mock", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "mock",
+		ShortUsage: "asc mock <subcommand> [flags]",
+		ShortHelp:  "Run a local mock of the App Store Connect API.",
+		LongHelp: `Run a local mock of the App Store Connect API for offline testing.
+
+Point the CLI at the mock with ASC_BASE_URL (or base_url in config.json).
+
+Examples:
+  asc mock serve
+  asc mock serve --fixtures ./fixtures --addr 127.0.0.1:9000
+  ASC_BASE_URL=http://127.0.0.1:8787 asc apps list`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MockServeCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// MockServeCommand returns the mock serve subcommand.
+func MockServeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("mock serve", flag.ExitOnError)
+
+	addr := fs.String("addr", defaultMockAddr, "Address to listen on (host:port)")
+	fixtures := fs.String("fixtures", "", "Fixture JSON file or directory of *.json fixtures")
+	noDefaults := fs.Bool("no-defaults", false, "Do not load the built-in canned fixture")
+	keyID := fs.String("key-id", "", "Require tokens with this key ID (kid)")
+	issuerID := fs.String("issuer-id", "", "Require tokens with this issuer ID (iss)")
+	privateKey := fs.String("private-key", "", "Verify token signatures with this .p8 key")
+	noAuth := fs.Bool("no-auth", false, "Accept requests without validating the bearer token")
+	quiet := fs.Bool("quiet", false, "Do not log requests to stderr")
+
+	return &ffcli.Command{
+		Name:       "serve",
+		ShortUsage: "asc mock serve [flags]",
+		ShortHelp:  "Serve a mock App Store Connect API.",
+		LongHelp: `Serve a mock App Store Connect API.
+
+The server keeps JSON:API resources in memory and supports list, get, create,
+update, delete and relationship endpoints, so flows such as
+"asc publish testflight" and "asc submit create" run end-to-end offline.
+Build uploads are accepted locally and turn into VALID builds once committed.
+
+Every /v1 request must carry an ES256 JWT like the ones asc generates. Use
+--private-key to verify signatures, or --no-auth to skip validation.
+
+Fixture files are JSON documents with "resources" (seed data) and "routes"
+(canned responses matched by method, path and optional query), for example:
+
+  {
+    "resources": [{"type": "apps", "id": "123", "attributes": {"name": "Demo"}}],
+    "routes": [{"method": "GET", "path": "/v1/apps/123/perfPowerMetrics", "status": 200, "body": {"productData": []}}]
+  }
+
+Examples:
+  asc mock serve
+  asc mock serve --fixtures ./fixtures --no-defaults
+  asc mock serve --private-key ./AuthKey.p8 --key-id ABC123 --issuer-id ISSUER
+  ASC_BASE_URL=http://127.0.0.1:8787 asc publish testflight --app 1234567890 --ipa app.ipa --group "Internal Testers"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			listenAddr := strings.TrimSpace(*addr)
+			if listenAddr == "" {
+				fmt.Fprintln(os.Stderr, "Error: --addr is required")
+				return flag.ErrHelp
+			}
+			if *noAuth && (strings.TrimSpace(*privateKey) != "" || strings.TrimSpace(*keyID) != "" || strings.TrimSpace(*issuerID) != "") {
+				return fmt.Errorf("mock serve: --no-auth cannot be combined with --private-key, --key-id or --issuer-id")
+			}
+
+			authConfig := mockserver.AuthConfig{
+				KeyID:    strings.TrimSpace(*keyID),
+				IssuerID: strings.TrimSpace(*issuerID),
+				Disabled: *noAuth,
+			}
+			if path := strings.TrimSpace(*privateKey); path != "" {
+				publicKey, err := loadPublicKey(path)
+				if err != nil {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+				authConfig.PublicKey = publicKey
+			}
+
+			loaded := make([]*mockserver.Fixture, 0, 2)
+			// Custom fixtures load first so their canned routes win.
+			if path := strings.TrimSpace(*fixtures); path != "" {
+				fixture, err := mockserver.LoadFixtures(path)
+				if err != nil {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+				loaded = append(loaded, fixture)
+			}
+			if !*noDefaults {
+				fixture, err := mockserver.DefaultFixture()
+				if err != nil {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+				loaded = append(loaded, fixture)
+			}
+
+			var logger io.Writer = os.Stderr
+			if *quiet {
+				logger = nil
+			}
+			server := mockserver.New(mockserver.Options{
+				Auth:     authConfig,
+				Fixtures: loaded,
+				Logger:   logger,
+			})
+
+			listener, err := net.Listen("tcp", listenAddr)
+			if err != nil {
+				return fmt.Errorf("mock serve: failed to listen: %w", err)
+			}
+
+			baseURL := "http://" + listener.Addr().String()
+			fmt.Fprintf(os.Stderr, "Mock App Store Connect API listening on %s\n", baseURL)
+			fmt.Fprintf(os.Stderr, "Point asc at it with: export ASC_BASE_URL=%s\n", baseURL)
+
+			serveCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			if err := server.Serve(serveCtx, listener); err != nil {
+				return fmt.Errorf("mock serve: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+func loadPublicKey(path string) (*ecdsa.PublicKey, error) {
+	if err := auth.ValidateKeyFile(path); err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	key, err := auth.LoadPrivateKey(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load private key: %w", err)
+	}
+	return &key.PublicKey, nil
+}
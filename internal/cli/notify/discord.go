@@ -0,0 +1,100 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	discordWebhookEnvVar     = "ASC_DISCORD_WEBHOOK"
+	discordWebhookPathPrefix = "/api/webhooks/"
+	discordMaxContentLength  = 2000
+)
+
+var discordWebhookHosts = []string{"discord.com", "discordapp.com"}
+
+// DiscordMessage is the payload posted to a Discord webhook.
+type DiscordMessage struct {
+	Content  string `json:"content"`
+	Username string `json:"username,omitempty"`
+}
+
+func DiscordCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notify discord", flag.ExitOnError)
+
+	webhook := fs.String("webhook", "", "Discord webhook URL (or set "+discordWebhookEnvVar+" env var)")
+	username := fs.String("username", "", "Override the webhook's display name")
+	message := bindMessageFlags(fs, "Message to send to Discord (up to 2000 characters)")
+
+	return &ffcli.Command{
+		Name:       "discord",
+		ShortUsage: "asc notify discord --webhook URL --message TEXT",
+		ShortHelp:  "Send a message to Discord via webhook.",
+		LongHelp: `Send a message to a Discord channel via webhook.
+
+The webhook URL can be provided via --webhook flag or ASC_DISCORD_WEBHOOK env var.
+
+Examples:
+  asc notify discord --webhook "https://discord.com/api/webhooks/..." --message "Build uploaded"
+  asc notify discord --message "Release ready" --username "Release Bot"
+  asc builds latest --app "APP_ID" | asc notify discord --data - --message "Build {{.data.attributes.version}} is on TestFlight"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			webhookURL := resolveFlagOrEnv(*webhook, discordWebhookEnvVar)
+			if webhookURL == "" {
+				fmt.Fprintf(os.Stderr, "Error: --webhook is required or set %s env var\n", discordWebhookEnvVar)
+				return flag.ErrHelp
+			}
+			if err := ValidateDiscordWebhookURL(webhookURL); err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err.Error())
+				return flag.ErrHelp
+			}
+			if message.empty() {
+				fmt.Fprintln(os.Stderr, "Error: --message is required")
+				return flag.ErrHelp
+			}
+
+			msg, _, err := message.render()
+			if err != nil {
+				return fmt.Errorf("notify discord: %w", err)
+			}
+			if err := SendDiscord(ctx, webhookURL, DiscordMessage{Content: msg, Username: strings.TrimSpace(*username)}); err != nil {
+				return fmt.Errorf("notify discord: %w", err)
+			}
+
+			fmt.Fprintln(os.Stderr, "Message sent to Discord successfully")
+			return nil
+		},
+	}
+}
+
+// ValidateDiscordWebhookURL checks that a URL is a Discord webhook.
+func ValidateDiscordWebhookURL(rawURL string) error {
+	return validateServiceWebhookURL(rawURL, "Discord", discordWebhookHosts, discordWebhookPathPrefix)
+}
+
+// SendDiscord posts a message to a Discord webhook.
+func SendDiscord(ctx context.Context, webhookURL string, message DiscordMessage) error {
+	if err := ValidateDiscordWebhookURL(webhookURL); err != nil {
+		return err
+	}
+	if length := utf8.RuneCountInString(message.Content); length > discordMaxContentLength {
+		return fmt.Errorf("message is %d characters; Discord allows %d", length, discordMaxContentLength)
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	return postJSON(ctx, webhookURL, body, nil)
+}
@@ -0,0 +1,240 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	smtpHostEnvVar     = "ASC_SMTP_HOST"
+	smtpPortEnvVar     = "ASC_SMTP_PORT"
+	smtpUsernameEnvVar = "ASC_SMTP_USERNAME"
+	smtpPasswordEnvVar = "ASC_SMTP_PASSWORD"
+	smtpFromEnvVar     = "ASC_SMTP_FROM"
+	smtpDefaultPort    = 587
+)
+
+// SMTPConfig describes the SMTP server used by SendEmail.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+}
+
+// EmailMessage is a plain-text email.
+type EmailMessage struct {
+	From    string
+	To      []string
+	Subject string
+	Text    string
+}
+
+func EmailCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notify email", flag.ExitOnError)
+
+	host := fs.String("smtp-host", "", "SMTP server host (or set "+smtpHostEnvVar+" env var)")
+	port := fs.String("smtp-port", "", "SMTP server port (default 587, or set "+smtpPortEnvVar+" env var)")
+	username := fs.String("smtp-username", "", "SMTP username (or set "+smtpUsernameEnvVar+" env var)")
+	from := fs.String("from", "", "Sender address (or set "+smtpFromEnvVar+" env var)")
+	to := fs.String("to", "", "Recipient address(es), comma-separated")
+	subject := fs.String("subject", "", "Subject; a Go template over --data when it is set")
+	message := bindMessageFlags(fs, "Message body")
+
+	return &ffcli.Command{
+		Name:       "email",
+		ShortUsage: "asc notify email --smtp-host HOST --from ADDR --to ADDR --subject TEXT --message TEXT",
+		ShortHelp:  "Send a plain-text email via SMTP.",
+		LongHelp: `Send a plain-text email via SMTP.
+
+STARTTLS is used when the server offers it. The password is read from the
+ASC_SMTP_PASSWORD env var only, and is sent only over TLS or to localhost.
+
+Examples:
+  asc notify email --smtp-host smtp.example.com --from ci@example.com --to team@example.com --subject "Build uploaded" --message "Build 345 is processing"
+  ASC_SMTP_HOST=smtp.example.com ASC_SMTP_USERNAME=ci ASC_SMTP_PASSWORD=... asc notify email --from ci@example.com --to "a@example.com,b@example.com" --subject "Release" --message "Submitted"
+  asc builds latest --app "APP_ID" | asc notify email --to team@example.com --data - --subject "Build {{.data.attributes.version}}" --message "Build {{.data.attributes.version}} is on TestFlight"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			smtpHost := resolveFlagOrEnv(*host, smtpHostEnvVar)
+			if smtpHost == "" {
+				fmt.Fprintf(os.Stderr, "Error: --smtp-host is required or set %s env var\n", smtpHostEnvVar)
+				return flag.ErrHelp
+			}
+			sender := resolveFlagOrEnv(*from, smtpFromEnvVar)
+			if sender == "" {
+				fmt.Fprintf(os.Stderr, "Error: --from is required or set %s env var\n", smtpFromEnvVar)
+				return flag.ErrHelp
+			}
+			recipients := shared.SplitCSV(*to)
+			if len(recipients) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --to is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*subject) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subject is required")
+				return flag.ErrHelp
+			}
+			if message.empty() {
+				fmt.Fprintln(os.Stderr, "Error: --message is required")
+				return flag.ErrHelp
+			}
+
+			smtpPort := smtpDefaultPort
+			if value := resolveFlagOrEnv(*port, smtpPortEnvVar); value != "" {
+				parsed, err := strconv.Atoi(value)
+				if err != nil || parsed < 1 || parsed > 65535 {
+					return fmt.Errorf("notify email: --smtp-port must be between 1 and 65535")
+				}
+				smtpPort = parsed
+			}
+
+			body, data, err := message.render()
+			if err != nil {
+				return fmt.Errorf("notify email: %w", err)
+			}
+			subjectText := strings.TrimSpace(*subject)
+			if data != nil {
+				subjectText, err = RenderTemplate(subjectText, data)
+				if err != nil {
+					return fmt.Errorf("notify email: %w", err)
+				}
+			}
+
+			config := SMTPConfig{
+				Host:     smtpHost,
+				Port:     smtpPort,
+				Username: resolveFlagOrEnv(*username, smtpUsernameEnvVar),
+				Password: os.Getenv(smtpPasswordEnvVar),
+			}
+			email := EmailMessage{From: sender, To: recipients, Subject: strings.TrimSpace(subjectText), Text: body}
+			if err := SendEmail(ctx, config, email); err != nil {
+				return fmt.Errorf("notify email: %w", err)
+			}
+
+			fmt.Fprintln(os.Stderr, "Email sent successfully")
+			return nil
+		},
+	}
+}
+
+// SendEmail delivers a message through an SMTP server.
+func SendEmail(ctx context.Context, config SMTPConfig, message EmailMessage) error {
+	raw, err := buildEmail(message)
+	if err != nil {
+		return err
+	}
+	from, err := mail.ParseAddress(message.From)
+	if err != nil {
+		return fmt.Errorf("invalid --from address: %w", err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(requestCtx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+	if deadline, ok := requestCtx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else {
+		_ = conn.SetDeadline(time.Now().Add(time.Minute))
+	}
+
+	client, err := smtp.NewClient(conn, config.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: config.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("STARTTLS failed: %w", err)
+		}
+	}
+	if config.Username != "" {
+		// PlainAuth refuses to send credentials without TLS, except to localhost.
+		if err := client.Auth(smtp.PlainAuth("", config.Username, config.Password, config.Host)); err != nil {
+			return fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+
+	if err := client.Mail(from.Address); err != nil {
+		return fmt.Errorf("MAIL FROM failed: %w", err)
+	}
+	for _, recipient := range message.To {
+		address, err := mail.ParseAddress(recipient)
+		if err != nil {
+			return fmt.Errorf("invalid --to address %q: %w", recipient, err)
+		}
+		if err := client.Rcpt(address.Address); err != nil {
+			return fmt.Errorf("RCPT TO %s failed: %w", address.Address, err)
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("DATA failed: %w", err)
+	}
+	if _, err := writer.Write(raw); err != nil {
+		writer.Close()
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	return client.Quit()
+}
+
+func buildEmail(message EmailMessage) ([]byte, error) {
+	from, err := mail.ParseAddress(message.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --from address: %w", err)
+	}
+	if len(message.To) == 0 {
+		return nil, fmt.Errorf("at least one recipient is required")
+	}
+	to := make([]string, 0, len(message.To))
+	for _, recipient := range message.To {
+		address, err := mail.ParseAddress(recipient)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --to address %q: %w", recipient, err)
+		}
+		to = append(to, address.String())
+	}
+	if strings.ContainsAny(message.Subject, "\r\n") {
+		return nil, fmt.Errorf("subject must be a single line")
+	}
+
+	var b strings.Builder
+	b.WriteString("From: " + from.String() + "\r\n")
+	b.WriteString("To: " + strings.Join(to, ", ") + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", message.Subject) + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	text := strings.ReplaceAll(message.Text, "\r\n", "\n")
+	b.WriteString(strings.ReplaceAll(text, "\n", "\r\n"))
+	b.WriteString("\r\n")
+	return []byte(b.String()), nil
+}
@@ -1,40 +1,30 @@
 package notify
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
-	"io"
 	"net"
-	"net/http"
 	"net/url"
 	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
-	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
 const (
-	slackWebhookEnvVar               = "ASC_SLACK_WEBHOOK"
-	slackWebhookAllowLocalEnv        = "ASC_SLACK_WEBHOOK_ALLOW_LOCALHOST"
-	slackWebhookHost                 = "hooks.slack.com"
-	slackWebhookPathPrefix           = "/services/"
-	slackWebhookMaxResponseBodyBytes = 4096
+	slackWebhookEnvVar        = "ASC_SLACK_WEBHOOK"
+	slackWebhookAllowLocalEnv = "ASC_SLACK_WEBHOOK_ALLOW_LOCALHOST"
+	slackWebhookHost          = "hooks.slack.com"
+	slackWebhookPathPrefix    = "/services/"
 )
 
-var slackHTTPClient = func() *http.Client {
-	return &http.Client{Timeout: asc.ResolveTimeout()}
-}
-
-func slackFlags(fs *flag.FlagSet) (webhook *string, channel *string, message *string, blocksJSON *string, blocksFile *string) {
+func slackFlags(fs *flag.FlagSet) (webhook *string, channel *string, blocksJSON *string, blocksFile *string) {
 	webhook = fs.String("webhook", "", "Slack webhook URL (or set "+slackWebhookEnvVar+" env var)")
 	channel = fs.String("channel", "", "Slack channel (#channel or @username)")
-	message = fs.String("message", "", "Message to send to Slack")
 	blocksJSON = fs.String("blocks-json", "", "Slack Block Kit JSON array")
 	blocksFile = fs.String("blocks-file", "", "Path to Slack Block Kit JSON array file")
 	return
@@ -49,13 +39,27 @@ func NotifyCommand() *ffcli.Command {
 		ShortHelp:  "Send notifications to external services.",
 		LongHelp: `Send notifications to external services.
 
+Every target takes --message. With --data (a JSON file, or - for stdin) the
+message is rendered as a Go template over that document, so the output of
+another asc command can be turned into a notification. Fields use their JSON
+names; upper, lower, join, first, and json are available as functions.
+
 Examples:
   asc notify slack --webhook $WEBHOOK --message "Build uploaded"
-  ASC_SLACK_WEBHOOK=$WEBHOOK asc notify slack --message "Done"`,
+  ASC_SLACK_WEBHOOK=$WEBHOOK asc notify slack --message "Done"
+  asc notify teams --webhook $TEAMS_WEBHOOK --message "Release submitted"
+  asc notify discord --webhook $DISCORD_WEBHOOK --message "Release submitted"
+  asc notify webhook --url "https://example.com/hooks/asc" --secret $SECRET --message "Release submitted"
+  asc notify email --smtp-host smtp.example.com --from ci@example.com --to team@example.com --subject "Release" --message "Submitted"
+  asc builds latest --app "APP_ID" | asc notify slack --data - --message "Build {{.data.attributes.version}} is on TestFlight"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			SlackCommand(),
+			TeamsCommand(),
+			DiscordCommand(),
+			WebhookCommand(),
+			EmailCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -66,7 +70,8 @@ Examples:
 func SlackCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("notify slack", flag.ExitOnError)
 
-	webhook, channel, message, blocksJSON, blocksFile := slackFlags(fs)
+	webhook, channel, blocksJSON, blocksFile := slackFlags(fs)
+	message := bindMessageFlags(fs, "Message to send to Slack")
 
 	return &ffcli.Command{
 		Name:       "slack",
@@ -87,18 +92,17 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			webhookURL := resolveWebhook(*webhook)
+			webhookURL := ResolveSlackWebhook(*webhook)
 			if webhookURL == "" {
 				fmt.Fprintf(os.Stderr, "Error: --webhook is required or set %s env var\n", slackWebhookEnvVar)
 				return flag.ErrHelp
 			}
-			if err := validateSlackWebhookURL(webhookURL); err != nil {
+			if err := ValidateSlackWebhookURL(webhookURL); err != nil {
 				fmt.Fprintln(os.Stderr, "Error:", err.Error())
 				return flag.ErrHelp
 			}
 
-			msg := strings.TrimSpace(*message)
-			if msg == "" {
+			if message.empty() {
 				fmt.Fprintln(os.Stderr, "Error: --message is required")
 				return flag.ErrHelp
 			}
@@ -109,48 +113,14 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			payload := map[string]any{}
-			payload["text"] = msg
-
-			if ch := strings.TrimSpace(*channel); ch != "" {
-				payload["channel"] = ch
-			}
-			if blocks != nil {
-				payload["blocks"] = blocks
-			}
-
-			body, err := json.Marshal(payload)
+			msg, _, err := message.render()
 			if err != nil {
-				return fmt.Errorf("notify slack: failed to marshal payload: %w", err)
+				return fmt.Errorf("notify slack: %w", err)
 			}
 
-			requestCtx, cancel := shared.ContextWithTimeout(ctx)
-			defer cancel()
-
-			req, err := http.NewRequestWithContext(requestCtx, "POST", webhookURL, bytes.NewReader(body))
-			if err != nil {
-				return fmt.Errorf("notify slack: failed to create request: %w", err)
-			}
-			req.Header.Set("Content-Type", "application/json")
-
-			client := slackHTTPClient()
-			resp, err := client.Do(req)
-			if err != nil {
-				return fmt.Errorf("notify slack: failed to send: %w", err)
-			}
-			defer resp.Body.Close()
-
-			if resp.StatusCode != http.StatusOK {
-				limited := io.LimitReader(resp.Body, slackWebhookMaxResponseBodyBytes)
-				respBody, readErr := io.ReadAll(limited)
-				if readErr != nil {
-					return fmt.Errorf("notify slack: failed to read response: %w", readErr)
-				}
-				message := strings.TrimSpace(string(respBody))
-				if message == "" {
-					return fmt.Errorf("notify slack: unexpected response %d", resp.StatusCode)
-				}
-				return fmt.Errorf("notify slack: unexpected response %d: %s", resp.StatusCode, message)
+			slackMessage := SlackMessage{Text: msg, Channel: strings.TrimSpace(*channel), Blocks: blocks}
+			if err := SendSlack(ctx, webhookURL, slackMessage); err != nil {
+				return fmt.Errorf("notify slack: %w", err)
 			}
 
 			fmt.Fprintln(os.Stderr, "Message sent to Slack successfully")
@@ -159,14 +129,30 @@ Examples:
 	}
 }
 
-func resolveWebhook(flagValue string) string {
-	if v := strings.TrimSpace(flagValue); v != "" {
-		return v
+// SlackMessage is the payload posted to a Slack incoming webhook.
+type SlackMessage struct {
+	Text    string            `json:"text"`
+	Channel string            `json:"channel,omitempty"`
+	Blocks  []json.RawMessage `json:"blocks,omitempty"`
+}
+
+// SendSlack posts a message to a Slack incoming webhook.
+func SendSlack(ctx context.Context, webhookURL string, message SlackMessage) error {
+	if err := ValidateSlackWebhookURL(webhookURL); err != nil {
+		return err
 	}
-	if v := strings.TrimSpace(os.Getenv(slackWebhookEnvVar)); v != "" {
-		return v
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
 	}
-	return ""
+
+	return postJSON(ctx, webhookURL, body, nil)
+}
+
+// ResolveSlackWebhook returns the webhook flag value or the ASC_SLACK_WEBHOOK env var.
+func ResolveSlackWebhook(flagValue string) string {
+	return resolveFlagOrEnv(flagValue, slackWebhookEnvVar)
 }
 
 func parseSlackBlocks(blocksJSON string, blocksFile string) ([]json.RawMessage, error) {
@@ -201,7 +187,8 @@ func parseSlackBlocks(blocksJSON string, blocksFile string) ([]json.RawMessage,
 	return blocks, nil
 }
 
-func validateSlackWebhookURL(rawURL string) error {
+// ValidateSlackWebhookURL checks that a URL is a Slack incoming webhook.
+func ValidateSlackWebhookURL(rawURL string) error {
 	rawURL = strings.TrimSpace(rawURL)
 	parsed, err := url.Parse(rawURL)
 	if err != nil || parsed.Scheme == "" || parsed.Host == "" || parsed.User != nil {
@@ -231,7 +218,7 @@ func validateSlackWebhookURL(rawURL string) error {
 
 func allowLocalSlackWebhook() bool {
 	value := strings.TrimSpace(os.Getenv(slackWebhookAllowLocalEnv))
-	return value == "1" || strings.EqualFold(value, "true")
+	return value == "1" || strings.EqualFold(value, "true") || allowLocalNotify()
 }
 
 func isLocalhost(host string) bool {
@@ -0,0 +1,103 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	notifyAllowLocalEnv        = "ASC_NOTIFY_ALLOW_LOCALHOST"
+	notifyMaxResponseBodyBytes = 4096
+)
+
+var notifyHTTPClient = func() *http.Client {
+	return &http.Client{Timeout: asc.ResolveTimeout()}
+}
+
+// postJSON posts a JSON body and returns an error for non-2xx responses.
+func postJSON(ctx context.Context, targetURL string, body []byte, headers map[string]string) error {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, targetURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := notifyHTTPClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		limited := io.LimitReader(resp.Body, notifyMaxResponseBodyBytes)
+		respBody, readErr := io.ReadAll(limited)
+		if readErr != nil {
+			return fmt.Errorf("failed to read response: %w", readErr)
+		}
+		message := strings.TrimSpace(string(respBody))
+		if message == "" {
+			return fmt.Errorf("unexpected response %d", resp.StatusCode)
+		}
+		return fmt.Errorf("unexpected response %d: %s", resp.StatusCode, message)
+	}
+	return nil
+}
+
+// validateServiceWebhookURL checks that rawURL is an https URL on one of a
+// service's hosts (or a subdomain of one) under pathPrefix. Loopback URLs
+// are accepted when ASC_NOTIFY_ALLOW_LOCALHOST is set, for testing.
+func validateServiceWebhookURL(rawURL, service string, hosts []string, pathPrefix string) error {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" || parsed.User != nil {
+		return fmt.Errorf("--webhook must be a valid %s webhook URL", service)
+	}
+	host := strings.ToLower(parsed.Hostname())
+	if allowLocalNotify() && isLocalhost(host) {
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return fmt.Errorf("--webhook must use http or https")
+		}
+		return nil
+	}
+	if parsed.Scheme != "https" {
+		return fmt.Errorf("--webhook must use https")
+	}
+	if net.ParseIP(host) != nil || !slices.ContainsFunc(hosts, func(allowed string) bool {
+		return host == allowed || strings.HasSuffix(host, "."+allowed)
+	}) {
+		return fmt.Errorf("--webhook must target %s", strings.Join(hosts, " or "))
+	}
+	if pathPrefix != "" && !strings.HasPrefix(parsed.Path, pathPrefix) {
+		return fmt.Errorf("--webhook must start with %s", pathPrefix)
+	}
+	return nil
+}
+
+// resolveFlagOrEnv returns the flag value, or the env var when it is empty.
+func resolveFlagOrEnv(flagValue, envVar string) string {
+	if v := strings.TrimSpace(flagValue); v != "" {
+		return v
+	}
+	return strings.TrimSpace(os.Getenv(envVar))
+}
+
+func allowLocalNotify() bool {
+	value := strings.TrimSpace(os.Getenv(notifyAllowLocalEnv))
+	return value == "1" || strings.EqualFold(value, "true")
+}
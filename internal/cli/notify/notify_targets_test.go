@@ -0,0 +1,312 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+func runNotifyCommand(t *testing.T, cmd *ffcli.Command, args ...string) (string, error) {
+	t.Helper()
+	cmd.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := cmd.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = cmd.Run(context.Background())
+	})
+	return stderr, runErr
+}
+
+func capturePayload(t *testing.T, status int) (*httptest.Server, *map[string]any, *http.Header) {
+	t.Helper()
+	payload := map[string]any{}
+	header := http.Header{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for key, values := range r.Header {
+			header[key] = values
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("unmarshal payload: %v", err)
+		}
+		header.Set("X-Test-Body", string(body))
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &payload, &header
+}
+
+func writeTemplateData(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "data.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write data: %v", err)
+	}
+	return path
+}
+
+func TestRenderTemplate(t *testing.T) {
+	data, err := DecodeTemplateData([]byte(`{"data":[{"attributes":{"version":"345","number":1.20}}],"tags":["a","b"]}`))
+	if err != nil {
+		t.Fatalf("DecodeTemplateData() error: %v", err)
+	}
+
+	got, err := RenderTemplate(`Build {{(first .data).attributes.version}} {{(first .data).attributes.number}} {{join ", " .tags | upper}}`, data)
+	if err != nil {
+		t.Fatalf("RenderTemplate() error: %v", err)
+	}
+	if got != "Build 345 1.20 A, B" {
+		t.Fatalf("RenderTemplate() = %q", got)
+	}
+
+	if _, err := RenderTemplate(`{{.missing.field}}`, data); err == nil || !strings.Contains(err.Error(), "render message template") {
+		t.Fatalf("expected missing key error, got %v", err)
+	}
+	if _, err := RenderTemplate(`{{.data`, data); err == nil || !strings.Contains(err.Error(), "parse message template") {
+		t.Fatalf("expected parse error, got %v", err)
+	}
+}
+
+func TestNotifySlackRendersDataTemplate(t *testing.T) {
+	server, payload, _ := capturePayload(t, http.StatusOK)
+	t.Setenv(slackWebhookEnvVar, server.URL)
+	t.Setenv(notifyAllowLocalEnv, "1")
+
+	dataPath := writeTemplateData(t, `{"data":{"attributes":{"version":"345"}}}`)
+	if _, err := runNotifyCommand(t, SlackCommand(), "--data", dataPath, "--message", "Build {{.data.attributes.version}} is on TestFlight"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if (*payload)["text"] != "Build 345 is on TestFlight" {
+		t.Fatalf("unexpected text: %v", (*payload)["text"])
+	}
+}
+
+func TestNotifyTeamsSuccess(t *testing.T) {
+	server, payload, _ := capturePayload(t, http.StatusAccepted)
+	t.Setenv(teamsWebhookEnvVar, server.URL)
+	t.Setenv(notifyAllowLocalEnv, "1")
+
+	if _, err := runNotifyCommand(t, TeamsCommand(), "--title", "Release", "--message", "Submitted"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	attachments, _ := (*payload)["attachments"].([]any)
+	if (*payload)["type"] != "message" || len(attachments) != 1 {
+		t.Fatalf("unexpected payload: %v", *payload)
+	}
+	content := attachments[0].(map[string]any)["content"].(map[string]any)
+	body := content["body"].([]any)
+	if len(body) != 2 || body[0].(map[string]any)["text"] != "Release" || body[1].(map[string]any)["text"] != "Submitted" {
+		t.Fatalf("unexpected card body: %v", body)
+	}
+}
+
+func TestNotifyDiscordSuccess(t *testing.T) {
+	server, payload, _ := capturePayload(t, http.StatusNoContent)
+	t.Setenv(discordWebhookEnvVar, server.URL+"/api/webhooks/1/token")
+	t.Setenv(notifyAllowLocalEnv, "1")
+
+	if _, err := runNotifyCommand(t, DiscordCommand(), "--message", "Submitted", "--username", "Bot"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if (*payload)["content"] != "Submitted" || (*payload)["username"] != "Bot" {
+		t.Fatalf("unexpected payload: %v", *payload)
+	}
+}
+
+func TestNotifyDiscordRejectsLongMessage(t *testing.T) {
+	server, _, _ := capturePayload(t, http.StatusNoContent)
+	t.Setenv(discordWebhookEnvVar, server.URL)
+	t.Setenv(notifyAllowLocalEnv, "1")
+
+	_, err := runNotifyCommand(t, DiscordCommand(), "--message", strings.Repeat("x", 2001))
+	if err == nil || !strings.Contains(err.Error(), "Discord allows 2000") {
+		t.Fatalf("expected length error, got %v", err)
+	}
+}
+
+func TestNotifyWebhookSignsBody(t *testing.T) {
+	server, payload, header := capturePayload(t, http.StatusOK)
+	t.Setenv(webhookSecretEnvVar, "s3cret")
+
+	dataPath := writeTemplateData(t, `{"data":{"id":"BUILD_1"}}`)
+	if _, err := runNotifyCommand(t, WebhookCommand(), "--url", server.URL, "--data", dataPath, "--message", "Build {{.data.id}}"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if (*payload)["text"] != "Build BUILD_1" {
+		t.Fatalf("unexpected text: %v", (*payload)["text"])
+	}
+	if data, ok := (*payload)["data"].(map[string]any); !ok || data["data"].(map[string]any)["id"] != "BUILD_1" {
+		t.Fatalf("expected data document in payload, got %v", (*payload)["data"])
+	}
+
+	timestamp := header.Get(webhookTimestampHeader)
+	want := WebhookSignature("s3cret", timestamp, []byte(header.Get("X-Test-Body")))
+	if timestamp == "" || header.Get(webhookSignatureHeader) != want {
+		t.Fatalf("unexpected signature %q (timestamp %q), want %q", header.Get(webhookSignatureHeader), timestamp, want)
+	}
+}
+
+func TestWebhookSignature(t *testing.T) {
+	got := WebhookSignature("key", "1700000000", []byte(`{"text":"hi"}`))
+	want := "sha256=e0fb27bbd98eedf3b560379f4c21ed82331fa6f39b2c39ccf439a53c12cd1485"
+	if got != want {
+		t.Fatalf("WebhookSignature() = %q, want %q", got, want)
+	}
+}
+
+func TestNotifyTargetValidationErrors(t *testing.T) {
+	for _, env := range []string{teamsWebhookEnvVar, discordWebhookEnvVar, webhookSecretEnvVar, smtpHostEnvVar, smtpFromEnvVar, smtpPortEnvVar} {
+		t.Setenv(env, "")
+	}
+	tests := []struct {
+		name    string
+		cmd     func() *ffcli.Command
+		args    []string
+		wantErr string
+	}{
+		{name: "teams missing webhook", cmd: TeamsCommand, args: []string{"--message", "hi"}, wantErr: "--webhook is required or set ASC_TEAMS_WEBHOOK"},
+		{name: "teams wrong host", cmd: TeamsCommand, args: []string{"--webhook", "https://example.com/hook", "--message", "hi"}, wantErr: "--webhook must target webhook.office.com"},
+		{name: "teams missing message", cmd: TeamsCommand, args: []string{"--webhook", "https://acme.webhook.office.com/webhookb2/x"}, wantErr: "--message is required"},
+		{name: "discord wrong path", cmd: DiscordCommand, args: []string{"--webhook", "https://discord.com/channels/1", "--message", "hi"}, wantErr: "--webhook must start with /api/webhooks/"},
+		{name: "discord insecure", cmd: DiscordCommand, args: []string{"--webhook", "http://discord.com/api/webhooks/1/x", "--message", "hi"}, wantErr: "--webhook must use https"},
+		{name: "webhook missing url", cmd: WebhookCommand, args: []string{"--message", "hi"}, wantErr: "--url is required"},
+		{name: "webhook insecure remote", cmd: WebhookCommand, args: []string{"--url", "http://example.com/hook", "--message", "hi"}, wantErr: "--url must use https unless it targets localhost"},
+		{name: "email missing host", cmd: EmailCommand, args: []string{"--from", "a@example.com", "--to", "b@example.com", "--subject", "s", "--message", "m"}, wantErr: "--smtp-host is required"},
+		{name: "email missing to", cmd: EmailCommand, args: []string{"--smtp-host", "localhost", "--from", "a@example.com", "--subject", "s", "--message", "m"}, wantErr: "--to is required"},
+		{name: "email missing subject", cmd: EmailCommand, args: []string{"--smtp-host", "localhost", "--from", "a@example.com", "--to", "b@example.com", "--message", "m"}, wantErr: "--subject is required"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stderr, err := runNotifyCommand(t, test.cmd(), test.args...)
+			if !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected flag.ErrHelp, got %v", err)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+// startTestSMTPServer accepts one session and returns the DATA it received.
+func startTestSMTPServer(t *testing.T) (string, <-chan string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+		var transcript strings.Builder
+		reply("220 localhost ESMTP test")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.ToUpper(strings.TrimSpace(line))
+			transcript.WriteString(strings.TrimSpace(line) + "\n")
+			switch {
+			case strings.HasPrefix(command, "EHLO"):
+				reply("250-localhost")
+				reply("250 AUTH PLAIN")
+			case strings.HasPrefix(command, "AUTH"), strings.HasPrefix(command, "MAIL"), strings.HasPrefix(command, "RCPT"):
+				if strings.HasPrefix(command, "AUTH") {
+					reply("235 ok")
+				} else {
+					reply("250 ok")
+				}
+			case command == "DATA":
+				reply("354 go ahead")
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if dataLine == ".\r\n" {
+						break
+					}
+					transcript.WriteString(dataLine)
+				}
+				reply("250 queued")
+			case command == "QUIT":
+				reply("221 bye")
+				received <- transcript.String()
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+
+	return listener.Addr().String(), received
+}
+
+func TestNotifyEmailSendsViaSMTP(t *testing.T) {
+	addr, received := startTestSMTPServer(t)
+	host, port, _ := net.SplitHostPort(addr)
+	t.Setenv(smtpPasswordEnvVar, "pw")
+
+	dataPath := writeTemplateData(t, `{"data":{"attributes":{"version":"345"}}}`)
+	_, err := runNotifyCommand(t, EmailCommand(),
+		"--smtp-host", host, "--smtp-port", port, "--smtp-username", "ci",
+		"--from", "CI <ci@example.com>", "--to", "a@example.com, b@example.com",
+		"--data", dataPath,
+		"--subject", "Build {{.data.attributes.version}}",
+		"--message", "Build {{.data.attributes.version}} is on TestFlight\n.\nDone",
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	transcript := <-received
+	for _, want := range []string{
+		"AUTH PLAIN",
+		"MAIL FROM:<ci@example.com>",
+		"RCPT TO:<a@example.com>",
+		"RCPT TO:<b@example.com>",
+		"From: \"CI\" <ci@example.com>\r\n",
+		"To: <a@example.com>, <b@example.com>\r\n",
+		"Subject: Build 345\r\n",
+		"Build 345 is on TestFlight\r\n..\r\nDone\r\n",
+	} {
+		if !strings.Contains(transcript, want) {
+			t.Fatalf("expected transcript to contain %q, got:\n%s", want, transcript)
+		}
+	}
+}
+
+func TestBuildEmailRejectsHeaderInjection(t *testing.T) {
+	_, err := buildEmail(EmailMessage{From: "a@example.com", To: []string{"b@example.com"}, Subject: "Hi\r\nBcc: c@example.com", Text: "x"})
+	if err == nil || !strings.Contains(err.Error(), "single line") {
+		t.Fatalf("expected subject error, got %v", err)
+	}
+	_, err = buildEmail(EmailMessage{From: "a@example.com", To: []string{"not an address"}, Subject: "Hi", Text: "x"})
+	if err == nil || !strings.Contains(err.Error(), "invalid --to address") {
+		t.Fatalf("expected address error, got %v", err)
+	}
+}
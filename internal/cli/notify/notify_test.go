@@ -416,9 +416,9 @@ func TestResolveWebhook(t *testing.T) {
 			} else {
 				t.Setenv(slackWebhookEnvVar, "")
 			}
-			got := resolveWebhook(test.flagValue)
+			got := ResolveSlackWebhook(test.flagValue)
 			if got != test.want {
-				t.Errorf("resolveWebhook(%q) = %q, want %q", test.flagValue, got, test.want)
+				t.Errorf("ResolveSlackWebhook(%q) = %q, want %q", test.flagValue, got, test.want)
 			}
 		})
 	}
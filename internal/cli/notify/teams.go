@@ -0,0 +1,113 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const teamsWebhookEnvVar = "ASC_TEAMS_WEBHOOK"
+
+// teamsWebhookHosts are the hosts of Teams incoming webhooks and of
+// Power Automate workflows that post to Teams.
+var teamsWebhookHosts = []string{"webhook.office.com", "logic.azure.com", "powerplatform.com"}
+
+// TeamsMessage is a message posted to Microsoft Teams as an Adaptive Card.
+type TeamsMessage struct {
+	Title string
+	Text  string
+}
+
+func TeamsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notify teams", flag.ExitOnError)
+
+	webhook := fs.String("webhook", "", "Teams webhook URL (or set "+teamsWebhookEnvVar+" env var)")
+	title := fs.String("title", "", "Card title")
+	message := bindMessageFlags(fs, "Message to send to Teams")
+
+	return &ffcli.Command{
+		Name:       "teams",
+		ShortUsage: "asc notify teams --webhook URL --message TEXT",
+		ShortHelp:  "Send a message to Microsoft Teams via webhook.",
+		LongHelp: `Send a message to Microsoft Teams via an incoming webhook or a Power Automate
+workflow webhook. The message is posted as an Adaptive Card.
+
+The webhook URL can be provided via --webhook flag or ASC_TEAMS_WEBHOOK env var.
+
+Examples:
+  asc notify teams --webhook "https://example.webhook.office.com/..." --message "Build uploaded"
+  asc notify teams --title "Release" --message "Version 2.1 submitted for review"
+  asc builds latest --app "APP_ID" | asc notify teams --data - --message "Build {{.data.attributes.version}} is on TestFlight"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			webhookURL := resolveFlagOrEnv(*webhook, teamsWebhookEnvVar)
+			if webhookURL == "" {
+				fmt.Fprintf(os.Stderr, "Error: --webhook is required or set %s env var\n", teamsWebhookEnvVar)
+				return flag.ErrHelp
+			}
+			if err := ValidateTeamsWebhookURL(webhookURL); err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err.Error())
+				return flag.ErrHelp
+			}
+			if message.empty() {
+				fmt.Fprintln(os.Stderr, "Error: --message is required")
+				return flag.ErrHelp
+			}
+
+			msg, _, err := message.render()
+			if err != nil {
+				return fmt.Errorf("notify teams: %w", err)
+			}
+			if err := SendTeams(ctx, webhookURL, TeamsMessage{Title: strings.TrimSpace(*title), Text: msg}); err != nil {
+				return fmt.Errorf("notify teams: %w", err)
+			}
+
+			fmt.Fprintln(os.Stderr, "Message sent to Teams successfully")
+			return nil
+		},
+	}
+}
+
+// ValidateTeamsWebhookURL checks that a URL is a Teams or Power Automate webhook.
+func ValidateTeamsWebhookURL(rawURL string) error {
+	return validateServiceWebhookURL(rawURL, "Teams", teamsWebhookHosts, "")
+}
+
+// SendTeams posts a message to a Teams webhook.
+func SendTeams(ctx context.Context, webhookURL string, message TeamsMessage) error {
+	if err := ValidateTeamsWebhookURL(webhookURL); err != nil {
+		return err
+	}
+
+	var body []map[string]any
+	if message.Title != "" {
+		body = append(body, map[string]any{"type": "TextBlock", "text": message.Title, "weight": "Bolder", "size": "Medium", "wrap": true})
+	}
+	body = append(body, map[string]any{"type": "TextBlock", "text": message.Text, "wrap": true})
+
+	payload := map[string]any{
+		"type": "message",
+		"attachments": []map[string]any{{
+			"contentType": "application/vnd.microsoft.card.adaptive",
+			"content": map[string]any{
+				"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+				"type":    "AdaptiveCard",
+				"version": "1.4",
+				"body":    body,
+			},
+		}},
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	return postJSON(ctx, webhookURL, data, nil)
+}
@@ -0,0 +1,146 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// messageFlags are the message flags shared by every notify target.
+type messageFlags struct {
+	message *string
+	data    *string
+}
+
+func bindMessageFlags(fs *flag.FlagSet, usage string) messageFlags {
+	return messageFlags{
+		message: fs.String("message", "", usage),
+		data:    fs.String("data", "", "JSON file to render --message as a template with (- for stdin), e.g. asc command output"),
+	}
+}
+
+func (f messageFlags) empty() bool {
+	return strings.TrimSpace(*f.message) == ""
+}
+
+// render returns the message, rendered over --data when it is set, and the
+// decoded --data document.
+func (f messageFlags) render() (string, any, error) {
+	text := strings.TrimSpace(*f.message)
+	dataPath := strings.TrimSpace(*f.data)
+	if dataPath == "" {
+		return text, nil, nil
+	}
+	data, err := readTemplateData(dataPath)
+	if err != nil {
+		return "", nil, err
+	}
+	rendered, err := RenderTemplate(text, data)
+	if err != nil {
+		return "", nil, err
+	}
+	return strings.TrimSpace(rendered), data, nil
+}
+
+func readTemplateData(path string) (any, error) {
+	var raw []byte
+	var err error
+	if path == "-" {
+		raw, err = io.ReadAll(os.Stdin)
+	} else {
+		raw, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read --data: %w", err)
+	}
+	data, err := DecodeTemplateData(raw)
+	if err != nil {
+		return nil, fmt.Errorf("--data must contain JSON: %w", err)
+	}
+	return data, nil
+}
+
+// DecodeTemplateData decodes a JSON document for RenderTemplate. Numbers
+// keep their original text, so build numbers render as written.
+func DecodeTemplateData(raw []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("expected a single JSON document")
+	}
+	return data, nil
+}
+
+// TemplateDataFrom converts a value to the shape of its JSON output, so
+// templates address fields by their JSON names.
+func TemplateDataFrom(value any) (any, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeTemplateData(raw)
+}
+
+var templateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"join": func(sep string, items []any) string {
+		parts := make([]string, 0, len(items))
+		for _, item := range items {
+			parts = append(parts, fmt.Sprint(item))
+		}
+		return strings.Join(parts, sep)
+	},
+	"first": func(items []any) (any, error) {
+		if len(items) == 0 {
+			return nil, errors.New("list is empty")
+		}
+		return items[0], nil
+	},
+	"json": func(value any) (string, error) {
+		raw, err := json.Marshal(value)
+		return string(raw), err
+	},
+}
+
+// RenderTemplate renders text as a Go text/template over data, usually the
+// JSON output of another asc command:
+//
+//	Build {{.data.attributes.version}} is on TestFlight
+//
+// Missing keys are errors rather than "<no value>".
+func RenderTemplate(text string, data any) (string, error) {
+	tmpl, err := parseTemplate(text)
+	if err != nil {
+		return "", err
+	}
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("render message template: %w", err)
+	}
+	return out.String(), nil
+}
+
+// ValidateTemplate reports whether text parses as a message template.
+func ValidateTemplate(text string) error {
+	_, err := parseTemplate(text)
+	return err
+}
+
+func parseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("message").Option("missingkey=error").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse message template: %w", err)
+	}
+	return tmpl, nil
+}
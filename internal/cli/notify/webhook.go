@@ -0,0 +1,138 @@
+package notify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	webhookSecretEnvVar    = "ASC_WEBHOOK_SECRET"
+	webhookSignatureHeader = "X-ASC-Signature"
+	webhookTimestampHeader = "X-ASC-Timestamp"
+)
+
+// WebhookMessage is the JSON body posted by notify webhook.
+type WebhookMessage struct {
+	Text string `json:"text"`
+	Data any    `json:"data,omitempty"`
+}
+
+func WebhookCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notify webhook", flag.ExitOnError)
+
+	targetURL := fs.String("url", "", "Webhook URL (https, or http for localhost)")
+	secret := fs.String("secret", "", "HMAC-SHA256 signing secret (or set "+webhookSecretEnvVar+" env var)")
+	message := bindMessageFlags(fs, "Message text")
+
+	return &ffcli.Command{
+		Name:       "webhook",
+		ShortUsage: "asc notify webhook --url URL --message TEXT [flags]",
+		ShortHelp:  "POST a JSON message to any webhook.",
+		LongHelp: `POST a JSON message to any webhook.
+
+The body is {"text": "...", "data": ...}, where data is the --data document
+when one is given.
+
+With --secret (or ASC_WEBHOOK_SECRET), each request carries:
+  X-ASC-Timestamp: <unix seconds>
+  X-ASC-Signature: sha256=<hex HMAC-SHA256 of "<timestamp>.<body>">
+Receivers should recompute the signature and reject stale timestamps.
+
+Examples:
+  asc notify webhook --url "https://example.com/hooks/asc" --message "Build uploaded"
+  ASC_WEBHOOK_SECRET=... asc notify webhook --url "https://example.com/hooks/asc" --message "Signed"
+  asc builds latest --app "APP_ID" | asc notify webhook --url "https://example.com/hooks/asc" --data - --message "Build {{.data.attributes.version}} is on TestFlight"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			target := strings.TrimSpace(*targetURL)
+			if target == "" {
+				fmt.Fprintln(os.Stderr, "Error: --url is required")
+				return flag.ErrHelp
+			}
+			if err := ValidateWebhookURL(target); err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err.Error())
+				return flag.ErrHelp
+			}
+			if message.empty() {
+				fmt.Fprintln(os.Stderr, "Error: --message is required")
+				return flag.ErrHelp
+			}
+
+			msg, data, err := message.render()
+			if err != nil {
+				return fmt.Errorf("notify webhook: %w", err)
+			}
+			signingSecret := resolveFlagOrEnv(*secret, webhookSecretEnvVar)
+			if err := SendWebhook(ctx, target, signingSecret, WebhookMessage{Text: msg, Data: data}); err != nil {
+				return fmt.Errorf("notify webhook: %w", err)
+			}
+
+			fmt.Fprintln(os.Stderr, "Message sent to webhook successfully")
+			return nil
+		},
+	}
+}
+
+// ValidateWebhookURL checks that a URL uses https, or http on a loopback host.
+func ValidateWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Host == "" || parsed.User != nil {
+		return fmt.Errorf("--url must be a valid http or https URL")
+	}
+	switch parsed.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if isLocalhost(strings.ToLower(parsed.Hostname())) {
+			return nil
+		}
+		return fmt.Errorf("--url must use https unless it targets localhost")
+	default:
+		return fmt.Errorf("--url must be a valid http or https URL")
+	}
+}
+
+// SendWebhook posts a message as JSON, signed when secret is not empty.
+func SendWebhook(ctx context.Context, targetURL, secret string, message WebhookMessage) error {
+	if err := ValidateWebhookURL(targetURL); err != nil {
+		return err
+	}
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	var headers map[string]string
+	if secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		headers = map[string]string{
+			webhookTimestampHeader: timestamp,
+			webhookSignatureHeader: WebhookSignature(secret, timestamp, body),
+		}
+	}
+	return postJSON(ctx, targetURL, body, headers)
+}
+
+// WebhookSignature returns the X-ASC-Signature value for a request body.
+func WebhookSignature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -59,7 +59,7 @@ func OfferCodesListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	offerCodeID := fs.String("offer-code", "", "Subscription offer code ID (required)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
@@ -111,7 +111,7 @@ Examples:
 					return fmt.Errorf("offer-codes list: failed to fetch: %w", err)
 				}
 
-				pages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				pages, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetSubscriptionOfferCodeOneTimeUseCodes(ctx, trimmedOfferCodeID, asc.WithSubscriptionOfferCodeOneTimeUseCodesNextURL(nextURL))
 				})
 				if err != nil {
@@ -139,7 +139,7 @@ func OfferCodesGenerateCommand() *ffcli.Command {
 	quantity := fs.Int("quantity", 0, "Number of one-time use codes to generate (required)")
 	expirationDate := fs.String("expiration-date", "", "Expiration date (YYYY-MM-DD) (required)")
 	outputPath := fs.String("output", "", "Output file path for offer codes (one per line)")
-	outputFormat := fs.String("output-format", "json", "Output format for metadata: json (default), table, markdown")
+	outputFormat := fs.String("output-format", "json", "Output format for metadata: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
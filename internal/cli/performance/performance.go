@@ -23,13 +23,15 @@ Examples:
   asc performance metrics get --build "BUILD_ID"
   asc performance diagnostics list --build "BUILD_ID"
   asc performance diagnostics get --id "SIGNATURE_ID"
-  asc performance download --build "BUILD_ID" --output ./metrics.json`,
+  asc performance download --build "BUILD_ID" --output ./metrics.json
+  asc performance compare --base "BUILD_A" --head "BUILD_B" --threshold 10`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			PerformanceMetricsCommand(),
 			PerformanceDiagnosticsCommand(),
 			PerformanceDownloadCommand(),
+			PerformanceCompareCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -0,0 +1,393 @@
+package performance
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// PerformanceCompareCommand returns the performance compare subcommand.
+func PerformanceCompareCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("compare", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (required with --base-version and --head-version)")
+	base := fs.String("base", "", "Base build ID")
+	head := fs.String("head", "", "Head build ID")
+	baseVersion := fs.String("base-version", "", "Base app version (e.g. 2.3), compared within the --app metrics history")
+	headVersion := fs.String("head-version", "", "Head app version (e.g. 2.4), compared within the --app metrics history")
+	threshold := fs.Float64("threshold", 10, "Percent increase over base that counts as a regression")
+	platform := fs.String("platform", "", "Platform filter (IOS)")
+	metricType := fs.String("metric-type", "", "Metric types (comma-separated: "+strings.Join(perfPowerMetricTypeList(), ", ")+")")
+	deviceType := fs.String("device-type", "", "Device types (comma-separated, e.g., iPhone15,2)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "compare",
+		ShortUsage: "asc performance compare --base \"BUILD_ID\" --head \"BUILD_ID\" [flags]",
+		ShortHelp:  "Compare performance metrics between two builds and flag regressions.",
+		LongHelp: `Compare performance metrics between two builds and flag regressions.
+
+Fetches the launch, hang, memory, disk, battery, and other metrics of the base
+and head builds and compares every metric percentile per device class. When a
+metric reports several versions for a build, the latest one is used. To
+compare app versions within the app's metrics history instead, pass --app
+with --base-version and --head-version.
+
+Every metric is lower-is-better, so an increase of more than --threshold
+percent over the base is a regression. The command exits non-zero when any
+metric regresses, so it can gate a release pipeline. With the root
+--report junit flag, the report has one test case per metric.
+
+Examples:
+  asc performance compare --base "BUILD_A" --head "BUILD_B"
+  asc performance compare --base "BUILD_A" --head "BUILD_B" --threshold 5 --output table
+  asc performance compare --app "APP_ID" --base-version "2.3" --head-version "2.4" --metric-type "LAUNCH,HANG"
+  asc --report junit --report-file perf.xml performance compare --base "BUILD_A" --head "BUILD_B"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := strings.TrimSpace(*appID)
+			baseValue := strings.TrimSpace(*base)
+			headValue := strings.TrimSpace(*head)
+			baseVersionValue := strings.TrimSpace(*baseVersion)
+			headVersionValue := strings.TrimSpace(*headVersion)
+			byVersion := baseVersionValue != "" || headVersionValue != ""
+			if byVersion {
+				if baseValue != "" || headValue != "" {
+					return fmt.Errorf("performance compare: --base/--head and --base-version/--head-version are mutually exclusive")
+				}
+				if resolvedAppID == "" {
+					fmt.Fprintln(os.Stderr, "Error: --app is required with --base-version and --head-version")
+					return flag.ErrHelp
+				}
+				if baseVersionValue == "" {
+					fmt.Fprintln(os.Stderr, "Error: --base-version is required with --head-version")
+					return flag.ErrHelp
+				}
+				if headVersionValue == "" {
+					fmt.Fprintln(os.Stderr, "Error: --head-version is required with --base-version")
+					return flag.ErrHelp
+				}
+				baseValue, headValue = baseVersionValue, headVersionValue
+			} else {
+				if baseValue == "" {
+					fmt.Fprintln(os.Stderr, "Error: --base is required")
+					return flag.ErrHelp
+				}
+				if headValue == "" {
+					fmt.Fprintln(os.Stderr, "Error: --head is required")
+					return flag.ErrHelp
+				}
+			}
+			if *threshold < 0 || math.IsNaN(*threshold) || math.IsInf(*threshold, 0) {
+				return fmt.Errorf("performance compare: --threshold must be 0 or greater")
+			}
+
+			platforms, err := normalizePerfPowerMetricPlatforms(shared.SplitCSVUpper(*platform), "--platform")
+			if err != nil {
+				return fmt.Errorf("performance compare: %w", err)
+			}
+			metricTypes, err := normalizePerfPowerMetricTypes(shared.SplitCSVUpper(*metricType))
+			if err != nil {
+				return fmt.Errorf("performance compare: %w", err)
+			}
+			opts := []asc.PerfPowerMetricsOption{
+				asc.WithPerfPowerMetricsPlatforms(platforms),
+				asc.WithPerfPowerMetricsMetricTypes(metricTypes),
+				asc.WithPerfPowerMetricsDeviceTypes(shared.SplitCSV(*deviceType)),
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("performance compare: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			var baseMetrics, headMetrics perfMetricPoints
+			if byVersion {
+				resp, err := client.GetPerfPowerMetricsForApp(requestCtx, resolvedAppID, opts...)
+				if err != nil {
+					return fmt.Errorf("performance compare: %w", err)
+				}
+				if baseMetrics, err = parsePerfMetricPoints(resp.Data, baseValue); err != nil {
+					return fmt.Errorf("performance compare: %w", err)
+				}
+				if headMetrics, err = parsePerfMetricPoints(resp.Data, headValue); err != nil {
+					return fmt.Errorf("performance compare: %w", err)
+				}
+			} else {
+				baseResp, err := client.GetPerfPowerMetricsForBuild(requestCtx, baseValue, opts...)
+				if err != nil {
+					return fmt.Errorf("performance compare: base build: %w", err)
+				}
+				headResp, err := client.GetPerfPowerMetricsForBuild(requestCtx, headValue, opts...)
+				if err != nil {
+					return fmt.Errorf("performance compare: head build: %w", err)
+				}
+				if baseMetrics, err = parsePerfMetricPoints(baseResp.Data, ""); err != nil {
+					return fmt.Errorf("performance compare: base build: %w", err)
+				}
+				if headMetrics, err = parsePerfMetricPoints(headResp.Data, ""); err != nil {
+					return fmt.Errorf("performance compare: head build: %w", err)
+				}
+			}
+			if len(baseMetrics) == 0 {
+				return fmt.Errorf("performance compare: no metrics reported for base %q", baseValue)
+			}
+			if len(headMetrics) == 0 {
+				return fmt.Errorf("performance compare: no metrics reported for head %q", headValue)
+			}
+
+			result := comparePerfMetrics(baseMetrics, headMetrics, *threshold)
+			result.AppID = resolvedAppID
+			result.Base = baseValue
+			result.Head = headValue
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if shared.ReportFormat() == shared.ReportFormatJUnit {
+				junit := performanceCompareJUnitReport(result, time.Now().UTC())
+				shared.SetCommandReport(&junit)
+			}
+			if result.Regressions > 0 {
+				return shared.NewReportedError(fmt.Errorf("performance compare: %d metric(s) regressed by more than %g%%", result.Regressions, result.ThresholdPercent))
+			}
+			return nil
+		},
+	}
+}
+
+// perfMetricKey identifies one metric percentile on one device class.
+type perfMetricKey struct {
+	category   string
+	metric     string
+	device     string
+	percentile string
+}
+
+type perfMetricPoint struct {
+	value float64
+	unit  string
+}
+
+type perfMetricPoints map[perfMetricKey]perfMetricPoint
+
+// xcodeMetrics is the part of the Xcode metrics payload returned by the
+// perfPowerMetrics endpoints that comparisons use.
+type xcodeMetrics struct {
+	ProductData []struct {
+		MetricCategories []struct {
+			Identifier string `json:"identifier"`
+			Metrics    []struct {
+				Identifier string `json:"identifier"`
+				Unit       struct {
+					Identifier  string `json:"identifier"`
+					DisplayName string `json:"displayName"`
+				} `json:"unit"`
+				Datasets []struct {
+					FilterCriteria struct {
+						Percentile          string `json:"percentile"`
+						Device              string `json:"device"`
+						DeviceMarketingName string `json:"deviceMarketingName"`
+					} `json:"filterCriteria"`
+					Points []struct {
+						Version string   `json:"version"`
+						Value   *float64 `json:"value"`
+					} `json:"points"`
+				} `json:"datasets"`
+			} `json:"metrics"`
+		} `json:"metricCategories"`
+	} `json:"productData"`
+}
+
+// parsePerfMetricPoints returns the value of every metric dataset for the
+// given version, or the latest point of each dataset when version is empty.
+func parsePerfMetricPoints(data json.RawMessage, version string) (perfMetricPoints, error) {
+	if len(data) == 0 {
+		return perfMetricPoints{}, nil
+	}
+	var payload xcodeMetrics
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("decode perf power metrics: %w", err)
+	}
+
+	points := perfMetricPoints{}
+	for _, product := range payload.ProductData {
+		for _, category := range product.MetricCategories {
+			for _, metric := range category.Metrics {
+				for _, dataset := range metric.Datasets {
+					var value *float64
+					for _, point := range dataset.Points {
+						if point.Value != nil && (version == "" || point.Version == version) {
+							value = point.Value
+						}
+					}
+					if value == nil {
+						continue
+					}
+					device := dataset.FilterCriteria.DeviceMarketingName
+					if device == "" {
+						device = dataset.FilterCriteria.Device
+					}
+					key := perfMetricKey{
+						category:   category.Identifier,
+						metric:     metric.Identifier,
+						device:     device,
+						percentile: normalizePercentile(dataset.FilterCriteria.Percentile),
+					}
+					points[key] = perfMetricPoint{value: *value, unit: metric.Unit.Identifier}
+				}
+			}
+		}
+	}
+	return points, nil
+}
+
+func normalizePercentile(value string) string {
+	switch value {
+	case "percentile.fifty":
+		return "p50"
+	case "percentile.ninety":
+		return "p90"
+	default:
+		return value
+	}
+}
+
+// comparePerfMetrics compares every metric reported for either build. All
+// metrics are lower-is-better, so increases beyond threshold percent are
+// regressions and decreases beyond it are improvements.
+func comparePerfMetrics(base, head perfMetricPoints, threshold float64) *asc.PerformanceComparisonResult {
+	keys := make([]perfMetricKey, 0, len(base)+len(head))
+	for key := range base {
+		keys = append(keys, key)
+	}
+	for key := range head {
+		if _, ok := base[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.SortFunc(keys, func(a, b perfMetricKey) int {
+		return cmp.Or(
+			cmp.Compare(a.category, b.category),
+			cmp.Compare(a.metric, b.metric),
+			cmp.Compare(a.device, b.device),
+			cmp.Compare(a.percentile, b.percentile),
+		)
+	})
+
+	result := &asc.PerformanceComparisonResult{ThresholdPercent: threshold, Metrics: make([]asc.PerformanceMetricChange, 0, len(keys))}
+	for _, key := range keys {
+		change := asc.PerformanceMetricChange{
+			Category:   key.category,
+			Metric:     key.metric,
+			Device:     key.device,
+			Percentile: key.percentile,
+		}
+		basePoint, hasBase := base[key]
+		headPoint, hasHead := head[key]
+		switch {
+		case !hasBase:
+			change.Unit = headPoint.unit
+			change.Head = &headPoint.value
+			change.Status = asc.PerformanceMetricAdded
+		case !hasHead:
+			change.Unit = basePoint.unit
+			change.Base = &basePoint.value
+			change.Status = asc.PerformanceMetricRemoved
+		default:
+			change.Unit = headPoint.unit
+			change.Base = &basePoint.value
+			change.Head = &headPoint.value
+			delta := roundPerf(headPoint.value-basePoint.value, 4)
+			change.Delta = &delta
+			change.Status = asc.PerformanceMetricUnchanged
+			if basePoint.value != 0 {
+				percent := roundPerf(delta/basePoint.value*100, 2)
+				change.DeltaPercent = &percent
+				if percent > threshold {
+					change.Status = asc.PerformanceMetricRegressed
+				} else if percent < -threshold {
+					change.Status = asc.PerformanceMetricImproved
+				}
+			} else if delta > 0 {
+				change.Status = asc.PerformanceMetricRegressed
+			}
+		}
+		switch change.Status {
+		case asc.PerformanceMetricRegressed:
+			result.Regressions++
+		case asc.PerformanceMetricImproved:
+			result.Improvements++
+		}
+		result.Metrics = append(result.Metrics, change)
+	}
+	return result
+}
+
+func roundPerf(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
+
+// performanceCompareJUnitReport has one test case per compared metric.
+// Regressions fail the test case.
+func performanceCompareJUnitReport(result *asc.PerformanceComparisonResult, now time.Time) shared.JUnitReport {
+	report := shared.JUnitReport{Name: "asc performance compare", Timestamp: now}
+	for _, metric := range result.Metrics {
+		name := metric.Metric + " (" + metric.Device
+		if metric.Percentile != "" {
+			name += ", " + metric.Percentile
+		}
+		name += ")"
+		testCase := shared.JUnitTestCase{
+			Name:      name,
+			Classname: "performance." + strings.ToLower(metric.Category),
+		}
+		summary := describePerfChange(metric)
+		if metric.Status == asc.PerformanceMetricRegressed {
+			testCase.Failure = "regression"
+			testCase.Message = fmt.Sprintf("%s (threshold %g%%)", summary, result.ThresholdPercent)
+		} else {
+			testCase.SystemOut = metric.Status + ": " + summary
+		}
+		report.Tests = append(report.Tests, testCase)
+	}
+	return report
+}
+
+func describePerfChange(metric asc.PerformanceMetricChange) string {
+	format := func(value *float64) string {
+		text := fmt.Sprintf("%g", *value)
+		if metric.Unit != "" {
+			text += " " + metric.Unit
+		}
+		return text
+	}
+	switch {
+	case metric.Base == nil:
+		return "only reported for head: " + format(metric.Head)
+	case metric.Head == nil:
+		return "only reported for base: " + format(metric.Base)
+	case metric.DeltaPercent != nil:
+		return fmt.Sprintf("%s -> %s (%+.1f%%)", format(metric.Base), format(metric.Head), *metric.DeltaPercent)
+	default:
+		return fmt.Sprintf("%s -> %s", format(metric.Base), format(metric.Head))
+	}
+}
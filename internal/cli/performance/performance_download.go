@@ -29,7 +29,7 @@ func PerformanceDownloadCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Limit number of logs (max 200, diagnostic logs only)")
 	output := fs.String("output", "", "Output file path (default: metrics/diagnostic file name)")
 	decompress := fs.Bool("decompress", false, "Decompress gzip output (if compressed)")
-	outputFormat := fs.String("output-format", "json", "Output format for metadata: json (default), table, markdown")
+	outputFormat := fs.String("output-format", "json", "Output format for metadata: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
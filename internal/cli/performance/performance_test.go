@@ -27,4 +27,32 @@ func TestPerformanceCommandConstructors(t *testing.T) {
 	if got := PerformanceDownloadCommand(); got == nil {
 		t.Fatal("expected download command")
 	}
+	if got := PerformanceCompareCommand(); got == nil {
+		t.Fatal("expected compare command")
+	}
+}
+
+func TestComparePerfMetrics(t *testing.T) {
+	launch := perfMetricKey{category: "LAUNCH", metric: "launchTime", device: "All iPhones", percentile: "p50"}
+	hang := perfMetricKey{category: "HANG", metric: "hangRate", device: "All iPhones", percentile: "p50"}
+	memory := perfMetricKey{category: "MEMORY", metric: "peakMemory", device: "All iPhones", percentile: "p90"}
+
+	result := comparePerfMetrics(
+		perfMetricPoints{launch: {value: 1000, unit: "ms"}, hang: {value: 0, unit: "s/hr"}, memory: {value: 200, unit: "MB"}},
+		perfMetricPoints{launch: {value: 1080, unit: "ms"}, hang: {value: 0.3, unit: "s/hr"}},
+		5,
+	)
+	if result.Regressions != 2 || len(result.Metrics) != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	statuses := map[string]string{}
+	for _, metric := range result.Metrics {
+		statuses[metric.Metric] = metric.Status
+	}
+	if statuses["launchTime"] != "regressed" || statuses["hangRate"] != "regressed" || statuses["peakMemory"] != "removed" {
+		t.Fatalf("unexpected statuses: %v", statuses)
+	}
+	if result.Metrics[0].Metric != "hangRate" || result.Metrics[0].DeltaPercent != nil || *result.Metrics[0].Delta != 0.3 {
+		t.Fatalf("expected a regression from zero without a percentage: %+v", result.Metrics[0])
+	}
 }
@@ -2,8 +2,6 @@ package preorders
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
@@ -50,7 +48,7 @@ func PreOrdersGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pre-orders get", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -96,7 +94,7 @@ func PreOrdersListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pre-orders list", flag.ExitOnError)
 
 	availabilityID := fs.String("availability", "", "App availability ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -143,7 +141,7 @@ func PreOrdersEnableCommand() *ffcli.Command {
 	releaseDate := fs.String("release-date", "", "Release date (YYYY-MM-DD)")
 	var availableInNewTerritories shared.OptionalBool
 	fs.Var(&availableInNewTerritories, "available-in-new-territories", "Set available-in-new-territories: true or false")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -277,7 +275,7 @@ func PreOrdersUpdateCommand() *ffcli.Command {
 
 	territoryAvailabilityID := fs.String("territory-availability", "", "Territory availability ID")
 	releaseDate := fs.String("release-date", "", "Release date (YYYY-MM-DD)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -331,7 +329,7 @@ func PreOrdersDisableCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pre-orders disable", flag.ExitOnError)
 
 	territoryAvailabilityID := fs.String("territory-availability", "", "Territory availability ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -377,7 +375,7 @@ func PreOrdersEndCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pre-orders end", flag.ExitOnError)
 
 	territoryAvailabilityIDs := fs.String("territory-availability", "", "Territory availability IDs (comma-separated)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -419,61 +417,6 @@ func normalizePreOrderReleaseDate(value string) (string, error) {
 	return shared.NormalizeDate(value, "--release-date")
 }
 
-type territoryAvailabilityIDPayload struct {
-	Territory string `json:"t"`
-}
-
 func mapTerritoryAvailabilityIDs(resp *asc.TerritoryAvailabilitiesResponse) (map[string]string, error) {
-	if resp == nil {
-		return nil, fmt.Errorf("territory availabilities response is nil")
-	}
-	ids := make(map[string]string, len(resp.Data))
-	for _, item := range resp.Data {
-		territoryID := ""
-		if len(item.Relationships) > 0 {
-			var relationships asc.TerritoryAvailabilityRelationships
-			if err := json.Unmarshal(item.Relationships, &relationships); err != nil {
-				return nil, fmt.Errorf("decode territory availability relationships for %q: %w", item.ID, err)
-			}
-			territoryID = strings.ToUpper(strings.TrimSpace(relationships.Territory.Data.ID))
-		}
-		if territoryID == "" {
-			var ok bool
-			territoryID, ok = territoryIDFromAvailabilityID(item.ID)
-			if !ok {
-				return nil, fmt.Errorf("territory availability %q missing territory id", item.ID)
-			}
-		}
-		ids[territoryID] = item.ID
-	}
-	return ids, nil
-}
-
-func territoryIDFromAvailabilityID(availabilityID string) (string, bool) {
-	trimmed := strings.TrimSpace(availabilityID)
-	if trimmed == "" {
-		return "", false
-	}
-	decoded, err := base64.RawStdEncoding.DecodeString(trimmed)
-	if err != nil {
-		decoded, err = base64.StdEncoding.DecodeString(trimmed)
-		if err != nil {
-			decoded, err = base64.RawURLEncoding.DecodeString(trimmed)
-			if err != nil {
-				decoded, err = base64.URLEncoding.DecodeString(trimmed)
-				if err != nil {
-					return "", false
-				}
-			}
-		}
-	}
-	var payload territoryAvailabilityIDPayload
-	if err := json.Unmarshal(decoded, &payload); err != nil {
-		return "", false
-	}
-	territoryID := strings.TrimSpace(payload.Territory)
-	if territoryID == "" {
-		return "", false
-	}
-	return strings.ToUpper(territoryID), true
+	return shared.MapTerritoryAvailabilityIDs(resp)
 }
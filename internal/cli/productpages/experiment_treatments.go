@@ -51,7 +51,7 @@ func ExperimentTreatmentsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	v2 := fs.Bool("v2", false, "Use v2 experiments endpoint")
 
@@ -106,7 +106,7 @@ Examples:
 					return fmt.Errorf("experiments treatments list: failed to fetch: %w", err)
 				}
 
-				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				paginated, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					if *v2 {
 						return client.GetAppStoreVersionExperimentTreatmentsV2(ctx, trimmedID, asc.WithAppStoreVersionExperimentTreatmentsNextURL(nextURL))
 					}
@@ -139,7 +139,7 @@ func ExperimentTreatmentsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("experiment-treatments get", flag.ExitOnError)
 
 	treatmentID := fs.String("treatment-id", "", "Treatment ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -184,7 +184,7 @@ func ExperimentTreatmentsCreateCommand() *ffcli.Command {
 	experimentID := fs.String("experiment-id", "", "Experiment ID")
 	name := fs.String("name", "", "Treatment name")
 	appIconName := fs.String("app-icon-name", "", "App icon asset name")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -236,7 +236,7 @@ func ExperimentTreatmentsUpdateCommand() *ffcli.Command {
 	treatmentID := fs.String("treatment-id", "", "Treatment ID")
 	name := fs.String("name", "", "Update treatment name")
 	appIconName := fs.String("app-icon-name", "", "Update app icon asset name")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -296,7 +296,7 @@ func ExperimentTreatmentsDeleteCommand() *ffcli.Command {
 
 	treatmentID := fs.String("treatment-id", "", "Treatment ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -32,6 +32,7 @@ Examples:
   asc profiles create --name "Profile" --profile-type IOS_APP_DEVELOPMENT --bundle "BUNDLE_ID" --certificate "CERT_ID"
   asc profiles delete --id "PROFILE_ID" --confirm
   asc profiles download --id "PROFILE_ID" --output "./profile.mobileprovision"
+  asc profiles inspect --file "./profile.mobileprovision"
   asc profiles relationships bundle-id --id "PROFILE_ID"
   asc profiles relationships certificates --id "PROFILE_ID"
   asc profiles relationships devices --id "PROFILE_ID"`,
@@ -44,6 +45,7 @@ Examples:
 			ProfilesCreateCommand(),
 			ProfilesDeleteCommand(),
 			ProfilesDownloadCommand(),
+			ProfilesInspectCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -59,7 +61,7 @@ func ProfilesListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -107,7 +109,7 @@ Examples:
 					return fmt.Errorf("profiles list: failed to fetch: %w", err)
 				}
 
-				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				paginated, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetProfiles(ctx, asc.WithProfilesNextURL(nextURL))
 				})
 				if err != nil {
@@ -133,7 +135,7 @@ func ProfilesGetCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Profile ID")
 	include := fs.String("include", "", "Include related resources: bundleId, certificates, devices")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -191,7 +193,7 @@ func ProfilesCreateCommand() *ffcli.Command {
 	bundleID := fs.String("bundle", "", "Bundle ID")
 	certificates := fs.String("certificate", "", "Certificate ID(s), comma-separated")
 	devices := fs.String("device", "", "Device ID(s), comma-separated (optional)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -256,7 +258,7 @@ func ProfilesDeleteCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Profile ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -308,7 +310,7 @@ func ProfilesDownloadCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Profile ID")
 	outputPath := fs.String("output", "", "Output .mobileprovision file path")
-	output := fs.String("output-format", "json", "Output format: json (default), table, markdown")
+	output := fs.String("output-format", "json", "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -0,0 +1,128 @@
+package profiles
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// ProfilesInspectCommand returns the profiles inspect subcommand.
+func ProfilesInspectCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("inspect", flag.ExitOnError)
+
+	file := fs.String("file", "", "Path to a .mobileprovision file")
+	id := fs.String("id", "", "Profile ID to fetch and inspect")
+	offline := fs.Bool("offline", false, "Skip cross-checking certificates against App Store Connect")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "inspect",
+		ShortUsage: "asc profiles inspect (--file ./profile.mobileprovision | --id \"PROFILE_ID\") [flags]",
+		ShortHelp:  "Decode a provisioning profile.",
+		LongHelp: `Decode a provisioning profile.
+
+Reports the team, app ID prefix, entitlements, provisioned device UDIDs,
+expiration, and the SHA-1/SHA-256 fingerprints of the embedded certificates.
+Certificates are cross-checked against App Store Connect to flag revoked
+ones; use --offline to inspect a local file without credentials.
+
+Examples:
+  asc profiles inspect --file "./profile.mobileprovision"
+  asc profiles inspect --file "./profile.mobileprovision" --offline --output table
+  asc profiles inspect --id "PROFILE_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			fileValue := strings.TrimSpace(*file)
+			idValue := strings.TrimSpace(*id)
+			if fileValue == "" && idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file or --id is required")
+				return flag.ErrHelp
+			}
+			if fileValue != "" && idValue != "" {
+				return fmt.Errorf("profiles inspect: --file and --id are mutually exclusive")
+			}
+
+			var client *asc.Client
+			if idValue != "" || !*offline {
+				var err error
+				client, err = shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("profiles inspect: %w", err)
+				}
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			var (
+				data   []byte
+				source string
+			)
+			if idValue != "" {
+				resp, err := client.GetProfile(requestCtx, idValue)
+				if err != nil {
+					return fmt.Errorf("profiles inspect: failed to fetch: %w", err)
+				}
+				data, err = decodeProfileContent(resp.Data.Attributes.ProfileContent)
+				if err != nil {
+					return fmt.Errorf("profiles inspect: %w", err)
+				}
+				source = idValue
+			} else {
+				var err error
+				data, err = os.ReadFile(fileValue)
+				if err != nil {
+					return fmt.Errorf("profiles inspect: %w", err)
+				}
+				source = fileValue
+			}
+
+			profile, err := shared.ParseProvisioningProfile(data)
+			if err != nil {
+				return fmt.Errorf("profiles inspect: %w", err)
+			}
+			result, err := shared.InspectProvisioningProfile(profile, source, time.Now())
+			if err != nil {
+				return fmt.Errorf("profiles inspect: %w", err)
+			}
+
+			if !*offline && len(result.Certificates) > 0 {
+				certificates, err := fetchAllCertificates(requestCtx, client)
+				if err != nil {
+					return fmt.Errorf("profiles inspect: %w", err)
+				}
+				shared.CrossCheckProfileCertificates(result, certificates)
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+func fetchAllCertificates(ctx context.Context, client *asc.Client) ([]asc.Resource[asc.CertificateAttributes], error) {
+	firstPage, err := client.GetCertificates(ctx, asc.WithCertificatesLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch certificates: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCertificates(ctx, asc.WithCertificatesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch certificates: %w", err)
+	}
+	resp, ok := paginated.(*asc.CertificatesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected certificates response type %T", paginated)
+	}
+	return resp.Data, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/alternativedistribution"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/analytics"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/androidiosmapping"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/api"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/app_events"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/appclips"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/apps"
@@ -42,7 +43,9 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/localizations"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/marketplace"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/merchantids"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/metadata"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/migrate"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/mock"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/nominations"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notarization"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notify"
@@ -134,6 +137,7 @@ func Subcommands(version string) []*ffcli.Command {
 		preorders.PreOrdersCommand(),
 		prerelease.PreReleaseVersionsCommand(),
 		localizations.LocalizationsCommand(),
+		metadata.MetadataCommand(),
 		assets.AssetsCommand(),
 		backgroundassets.BackgroundAssetsCommand(),
 		buildlocalizations.BuildLocalizationsCommand(),
@@ -155,6 +159,8 @@ func Subcommands(version string) []*ffcli.Command {
 		promotedpurchases.PromotedPurchasesCommand(),
 		migrate.MigrateCommand(),
 		notify.NotifyCommand(),
+		mock.MockCommand(),
+		api.APICommand(),
 		gamecenter.GameCenterCommand(),
 		VersionCommand(version),
 	}
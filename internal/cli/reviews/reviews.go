@@ -18,7 +18,7 @@ func ReviewsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("reviews", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	stars := fs.Int("stars", 0, "Filter by star rating (1-5)")
 	territory := fs.String("territory", "", "Filter by territory (e.g., US, GBR)")
@@ -45,6 +45,7 @@ Examples:
   asc reviews --next "<links.next>"
   asc reviews --app "123456789" --paginate
   asc reviews get --id "REVIEW_ID"
+  asc reviews watch --app "123456789" --max-stars 1 --notify slack
   asc reviews ratings --app "123456789"
   asc reviews ratings --app "123456789" --all
   asc reviews summarizations --app "123456789" --platform IOS --territory US
@@ -57,6 +58,7 @@ Examples:
 		Subcommands: []*ffcli.Command{
 			ReviewsListCommand(),
 			ReviewsGetCommand(),
+			ReviewsWatchCommand(),
 			ReviewsRatingsCommand(),
 			ReviewsSummarizationsCommand(),
 			ReviewsRespondCommand(),
@@ -81,7 +83,7 @@ func ReviewsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	stars := fs.Int("stars", 0, "Filter by star rating (1-5)")
 	territory := fs.String("territory", "", "Filter by territory (e.g., US, GBR)")
@@ -157,7 +159,7 @@ func executeReviewsList(ctx context.Context, appID, output string, pretty bool,
 		}
 
 		// Fetch all remaining pages
-		reviews, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		reviews, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			return client.GetReviews(ctx, appID, asc.WithNextURL(nextURL))
 		})
 		if err != nil {
@@ -0,0 +1,508 @@
+package reviews
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notify"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+const reviewsWatchDefaultStateFile = ".asc-reviews-watch.json"
+
+var reviewsWatchNotifyTargets = []string{"slack", "teams", "discord", "webhook"}
+
+type reviewsWatchClient interface {
+	GetReviews(ctx context.Context, appID string, opts ...asc.ReviewOption) (*asc.ReviewsResponse, error)
+}
+
+type customerReview = asc.Resource[asc.ReviewAttributes]
+
+// reviewNotifier delivers one new review to an external service.
+type reviewNotifier func(ctx context.Context, appID string, item customerReview) error
+
+// reviewsWatchState is the cursor saved between watch runs. LastReviewIDs
+// holds the reviews already reported at LastCreatedDate, since several
+// reviews can share a timestamp.
+type reviewsWatchState struct {
+	AppID           string    `json:"appId"`
+	LastCreatedDate string    `json:"lastCreatedDate,omitempty"`
+	LastReviewIDs   []string  `json:"lastReviewIds,omitempty"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+type reviewsWatchFilter struct {
+	minStars    int
+	maxStars    int
+	territories []string
+	keywords    []string
+}
+
+// ReviewsWatchCommand returns the reviews watch subcommand.
+func ReviewsWatchCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	stateFile := fs.String("state-file", reviewsWatchDefaultStateFile, "File that stores the last seen review")
+	minStars := fs.Int("min-stars", 0, "Only report reviews rated at least this many stars (1-5)")
+	maxStars := fs.Int("max-stars", 0, "Only report reviews rated at most this many stars (1-5)")
+	territory := fs.String("territory", "", "Only report reviews from these territories, comma-separated (e.g., USA,GBR)")
+	keyword := fs.String("keyword", "", "Only report reviews whose title or body contains one of these words, comma-separated")
+	since := fs.Duration("since", 0, "On the first run, report reviews from this far back (e.g., 24h)")
+	interval := fs.Duration("interval", 0, "Poll again after this long (e.g., 15m); 0 runs once")
+	notifyTarget := fs.String("notify", "", "Also send each new review to: "+strings.Join(reviewsWatchNotifyTargets, ", "))
+	webhook := fs.String("webhook", "", "Webhook URL for --notify (or the target's env var, e.g. ASC_SLACK_WEBHOOK)")
+	channel := fs.String("channel", "", "Slack channel for --notify slack")
+	secret := fs.String("secret", "", "Signing secret for --notify webhook (or ASC_WEBHOOK_SECRET env)")
+	message := fs.String("message", "", "Notification template over each review's JSON, e.g. '{{.attributes.rating}}★ {{.attributes.title}}'")
+	output := fs.String("output", "ndjson", "Output format: ndjson (default), json, table, markdown, csv, tsv, yaml")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "watch",
+		ShortUsage: "asc reviews watch --app \"APP_ID\" [flags]",
+		ShortHelp:  "Report new customer reviews since the last run.",
+		LongHelp: `Report new customer reviews since the last run.
+
+The newest review seen is stored in --state-file, and each run prints only
+reviews created after it. The first run without a state file records the
+newest review and prints nothing, unless --since is set. Commit or cache the
+state file between CI runs.
+
+Filters apply to what is printed and notified; the cursor always advances
+past every new review. The cursor is saved after each notification, so when
+one fails the next run resumes at the failed review without notifying the
+earlier ones again.
+
+--notify sends one message per review to the same targets as "asc notify".
+--message is rendered like "asc notify --data", with the review's JSON
+(id, attributes.rating, attributes.title, ...) as the data.
+
+Examples:
+  asc reviews watch --app "123456789"
+  asc reviews watch --app "123456789" --max-stars 1 --notify slack --channel "#support"
+  asc reviews watch --app "123456789" --territory USA,GBR --keyword "crash,refund"
+  asc reviews watch --app "123456789" --notify discord --message "{{.attributes.rating}}★ {{.attributes.title}}"
+  asc reviews watch --app "123456789" --since 24h --state-file ./state/reviews.json
+  asc reviews watch --app "123456789" --interval 15m --max-stars 2`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
+				return flag.ErrHelp
+			}
+			statePath := strings.TrimSpace(*stateFile)
+			if statePath == "" {
+				fmt.Fprintln(os.Stderr, "Error: --state-file is required")
+				return flag.ErrHelp
+			}
+			if *minStars != 0 && (*minStars < 1 || *minStars > 5) {
+				return fmt.Errorf("reviews watch: --min-stars must be between 1 and 5")
+			}
+			if *maxStars != 0 && (*maxStars < 1 || *maxStars > 5) {
+				return fmt.Errorf("reviews watch: --max-stars must be between 1 and 5")
+			}
+			if *minStars != 0 && *maxStars != 0 && *minStars > *maxStars {
+				return fmt.Errorf("reviews watch: --min-stars cannot be greater than --max-stars")
+			}
+			if *since < 0 || *interval < 0 {
+				return fmt.Errorf("reviews watch: --since and --interval must not be negative")
+			}
+
+			notifier, err := newReviewNotifier(reviewNotifyOptions{
+				target:   *notifyTarget,
+				webhook:  *webhook,
+				channel:  *channel,
+				secret:   *secret,
+				template: *message,
+			})
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err.Error())
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("reviews watch: %w", err)
+			}
+
+			watcher := &reviewsWatcher{
+				client:    client,
+				appID:     resolvedAppID,
+				statePath: statePath,
+				filter: reviewsWatchFilter{
+					minStars:    *minStars,
+					maxStars:    *maxStars,
+					territories: shared.SplitCSVUpper(*territory),
+					keywords:    shared.SplitCSV(*keyword),
+				},
+				since:    *since,
+				notifier: notifier,
+				print: func(items []customerReview) error {
+					if len(items) == 0 && *interval > 0 {
+						return nil
+					}
+					return shared.PrintOutput(&asc.ReviewsResponse{Data: items}, *output, *pretty)
+				},
+			}
+
+			if *interval == 0 {
+				if err := watcher.run(ctx); err != nil {
+					return fmt.Errorf("reviews watch: %w", err)
+				}
+				return nil
+			}
+
+			watchCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			ticker := time.NewTicker(*interval)
+			defer ticker.Stop()
+			for {
+				if err := watcher.run(watchCtx); err != nil {
+					if watchCtx.Err() != nil {
+						return nil
+					}
+					// Keep polling through transient failures; the cursor is unchanged.
+					fmt.Fprintf(os.Stderr, "Warning: reviews watch: %v\n", err)
+				}
+				select {
+				case <-watchCtx.Done():
+					return nil
+				case <-ticker.C:
+				}
+			}
+		},
+	}
+}
+
+type reviewNotifyOptions struct {
+	target   string
+	webhook  string
+	channel  string
+	secret   string
+	template string
+}
+
+func newReviewNotifier(opts reviewNotifyOptions) (reviewNotifier, error) {
+	target := strings.ToLower(strings.TrimSpace(opts.target))
+	if target == "" {
+		if strings.TrimSpace(opts.template) != "" {
+			return nil, fmt.Errorf("--message requires --notify")
+		}
+		return nil, nil
+	}
+
+	var envVar string
+	var validate func(string) error
+	switch target {
+	case "slack":
+		envVar, validate = "ASC_SLACK_WEBHOOK", notify.ValidateSlackWebhookURL
+	case "teams":
+		envVar, validate = "ASC_TEAMS_WEBHOOK", notify.ValidateTeamsWebhookURL
+	case "discord":
+		envVar, validate = "ASC_DISCORD_WEBHOOK", notify.ValidateDiscordWebhookURL
+	case "webhook":
+		validate = notify.ValidateWebhookURL
+	default:
+		return nil, fmt.Errorf("--notify must be one of: %s", strings.Join(reviewsWatchNotifyTargets, ", "))
+	}
+
+	webhookURL := strings.TrimSpace(opts.webhook)
+	if webhookURL == "" && envVar != "" {
+		webhookURL = strings.TrimSpace(os.Getenv(envVar))
+	}
+	if webhookURL == "" {
+		if envVar == "" {
+			return nil, fmt.Errorf("--webhook is required for --notify %s", target)
+		}
+		return nil, fmt.Errorf("--webhook is required for --notify %s (or set %s)", target, envVar)
+	}
+	if err := validate(webhookURL); err != nil {
+		return nil, err
+	}
+
+	template := strings.TrimSpace(opts.template)
+	if err := notify.ValidateTemplate(template); err != nil {
+		return nil, fmt.Errorf("--message: %w", err)
+	}
+	channel := strings.TrimSpace(opts.channel)
+	secret := strings.TrimSpace(opts.secret)
+	if secret == "" {
+		secret = strings.TrimSpace(os.Getenv("ASC_WEBHOOK_SECRET"))
+	}
+
+	return func(ctx context.Context, appID string, item customerReview) error {
+		data, err := notify.TemplateDataFrom(item)
+		if err != nil {
+			return err
+		}
+		text := reviewNotificationText(appID, item)
+		if template != "" {
+			rendered, err := notify.RenderTemplate(template, data)
+			if err != nil {
+				return err
+			}
+			text = strings.TrimSpace(rendered)
+		}
+
+		switch target {
+		case "slack":
+			return notify.SendSlack(ctx, webhookURL, notify.SlackMessage{Text: text, Channel: channel})
+		case "teams":
+			return notify.SendTeams(ctx, webhookURL, notify.TeamsMessage{Text: text})
+		case "discord":
+			return notify.SendDiscord(ctx, webhookURL, notify.DiscordMessage{Content: text})
+		default:
+			return notify.SendWebhook(ctx, webhookURL, secret, notify.WebhookMessage{Text: text, Data: data})
+		}
+	}, nil
+}
+
+type reviewsWatcher struct {
+	client    reviewsWatchClient
+	appID     string
+	statePath string
+	filter    reviewsWatchFilter
+	since     time.Duration
+	notifier  reviewNotifier
+	print     func(items []customerReview) error
+	now       func() time.Time
+}
+
+// run performs one poll: fetch, print, notify, then save the cursor. With a
+// notifier the cursor is also saved after each notification.
+func (w *reviewsWatcher) run(ctx context.Context) error {
+	state, err := loadReviewsWatchState(w.statePath, w.appID)
+	if err != nil {
+		return err
+	}
+	firstRun := state.LastCreatedDate == ""
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	now := time.Now
+	if w.now != nil {
+		now = w.now
+	}
+	var cutoff time.Time
+	if firstRun && w.since > 0 {
+		cutoff = now().Add(-w.since)
+	}
+
+	items, err := fetchNewReviews(requestCtx, w.client, w.appID, state, cutoff)
+	if err != nil {
+		return err
+	}
+
+	var matched []customerReview
+	if !firstRun || w.since > 0 {
+		for _, item := range items {
+			if w.filter.matches(item) {
+				matched = append(matched, item)
+			}
+		}
+	}
+
+	if err := w.print(matched); err != nil {
+		return err
+	}
+	if w.notifier != nil {
+		for _, item := range matched {
+			if err := w.notifier(requestCtx, w.appID, item); err != nil {
+				return fmt.Errorf("notify review %s: %w", item.ID, err)
+			}
+			// Save the cursor past each notified review so a later failure
+			// does not send the earlier ones again on the next run.
+			notified := slices.IndexFunc(items, func(candidate customerReview) bool { return candidate.ID == item.ID })
+			if err := state.advance(items[:notified+1]); err != nil {
+				return err
+			}
+			if err := state.save(w.statePath); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := state.advance(items); err != nil {
+		return err
+	}
+	if firstRun && state.LastCreatedDate == "" {
+		// No reviews yet: start from now so the first one is reported.
+		state.LastCreatedDate = now().UTC().Format(time.RFC3339)
+	}
+	if err := state.save(w.statePath); err != nil {
+		return err
+	}
+	if firstRun && w.since == 0 {
+		fmt.Fprintf(os.Stderr, "Recorded the newest review in %s; later runs report reviews after it\n", w.statePath)
+	}
+	return nil
+}
+
+// fetchNewReviews returns reviews created after the state cursor, oldest
+// first. Without a cursor it returns reviews at or after cutoff, or only the
+// newest review when cutoff is zero so the cursor can be seeded.
+func fetchNewReviews(ctx context.Context, client reviewsWatchClient, appID string, state *reviewsWatchState, cutoff time.Time) ([]customerReview, error) {
+	seen := make(map[string]bool, len(state.LastReviewIDs))
+	limit := 200
+	if state.LastCreatedDate != "" {
+		cursor, err := parseReviewDate(state.LastCreatedDate)
+		if err != nil {
+			return nil, fmt.Errorf("state file has invalid lastCreatedDate: %w", err)
+		}
+		cutoff = cursor
+		for _, id := range state.LastReviewIDs {
+			seen[id] = true
+		}
+	} else if cutoff.IsZero() {
+		limit = 1
+	}
+
+	resp, err := client.GetReviews(ctx, appID, asc.WithReviewSort("-createdDate"), asc.WithLimit(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch: %w", err)
+	}
+
+	var items []customerReview
+	for {
+		for _, item := range resp.Data {
+			created, err := parseReviewDate(item.Attributes.CreatedDate)
+			if err != nil {
+				return nil, fmt.Errorf("review %s has invalid createdDate: %w", item.ID, err)
+			}
+			if created.Before(cutoff) {
+				slices.Reverse(items)
+				return items, nil
+			}
+			if seen[item.ID] {
+				continue
+			}
+			items = append(items, item)
+		}
+		if limit == 1 || strings.TrimSpace(resp.Links.Next) == "" {
+			break
+		}
+		resp, err = client.GetReviews(ctx, appID, asc.WithNextURL(resp.Links.Next))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch: %w", err)
+		}
+	}
+	slices.Reverse(items)
+	return items, nil
+}
+
+func (f reviewsWatchFilter) matches(item customerReview) bool {
+	attrs := item.Attributes
+	if f.minStars != 0 && attrs.Rating < f.minStars {
+		return false
+	}
+	if f.maxStars != 0 && attrs.Rating > f.maxStars {
+		return false
+	}
+	if len(f.territories) > 0 && !slices.Contains(f.territories, strings.ToUpper(attrs.Territory)) {
+		return false
+	}
+	if len(f.keywords) > 0 {
+		text := strings.ToLower(attrs.Title + "\n" + attrs.Body)
+		for _, keyword := range f.keywords {
+			if strings.Contains(text, strings.ToLower(keyword)) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func reviewNotificationText(appID string, item customerReview) string {
+	attrs := item.Attributes
+	rating := min(max(attrs.Rating, 0), 5)
+	stars := strings.Repeat("★", rating) + strings.Repeat("☆", 5-rating)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "New review for app %s: %s %s\n", appID, stars, strings.TrimSpace(attrs.Title))
+	if body := strings.TrimSpace(attrs.Body); body != "" {
+		b.WriteString(body)
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "— %s (%s)", attrs.ReviewerNickname, attrs.Territory)
+	return b.String()
+}
+
+func parseReviewDate(value string) (time.Time, error) {
+	return time.Parse(time.RFC3339, strings.TrimSpace(value))
+}
+
+func loadReviewsWatchState(path, appID string) (*reviewsWatchState, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &reviewsWatchState{AppID: appID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var state reviewsWatchState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parse state file %s: %w", path, err)
+	}
+	if state.AppID != appID {
+		return nil, fmt.Errorf("state file %s belongs to app %s; use a different --state-file", path, state.AppID)
+	}
+	return &state, nil
+}
+
+// advance moves the cursor to the newest of the given reviews.
+func (s *reviewsWatchState) advance(items []customerReview) error {
+	var cursor time.Time
+	if s.LastCreatedDate != "" {
+		parsed, err := parseReviewDate(s.LastCreatedDate)
+		if err != nil {
+			return fmt.Errorf("state file has invalid lastCreatedDate: %w", err)
+		}
+		cursor = parsed
+	}
+	for _, item := range items {
+		created, err := parseReviewDate(item.Attributes.CreatedDate)
+		if err != nil {
+			return fmt.Errorf("review %s has invalid createdDate: %w", item.ID, err)
+		}
+		switch {
+		case created.After(cursor):
+			cursor = created
+			s.LastCreatedDate = item.Attributes.CreatedDate
+			s.LastReviewIDs = []string{item.ID}
+		case created.Equal(cursor) && !slices.Contains(s.LastReviewIDs, item.ID):
+			s.LastReviewIDs = append(s.LastReviewIDs, item.ID)
+		}
+	}
+	return nil
+}
+
+// save writes the state atomically with owner-only permissions.
+func (s *reviewsWatchState) save(path string) error {
+	s.UpdatedAt = time.Now().UTC()
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := fileutil.WriteFileAtomic(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("save state file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,328 @@
+package reviews
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// stubReviewsClient serves reviews newest first, two per page.
+type stubReviewsClient struct {
+	reviews []customerReview
+	calls   int
+}
+
+func (c *stubReviewsClient) GetReviews(_ context.Context, _ string, opts ...asc.ReviewOption) (*asc.ReviewsResponse, error) {
+	c.calls++
+	start := 0
+	if c.calls > 1 {
+		start = (c.calls - 1) * 2
+	}
+	end := min(start+2, len(c.reviews))
+	resp := &asc.ReviewsResponse{Data: c.reviews[start:end]}
+	if end < len(c.reviews) {
+		resp.Links.Next = fmt.Sprintf("https://api.appstoreconnect.apple.com/v1/apps/APP/customerReviews?page=%d", c.calls+1)
+	}
+	return resp, nil
+}
+
+func testReview(id string, rating int, created, territory, title string) customerReview {
+	item := customerReview{ID: id}
+	item.Attributes = asc.ReviewAttributes{
+		Rating:           rating,
+		Title:            title,
+		Body:             "Body of " + id,
+		ReviewerNickname: "user-" + id,
+		CreatedDate:      created,
+		Territory:        territory,
+	}
+	return item
+}
+
+func reviewIDs(items []customerReview) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+func newTestWatcher(t *testing.T, client reviewsWatchClient, filter reviewsWatchFilter) (*reviewsWatcher, *[][]customerReview) {
+	t.Helper()
+	var printed [][]customerReview
+	watcher := &reviewsWatcher{
+		client:    client,
+		appID:     "APP",
+		statePath: filepath.Join(t.TempDir(), "state.json"),
+		filter:    filter,
+		print: func(items []customerReview) error {
+			printed = append(printed, items)
+			return nil
+		},
+		now: func() time.Time { return time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC) },
+	}
+	return watcher, &printed
+}
+
+func TestReviewsWatcherRun(t *testing.T) {
+	client := &stubReviewsClient{reviews: []customerReview{
+		testReview("r3", 5, "2026-03-09T10:00:00-07:00", "USA", "Great"),
+		testReview("r2", 1, "2026-03-08T10:00:00-07:00", "GBR", "Crashes"),
+		testReview("r1", 2, "2026-03-07T10:00:00-07:00", "USA", "Slow"),
+	}}
+	watcher, printed := newTestWatcher(t, client, reviewsWatchFilter{})
+
+	// The first run only records the newest review.
+	if err := watcher.run(context.Background()); err != nil {
+		t.Fatalf("first run error: %v", err)
+	}
+	if len((*printed)[0]) != 0 {
+		t.Fatalf("expected nothing on first run, got %v", reviewIDs((*printed)[0]))
+	}
+
+	client.calls = 0
+	client.reviews = append([]customerReview{
+		testReview("r5", 1, "2026-03-09T18:00:00Z", "USA", "Refund please"),
+		testReview("r4", 4, "2026-03-09T17:00:00Z", "USA", "Nice"),
+	}, client.reviews...)
+
+	notified := []string{}
+	watcher.notifier = func(_ context.Context, appID string, item customerReview) error {
+		notified = append(notified, appID+"/"+item.ID)
+		return nil
+	}
+	watcher.filter = reviewsWatchFilter{maxStars: 1}
+	if err := watcher.run(context.Background()); err != nil {
+		t.Fatalf("second run error: %v", err)
+	}
+	if got := reviewIDs((*printed)[1]); !reflect.DeepEqual(got, []string{"r5"}) {
+		t.Fatalf("expected r5, got %v", got)
+	}
+	if !reflect.DeepEqual(notified, []string{"APP/r5"}) {
+		t.Fatalf("unexpected notifications: %v", notified)
+	}
+	if client.calls != 2 {
+		t.Fatalf("expected paging to stop at the cursor after 2 calls, got %d", client.calls)
+	}
+
+	// Nothing new: the cursor moved past the filtered-out r4 as well.
+	client.calls = 0
+	watcher.filter = reviewsWatchFilter{}
+	if err := watcher.run(context.Background()); err != nil {
+		t.Fatalf("third run error: %v", err)
+	}
+	if len((*printed)[2]) != 0 {
+		t.Fatalf("expected no new reviews, got %v", reviewIDs((*printed)[2]))
+	}
+}
+
+func TestReviewsWatcherRunSinceAndSameTimestamp(t *testing.T) {
+	client := &stubReviewsClient{reviews: []customerReview{
+		testReview("r3", 3, "2026-03-10T09:00:00Z", "USA", "Ok"),
+		testReview("r2", 3, "2026-03-10T09:00:00Z", "USA", "Ok"),
+		testReview("r1", 3, "2026-03-01T09:00:00Z", "USA", "Old"),
+	}}
+	watcher, printed := newTestWatcher(t, client, reviewsWatchFilter{})
+	watcher.since = 24 * time.Hour
+
+	if err := watcher.run(context.Background()); err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if got := reviewIDs((*printed)[0]); !reflect.DeepEqual(got, []string{"r2", "r3"}) {
+		t.Fatalf("expected reviews within --since oldest first, got %v", got)
+	}
+
+	data, err := os.ReadFile(watcher.statePath)
+	if err != nil {
+		t.Fatalf("read state: %v", err)
+	}
+	var state reviewsWatchState
+	if err := json.Unmarshal(data, &state); err != nil {
+		t.Fatalf("decode state: %v", err)
+	}
+	if state.LastCreatedDate != "2026-03-10T09:00:00Z" || !reflect.DeepEqual(state.LastReviewIDs, []string{"r2", "r3"}) {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+
+	// A review sharing the cursor timestamp is still reported once.
+	client.calls = 0
+	client.reviews = append([]customerReview{testReview("r4", 3, "2026-03-10T09:00:00Z", "USA", "Same second")}, client.reviews...)
+	if err := watcher.run(context.Background()); err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if got := reviewIDs((*printed)[1]); !reflect.DeepEqual(got, []string{"r4"}) {
+		t.Fatalf("expected r4, got %v", got)
+	}
+}
+
+func TestReviewsWatcherRunKeepsCursorWhenNotifyFails(t *testing.T) {
+	client := &stubReviewsClient{reviews: []customerReview{
+		testReview("r1", 1, "2026-03-10T09:00:00Z", "USA", "Bad"),
+	}}
+	watcher, _ := newTestWatcher(t, client, reviewsWatchFilter{})
+	watcher.since = time.Hour * 48
+	watcher.notifier = func(context.Context, string, customerReview) error {
+		return fmt.Errorf("boom")
+	}
+
+	err := watcher.run(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "notify review r1: boom") {
+		t.Fatalf("expected notify error, got %v", err)
+	}
+	if _, err := os.Stat(watcher.statePath); !os.IsNotExist(err) {
+		t.Fatalf("expected state file not to be written, got %v", err)
+	}
+}
+
+func TestReviewsWatcherRunSavesCursorAfterEachNotification(t *testing.T) {
+	client := &stubReviewsClient{reviews: []customerReview{
+		testReview("r3", 1, "2026-03-10T09:00:00Z", "USA", "Worse"),
+		testReview("r2", 1, "2026-03-10T09:00:00Z", "USA", "Bad"),
+		testReview("r1", 1, "2026-03-10T07:00:00Z", "USA", "Meh"),
+	}}
+	watcher, _ := newTestWatcher(t, client, reviewsWatchFilter{})
+	watcher.since = time.Hour * 48
+
+	var notified []string
+	failOn := "r2"
+	watcher.notifier = func(_ context.Context, _ string, item customerReview) error {
+		if item.ID == failOn {
+			return fmt.Errorf("boom")
+		}
+		notified = append(notified, item.ID)
+		return nil
+	}
+
+	err := watcher.run(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "notify review r2: boom") {
+		t.Fatalf("expected notify error, got %v", err)
+	}
+	if !reflect.DeepEqual(notified, []string{"r1"}) {
+		t.Fatalf("unexpected notifications: %v", notified)
+	}
+	assertWatchStateIDs(t, watcher.statePath, []string{"r1"})
+
+	// The retry resumes after r1 instead of sending it again.
+	client.calls = 0
+	failOn = ""
+	if err := watcher.run(context.Background()); err != nil {
+		t.Fatalf("retry error: %v", err)
+	}
+	if !reflect.DeepEqual(notified, []string{"r1", "r2", "r3"}) {
+		t.Fatalf("expected each review to be notified once, got %v", notified)
+	}
+	// r2 and r3 share a timestamp; each is recorded once.
+	assertWatchStateIDs(t, watcher.statePath, []string{"r2", "r3"})
+}
+
+func assertWatchStateIDs(t *testing.T, path string, want []string) {
+	t.Helper()
+	state, err := loadReviewsWatchState(path, "APP")
+	if err != nil {
+		t.Fatalf("load state: %v", err)
+	}
+	if !reflect.DeepEqual(state.LastReviewIDs, want) {
+		t.Fatalf("saved lastReviewIds = %v, want %v", state.LastReviewIDs, want)
+	}
+}
+
+func TestReviewsWatcherRejectsStateForOtherApp(t *testing.T) {
+	watcher, _ := newTestWatcher(t, &stubReviewsClient{}, reviewsWatchFilter{})
+	if err := os.WriteFile(watcher.statePath, []byte(`{"appId":"OTHER"}`), 0o600); err != nil {
+		t.Fatalf("write state: %v", err)
+	}
+	err := watcher.run(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "belongs to app OTHER") {
+		t.Fatalf("expected app mismatch error, got %v", err)
+	}
+}
+
+func TestReviewsWatchFilterMatches(t *testing.T) {
+	item := testReview("r1", 2, "2026-03-10T09:00:00Z", "usa", "App CRASHES on launch")
+	tests := []struct {
+		name   string
+		filter reviewsWatchFilter
+		want   bool
+	}{
+		{name: "no filter", filter: reviewsWatchFilter{}, want: true},
+		{name: "max stars", filter: reviewsWatchFilter{maxStars: 1}, want: false},
+		{name: "min stars", filter: reviewsWatchFilter{minStars: 2}, want: true},
+		{name: "territory", filter: reviewsWatchFilter{territories: []string{"GBR", "USA"}}, want: true},
+		{name: "other territory", filter: reviewsWatchFilter{territories: []string{"GBR"}}, want: false},
+		{name: "keyword", filter: reviewsWatchFilter{keywords: []string{"refund", "crash"}}, want: true},
+		{name: "missing keyword", filter: reviewsWatchFilter{keywords: []string{"refund"}}, want: false},
+	}
+	for _, test := range tests {
+		if got := test.filter.matches(item); got != test.want {
+			t.Errorf("%s: matches() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestReviewNotificationText(t *testing.T) {
+	got := reviewNotificationText("APP", testReview("r1", 2, "2026-03-10T09:00:00Z", "USA", "Slow"))
+	want := "New review for app APP: ★★☆☆☆ Slow\nBody of r1\n— user-r1 (USA)"
+	if got != want {
+		t.Fatalf("reviewNotificationText() = %q, want %q", got, want)
+	}
+}
+
+func TestNewReviewNotifierWebhookTemplate(t *testing.T) {
+	var payload map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("unmarshal payload: %v", err)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	notifier, err := newReviewNotifier(reviewNotifyOptions{
+		target:   "webhook",
+		webhook:  server.URL,
+		template: "{{.attributes.rating}}★ {{.attributes.title}} ({{.attributes.territory}})",
+	})
+	if err != nil {
+		t.Fatalf("newReviewNotifier() error: %v", err)
+	}
+	if err := notifier(context.Background(), "APP", testReview("r1", 1, "2026-03-10T09:00:00Z", "USA", "Crashes")); err != nil {
+		t.Fatalf("notifier error: %v", err)
+	}
+	if payload["text"] != "1★ Crashes (USA)" {
+		t.Fatalf("unexpected text: %v", payload["text"])
+	}
+	if data, ok := payload["data"].(map[string]any); !ok || data["id"] != "r1" {
+		t.Fatalf("expected review data in payload, got %v", payload["data"])
+	}
+}
+
+func TestNewReviewNotifierErrors(t *testing.T) {
+	t.Setenv("ASC_TEAMS_WEBHOOK", "")
+	tests := []struct {
+		opts    reviewNotifyOptions
+		wantErr string
+	}{
+		{opts: reviewNotifyOptions{template: "{{.id}}"}, wantErr: "--message requires --notify"},
+		{opts: reviewNotifyOptions{target: "teams"}, wantErr: "--webhook is required for --notify teams (or set ASC_TEAMS_WEBHOOK)"},
+		{opts: reviewNotifyOptions{target: "webhook"}, wantErr: "--webhook is required for --notify webhook"},
+		{opts: reviewNotifyOptions{target: "webhook", webhook: "https://example.com", template: "{{.id"}, wantErr: "parse message template"},
+	}
+	for _, test := range tests {
+		_, err := newReviewNotifier(test.opts)
+		if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+			t.Errorf("newReviewNotifier(%+v) error = %v, want %q", test.opts, err, test.wantErr)
+		}
+	}
+}
@@ -11,8 +11,9 @@ const (
 )
 
 var (
-	reportFormat string
-	reportFile   string
+	reportFormat  string
+	reportFile    string
+	commandReport *JUnitReport
 )
 
 // BindCIFlags registers CI-related flags for report output.
@@ -20,6 +21,7 @@ var (
 func BindCIFlags(fs *flag.FlagSet) {
 	fs.StringVar(&reportFormat, "report", "", "Report format for CI output (e.g., junit)")
 	fs.StringVar(&reportFile, "report-file", "", "Path to write CI report file")
+	commandReport = nil
 }
 
 // ValidateReportFlags validates the CI report flags and returns an error if invalid.
@@ -46,6 +48,17 @@ func ReportFile() string {
 	return reportFile
 }
 
+// SetCommandReport replaces the default single test case of the --report
+// junit report with the command's own report, e.g. one test case per check.
+func SetCommandReport(report *JUnitReport) {
+	commandReport = report
+}
+
+// CommandReport returns the report set by SetCommandReport, or nil.
+func CommandReport() *JUnitReport {
+	return commandReport
+}
+
 // SetReportFormat sets the report format (for testing).
 func SetReportFormat(format string) {
 	reportFormat = format
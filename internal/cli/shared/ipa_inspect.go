@@ -0,0 +1,355 @@
+package shared
+
+import (
+	"archive/zip"
+	"debug/macho"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"howett.net/plist"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	loadCmdCodeSignature     = 0x1d
+	codeSignatureSuperBlob   = 0xfade0cc0
+	codeSignatureEntitlement = 0xfade7171
+	codeSlotEntitlements     = 5
+
+	// maxIPAExecutableSize caps how much of a bundle executable is copied
+	// out of the IPA, and maxCodeSignatureSize how much of a code signature
+	// is read, so a crafted IPA cannot force huge allocations or copies.
+	maxIPAExecutableSize = 2 << 30
+	maxCodeSignatureSize = 16 << 20
+)
+
+var bundleIconKeys = []string{"CFBundleIcons", "CFBundleIcons~ipad", "CFBundleIconFiles", "CFBundleIconFile", "CFBundleIconName"}
+
+// InspectIPA lists the bundles in an IPA with their Info.plist details,
+// Mach-O architectures, signed entitlements, and embedded provisioning
+// profiles. Problems reading an individual bundle are recorded on the bundle
+// rather than failing the whole inspection.
+func InspectIPA(ipaPath string, now time.Time) (*asc.IPAInspectResult, error) {
+	info, err := os.Stat(ipaPath)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := zip.OpenReader(ipaPath)
+	if err != nil {
+		return nil, fmt.Errorf("open IPA: %w", err)
+	}
+	defer reader.Close()
+
+	files := make(map[string]*zip.File, len(reader.File))
+	var bundleDirs []string
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		name := path.Clean(file.Name)
+		files[name] = file
+		if path.Base(name) != "Info.plist" || !strings.HasPrefix(name, "Payload/") {
+			continue
+		}
+		if ipaBundleKind(path.Dir(name)) != "" {
+			bundleDirs = append(bundleDirs, path.Dir(name))
+		}
+	}
+	sort.Strings(bundleDirs)
+
+	result := &asc.IPAInspectResult{
+		Path:    ipaPath,
+		Size:    info.Size(),
+		Bundles: make([]asc.IPABundle, 0, len(bundleDirs)),
+	}
+	for _, dir := range bundleDirs {
+		result.Bundles = append(result.Bundles, inspectIPABundle(files, dir, now))
+	}
+	return result, nil
+}
+
+// ipaBundleKind classifies a bundle directory, or returns "" for directories
+// that are not bundles this inspection cares about.
+func ipaBundleKind(dir string) string {
+	parent := path.Base(path.Dir(dir))
+	switch path.Ext(dir) {
+	case ".appex":
+		return asc.IPABundleKindAppExtension
+	case ".framework":
+		return asc.IPABundleKindFramework
+	case ".app":
+		switch {
+		case path.Dir(dir) == "Payload":
+			return asc.IPABundleKindApp
+		case parent == "Watch":
+			return asc.IPABundleKindWatchApp
+		case parent == "AppClips":
+			return asc.IPABundleKindAppClip
+		default:
+			return asc.IPABundleKindApp
+		}
+	}
+	return ""
+}
+
+func inspectIPABundle(files map[string]*zip.File, dir string, now time.Time) asc.IPABundle {
+	bundle := asc.IPABundle{Path: dir, Kind: ipaBundleKind(dir)}
+
+	var info map[string]any
+	data, err := readZipFile(files[dir+"/Info.plist"])
+	if err == nil {
+		_, err = plist.Unmarshal(data, &info)
+	}
+	if err != nil {
+		bundle.Errors = append(bundle.Errors, fmt.Sprintf("read Info.plist: %v", err))
+		return bundle
+	}
+	bundle.Info = info
+	bundle.BundleID = coercePlistValueToString(info["CFBundleIdentifier"])
+	bundle.Name = coercePlistValueToString(info["CFBundleDisplayName"])
+	if bundle.Name == "" {
+		bundle.Name = coercePlistValueToString(info["CFBundleName"])
+	}
+	bundle.Version = coercePlistValueToString(info["CFBundleShortVersionString"])
+	bundle.BuildNumber = coercePlistValueToString(info["CFBundleVersion"])
+	bundle.MinimumOSVersion = coercePlistValueToString(info["MinimumOSVersion"])
+	if bundle.MinimumOSVersion == "" {
+		bundle.MinimumOSVersion = coercePlistValueToString(info["LSMinimumSystemVersion"])
+	}
+	bundle.RequiredDeviceCapabilities = requiredDeviceCapabilities(info["UIRequiredDeviceCapabilities"])
+	bundle.Executable = coercePlistValueToString(info["CFBundleExecutable"])
+	for _, key := range bundleIconKeys {
+		if _, ok := info[key]; ok {
+			bundle.HasIcons = true
+			break
+		}
+	}
+	if value, ok := info["ITSAppUsesNonExemptEncryption"].(bool); ok {
+		bundle.UsesNonExemptEncryption = &value
+	}
+	_, bundle.HasPrivacyManifest = files[dir+"/PrivacyInfo.xcprivacy"]
+
+	if file, ok := files[dir+"/embedded.mobileprovision"]; ok {
+		if err := inspectEmbeddedProfile(&bundle, file, now); err != nil {
+			bundle.Errors = append(bundle.Errors, fmt.Sprintf("embedded.mobileprovision: %v", err))
+		}
+	}
+
+	if bundle.Executable != "" {
+		file, ok := files[dir+"/"+bundle.Executable]
+		if !ok {
+			bundle.Errors = append(bundle.Errors, fmt.Sprintf("executable %q not found", bundle.Executable))
+		} else if err := inspectBundleExecutable(&bundle, file); err != nil {
+			bundle.Errors = append(bundle.Errors, fmt.Sprintf("executable %q: %v", bundle.Executable, err))
+		}
+	}
+	return bundle
+}
+
+func inspectEmbeddedProfile(bundle *asc.IPABundle, file *zip.File, now time.Time) error {
+	data, err := readZipFile(file)
+	if err != nil {
+		return err
+	}
+	profile, err := ParseProvisioningProfile(data)
+	if err != nil {
+		return err
+	}
+	bundle.Profile, err = InspectProvisioningProfile(profile, path.Clean(file.Name), now)
+	return err
+}
+
+// inspectBundleExecutable records the Mach-O architectures and the
+// entitlements from the code signature. The executable is copied to a
+// temporary file because debug/macho needs random access.
+func inspectBundleExecutable(bundle *asc.IPABundle, file *zip.File) error {
+	src, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	tmp, err := os.CreateTemp("", "asc-ipa-executable-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	copied, err := io.Copy(tmp, io.LimitReader(src, maxIPAExecutableSize+1))
+	if err != nil {
+		return err
+	}
+	if copied > maxIPAExecutableSize {
+		return fmt.Errorf("executable is larger than %d bytes", int64(maxIPAExecutableSize))
+	}
+
+	type slice struct {
+		file   *macho.File
+		offset int64
+		size   int64
+	}
+	var slices []slice
+	fat, err := macho.NewFatFile(tmp)
+	switch {
+	case err == nil:
+		for _, arch := range fat.Arches {
+			slices = append(slices, slice{file: arch.File, offset: int64(arch.Offset), size: int64(arch.Size)})
+		}
+	case errors.Is(err, macho.ErrNotFat):
+		thin, err := macho.NewFile(tmp)
+		if err != nil {
+			return fmt.Errorf("not a Mach-O file: %w", err)
+		}
+		slices = append(slices, slice{file: thin, size: copied})
+	default:
+		return fmt.Errorf("not a Mach-O file: %w", err)
+	}
+
+	for _, s := range slices {
+		bundle.Architectures = append(bundle.Architectures, machoArchName(s.file.Cpu, s.file.SubCpu))
+	}
+	for _, s := range slices {
+		entitlements, err := machoEntitlements(tmp, s.file, s.offset, s.size)
+		if err != nil {
+			return err
+		}
+		if entitlements != nil {
+			bundle.Entitlements = entitlements
+			break
+		}
+	}
+	return nil
+}
+
+// machoEntitlements reads the XML entitlements blob from the code signature
+// of the slice at offset with the given size. It returns nil when the slice
+// is unsigned or has no entitlements.
+func machoEntitlements(r io.ReaderAt, file *macho.File, offset, size int64) (map[string]any, error) {
+	for _, load := range file.Loads {
+		raw := load.Raw()
+		if len(raw) < 16 || file.ByteOrder.Uint32(raw) != loadCmdCodeSignature {
+			continue
+		}
+		dataOff := int64(file.ByteOrder.Uint32(raw[8:]))
+		dataSize := int64(file.ByteOrder.Uint32(raw[12:]))
+		if dataSize > maxCodeSignatureSize {
+			return nil, fmt.Errorf("code signature is larger than %d bytes", int64(maxCodeSignatureSize))
+		}
+		if dataOff+dataSize > size {
+			return nil, fmt.Errorf("code signature extends past the end of the executable")
+		}
+		signature := make([]byte, dataSize)
+		if _, err := r.ReadAt(signature, offset+dataOff); err != nil {
+			return nil, fmt.Errorf("read code signature: %w", err)
+		}
+		blob := codeSignatureBlob(signature, codeSlotEntitlements, codeSignatureEntitlement)
+		if blob == nil {
+			return nil, nil
+		}
+		var entitlements map[string]any
+		if _, err := plist.Unmarshal(blob, &entitlements); err != nil {
+			return nil, fmt.Errorf("decode entitlements: %w", err)
+		}
+		return entitlements, nil
+	}
+	return nil, nil
+}
+
+// codeSignatureBlob returns the payload of the blob in slot from a code
+// signature super blob, or nil when absent. Code signatures are big-endian.
+func codeSignatureBlob(signature []byte, slot, magic uint32) []byte {
+	be := binary.BigEndian
+	if len(signature) < 12 || be.Uint32(signature) != codeSignatureSuperBlob {
+		return nil
+	}
+	count := int(be.Uint32(signature[8:]))
+	for i := 0; i < count; i++ {
+		entry := 12 + i*8
+		if entry+8 > len(signature) {
+			return nil
+		}
+		if be.Uint32(signature[entry:]) != slot {
+			continue
+		}
+		start := int(be.Uint32(signature[entry+4:]))
+		if start+8 > len(signature) || be.Uint32(signature[start:]) != magic {
+			return nil
+		}
+		end := start + int(be.Uint32(signature[start+4:]))
+		if end > len(signature) || end < start+8 {
+			return nil
+		}
+		return signature[start+8 : end]
+	}
+	return nil
+}
+
+func machoArchName(cpu macho.Cpu, subCpu uint32) string {
+	const cpuArm64_32 = 0x0200000c
+	switch cpu {
+	case macho.CpuArm64:
+		if subCpu&0xff == 2 {
+			return "arm64e"
+		}
+		return "arm64"
+	case cpuArm64_32:
+		return "arm64_32"
+	case macho.CpuArm:
+		switch subCpu {
+		case 9:
+			return "armv7"
+		case 11:
+			return "armv7s"
+		case 12:
+			return "armv7k"
+		}
+		return "arm"
+	case macho.CpuAmd64:
+		return "x86_64"
+	case macho.Cpu386:
+		return "i386"
+	}
+	return fmt.Sprintf("cpu-%d", uint32(cpu))
+}
+
+// requiredDeviceCapabilities accepts both the array form and the dictionary
+// form (capability -> required bool) of UIRequiredDeviceCapabilities.
+func requiredDeviceCapabilities(value any) []string {
+	var capabilities []string
+	switch v := value.(type) {
+	case []any:
+		for _, item := range v {
+			if capability := coercePlistValueToString(item); capability != "" {
+				capabilities = append(capabilities, capability)
+			}
+		}
+	case map[string]any:
+		for capability, required := range v {
+			if ok, _ := required.(bool); ok {
+				capabilities = append(capabilities, capability)
+			}
+		}
+		sort.Strings(capabilities)
+	}
+	return capabilities
+}
+
+func readZipFile(file *zip.File) ([]byte, error) {
+	if file == nil {
+		return nil, os.ErrNotExist
+	}
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
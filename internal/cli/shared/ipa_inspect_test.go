@@ -0,0 +1,337 @@
+package shared
+
+import (
+	"debug/macho"
+	"encoding/binary"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"howett.net/plist"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+func TestInspectIPA(t *testing.T) {
+	now := time.Now()
+	ipaPath := writeTestIPA(t, map[string][]byte{
+		"Payload/Demo.app/Info.plist": mustPlist(t, testAppInfo("com.example.demo", map[string]any{
+			"UIRequiredDeviceCapabilities": map[string]any{"arm64": true, "nfc": false, "metal": true},
+		})),
+		"Payload/Demo.app/Demo":                     buildTestMachO(t, uint32(macho.CpuArm64), 0, map[string]any{"application-identifier": "TEAM123456.com.example.demo"}),
+		"Payload/Demo.app/embedded.mobileprovision": testStoreProfile(t, now, "com.example.demo"),
+		"Payload/Demo.app/PrivacyInfo.xcprivacy":    []byte("<plist/>"),
+		"Payload/Demo.app/PlugIns/Widget.appex/Info.plist": mustPlist(t, testAppInfo("com.example.demo.widget", map[string]any{
+			"CFBundleExecutable": "",
+		})),
+		"Payload/Demo.app/Frameworks/Kit.framework/Info.plist": mustPlist(t, map[string]any{
+			"CFBundleIdentifier": "com.vendor.kit",
+			"CFBundleExecutable": "Kit",
+		}),
+		"Payload/Demo.app/Frameworks/Kit.framework/Kit": buildTestFatMachO(t,
+			buildTestMachO(t, uint32(macho.CpuArm64), 0, nil),
+			buildTestMachO(t, uint32(macho.CpuAmd64), 3, nil),
+		),
+		"Payload/Demo.app/Watch/DemoWatch.app/Info.plist":         mustPlist(t, map[string]any{"CFBundleIdentifier": "com.example.demo.watchkitapp"}),
+		"Payload/Demo.app/AppClips/DemoClip.app/Info.plist":       mustPlist(t, map[string]any{"CFBundleIdentifier": "com.example.demo.Clip"}),
+		"Payload/Demo.app/Base.lproj/Main.storyboardc/Info.plist": []byte("ignored"),
+	})
+
+	result, err := InspectIPA(ipaPath, now)
+	if err != nil {
+		t.Fatalf("InspectIPA() error: %v", err)
+	}
+
+	kinds := map[string]string{}
+	for _, bundle := range result.Bundles {
+		kinds[bundle.Path] = bundle.Kind
+	}
+	wantKinds := map[string]string{
+		"Payload/Demo.app":                          asc.IPABundleKindApp,
+		"Payload/Demo.app/PlugIns/Widget.appex":     asc.IPABundleKindAppExtension,
+		"Payload/Demo.app/Frameworks/Kit.framework": asc.IPABundleKindFramework,
+		"Payload/Demo.app/Watch/DemoWatch.app":      asc.IPABundleKindWatchApp,
+		"Payload/Demo.app/AppClips/DemoClip.app":    asc.IPABundleKindAppClip,
+	}
+	if len(kinds) != len(wantKinds) {
+		t.Fatalf("expected %d bundles, got %v", len(wantKinds), kinds)
+	}
+	for path, kind := range wantKinds {
+		if kinds[path] != kind {
+			t.Fatalf("expected %s to be %q, got %q", path, kind, kinds[path])
+		}
+	}
+
+	app := result.Bundles[0]
+	if app.Path != "Payload/Demo.app" {
+		t.Fatalf("expected main app first, got %q", app.Path)
+	}
+	if app.BundleID != "com.example.demo" || app.MinimumOSVersion != "17.0" || !app.HasPrivacyManifest || !app.HasIcons {
+		t.Fatalf("unexpected app details: %+v", app)
+	}
+	if !slices.Equal(app.RequiredDeviceCapabilities, []string{"arm64", "metal"}) {
+		t.Fatalf("unexpected capabilities: %v", app.RequiredDeviceCapabilities)
+	}
+	if !slices.Equal(app.Architectures, []string{"arm64"}) {
+		t.Fatalf("unexpected architectures: %v", app.Architectures)
+	}
+	if app.Entitlements["application-identifier"] != "TEAM123456.com.example.demo" {
+		t.Fatalf("expected signed entitlements, got %v", app.Entitlements)
+	}
+	if app.Profile == nil || app.Profile.ProfileType != ProfileTypeAppStore {
+		t.Fatalf("expected App Store profile, got %+v", app.Profile)
+	}
+	if len(app.Errors) != 0 {
+		t.Fatalf("unexpected errors: %v", app.Errors)
+	}
+
+	for _, bundle := range result.Bundles {
+		if bundle.Kind == asc.IPABundleKindFramework && !slices.Equal(bundle.Architectures, []string{"arm64", "x86_64"}) {
+			t.Fatalf("unexpected framework architectures: %v", bundle.Architectures)
+		}
+	}
+}
+
+func TestInspectIPARejectsOversizedCodeSignature(t *testing.T) {
+	tests := []struct {
+		name     string
+		dataSize uint32
+		wantErr  string
+	}{
+		{name: "huge size", dataSize: 0xfffffff0, wantErr: "code signature is larger than"},
+		{name: "past end", dataSize: 1 << 20, wantErr: "code signature extends past the end"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			executable := buildTestMachO(t, uint32(macho.CpuArm64), 0, map[string]any{"application-identifier": "TEAM123456.com.example.demo"})
+			// The LC_CODE_SIGNATURE datasize field follows the 32-byte header.
+			binary.LittleEndian.PutUint32(executable[44:], test.dataSize)
+			ipaPath := writeTestIPA(t, map[string][]byte{
+				"Payload/Demo.app/Info.plist": mustPlist(t, testAppInfo("com.example.demo", nil)),
+				"Payload/Demo.app/Demo":       executable,
+			})
+
+			result, err := InspectIPA(ipaPath, time.Now())
+			if err != nil {
+				t.Fatalf("InspectIPA() error: %v", err)
+			}
+			app := result.Bundles[0]
+			if len(app.Errors) != 1 || !strings.Contains(app.Errors[0], test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, app.Errors)
+			}
+		})
+	}
+}
+
+func TestLintIPA(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		files    map[string][]byte
+		strict   bool
+		wantIDs  []string
+		blocking int
+	}{
+		{
+			name: "clean",
+			files: map[string][]byte{
+				"Payload/Demo.app/Info.plist":               mustPlist(t, testAppInfo("com.example.demo", nil)),
+				"Payload/Demo.app/Demo":                     buildTestMachO(t, uint32(macho.CpuArm64), 0, map[string]any{"application-identifier": "TEAM123456.com.example.demo"}),
+				"Payload/Demo.app/embedded.mobileprovision": testStoreProfile(t, now, "com.example.demo"),
+				"Payload/Demo.app/PrivacyInfo.xcprivacy":    []byte("<plist/>"),
+			},
+		},
+		{
+			name: "missing privacy manifest is a warning",
+			files: map[string][]byte{
+				"Payload/Demo.app/Info.plist":               mustPlist(t, testAppInfo("com.example.demo", map[string]any{"CFBundleExecutable": ""})),
+				"Payload/Demo.app/embedded.mobileprovision": testStoreProfile(t, now, "com.example.demo"),
+			},
+			strict:   true,
+			wantIDs:  []string{"ipa.privacy_manifest.missing"},
+			blocking: 1,
+		},
+		{
+			name: "development signing and bad extension",
+			files: map[string][]byte{
+				"Payload/Demo.app/Info.plist": mustPlist(t, testAppInfo("com.example.demo", nil)),
+				"Payload/Demo.app/Demo": buildTestMachO(t, uint32(macho.CpuArm64), 0, map[string]any{
+					"application-identifier": "TEAM123456.com.example.demo",
+					"get-task-allow":         true,
+					"aps-environment":        "development",
+				}),
+				"Payload/Demo.app/embedded.mobileprovision": buildTestProvisioningProfile(t, map[string]any{
+					"Name":           "Dev",
+					"ExpirationDate": now.Add(time.Hour),
+					"Entitlements": map[string]any{
+						"application-identifier": "TEAM123456.com.example.demo",
+						"get-task-allow":         true,
+					},
+				}),
+				"Payload/Demo.app/PrivacyInfo.xcprivacy": []byte("<plist/>"),
+				"Payload/Demo.app/PlugIns/Share.appex/Info.plist": mustPlist(t, testAppInfo("com.other.share", map[string]any{
+					"CFBundleExecutable": "",
+					"CFBundleVersion":    "43",
+				})),
+				"Payload/Demo.app/Frameworks/Kit.framework/Info.plist": mustPlist(t, map[string]any{"CFBundleIdentifier": "com.vendor.kit", "CFBundleExecutable": "Kit"}),
+				"Payload/Demo.app/Frameworks/Kit.framework/Kit":        buildTestMachO(t, uint32(macho.CpuAmd64), 3, nil),
+			},
+			wantIDs: []string{
+				"ipa.entitlements.get_task_allow",
+				"ipa.profile.distribution",
+				"ipa.entitlements.not_in_profile",
+				"ipa.bundle_id.prefix",
+				"ipa.build_number.mismatch",
+				"ipa.profile.missing",
+				"ipa.arch.simulator",
+				"ipa.arch.arm64",
+			},
+			blocking: 7,
+		},
+		{
+			name: "no app",
+			files: map[string][]byte{
+				"Payload/README.txt": []byte("empty"),
+			},
+			wantIDs:  []string{"ipa.app.missing"},
+			blocking: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := InspectIPA(writeTestIPA(t, test.files), now)
+			if err != nil {
+				t.Fatalf("InspectIPA() error: %v", err)
+			}
+			LintIPA(result, test.strict)
+
+			var ids []string
+			for _, check := range result.Checks {
+				if check.Severity != validation.SeverityInfo {
+					ids = append(ids, check.ID)
+				}
+			}
+			for _, want := range test.wantIDs {
+				if !slices.Contains(ids, want) {
+					t.Fatalf("expected check %q, got %v", want, result.Checks)
+				}
+			}
+			if len(ids) != len(test.wantIDs) {
+				t.Fatalf("expected checks %v, got %v", test.wantIDs, ids)
+			}
+			if result.Summary.Blocking != test.blocking {
+				t.Fatalf("expected %d blocking, got %d (%v)", test.blocking, result.Summary.Blocking, result.Checks)
+			}
+		})
+	}
+}
+
+func testAppInfo(bundleID string, overrides map[string]any) map[string]any {
+	info := map[string]any{
+		"CFBundleIdentifier":            bundleID,
+		"CFBundleName":                  "Demo",
+		"CFBundleShortVersionString":    "1.2.0",
+		"CFBundleVersion":               "42",
+		"CFBundleExecutable":            "Demo",
+		"CFBundleIcons":                 map[string]any{},
+		"MinimumOSVersion":              "17.0",
+		"UILaunchStoryboardName":        "LaunchScreen",
+		"ITSAppUsesNonExemptEncryption": false,
+	}
+	for key, value := range overrides {
+		info[key] = value
+	}
+	return info
+}
+
+func testStoreProfile(t *testing.T, now time.Time, bundleID string) []byte {
+	t.Helper()
+	return buildTestProvisioningProfile(t, map[string]any{
+		"Name":                        "Store",
+		"ApplicationIdentifierPrefix": []string{"TEAM123456"},
+		"ExpirationDate":              now.Add(30 * 24 * time.Hour),
+		"Entitlements": map[string]any{
+			"application-identifier": "TEAM123456." + bundleID,
+		},
+	})
+}
+
+func mustPlist(t *testing.T, value any) []byte {
+	t.Helper()
+	data, err := plist.Marshal(value, plist.XMLFormat)
+	if err != nil {
+		t.Fatalf("marshal plist: %v", err)
+	}
+	return data
+}
+
+// buildTestMachO returns a minimal 64-bit Mach-O executable. When
+// entitlements is non-nil it carries a code signature with an entitlements
+// blob.
+func buildTestMachO(t *testing.T, cpu, subCpu uint32, entitlements map[string]any) []byte {
+	t.Helper()
+	le := binary.LittleEndian
+	be := binary.BigEndian
+
+	header := make([]byte, 32)
+	le.PutUint32(header[0:], macho.Magic64)
+	le.PutUint32(header[4:], cpu)
+	le.PutUint32(header[8:], subCpu)
+	le.PutUint32(header[12:], uint32(macho.TypeExec))
+	if entitlements == nil {
+		return header
+	}
+
+	blob := mustPlist(t, entitlements)
+	entitlementBlob := make([]byte, 8, 8+len(blob))
+	be.PutUint32(entitlementBlob[0:], codeSignatureEntitlement)
+	be.PutUint32(entitlementBlob[4:], uint32(8+len(blob)))
+	entitlementBlob = append(entitlementBlob, blob...)
+
+	superBlob := make([]byte, 20)
+	be.PutUint32(superBlob[0:], codeSignatureSuperBlob)
+	be.PutUint32(superBlob[4:], uint32(20+len(entitlementBlob)))
+	be.PutUint32(superBlob[8:], 1)
+	be.PutUint32(superBlob[12:], codeSlotEntitlements)
+	be.PutUint32(superBlob[16:], 20)
+	superBlob = append(superBlob, entitlementBlob...)
+
+	le.PutUint32(header[16:], 1)
+	le.PutUint32(header[20:], 16)
+	command := make([]byte, 16)
+	le.PutUint32(command[0:], loadCmdCodeSignature)
+	le.PutUint32(command[4:], 16)
+	le.PutUint32(command[8:], 48)
+	le.PutUint32(command[12:], uint32(len(superBlob)))
+
+	out := append(header, command...)
+	return append(out, superBlob...)
+}
+
+func buildTestFatMachO(t *testing.T, slices ...[]byte) []byte {
+	t.Helper()
+	be := binary.BigEndian
+	const align = 12
+	header := make([]byte, 8+20*len(slices))
+	be.PutUint32(header[0:], macho.MagicFat)
+	be.PutUint32(header[4:], uint32(len(slices)))
+
+	out := header
+	for i, slice := range slices {
+		for len(out)%(1<<align) != 0 {
+			out = append(out, 0)
+		}
+		entry := out[8+20*i:]
+		be.PutUint32(entry[0:], binary.LittleEndian.Uint32(slice[4:]))
+		be.PutUint32(entry[4:], binary.LittleEndian.Uint32(slice[8:]))
+		be.PutUint32(entry[8:], uint32(len(out)))
+		be.PutUint32(entry[12:], uint32(len(slice)))
+		be.PutUint32(entry[16:], align)
+		out = append(out, slice...)
+	}
+	return out
+}
@@ -0,0 +1,192 @@
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+// App Store Connect accepts up to three period-separated integers for both
+// CFBundleVersion and CFBundleShortVersionString.
+var bundleVersionPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+){0,2}$`)
+
+// LintIPA checks an inspected IPA for problems that commonly cause App Store
+// Connect to reject an upload or a submission, and records the results on
+// the inspection.
+func LintIPA(result *asc.IPAInspectResult, strict bool) {
+	checks := make([]validation.CheckResult, 0)
+	add := func(severity validation.Severity, id string, bundle *asc.IPABundle, field, message, remediation string) {
+		check := validation.CheckResult{
+			ID:          id,
+			Severity:    severity,
+			Message:     message,
+			Remediation: remediation,
+			Field:       field,
+		}
+		if bundle != nil {
+			check.ResourceType = "bundle"
+			check.ResourceID = bundle.Path
+		}
+		checks = append(checks, check)
+	}
+
+	var app *asc.IPABundle
+	for i := range result.Bundles {
+		if result.Bundles[i].Kind == asc.IPABundleKindApp && strings.Count(result.Bundles[i].Path, "/") == 1 {
+			app = &result.Bundles[i]
+			break
+		}
+	}
+	if app == nil {
+		add(validation.SeverityError, "ipa.app.missing", nil, "", "No app bundle found under Payload/", "Export the archive as an IPA for App Store Connect distribution")
+	} else {
+		lintMainApp(app, add)
+	}
+
+	for i := range result.Bundles {
+		bundle := &result.Bundles[i]
+		for _, problem := range bundle.Errors {
+			add(validation.SeverityError, "ipa.bundle.unreadable", bundle, "", problem, "")
+		}
+		lintBundleArchitectures(bundle, add)
+		if bundle.Kind == asc.IPABundleKindFramework || bundle.Info == nil {
+			continue
+		}
+		lintBundleInfo(bundle, app, add)
+		lintBundleSigning(bundle, add)
+	}
+
+	result.Checks = checks
+	summary := validation.Summarize(checks, strict)
+	result.Summary = &summary
+	result.Strict = strict
+}
+
+type lintFunc func(severity validation.Severity, id string, bundle *asc.IPABundle, field, message, remediation string)
+
+func lintMainApp(app *asc.IPABundle, add lintFunc) {
+	if app.Info == nil {
+		return
+	}
+	if !app.HasIcons {
+		add(validation.SeverityError, "ipa.icons.missing", app, "CFBundleIcons", "App has no icon declared in Info.plist", "Add an AppIcon asset to the asset catalog")
+	}
+	if _, ok := app.Info["UILaunchStoryboardName"]; !ok {
+		if _, ok := app.Info["UILaunchScreen"]; !ok {
+			add(validation.SeverityWarning, "ipa.launch_screen.missing", app, "UILaunchStoryboardName", "App does not declare a launch screen", "Set UILaunchStoryboardName or UILaunchScreen in Info.plist")
+		}
+	}
+	if !app.HasPrivacyManifest {
+		add(validation.SeverityWarning, "ipa.privacy_manifest.missing", app, "PrivacyInfo.xcprivacy", "App has no PrivacyInfo.xcprivacy privacy manifest", "Add a privacy manifest declaring required-reason API usage and collected data")
+	}
+	if app.UsesNonExemptEncryption == nil {
+		add(validation.SeverityInfo, "ipa.encryption.undeclared", app, "ITSAppUsesNonExemptEncryption", "Export compliance is not declared, so the build will wait for an answer in App Store Connect", "Set ITSAppUsesNonExemptEncryption in Info.plist")
+	}
+}
+
+func lintBundleInfo(bundle, app *asc.IPABundle, add lintFunc) {
+	if bundle.BundleID == "" {
+		add(validation.SeverityError, "ipa.bundle_id.missing", bundle, "CFBundleIdentifier", "Bundle has no CFBundleIdentifier", "")
+	}
+	switch {
+	case bundle.Version == "":
+		add(validation.SeverityError, "ipa.version.missing", bundle, "CFBundleShortVersionString", "Bundle has no CFBundleShortVersionString", "")
+	case !bundleVersionPattern.MatchString(bundle.Version):
+		add(validation.SeverityError, "ipa.version.invalid", bundle, "CFBundleShortVersionString", fmt.Sprintf("Version %q is not one to three period-separated integers", bundle.Version), "")
+	}
+	switch {
+	case bundle.BuildNumber == "":
+		add(validation.SeverityError, "ipa.build_number.missing", bundle, "CFBundleVersion", "Bundle has no CFBundleVersion", "")
+	case !bundleVersionPattern.MatchString(bundle.BuildNumber):
+		add(validation.SeverityError, "ipa.build_number.invalid", bundle, "CFBundleVersion", fmt.Sprintf("Build number %q is not one to three period-separated integers", bundle.BuildNumber), "")
+	}
+	if bundle.Kind == asc.IPABundleKindApp && bundle.MinimumOSVersion == "" {
+		add(validation.SeverityWarning, "ipa.minimum_os.missing", bundle, "MinimumOSVersion", "Bundle does not declare a minimum OS version", "")
+	}
+
+	if app == nil || bundle == app {
+		return
+	}
+	if app.BundleID != "" && bundle.BundleID != "" && !strings.HasPrefix(bundle.BundleID, app.BundleID+".") {
+		add(validation.SeverityError, "ipa.bundle_id.prefix", bundle, "CFBundleIdentifier",
+			fmt.Sprintf("Bundle ID %q is not prefixed with the app bundle ID %q", bundle.BundleID, app.BundleID),
+			"Embedded bundles must use the containing app's bundle ID as a prefix")
+	}
+	if app.Version != "" && bundle.Version != "" && bundle.Version != app.Version {
+		add(validation.SeverityWarning, "ipa.version.mismatch", bundle, "CFBundleShortVersionString",
+			fmt.Sprintf("Version %q does not match the app version %q", bundle.Version, app.Version), "")
+	}
+	if app.BuildNumber != "" && bundle.BuildNumber != "" && bundle.BuildNumber != app.BuildNumber {
+		add(validation.SeverityWarning, "ipa.build_number.mismatch", bundle, "CFBundleVersion",
+			fmt.Sprintf("Build number %q does not match the app build number %q", bundle.BuildNumber, app.BuildNumber), "")
+	}
+}
+
+func lintBundleSigning(bundle *asc.IPABundle, add lintFunc) {
+	if getTaskAllow, _ := bundle.Entitlements["get-task-allow"].(bool); getTaskAllow {
+		add(validation.SeverityError, "ipa.entitlements.get_task_allow", bundle, "get-task-allow", "Bundle is signed with get-task-allow (a debug/development signature)", "Re-export the archive with App Store Connect distribution")
+	}
+
+	profile := bundle.Profile
+	if profile == nil {
+		add(validation.SeverityError, "ipa.profile.missing", bundle, "embedded.mobileprovision", "Bundle has no embedded provisioning profile", "Re-export the archive with App Store Connect distribution")
+		return
+	}
+	if profile.ProfileType != ProfileTypeAppStore {
+		add(validation.SeverityError, "ipa.profile.distribution", bundle, "embedded.mobileprovision",
+			fmt.Sprintf("Bundle is signed with a %s profile (%s)", profile.ProfileType, profile.Name),
+			"Sign with an App Store distribution profile")
+	}
+	if profile.Expired {
+		add(validation.SeverityError, "ipa.profile.expired", bundle, "embedded.mobileprovision",
+			fmt.Sprintf("Provisioning profile %q expired on %s", profile.Name, profile.ExpirationDate), "Regenerate the profile and re-sign")
+	}
+	if bundle.BundleID != "" && profile.BundleID != "" && !bundleIDMatches(profile.BundleID, bundle.BundleID) {
+		add(validation.SeverityError, "ipa.profile.bundle_id", bundle, "embedded.mobileprovision",
+			fmt.Sprintf("Provisioning profile is for %q but the bundle ID is %q", profile.BundleID, bundle.BundleID), "")
+	}
+	if bundle.Entitlements != nil && profile.Entitlements != nil {
+		var missing []string
+		for key := range bundle.Entitlements {
+			if _, ok := profile.Entitlements[key]; !ok {
+				missing = append(missing, key)
+			}
+		}
+		sort.Strings(missing)
+		for _, key := range missing {
+			add(validation.SeverityError, "ipa.entitlements.not_in_profile", bundle, key,
+				fmt.Sprintf("Entitlement %q is signed into the binary but not granted by the provisioning profile", key),
+				"Enable the capability for the App ID and regenerate the profile")
+		}
+	}
+}
+
+func lintBundleArchitectures(bundle *asc.IPABundle, add lintFunc) {
+	if len(bundle.Architectures) == 0 {
+		return
+	}
+	for _, arch := range bundle.Architectures {
+		if arch == "x86_64" || arch == "i386" {
+			add(validation.SeverityError, "ipa.arch.simulator", bundle, bundle.Executable,
+				fmt.Sprintf("Executable contains a simulator slice (%s)", arch), "Remove simulator slices, or ship the framework as an XCFramework")
+		}
+	}
+	if !slices.ContainsFunc(bundle.Architectures, func(arch string) bool { return strings.HasPrefix(arch, "arm64") }) {
+		add(validation.SeverityError, "ipa.arch.arm64", bundle, bundle.Executable,
+			fmt.Sprintf("Executable has no 64-bit ARM slice (found %s)", strings.Join(bundle.Architectures, ", ")), "Build with ARCHS=arm64")
+	}
+}
+
+// bundleIDMatches reports whether a profile's bundle ID, which may end in a
+// wildcard, covers bundleID.
+func bundleIDMatches(profileBundleID, bundleID string) bool {
+	if prefix, ok := strings.CutSuffix(profileBundleID, "*"); ok {
+		return strings.HasPrefix(bundleID, prefix)
+	}
+	return profileBundleID == bundleID
+}
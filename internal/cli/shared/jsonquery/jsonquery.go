@@ -0,0 +1,697 @@
+// Package jsonquery evaluates a small jq-like expression language against
+// decoded JSON values, so output can be narrowed without external tools.
+//
+// Supported syntax:
+//
+//	.                   identity
+//	.foo, ."foo bar"    object field (null on null input)
+//	.[0], .[-1]         array index (null when out of range)
+//	.["foo"]            object field
+//	.[]                 iterate array elements or object values
+//	a | b               pipe each output of a into b
+//	a, b                concatenate outputs
+//	a == b, a != b      equality
+//	select(cond)        keep inputs where cond is truthy
+//	length, keys, not   builtins
+//	"s", 1, true, null  literals
+//
+// A leading field name without a dot is accepted (data[0].id is .data[0].id).
+package jsonquery
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Query is a parsed expression.
+type Query struct {
+	root node
+}
+
+// Parse compiles an expression.
+func Parse(expr string) (*Query, error) {
+	tokens, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, fmt.Errorf("query is empty")
+	}
+	root, err := p.parsePipe()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s at offset %d", tok, tok.pos)
+	}
+	return &Query{root: root}, nil
+}
+
+// Eval runs the query against a value decoded from JSON (maps, slices,
+// strings, float64 or json.Number, bools and nil) and returns every output.
+func (q *Query) Eval(input any) ([]any, error) {
+	return q.root.eval(input)
+}
+
+type node interface {
+	eval(input any) ([]any, error)
+}
+
+type identityNode struct{}
+
+func (identityNode) eval(input any) ([]any, error) {
+	return []any{input}, nil
+}
+
+type literalNode struct {
+	value any
+}
+
+func (n literalNode) eval(any) ([]any, error) {
+	return []any{n.value}, nil
+}
+
+type fieldNode struct {
+	target node
+	name   string
+}
+
+func (n fieldNode) eval(input any) ([]any, error) {
+	return flatMap(n.target, input, func(value any) ([]any, error) {
+		switch v := value.(type) {
+		case nil:
+			return []any{nil}, nil
+		case map[string]any:
+			return []any{v[n.name]}, nil
+		default:
+			return nil, fmt.Errorf("cannot index %s with %q", typeName(value), n.name)
+		}
+	})
+}
+
+type indexNode struct {
+	target node
+	index  int
+}
+
+func (n indexNode) eval(input any) ([]any, error) {
+	return flatMap(n.target, input, func(value any) ([]any, error) {
+		switch v := value.(type) {
+		case nil:
+			return []any{nil}, nil
+		case []any:
+			i := n.index
+			if i < 0 {
+				i += len(v)
+			}
+			if i < 0 || i >= len(v) {
+				return []any{nil}, nil
+			}
+			return []any{v[i]}, nil
+		default:
+			return nil, fmt.Errorf("cannot index %s with number", typeName(value))
+		}
+	})
+}
+
+type iterateNode struct {
+	target node
+}
+
+func (n iterateNode) eval(input any) ([]any, error) {
+	return flatMap(n.target, input, func(value any) ([]any, error) {
+		switch v := value.(type) {
+		case []any:
+			return v, nil
+		case map[string]any:
+			keys := sortedKeys(v)
+			out := make([]any, 0, len(keys))
+			for _, key := range keys {
+				out = append(out, v[key])
+			}
+			return out, nil
+		default:
+			return nil, fmt.Errorf("cannot iterate over %s", typeName(value))
+		}
+	})
+}
+
+type pipeNode struct {
+	left, right node
+}
+
+func (n pipeNode) eval(input any) ([]any, error) {
+	return flatMap(n.left, input, n.right.eval)
+}
+
+type commaNode struct {
+	left, right node
+}
+
+func (n commaNode) eval(input any) ([]any, error) {
+	left, err := n.left.eval(input)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(input)
+	if err != nil {
+		return nil, err
+	}
+	return append(left, right...), nil
+}
+
+type compareNode struct {
+	left, right node
+	negate      bool
+}
+
+func (n compareNode) eval(input any) ([]any, error) {
+	left, err := n.left.eval(input)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(input)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]any, 0, len(left)*len(right))
+	for _, r := range right {
+		for _, l := range left {
+			out = append(out, equal(l, r) != n.negate)
+		}
+	}
+	return out, nil
+}
+
+type selectNode struct {
+	cond node
+}
+
+func (n selectNode) eval(input any) ([]any, error) {
+	results, err := n.cond.eval(input)
+	if err != nil {
+		return nil, err
+	}
+	out := []any{}
+	for _, result := range results {
+		if truthy(result) {
+			out = append(out, input)
+		}
+	}
+	return out, nil
+}
+
+type builtinNode struct {
+	name string
+}
+
+func (n builtinNode) eval(input any) ([]any, error) {
+	switch n.name {
+	case "not":
+		return []any{!truthy(input)}, nil
+	case "length":
+		switch v := input.(type) {
+		case nil:
+			return []any{0.0}, nil
+		case string:
+			return []any{float64(utf8.RuneCountInString(v))}, nil
+		case []any:
+			return []any{float64(len(v))}, nil
+		case map[string]any:
+			return []any{float64(len(v))}, nil
+		}
+		if number, ok := toFloat(input); ok {
+			if number < 0 {
+				number = -number
+			}
+			return []any{number}, nil
+		}
+		return nil, fmt.Errorf("%s has no length", typeName(input))
+	case "keys":
+		switch v := input.(type) {
+		case map[string]any:
+			keys := sortedKeys(v)
+			out := make([]any, len(keys))
+			for i, key := range keys {
+				out[i] = key
+			}
+			return []any{out}, nil
+		case []any:
+			out := make([]any, len(v))
+			for i := range v {
+				out[i] = float64(i)
+			}
+			return []any{out}, nil
+		}
+		return nil, fmt.Errorf("%s has no keys", typeName(input))
+	}
+	return nil, fmt.Errorf("unknown function %q", n.name)
+}
+
+// flatMap evaluates target and applies fn to every output.
+func flatMap(target node, input any, fn func(any) ([]any, error)) ([]any, error) {
+	values, err := target.eval(input)
+	if err != nil {
+		return nil, err
+	}
+	var out []any
+	for _, value := range values {
+		results, err := fn(value)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, results...)
+	}
+	return out, nil
+}
+
+func truthy(value any) bool {
+	if value == nil {
+		return false
+	}
+	if b, ok := value.(bool); ok {
+		return b
+	}
+	return true
+}
+
+func equal(a, b any) bool {
+	if x, ok := toFloat(a); ok {
+		y, ok := toFloat(b)
+		return ok && x == y
+	}
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+// normalize converts json.Number values so decoded and literal numbers compare equal.
+func normalize(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = normalize(item)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			out[key] = normalize(item)
+		}
+		return out
+	}
+	return value
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func typeName(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	if _, ok := toFloat(value); ok {
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Parser
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) expect(kind tokenKind) (token, error) {
+	tok := p.next()
+	if tok.kind != kind {
+		return tok, fmt.Errorf("expected %s at offset %d, got %s", kind, tok.pos, tok)
+	}
+	return tok, nil
+}
+
+func (p *parser) parsePipe() (node, error) {
+	left, err := p.parseComma()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenPipe {
+		p.next()
+		right, err := p.parseComma()
+		if err != nil {
+			return nil, err
+		}
+		left = pipeNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseComma() (node, error) {
+	left, err := p.parseCompare()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenComma {
+		p.next()
+		right, err := p.parseCompare()
+		if err != nil {
+			return nil, err
+		}
+		left = commaNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseCompare() (node, error) {
+	left, err := p.parsePostfix()
+	if err != nil {
+		return nil, err
+	}
+	kind := p.peek().kind
+	if kind != tokenEq && kind != tokenNeq {
+		return left, nil
+	}
+	p.next()
+	right, err := p.parsePostfix()
+	if err != nil {
+		return nil, err
+	}
+	return compareNode{left: left, right: right, negate: kind == tokenNeq}, nil
+}
+
+func (p *parser) parsePostfix() (node, error) {
+	current, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch p.peek().kind {
+		case tokenDot:
+			p.next()
+			tok := p.next()
+			switch tok.kind {
+			case tokenIdent, tokenString:
+				current = fieldNode{target: current, name: tok.text}
+			default:
+				return nil, fmt.Errorf("expected field name at offset %d, got %s", tok.pos, tok)
+			}
+		case tokenLBracket:
+			current, err = p.parseBracket(current)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return current, nil
+		}
+	}
+}
+
+func (p *parser) parseBracket(target node) (node, error) {
+	p.next()
+	tok := p.next()
+	var result node
+	switch tok.kind {
+	case tokenRBracket:
+		return iterateNode{target: target}, nil
+	case tokenNumber:
+		index, err := strconv.Atoi(tok.text)
+		if err != nil {
+			return nil, fmt.Errorf("array index must be an integer, got %s", tok.text)
+		}
+		result = indexNode{target: target, index: index}
+	case tokenString:
+		result = fieldNode{target: target, name: tok.text}
+	default:
+		return nil, fmt.Errorf("expected index at offset %d, got %s", tok.pos, tok)
+	}
+	if _, err := p.expect(tokenRBracket); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenDot:
+		switch next := p.peek(); next.kind {
+		case tokenIdent, tokenString:
+			p.next()
+			return fieldNode{target: identityNode{}, name: next.text}, nil
+		}
+		return identityNode{}, nil
+	case tokenString:
+		return literalNode{value: tok.text}, nil
+	case tokenNumber:
+		number, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s", tok.text)
+		}
+		return literalNode{value: number}, nil
+	case tokenLParen:
+		inner, err := p.parsePipe()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRParen); err != nil {
+			return nil, err
+		}
+		return inner, nil
+	case tokenIdent:
+		switch tok.text {
+		case "true":
+			return literalNode{value: true}, nil
+		case "false":
+			return literalNode{value: false}, nil
+		case "null":
+			return literalNode{value: nil}, nil
+		case "length", "keys", "not":
+			return builtinNode{name: tok.text}, nil
+		case "select":
+			if _, err := p.expect(tokenLParen); err != nil {
+				return nil, err
+			}
+			cond, err := p.parsePipe()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.expect(tokenRParen); err != nil {
+				return nil, err
+			}
+			return selectNode{cond: cond}, nil
+		}
+		// JMESPath-style bare field name.
+		return fieldNode{target: identityNode{}, name: tok.text}, nil
+	}
+	return nil, fmt.Errorf("unexpected %s at offset %d", tok, tok.pos)
+}
+
+// Lexer
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenDot
+	tokenLBracket
+	tokenRBracket
+	tokenLParen
+	tokenRParen
+	tokenPipe
+	tokenComma
+	tokenEq
+	tokenNeq
+	tokenIdent
+	tokenString
+	tokenNumber
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokenEOF:
+		return "end of query"
+	case tokenDot:
+		return `"."`
+	case tokenLBracket:
+		return `"["`
+	case tokenRBracket:
+		return `"]"`
+	case tokenLParen:
+		return `"("`
+	case tokenRParen:
+		return `")"`
+	case tokenPipe:
+		return `"|"`
+	case tokenComma:
+		return `","`
+	case tokenEq:
+		return `"=="`
+	case tokenNeq:
+		return `"!="`
+	case tokenIdent:
+		return "identifier"
+	case tokenString:
+		return "string"
+	case tokenNumber:
+		return "number"
+	}
+	return "token"
+}
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenIdent, tokenNumber:
+		return strconv.Quote(t.text)
+	case tokenString:
+		return "string " + strconv.Quote(t.text)
+	}
+	return t.kind.String()
+}
+
+func lex(input string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(input); {
+		r, size := utf8.DecodeRuneInString(input[i:])
+		start := i
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+			continue
+		case r == '.':
+			tokens = append(tokens, token{kind: tokenDot, pos: start})
+		case r == '[':
+			tokens = append(tokens, token{kind: tokenLBracket, pos: start})
+		case r == ']':
+			tokens = append(tokens, token{kind: tokenRBracket, pos: start})
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, pos: start})
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, pos: start})
+		case r == '|':
+			tokens = append(tokens, token{kind: tokenPipe, pos: start})
+		case r == ',':
+			tokens = append(tokens, token{kind: tokenComma, pos: start})
+		case r == '=' || r == '!':
+			if !strings.HasPrefix(input[i+1:], "=") {
+				return nil, fmt.Errorf("unexpected %q at offset %d", r, start)
+			}
+			kind := tokenEq
+			if r == '!' {
+				kind = tokenNeq
+			}
+			tokens = append(tokens, token{kind: kind, pos: start})
+			i += 2
+			continue
+		case r == '"':
+			end := stringEnd(input, i)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string at offset %d", start)
+			}
+			text, err := strconv.Unquote(input[i:end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at offset %d: %w", start, err)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: start})
+			i = end
+			continue
+		case r == '-' || (r >= '0' && r <= '9'):
+			end := i + 1
+			for end < len(input) && (input[end] >= '0' && input[end] <= '9' || input[end] == '.') {
+				end++
+			}
+			if input[i:end] == "-" {
+				return nil, fmt.Errorf("unexpected '-' at offset %d", start)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: input[i:end], pos: start})
+			i = end
+			continue
+		case r == '_' || unicode.IsLetter(r):
+			end := i + size
+			for end < len(input) {
+				next, nextSize := utf8.DecodeRuneInString(input[end:])
+				if next != '_' && !unicode.IsLetter(next) && !unicode.IsDigit(next) {
+					break
+				}
+				end += nextSize
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: input[i:end], pos: start})
+			i = end
+			continue
+		default:
+			return nil, fmt.Errorf("unexpected %q at offset %d", r, start)
+		}
+		i += size
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(input)}), nil
+}
+
+// stringEnd returns the index just past the closing quote of the string
+// starting at input[start], or -1 if it is unterminated.
+func stringEnd(input string, start int) int {
+	for i := start + 1; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return -1
+}
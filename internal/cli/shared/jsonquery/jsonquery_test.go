@@ -0,0 +1,121 @@
+package jsonquery
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const document = `{
+  "data": [
+    {"type": "builds", "id": "b1", "attributes": {"version": "10", "processingState": "VALID", "expired": false}},
+    {"type": "builds", "id": "b2", "attributes": {"version": "11", "processingState": "PROCESSING", "expired": true}}
+  ],
+  "meta": {"paging": {"total": 2}}
+}`
+
+func decode(t *testing.T, raw string) any {
+	t.Helper()
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	return value
+}
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		expr string
+		want []any
+	}{
+		{expr: ".data[0].id", want: []any{"b1"}},
+		{expr: "data[0].id", want: []any{"b1"}},
+		{expr: ".data[-1].id", want: []any{"b2"}},
+		{expr: ".data[5]", want: []any{nil}},
+		{expr: ".data[].id", want: []any{"b1", "b2"}},
+		{expr: `.data[] | .["id"]`, want: []any{"b1", "b2"}},
+		{expr: ".data[0] | .id, .attributes.version", want: []any{"b1", "10"}},
+		{expr: `.data[] | select(.attributes.processingState == "VALID") | .id`, want: []any{"b1"}},
+		{expr: `.data[] | select(.attributes.expired) | .id`, want: []any{"b2"}},
+		{expr: `.data[] | select(.attributes.expired | not) | .id`, want: []any{"b1"}},
+		{expr: ".meta.paging.total == 2", want: []any{true}},
+		{expr: `.data[0].id != "b1"`, want: []any{false}},
+		{expr: ".data | length", want: []any{2.0}},
+		{expr: ".meta.paging | keys", want: []any{[]any{"total"}}},
+		{expr: ".missing.nested", want: []any{nil}},
+		{expr: ".", want: []any{nil}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			query, err := Parse(test.expr)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			input := decode(t, document)
+			if test.expr == "." {
+				input = nil
+			}
+			got, err := query.Eval(input)
+			if err != nil {
+				t.Fatalf("Eval() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("Eval() = %#v, want %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr string
+	}{
+		{expr: "", wantErr: "query is empty"},
+		{expr: ".data[", wantErr: "expected index"},
+		{expr: ".data[0", wantErr: `expected "]"`},
+		{expr: `.data | select(.id`, wantErr: `expected ")"`},
+		{expr: `.id = "x"`, wantErr: `unexpected '='`},
+		{expr: `.id == "x`, wantErr: "unterminated string"},
+		{expr: ".data ]", wantErr: `unexpected "]"`},
+		{expr: ".data[1.5]", wantErr: "array index must be an integer"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			_, err := Parse(test.expr)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr string
+	}{
+		{expr: ".data.id", wantErr: `cannot index array with "id"`},
+		{expr: ".data[0].id[0]", wantErr: "cannot index string with number"},
+		{expr: ".data[0].id[]", wantErr: "cannot iterate over string"},
+		{expr: ".data[0].attributes.expired | length", wantErr: "boolean has no length"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			query, err := Parse(test.expr)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			_, err = query.Eval(decode(t, document))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
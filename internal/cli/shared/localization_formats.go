@@ -0,0 +1,641 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+// Localization file formats supported by download and upload commands.
+const (
+	LocalizationFormatStrings = "strings"
+	LocalizationFormatXLIFF   = "xliff"
+	LocalizationFormatJSON    = "json"
+	LocalizationFormatCSV     = "csv"
+)
+
+// XLIFF document versions supported by the xliff format.
+const (
+	XLIFFVersion12 = "1.2"
+	XLIFFVersion20 = "2.0"
+)
+
+const (
+	defaultLocalizationSourceLocale = "en-US"
+	localizationBundleName          = "localizations"
+	csvKeyColumn                    = "key"
+	csvMaxLengthColumn              = "maxLength"
+)
+
+var localizationFormats = []string{
+	LocalizationFormatStrings,
+	LocalizationFormatXLIFF,
+	LocalizationFormatJSON,
+	LocalizationFormatCSV,
+}
+
+// localizationFileExtensions lists accepted extensions per format; the first is used when writing.
+var localizationFileExtensions = map[string][]string{
+	LocalizationFormatStrings: {".strings"},
+	LocalizationFormatXLIFF:   {".xliff", ".xlf"},
+	LocalizationFormatJSON:    {".json"},
+	LocalizationFormatCSV:     {".csv"},
+}
+
+// localizationFieldLimits holds App Store character limits for localization keys.
+var localizationFieldLimits = map[string]int{
+	"description":     validation.LimitDescription,
+	"keywords":        validation.LimitKeywords,
+	"whatsNew":        validation.LimitWhatsNew,
+	"promotionalText": validation.LimitPromotionalText,
+	"name":            validation.LimitName,
+	"subtitle":        validation.LimitSubtitle,
+}
+
+// LocalizationFileOptions controls how localization values map to files.
+type LocalizationFileOptions struct {
+	Type   string
+	Format string
+	// SourceLocale is the locale XLIFF files translate from.
+	SourceLocale string
+	// TargetLocales selects the XLIFF files to write. When empty, every
+	// downloaded locale other than the source gets a file.
+	TargetLocales []string
+	XLIFFVersion  string
+}
+
+// NewLocalizationFileOptions validates format flags and returns file options.
+func NewLocalizationFileOptions(locType, format, sourceLocale, xliffVersion string) (LocalizationFileOptions, error) {
+	normalizedFormat := strings.ToLower(strings.TrimSpace(format))
+	if normalizedFormat == "" {
+		normalizedFormat = LocalizationFormatStrings
+	}
+	if !slices.Contains(localizationFormats, normalizedFormat) {
+		return LocalizationFileOptions{}, fmt.Errorf("--format must be one of: %s", strings.Join(localizationFormats, ", "))
+	}
+
+	version := strings.TrimSpace(xliffVersion)
+	if version == "" {
+		version = XLIFFVersion12
+	}
+	if version != XLIFFVersion12 && version != XLIFFVersion20 {
+		return LocalizationFileOptions{}, fmt.Errorf("--xliff-version must be %q or %q", XLIFFVersion12, XLIFFVersion20)
+	}
+
+	source := strings.TrimSpace(sourceLocale)
+	if source == "" {
+		source = defaultLocalizationSourceLocale
+	}
+	if !isValidLocale(source) {
+		return LocalizationFileOptions{}, fmt.Errorf("--source-locale %q is not a valid locale code", source)
+	}
+
+	return LocalizationFileOptions{
+		Type:         locType,
+		Format:       normalizedFormat,
+		SourceLocale: source,
+		XLIFFVersion: version,
+	}, nil
+}
+
+// FetchLocales returns the locales to request for a --locale filter.
+// XLIFF files need the source locale alongside the requested targets.
+func (o LocalizationFileOptions) FetchLocales(locales []string) []string {
+	if o.Format != LocalizationFormatXLIFF || len(locales) == 0 || slices.Contains(locales, o.SourceLocale) {
+		return locales
+	}
+	return append(slices.Clone(locales), o.SourceLocale)
+}
+
+// WriteLocalizationFiles writes localization values in the configured format.
+func WriteLocalizationFiles(outputPath string, valuesByLocale map[string]map[string]string, opts LocalizationFileOptions) ([]asc.LocalizationFileResult, error) {
+	keys := localizationKeys(opts.Type)
+	switch opts.Format {
+	case "", LocalizationFormatStrings:
+		return writeLocalizationStrings(outputPath, valuesByLocale, keys)
+	case LocalizationFormatXLIFF:
+		return writeLocalizationXLIFF(outputPath, valuesByLocale, keys, opts)
+	case LocalizationFormatJSON:
+		return writeLocalizationBundle(outputPath, valuesByLocale, opts.Format, func([]string) ([]byte, error) {
+			return marshalLocalizationJSON(valuesByLocale, keys, opts.Type)
+		})
+	case LocalizationFormatCSV:
+		return writeLocalizationBundle(outputPath, valuesByLocale, opts.Format, func(locales []string) ([]byte, error) {
+			return marshalLocalizationCSV(valuesByLocale, locales, keys)
+		})
+	default:
+		return nil, fmt.Errorf("unsupported localization format %q", opts.Format)
+	}
+}
+
+// ReadLocalizationFiles reads localization values in the configured format.
+func ReadLocalizationFiles(inputPath string, locales []string, opts LocalizationFileOptions) (map[string]map[string]string, error) {
+	switch opts.Format {
+	case "", LocalizationFormatStrings:
+		return ReadLocalizationStrings(inputPath, locales)
+	case LocalizationFormatXLIFF:
+		return readLocalizationXLIFF(inputPath, locales)
+	case LocalizationFormatJSON:
+		return readLocalizationBundle(inputPath, locales, opts.Format, unmarshalLocalizationJSON)
+	case LocalizationFormatCSV:
+		return readLocalizationBundle(inputPath, locales, opts.Format, unmarshalLocalizationCSV)
+	default:
+		return nil, fmt.Errorf("unsupported localization format %q", opts.Format)
+	}
+}
+
+func hasLocalizationFileExtension(path, format string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext != "" && slices.Contains(localizationFileExtensions[format], ext)
+}
+
+func sortedLocales(valuesByLocale map[string]map[string]string) []string {
+	locales := make([]string, 0, len(valuesByLocale))
+	for locale := range valuesByLocale {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// writeLocalizationBundle writes every locale into one file, for formats that
+// keep all languages side by side.
+func writeLocalizationBundle(outputPath string, valuesByLocale map[string]map[string]string, format string, marshal func(locales []string) ([]byte, error)) ([]asc.LocalizationFileResult, error) {
+	if len(valuesByLocale) == 0 {
+		return nil, fmt.Errorf("no localizations returned")
+	}
+	locales := sortedLocales(valuesByLocale)
+	for _, locale := range locales {
+		if !isValidLocale(locale) {
+			return nil, fmt.Errorf("invalid locale code %q: must match pattern like 'en', 'en-US', or 'zh-Hans'", locale)
+		}
+	}
+
+	path := resolveLocalizationBundlePath(outputPath, format)
+	data, err := marshal(locales)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeLocalizationFile(path, data); err != nil {
+		return nil, err
+	}
+
+	results := make([]asc.LocalizationFileResult, 0, len(locales))
+	for _, locale := range locales {
+		results = append(results, asc.LocalizationFileResult{Locale: locale, Path: path})
+	}
+	return results, nil
+}
+
+func resolveLocalizationBundlePath(path, format string) string {
+	if strings.TrimSpace(path) == "" {
+		path = localizationBundleName
+	}
+	if hasLocalizationFileExtension(path, format) {
+		return path
+	}
+	return filepath.Join(path, localizationBundleName+localizationFileExtensions[format][0])
+}
+
+func readLocalizationBundle(inputPath string, locales []string, format string, unmarshal func([]byte) (map[string]map[string]string, error)) (map[string]map[string]string, error) {
+	info, err := os.Stat(inputPath)
+	if err != nil {
+		return nil, err
+	}
+	path := inputPath
+	if info.IsDir() {
+		path = resolveLocalizationBundlePath(inputPath, format)
+	}
+
+	data, err := readLocalizationFile(path)
+	if err != nil {
+		return nil, err
+	}
+	values, err := unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	values = filterLocalizationLocales(values, locales)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no localizations found in %q", path)
+	}
+	return values, nil
+}
+
+func filterLocalizationLocales(values map[string]map[string]string, locales []string) map[string]map[string]string {
+	if len(locales) == 0 {
+		return values
+	}
+	filtered := make(map[string]map[string]string, len(locales))
+	for _, locale := range locales {
+		if localeValues, ok := values[locale]; ok {
+			filtered[locale] = localeValues
+		}
+	}
+	return filtered
+}
+
+type localizationJSONDocument struct {
+	Type          string                       `json:"type,omitempty"`
+	Limits        map[string]int               `json:"limits,omitempty"`
+	Localizations map[string]map[string]string `json:"localizations"`
+}
+
+func marshalLocalizationJSON(valuesByLocale map[string]map[string]string, keys []string, locType string) ([]byte, error) {
+	doc := localizationJSONDocument{
+		Type:          locType,
+		Localizations: valuesByLocale,
+	}
+	for _, key := range keys {
+		if limit, ok := localizationFieldLimits[key]; ok {
+			if doc.Limits == nil {
+				doc.Limits = make(map[string]int)
+			}
+			doc.Limits[key] = limit
+		}
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func unmarshalLocalizationJSON(data []byte) (map[string]map[string]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var doc localizationJSONDocument
+	if err := decoder.Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("file is empty")
+		}
+		return nil, err
+	}
+	for locale := range doc.Localizations {
+		if !isValidLocale(locale) {
+			return nil, fmt.Errorf("invalid locale code %q", locale)
+		}
+	}
+	return doc.Localizations, nil
+}
+
+// marshalLocalizationCSV writes one row per key and one column per locale so
+// the file reads naturally in a spreadsheet.
+func marshalLocalizationCSV(valuesByLocale map[string]map[string]string, locales, keys []string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	header := append([]string{csvKeyColumn, csvMaxLengthColumn}, locales...)
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		row := []string{key, ""}
+		if limit, ok := localizationFieldLimits[key]; ok {
+			row[1] = strconv.Itoa(limit)
+		}
+		hasValue := false
+		for _, locale := range locales {
+			value := valuesByLocale[locale][key]
+			if value != "" {
+				hasValue = true
+			}
+			row = append(row, value)
+		}
+		if !hasValue {
+			continue
+		}
+		if err := writer.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func unmarshalLocalizationCSV(data []byte) (map[string]map[string]string, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("file is empty")
+	}
+
+	header := records[0]
+	if len(header) == 0 || strings.TrimSpace(header[0]) != csvKeyColumn {
+		return nil, fmt.Errorf("first column must be %q", csvKeyColumn)
+	}
+	columns := make(map[int]string, len(header))
+	for i, name := range header[1:] {
+		name = strings.TrimSpace(name)
+		if name == csvMaxLengthColumn {
+			continue
+		}
+		if !isValidLocale(name) {
+			return nil, fmt.Errorf("invalid locale column %q", name)
+		}
+		columns[i+1] = name
+	}
+
+	values := make(map[string]map[string]string)
+	seen := make(map[string]bool)
+	for line, record := range records[1:] {
+		key := strings.TrimSpace(record[0])
+		if key == "" {
+			continue
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("line %d: duplicate key %q", line+2, key)
+		}
+		seen[key] = true
+		for i, locale := range columns {
+			if record[i] == "" {
+				continue
+			}
+			if values[locale] == nil {
+				values[locale] = make(map[string]string)
+			}
+			values[locale][key] = record[i]
+		}
+	}
+	return values, nil
+}
+
+type xliffProbe struct {
+	Version string `xml:"version,attr"`
+}
+
+type xliff12Document struct {
+	XMLName xml.Name      `xml:"xliff"`
+	Xmlns   string        `xml:"xmlns,attr,omitempty"`
+	Version string        `xml:"version,attr"`
+	Files   []xliff12File `xml:"file"`
+}
+
+type xliff12File struct {
+	Original       string        `xml:"original,attr"`
+	SourceLanguage string        `xml:"source-language,attr"`
+	TargetLanguage string        `xml:"target-language,attr,omitempty"`
+	Datatype       string        `xml:"datatype,attr"`
+	Units          []xliff12Unit `xml:"body>trans-unit"`
+}
+
+type xliff12Unit struct {
+	ID       string `xml:"id,attr"`
+	MaxWidth int    `xml:"maxwidth,attr,omitempty"`
+	SizeUnit string `xml:"size-unit,attr,omitempty"`
+	Source   string `xml:"source"`
+	Target   string `xml:"target"`
+}
+
+type xliff20Document struct {
+	XMLName xml.Name      `xml:"xliff"`
+	Xmlns   string        `xml:"xmlns,attr,omitempty"`
+	Version string        `xml:"version,attr"`
+	SrcLang string        `xml:"srcLang,attr"`
+	TrgLang string        `xml:"trgLang,attr,omitempty"`
+	Files   []xliff20File `xml:"file"`
+}
+
+type xliff20File struct {
+	ID    string        `xml:"id,attr"`
+	Units []xliff20Unit `xml:"unit"`
+}
+
+type xliff20Unit struct {
+	ID       string           `xml:"id,attr"`
+	Notes    []xliff20Note    `xml:"notes>note,omitempty"`
+	Segments []xliff20Segment `xml:"segment"`
+}
+
+type xliff20Note struct {
+	Category string `xml:"category,attr,omitempty"`
+	Text     string `xml:",chardata"`
+}
+
+type xliff20Segment struct {
+	Source string `xml:"source"`
+	Target string `xml:"target"`
+}
+
+// writeLocalizationXLIFF writes one XLIFF file per target locale, pairing each
+// value with its source-locale text.
+func writeLocalizationXLIFF(outputPath string, valuesByLocale map[string]map[string]string, keys []string, opts LocalizationFileOptions) ([]asc.LocalizationFileResult, error) {
+	source, ok := valuesByLocale[opts.SourceLocale]
+	if !ok {
+		return nil, fmt.Errorf("source locale %q not found (use --source-locale)", opts.SourceLocale)
+	}
+
+	targets := make([]string, 0, len(valuesByLocale))
+	if len(opts.TargetLocales) > 0 {
+		for _, locale := range opts.TargetLocales {
+			if locale != opts.SourceLocale && !slices.Contains(targets, locale) {
+				targets = append(targets, locale)
+			}
+		}
+		sort.Strings(targets)
+	} else {
+		for _, locale := range sortedLocales(valuesByLocale) {
+			if locale != opts.SourceLocale {
+				targets = append(targets, locale)
+			}
+		}
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no target locales to export (use --locale to choose target locales)")
+	}
+
+	return writeLocalizationFilesPerLocale(outputPath, targets, LocalizationFormatXLIFF, func(path, locale string) error {
+		data, err := marshalLocalizationXLIFF(source, valuesByLocale[locale], keys, opts, locale)
+		if err != nil {
+			return err
+		}
+		return writeLocalizationFile(path, data)
+	})
+}
+
+func marshalLocalizationXLIFF(source, target map[string]string, keys []string, opts LocalizationFileOptions, targetLocale string) ([]byte, error) {
+	var doc any
+	switch opts.XLIFFVersion {
+	case XLIFFVersion20:
+		file := xliff20File{ID: opts.Type}
+		for _, key := range keys {
+			if source[key] == "" && target[key] == "" {
+				continue
+			}
+			unit := xliff20Unit{
+				ID:       key,
+				Segments: []xliff20Segment{{Source: source[key], Target: target[key]}},
+			}
+			if limit, ok := localizationFieldLimits[key]; ok {
+				unit.Notes = []xliff20Note{{Category: "maxLength", Text: strconv.Itoa(limit)}}
+			}
+			file.Units = append(file.Units, unit)
+		}
+		doc = xliff20Document{
+			Xmlns:   "urn:oasis:names:tc:xliff:document:2.0",
+			Version: XLIFFVersion20,
+			SrcLang: opts.SourceLocale,
+			TrgLang: targetLocale,
+			Files:   []xliff20File{file},
+		}
+	default:
+		file := xliff12File{
+			Original:       opts.Type,
+			SourceLanguage: opts.SourceLocale,
+			TargetLanguage: targetLocale,
+			Datatype:       "plaintext",
+		}
+		for _, key := range keys {
+			if source[key] == "" && target[key] == "" {
+				continue
+			}
+			unit := xliff12Unit{ID: key, Source: source[key], Target: target[key]}
+			if limit, ok := localizationFieldLimits[key]; ok {
+				unit.MaxWidth = limit
+				unit.SizeUnit = "char"
+			}
+			file.Units = append(file.Units, unit)
+		}
+		doc = xliff12Document{
+			Xmlns:   "urn:oasis:names:tc:xliff:document:1.2",
+			Version: XLIFFVersion12,
+			Files:   []xliff12File{file},
+		}
+	}
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte(xml.Header), data...), '\n'), nil
+}
+
+func readLocalizationXLIFF(inputPath string, locales []string) (map[string]map[string]string, error) {
+	info, err := os.Stat(inputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{inputPath}
+	if info.IsDir() {
+		entries, err := os.ReadDir(inputPath)
+		if err != nil {
+			return nil, err
+		}
+		paths = paths[:0]
+		for _, entry := range entries {
+			if entry.IsDir() || !hasLocalizationFileExtension(entry.Name(), LocalizationFormatXLIFF) {
+				continue
+			}
+			paths = append(paths, filepath.Join(inputPath, entry.Name()))
+		}
+		if len(paths) == 0 {
+			return nil, fmt.Errorf("no .xliff files found in %q", inputPath)
+		}
+	}
+
+	values := make(map[string]map[string]string)
+	for _, path := range paths {
+		data, err := readLocalizationFile(path)
+		if err != nil {
+			return nil, err
+		}
+		parsed, err := unmarshalLocalizationXLIFF(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		for locale, localeValues := range parsed {
+			if _, exists := values[locale]; exists {
+				return nil, fmt.Errorf("duplicate locale %q in %s", locale, inputPath)
+			}
+			values[locale] = localeValues
+		}
+	}
+
+	values = filterLocalizationLocales(values, locales)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no translated units found in %q", inputPath)
+	}
+	return values, nil
+}
+
+// unmarshalLocalizationXLIFF returns translated target values keyed by target
+// locale. Units without a target are treated as untranslated and skipped.
+func unmarshalLocalizationXLIFF(data []byte) (map[string]map[string]string, error) {
+	var probe xliffProbe
+	if err := xml.Unmarshal(data, &probe); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]map[string]string)
+	add := func(locale, key, value string) error {
+		if value == "" {
+			return nil
+		}
+		if !isValidLocale(locale) {
+			return fmt.Errorf("invalid or missing target language %q", locale)
+		}
+		if values[locale] == nil {
+			values[locale] = make(map[string]string)
+		}
+		if _, exists := values[locale][key]; exists {
+			return fmt.Errorf("duplicate unit %q for %s", key, locale)
+		}
+		values[locale][key] = value
+		return nil
+	}
+
+	switch probe.Version {
+	case XLIFFVersion12:
+		var doc xliff12Document
+		if err := xml.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		for _, file := range doc.Files {
+			for _, unit := range file.Units {
+				if err := add(file.TargetLanguage, unit.ID, unit.Target); err != nil {
+					return nil, err
+				}
+			}
+		}
+	case XLIFFVersion20:
+		var doc xliff20Document
+		if err := xml.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		for _, file := range doc.Files {
+			for _, unit := range file.Units {
+				var target strings.Builder
+				for _, segment := range unit.Segments {
+					target.WriteString(segment.Target)
+				}
+				if err := add(doc.TrgLang, unit.ID, target.String()); err != nil {
+					return nil, err
+				}
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported XLIFF version %q", probe.Version)
+	}
+	return values, nil
+}
@@ -0,0 +1,192 @@
+package shared
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testLocalizationValues() map[string]map[string]string {
+	return map[string]map[string]string{
+		"en-US": {
+			"description": "Line one\nLine \"two\" & <three>",
+			"keywords":    "one, two",
+			"whatsNew":    "  Leading and trailing spaces  ",
+		},
+		"de-DE": {
+			"description": "Zeile eins\nZeile zwei",
+			"keywords":    "eins, zwei",
+		},
+	}
+}
+
+func TestLocalizationFiles_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		version  string
+		path     string
+		wantFile string
+	}{
+		{name: "strings", format: LocalizationFormatStrings, path: "out", wantFile: "de-DE.strings"},
+		{name: "json", format: LocalizationFormatJSON, path: "out", wantFile: "localizations.json"},
+		{name: "json file", format: LocalizationFormatJSON, path: "metadata.json", wantFile: ""},
+		{name: "csv", format: LocalizationFormatCSV, path: "metadata.csv", wantFile: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			opts, err := NewLocalizationFileOptions(LocalizationTypeVersion, test.format, "", test.version)
+			if err != nil {
+				t.Fatalf("NewLocalizationFileOptions() error: %v", err)
+			}
+			outputPath := filepath.Join(dir, test.path)
+
+			files, err := WriteLocalizationFiles(outputPath, testLocalizationValues(), opts)
+			if err != nil {
+				t.Fatalf("WriteLocalizationFiles() error: %v", err)
+			}
+			if len(files) != 2 || files[0].Locale != "de-DE" || files[1].Locale != "en-US" {
+				t.Fatalf("unexpected files: %+v", files)
+			}
+			if test.wantFile != "" && files[0].Path != filepath.Join(outputPath, test.wantFile) {
+				t.Fatalf("expected %s, got %s", test.wantFile, files[0].Path)
+			}
+
+			got, err := ReadLocalizationFiles(outputPath, nil, opts)
+			if err != nil {
+				t.Fatalf("ReadLocalizationFiles() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, testLocalizationValues()) {
+				t.Fatalf("round trip mismatch:\n got %#v\nwant %#v", got, testLocalizationValues())
+			}
+
+			filtered, err := ReadLocalizationFiles(outputPath, []string{"de-DE"}, opts)
+			if err != nil {
+				t.Fatalf("ReadLocalizationFiles() with locale error: %v", err)
+			}
+			if len(filtered) != 1 || filtered["de-DE"] == nil {
+				t.Fatalf("expected only de-DE, got %v", filtered)
+			}
+		})
+	}
+}
+
+func TestLocalizationFiles_XLIFFRoundTrip(t *testing.T) {
+	for _, version := range []string{XLIFFVersion12, XLIFFVersion20} {
+		t.Run(version, func(t *testing.T) {
+			dir := t.TempDir()
+			opts, err := NewLocalizationFileOptions(LocalizationTypeVersion, LocalizationFormatXLIFF, "en-US", version)
+			if err != nil {
+				t.Fatalf("NewLocalizationFileOptions() error: %v", err)
+			}
+			opts.TargetLocales = []string{"de-DE", "ja"}
+
+			files, err := WriteLocalizationFiles(dir, testLocalizationValues(), opts)
+			if err != nil {
+				t.Fatalf("WriteLocalizationFiles() error: %v", err)
+			}
+			if len(files) != 2 || files[0].Path != filepath.Join(dir, "de-DE.xliff") || files[1].Path != filepath.Join(dir, "ja.xliff") {
+				t.Fatalf("unexpected files: %+v", files)
+			}
+
+			data, err := os.ReadFile(files[0].Path)
+			if err != nil {
+				t.Fatalf("read xliff: %v", err)
+			}
+			content := string(data)
+			if !strings.Contains(content, `version="`+version+`"`) {
+				t.Fatalf("expected XLIFF %s document, got:\n%s", version, content)
+			}
+			wantLimit := `maxwidth="4000" size-unit="char"`
+			if version == XLIFFVersion20 {
+				wantLimit = `<note category="maxLength">4000</note>`
+			}
+			if !strings.Contains(content, wantLimit) {
+				t.Fatalf("expected length annotation %q, got:\n%s", wantLimit, content)
+			}
+
+			got, err := ReadLocalizationFiles(dir, nil, opts)
+			if err != nil {
+				t.Fatalf("ReadLocalizationFiles() error: %v", err)
+			}
+			want := map[string]map[string]string{"de-DE": testLocalizationValues()["de-DE"]}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("expected only translated units:\n got %#v\nwant %#v", got, want)
+			}
+		})
+	}
+}
+
+func TestLocalizationFiles_XLIFFRequiresSourceLocale(t *testing.T) {
+	opts, err := NewLocalizationFileOptions(LocalizationTypeVersion, LocalizationFormatXLIFF, "fr-FR", "")
+	if err != nil {
+		t.Fatalf("NewLocalizationFileOptions() error: %v", err)
+	}
+	_, err = WriteLocalizationFiles(t.TempDir(), testLocalizationValues(), opts)
+	if err == nil || !strings.Contains(err.Error(), `source locale "fr-FR" not found`) {
+		t.Fatalf("expected missing source locale error, got %v", err)
+	}
+}
+
+func TestLocalizationFiles_Annotations(t *testing.T) {
+	dir := t.TempDir()
+	values := map[string]map[string]string{"en-US": {"keywords": "one", "supportUrl": "https://example.com"}}
+
+	stringsOpts, _ := NewLocalizationFileOptions(LocalizationTypeVersion, LocalizationFormatStrings, "", "")
+	if _, err := WriteLocalizationFiles(filepath.Join(dir, "en-US.strings"), values, stringsOpts); err != nil {
+		t.Fatalf("write strings: %v", err)
+	}
+	data, _ := os.ReadFile(filepath.Join(dir, "en-US.strings"))
+	want := "/* Maximum 100 characters. */\n\"keywords\" = \"one\";\n\"supportUrl\" = \"https://example.com\";\n"
+	if string(data) != want {
+		t.Fatalf("unexpected strings file:\n%s", data)
+	}
+
+	csvOpts, _ := NewLocalizationFileOptions(LocalizationTypeVersion, LocalizationFormatCSV, "", "")
+	if _, err := WriteLocalizationFiles(filepath.Join(dir, "out.csv"), values, csvOpts); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+	data, _ = os.ReadFile(filepath.Join(dir, "out.csv"))
+	want = "key,maxLength,en-US\nkeywords,100,one\nsupportUrl,,https://example.com\n"
+	if string(data) != want {
+		t.Fatalf("unexpected csv file:\n%s", data)
+	}
+}
+
+func TestNewLocalizationFileOptions_Errors(t *testing.T) {
+	tests := []struct {
+		format, source, version string
+		wantErr                 string
+	}{
+		{format: "yaml", wantErr: "--format must be one of"},
+		{format: "xliff", version: "1.1", wantErr: "--xliff-version"},
+		{format: "xliff", source: "../en", wantErr: "--source-locale"},
+	}
+	for _, test := range tests {
+		_, err := NewLocalizationFileOptions(LocalizationTypeVersion, test.format, test.source, test.version)
+		if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+			t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+		}
+	}
+}
+
+func TestUnmarshalLocalizationCSV_Errors(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantErr string
+	}{
+		{input: "field,en-US\nkeywords,one\n", wantErr: `first column must be "key"`},
+		{input: "key,../x\nkeywords,one\n", wantErr: "invalid locale column"},
+		{input: "key,en-US\nkeywords,one\nkeywords,two\n", wantErr: "duplicate key"},
+	}
+	for _, test := range tests {
+		_, err := unmarshalLocalizationCSV([]byte(test.input))
+		if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+			t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+		}
+	}
+}
@@ -17,8 +17,10 @@ import (
 )
 
 const (
-	LocalizationTypeVersion = "version"
-	LocalizationTypeAppInfo = "app-info"
+	LocalizationTypeVersion   = "version"
+	LocalizationTypeAppInfo   = "app-info"
+	LocalizationTypeBetaApp   = "beta-app"
+	LocalizationTypeBetaBuild = "beta-build"
 )
 
 var (
@@ -37,6 +39,16 @@ var (
 		"privacyChoicesUrl",
 		"privacyPolicyText",
 	}
+	betaAppLocalizationKeys = []string{
+		"description",
+		"feedbackEmail",
+		"marketingUrl",
+		"privacyPolicyUrl",
+		"tvOsPrivacyPolicy",
+	}
+	betaBuildLocalizationKeys = []string{
+		"whatsNew",
+	}
 )
 
 type versionLocalizationClient interface {
@@ -51,6 +63,18 @@ type appInfoLocalizationClient interface {
 	UpdateAppInfoLocalization(context.Context, string, asc.AppInfoLocalizationAttributes) (*asc.AppInfoLocalizationResponse, error)
 }
 
+type betaAppLocalizationClient interface {
+	GetBetaAppLocalizations(context.Context, ...asc.BetaAppLocalizationsOption) (*asc.BetaAppLocalizationsResponse, error)
+	CreateBetaAppLocalization(context.Context, string, asc.BetaAppLocalizationAttributes) (*asc.BetaAppLocalizationResponse, error)
+	UpdateBetaAppLocalization(context.Context, string, asc.BetaAppLocalizationUpdateAttributes) (*asc.BetaAppLocalizationResponse, error)
+}
+
+type betaBuildLocalizationClient interface {
+	GetBetaBuildLocalizations(context.Context, string, ...asc.BetaBuildLocalizationsOption) (*asc.BetaBuildLocalizationsResponse, error)
+	CreateBetaBuildLocalization(context.Context, string, asc.BetaBuildLocalizationAttributes) (*asc.BetaBuildLocalizationResponse, error)
+	UpdateBetaBuildLocalization(context.Context, string, asc.BetaBuildLocalizationAttributes) (*asc.BetaBuildLocalizationResponse, error)
+}
+
 func NormalizeLocalizationType(value string) (string, error) {
 	normalized := strings.ToLower(strings.TrimSpace(value))
 	switch normalized {
@@ -62,6 +86,15 @@ func NormalizeLocalizationType(value string) (string, error) {
 }
 
 func WriteVersionLocalizationStrings(outputPath string, items []asc.Resource[asc.AppStoreVersionLocalizationAttributes]) ([]asc.LocalizationFileResult, error) {
+	return writeLocalizationStrings(outputPath, VersionLocalizationValues(items), versionLocalizationKeys)
+}
+
+func WriteAppInfoLocalizationStrings(outputPath string, items []asc.Resource[asc.AppInfoLocalizationAttributes]) ([]asc.LocalizationFileResult, error) {
+	return writeLocalizationStrings(outputPath, AppInfoLocalizationValues(items), appInfoLocalizationKeys)
+}
+
+// VersionLocalizationValues maps version localizations to values keyed by locale.
+func VersionLocalizationValues(items []asc.Resource[asc.AppStoreVersionLocalizationAttributes]) map[string]map[string]string {
 	byLocale := make(map[string]map[string]string, len(items))
 	for _, item := range items {
 		locale := strings.TrimSpace(item.Attributes.Locale)
@@ -70,10 +103,11 @@ func WriteVersionLocalizationStrings(outputPath string, items []asc.Resource[asc
 		}
 		byLocale[locale] = mapVersionLocalizationStrings(item.Attributes)
 	}
-	return writeLocalizationStrings(outputPath, byLocale, versionLocalizationKeys)
+	return byLocale
 }
 
-func WriteAppInfoLocalizationStrings(outputPath string, items []asc.Resource[asc.AppInfoLocalizationAttributes]) ([]asc.LocalizationFileResult, error) {
+// AppInfoLocalizationValues maps app info localizations to values keyed by locale.
+func AppInfoLocalizationValues(items []asc.Resource[asc.AppInfoLocalizationAttributes]) map[string]map[string]string {
 	byLocale := make(map[string]map[string]string, len(items))
 	for _, item := range items {
 		locale := strings.TrimSpace(item.Attributes.Locale)
@@ -82,7 +116,54 @@ func WriteAppInfoLocalizationStrings(outputPath string, items []asc.Resource[asc
 		}
 		byLocale[locale] = mapAppInfoLocalizationStrings(item.Attributes)
 	}
-	return writeLocalizationStrings(outputPath, byLocale, appInfoLocalizationKeys)
+	return byLocale
+}
+
+// BetaAppLocalizationValues maps beta app localizations to values keyed by locale.
+func BetaAppLocalizationValues(items []asc.Resource[asc.BetaAppLocalizationAttributes]) map[string]map[string]string {
+	byLocale := make(map[string]map[string]string, len(items))
+	for _, item := range items {
+		locale := strings.TrimSpace(item.Attributes.Locale)
+		if locale == "" {
+			continue
+		}
+		values := make(map[string]string)
+		setIfNotEmpty(values, "description", item.Attributes.Description)
+		setIfNotEmpty(values, "feedbackEmail", item.Attributes.FeedbackEmail)
+		setIfNotEmpty(values, "marketingUrl", item.Attributes.MarketingURL)
+		setIfNotEmpty(values, "privacyPolicyUrl", item.Attributes.PrivacyPolicyURL)
+		setIfNotEmpty(values, "tvOsPrivacyPolicy", item.Attributes.TvOsPrivacyPolicy)
+		byLocale[locale] = values
+	}
+	return byLocale
+}
+
+// BetaBuildLocalizationValues maps TestFlight "What to Test" notes to values keyed by locale.
+func BetaBuildLocalizationValues(items []asc.Resource[asc.BetaBuildLocalizationAttributes]) map[string]map[string]string {
+	byLocale := make(map[string]map[string]string, len(items))
+	for _, item := range items {
+		locale := strings.TrimSpace(item.Attributes.Locale)
+		if locale == "" {
+			continue
+		}
+		values := make(map[string]string)
+		setIfNotEmpty(values, "whatsNew", item.Attributes.WhatsNew)
+		byLocale[locale] = values
+	}
+	return byLocale
+}
+
+func localizationKeys(locType string) []string {
+	switch locType {
+	case LocalizationTypeAppInfo:
+		return appInfoLocalizationKeys
+	case LocalizationTypeBetaApp:
+		return betaAppLocalizationKeys
+	case LocalizationTypeBetaBuild:
+		return betaBuildLocalizationKeys
+	default:
+		return versionLocalizationKeys
+	}
 }
 
 func writeLocalizationStrings(outputPath string, valuesByLocale map[string]map[string]string, order []string) ([]asc.LocalizationFileResult, error) {
@@ -96,7 +177,13 @@ func writeLocalizationStrings(outputPath string, valuesByLocale map[string]map[s
 	}
 	sort.Strings(locales)
 
-	paths, err := resolveLocalizationOutputPaths(outputPath, locales)
+	return writeLocalizationFilesPerLocale(outputPath, locales, LocalizationFormatStrings, func(path, locale string) error {
+		return writeStringsFile(path, valuesByLocale[locale], order)
+	})
+}
+
+func writeLocalizationFilesPerLocale(outputPath string, locales []string, format string, write func(path, locale string) error) ([]asc.LocalizationFileResult, error) {
+	paths, err := resolveLocalizationOutputPaths(outputPath, locales, format)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +194,7 @@ func writeLocalizationStrings(outputPath string, valuesByLocale map[string]map[s
 		if !ok {
 			continue
 		}
-		if err := writeStringsFile(path, valuesByLocale[locale], order); err != nil {
+		if err := write(path, locale); err != nil {
 			return nil, err
 		}
 		results = append(results, asc.LocalizationFileResult{
@@ -133,13 +220,13 @@ func isValidLocale(locale string) bool {
 	return localeValidationRegex.MatchString(locale)
 }
 
-func resolveLocalizationOutputPaths(outputPath string, locales []string) (map[string]string, error) {
+func resolveLocalizationOutputPaths(outputPath string, locales []string, format string) (map[string]string, error) {
 	if strings.TrimSpace(outputPath) == "" {
 		outputPath = "localizations"
 	}
 
 	result := make(map[string]string, len(locales))
-	if strings.HasSuffix(outputPath, ".strings") {
+	if hasLocalizationFileExtension(outputPath, format) {
 		if len(locales) != 1 {
 			return nil, fmt.Errorf("output path %q requires exactly one locale", outputPath)
 		}
@@ -159,7 +246,7 @@ func resolveLocalizationOutputPaths(outputPath string, locales []string) (map[st
 		if !isValidLocale(locale) {
 			return nil, fmt.Errorf("invalid locale code %q: must match pattern like 'en', 'en-US', or 'zh-Hans'", locale)
 		}
-		result[locale] = filepath.Join(outputPath, locale+".strings")
+		result[locale] = filepath.Join(outputPath, locale+localizationFileExtensions[format][0])
 	}
 	return result, nil
 }
@@ -354,6 +441,100 @@ func UploadAppInfoLocalizations(ctx context.Context, client appInfoLocalizationC
 	})
 }
 
+func UploadBetaAppLocalizations(ctx context.Context, client betaAppLocalizationClient, appID string, valuesByLocale map[string]map[string]string, dryRun bool) ([]asc.LocalizationUploadLocaleResult, error) {
+	validateKeys := buildAllowedKeys(betaAppLocalizationKeys)
+	for locale, values := range valuesByLocale {
+		if err := validateLocalizationKeys(locale, values, validateKeys); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := client.GetBetaAppLocalizations(ctx, asc.WithBetaAppLocalizationAppIDs([]string{appID}), asc.WithBetaAppLocalizationsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	existingByLocale := make(map[string]string, len(existing.Data))
+	for _, item := range existing.Data {
+		if strings.TrimSpace(item.Attributes.Locale) == "" {
+			continue
+		}
+		existingByLocale[item.Attributes.Locale] = item.ID
+	}
+
+	return uploadLocalizationValues(ctx, valuesByLocale, existingByLocale, func(locale string, values map[string]string, existingID string) (asc.LocalizationUploadLocaleResult, error) {
+		if existingID == "" {
+			if dryRun {
+				return asc.LocalizationUploadLocaleResult{Locale: locale, Action: "create"}, nil
+			}
+			attributes := asc.BetaAppLocalizationAttributes{
+				Locale:            locale,
+				Description:       values["description"],
+				FeedbackEmail:     values["feedbackEmail"],
+				MarketingURL:      values["marketingUrl"],
+				PrivacyPolicyURL:  values["privacyPolicyUrl"],
+				TvOsPrivacyPolicy: values["tvOsPrivacyPolicy"],
+			}
+			resp, err := client.CreateBetaAppLocalization(ctx, appID, attributes)
+			if err != nil {
+				return asc.LocalizationUploadLocaleResult{}, err
+			}
+			return asc.LocalizationUploadLocaleResult{Locale: locale, Action: "create", LocalizationID: resp.Data.ID}, nil
+		}
+		if dryRun {
+			return asc.LocalizationUploadLocaleResult{Locale: locale, Action: "update", LocalizationID: existingID}, nil
+		}
+		resp, err := client.UpdateBetaAppLocalization(ctx, existingID, buildBetaAppLocalizationUpdateAttributes(values))
+		if err != nil {
+			return asc.LocalizationUploadLocaleResult{}, err
+		}
+		return asc.LocalizationUploadLocaleResult{Locale: locale, Action: "update", LocalizationID: resp.Data.ID}, nil
+	})
+}
+
+func UploadBetaBuildLocalizations(ctx context.Context, client betaBuildLocalizationClient, buildID string, valuesByLocale map[string]map[string]string, dryRun bool) ([]asc.LocalizationUploadLocaleResult, error) {
+	validateKeys := buildAllowedKeys(betaBuildLocalizationKeys)
+	for locale, values := range valuesByLocale {
+		if err := validateLocalizationKeys(locale, values, validateKeys); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := client.GetBetaBuildLocalizations(ctx, buildID, asc.WithBetaBuildLocalizationsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	existingByLocale := make(map[string]string, len(existing.Data))
+	for _, item := range existing.Data {
+		if strings.TrimSpace(item.Attributes.Locale) == "" {
+			continue
+		}
+		existingByLocale[item.Attributes.Locale] = item.ID
+	}
+
+	return uploadLocalizationValues(ctx, valuesByLocale, existingByLocale, func(locale string, values map[string]string, existingID string) (asc.LocalizationUploadLocaleResult, error) {
+		attributes := asc.BetaBuildLocalizationAttributes{WhatsNew: values["whatsNew"]}
+		if existingID == "" {
+			if dryRun {
+				return asc.LocalizationUploadLocaleResult{Locale: locale, Action: "create"}, nil
+			}
+			attributes.Locale = locale
+			resp, err := client.CreateBetaBuildLocalization(ctx, buildID, attributes)
+			if err != nil {
+				return asc.LocalizationUploadLocaleResult{}, err
+			}
+			return asc.LocalizationUploadLocaleResult{Locale: locale, Action: "create", LocalizationID: resp.Data.ID}, nil
+		}
+		if dryRun {
+			return asc.LocalizationUploadLocaleResult{Locale: locale, Action: "update", LocalizationID: existingID}, nil
+		}
+		resp, err := client.UpdateBetaBuildLocalization(ctx, existingID, attributes)
+		if err != nil {
+			return asc.LocalizationUploadLocaleResult{}, err
+		}
+		return asc.LocalizationUploadLocaleResult{Locale: locale, Action: "update", LocalizationID: resp.Data.ID}, nil
+	})
+}
+
 func isWhatsNewUnsupportedError(err error) bool {
 	if err == nil {
 		return false
@@ -483,6 +664,26 @@ func buildAppInfoLocalizationAttributes(locale string, values map[string]string,
 	return attrs
 }
 
+func buildBetaAppLocalizationUpdateAttributes(values map[string]string) asc.BetaAppLocalizationUpdateAttributes {
+	attrs := asc.BetaAppLocalizationUpdateAttributes{}
+	if value, ok := values["description"]; ok {
+		attrs.Description = &value
+	}
+	if value, ok := values["feedbackEmail"]; ok {
+		attrs.FeedbackEmail = &value
+	}
+	if value, ok := values["marketingUrl"]; ok {
+		attrs.MarketingURL = &value
+	}
+	if value, ok := values["privacyPolicyUrl"]; ok {
+		attrs.PrivacyPolicyURL = &value
+	}
+	if value, ok := values["tvOsPrivacyPolicy"]; ok {
+		attrs.TvOsPrivacyPolicy = &value
+	}
+	return attrs
+}
+
 type stringsParser struct {
 	runes []rune
 	pos   int
@@ -490,6 +691,14 @@ type stringsParser struct {
 }
 
 func readStringsFile(path string) (map[string]string, error) {
+	data, err := readLocalizationFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseStringsContent(string(data))
+}
+
+func readLocalizationFile(path string) ([]byte, error) {
 	info, err := os.Lstat(path)
 	if err != nil {
 		return nil, err
@@ -507,11 +716,7 @@ func readStringsFile(path string) (map[string]string, error) {
 	}
 	defer file.Close()
 
-	data, err := io.ReadAll(file)
-	if err != nil {
-		return nil, err
-	}
-	return parseStringsContent(string(data))
+	return io.ReadAll(file)
 }
 
 func parseStringsContent(content string) (map[string]string, error) {
@@ -685,18 +890,24 @@ func (p *stringsParser) errorf(message string) error {
 }
 
 func writeStringsFile(path string, values map[string]string, order []string) error {
-	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
-		return err
-	}
-
 	var b strings.Builder
 	for _, key := range order {
 		value, ok := values[key]
 		if !ok {
 			continue
 		}
+		if limit, ok := localizationFieldLimits[key]; ok {
+			fmt.Fprintf(&b, "/* Maximum %d characters. */\n", limit)
+		}
 		fmt.Fprintf(&b, "\"%s\" = \"%s\";\n", key, escapeStringsValue(value))
 	}
+	return writeLocalizationFile(path, []byte(b.String()))
+}
+
+func writeLocalizationFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
 
 	// Create file securely to prevent symlink attacks and TOCTOU vulnerabilities
 	// O_EXCL ensures atomic creation, O_NOFOLLOW prevents symlink traversal
@@ -709,7 +920,7 @@ func writeStringsFile(path string, values map[string]string, order []string) err
 	}
 	defer file.Close()
 
-	if _, err := file.WriteString(b.String()); err != nil {
+	if _, err := file.Write(data); err != nil {
 		return err
 	}
 	return file.Sync()
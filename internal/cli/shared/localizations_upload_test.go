@@ -202,3 +202,61 @@ func TestIsWhatsNewUnsupportedError(t *testing.T) {
 		t.Fatal("did not expect unrelated error to match")
 	}
 }
+
+type stubBetaBuildLocalizationClient struct {
+	getResp *asc.BetaBuildLocalizationsResponse
+
+	createCalls []asc.BetaBuildLocalizationAttributes
+	updateCalls map[string]asc.BetaBuildLocalizationAttributes
+}
+
+func (s *stubBetaBuildLocalizationClient) GetBetaBuildLocalizations(_ context.Context, _ string, _ ...asc.BetaBuildLocalizationsOption) (*asc.BetaBuildLocalizationsResponse, error) {
+	return s.getResp, nil
+}
+
+func (s *stubBetaBuildLocalizationClient) CreateBetaBuildLocalization(_ context.Context, _ string, attrs asc.BetaBuildLocalizationAttributes) (*asc.BetaBuildLocalizationResponse, error) {
+	s.createCalls = append(s.createCalls, attrs)
+	return &asc.BetaBuildLocalizationResponse{Data: asc.Resource[asc.BetaBuildLocalizationAttributes]{ID: "created-" + attrs.Locale}}, nil
+}
+
+func (s *stubBetaBuildLocalizationClient) UpdateBetaBuildLocalization(_ context.Context, id string, attrs asc.BetaBuildLocalizationAttributes) (*asc.BetaBuildLocalizationResponse, error) {
+	if s.updateCalls == nil {
+		s.updateCalls = make(map[string]asc.BetaBuildLocalizationAttributes)
+	}
+	s.updateCalls[id] = attrs
+	return &asc.BetaBuildLocalizationResponse{Data: asc.Resource[asc.BetaBuildLocalizationAttributes]{ID: id}}, nil
+}
+
+func TestUploadBetaBuildLocalizations_CreatesAndUpdates(t *testing.T) {
+	client := &stubBetaBuildLocalizationClient{
+		getResp: &asc.BetaBuildLocalizationsResponse{
+			Data: []asc.Resource[asc.BetaBuildLocalizationAttributes]{
+				{ID: "loc-en", Attributes: asc.BetaBuildLocalizationAttributes{Locale: "en-US"}},
+			},
+		},
+	}
+
+	results, err := UploadBetaBuildLocalizations(context.Background(), client, "build-1", map[string]map[string]string{
+		"en-US": {"whatsNew": "Test the new onboarding"},
+		"ja":    {"whatsNew": "新しいオンボーディングをテスト"},
+	}, false)
+	if err != nil {
+		t.Fatalf("UploadBetaBuildLocalizations() error: %v", err)
+	}
+	if len(results) != 2 || results[0].Action != "update" || results[1].Action != "create" {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+	if got := client.updateCalls["loc-en"]; got.WhatsNew != "Test the new onboarding" || got.Locale != "" {
+		t.Fatalf("unexpected update attributes: %+v", got)
+	}
+	if len(client.createCalls) != 1 || client.createCalls[0].Locale != "ja" {
+		t.Fatalf("unexpected create calls: %+v", client.createCalls)
+	}
+
+	_, err = UploadBetaBuildLocalizations(context.Background(), client, "build-1", map[string]map[string]string{
+		"en-US": {"description": "not a build field"},
+	}, true)
+	if err == nil || !strings.Contains(err.Error(), "unsupported keys") {
+		t.Fatalf("expected unsupported key error, got %v", err)
+	}
+}
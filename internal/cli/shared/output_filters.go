@@ -0,0 +1,237 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared/jsonquery"
+)
+
+var (
+	outputFields   string
+	outputQuery    string
+	outputTemplate string
+)
+
+// BindOutputFilterFlags registers --fields, --select, --query, and --template,
+// which narrow whatever PrintOutput prints. --select is the same filter as
+// --fields; commands whose own --fields selects API sparse fieldsets keep that
+// meaning, and --select is how output is filtered there.
+func BindOutputFilterFlags(fs *flag.FlagSet) {
+	if fs.Lookup("fields") == nil {
+		fs.StringVar(&outputFields, "fields", "", "Only output these comma-separated fields (attributes or table columns)")
+	}
+	if fs.Lookup("select") == nil {
+		fs.StringVar(&outputFields, "select", "", "Only output these comma-separated fields; same as --fields, for commands whose --fields selects API fields")
+	}
+	if fs.Lookup("query") == nil {
+		fs.StringVar(&outputQuery, "query", "", "Filter JSON output with a jq-style expression (e.g. '.data[].id')")
+	}
+	if fs.Lookup("template") == nil {
+		fs.StringVar(&outputTemplate, "template", "", "Render output with a Go template (e.g. '{{.Data.ID}}')")
+	}
+}
+
+// SetOutputFilters sets the output filter flags (tests only).
+func SetOutputFilters(fields, query, tmpl string) {
+	outputFields = fields
+	outputQuery = query
+	outputTemplate = tmpl
+}
+
+func outputFiltersSet() bool {
+	return strings.TrimSpace(outputFields) != "" || strings.TrimSpace(outputQuery) != "" || outputTemplate != ""
+}
+
+// printFilteredOutput applies --template, --query, and --fields (or --select). Templates and
+// queries produce their own text and take precedence over the output format.
+func printFilteredOutput(data any, format string, pretty bool) error {
+	fields := splitCSV(outputFields)
+	query := strings.TrimSpace(outputQuery)
+
+	if outputTemplate != "" {
+		if query != "" || len(fields) > 0 {
+			return fmt.Errorf("--template cannot be combined with --query or --fields")
+		}
+		return printTemplate(data, outputTemplate)
+	}
+
+	if query == "" {
+		switch strings.ToLower(format) {
+		case "table", "markdown", "md", "csv", "tsv":
+			if pretty {
+				return fmt.Errorf("--pretty is only valid with JSON output")
+			}
+			if asc.HasTableRows(data) {
+				return asc.PrintColumns(data, format, fields)
+			}
+		}
+	}
+
+	value, err := decodeJSONValue(data)
+	if err != nil {
+		return err
+	}
+	if len(fields) > 0 {
+		value = selectFields(value, fieldSet(fields))
+	}
+	if query == "" {
+		return printFormatted(value, format, pretty)
+	}
+
+	parsed, err := jsonquery.Parse(query)
+	if err != nil {
+		return fmt.Errorf("--query: %w", err)
+	}
+	results, err := parsed.Eval(value)
+	if err != nil {
+		return fmt.Errorf("--query: %w", err)
+	}
+	return printQueryResults(results, pretty)
+}
+
+// printQueryResults prints one result per line: strings raw (like jq -r),
+// everything else as JSON.
+func printQueryResults(results []any, pretty bool) error {
+	var buf bytes.Buffer
+	for _, result := range results {
+		if text, ok := result.(string); ok {
+			buf.WriteString(text)
+			buf.WriteByte('\n')
+			continue
+		}
+		var encoded []byte
+		var err error
+		if pretty {
+			encoded, err = json.MarshalIndent(result, "", "  ")
+		} else {
+			encoded, err = json.Marshal(result)
+		}
+		if err != nil {
+			return err
+		}
+		buf.Write(encoded)
+		buf.WriteByte('\n')
+	}
+	_, err := os.Stdout.Write(buf.Bytes())
+	return err
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(value any) (string, error) {
+		encoded, err := json.Marshal(value)
+		return string(encoded), err
+	},
+	"join": strings.Join,
+}
+
+// printTemplate executes a Go template against the typed response, so field
+// names follow the Go structs (e.g. {{.Data.Attributes.Version}}).
+func printTemplate(data any, text string) error {
+	tmpl, err := template.New("output").Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return fmt.Errorf("--template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("--template: %w", err)
+	}
+	if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+	_, err = os.Stdout.Write(buf.Bytes())
+	return err
+}
+
+// decodeJSONValue converts data to its generic JSON form, keeping numbers exact.
+func decodeJSONValue(data any) (any, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func fieldSet(fields []string) map[string]bool {
+	set := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		set[normalizeFieldName(field)] = true
+	}
+	return set
+}
+
+// normalizeFieldName matches the table column matching in asc.PrintColumns.
+func normalizeFieldName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// selectFields trims a JSON value to the wanted fields. For JSON:API documents
+// only data is kept, and each resource keeps its type, id, and the wanted
+// attributes.
+func selectFields(value any, wanted map[string]bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		if data, ok := v["data"]; ok {
+			return map[string]any{"data": selectFields(data, wanted)}
+		}
+		return selectObjectFields(v, wanted)
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			if object, ok := item.(map[string]any); ok {
+				out[i] = selectObjectFields(object, wanted)
+				continue
+			}
+			out[i] = item
+		}
+		return out
+	}
+	return value
+}
+
+func selectObjectFields(object map[string]any, wanted map[string]bool) map[string]any {
+	out := map[string]any{}
+	attributes, isResource := object["attributes"].(map[string]any)
+	for key, item := range object {
+		if wanted[normalizeFieldName(key)] {
+			out[key] = item
+		}
+	}
+	if !isResource {
+		return out
+	}
+	for _, key := range []string{"type", "id"} {
+		if item, ok := object[key]; ok {
+			out[key] = item
+		}
+	}
+	selected := map[string]any{}
+	for key, item := range attributes {
+		if wanted[normalizeFieldName(key)] {
+			selected[key] = item
+		}
+	}
+	if _, ok := out["attributes"]; !ok {
+		out["attributes"] = selected
+	}
+	return out
+}
@@ -0,0 +1,28 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// FetchAllPages fetches the first page with first, follows next links with
+// next, and returns every resource. name describes the resources in errors.
+func FetchAllPages[T any](ctx context.Context, name string, first func(context.Context) (*asc.Response[T], error), next func(context.Context, string) (*asc.Response[T], error)) ([]asc.Resource[T], error) {
+	firstPage, err := first(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", name, err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return next(ctx, nextURL)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", name, err)
+	}
+	resp, ok := paginated.(*asc.Response[T])
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s response type %T", name, paginated)
+	}
+	return resp.Data, nil
+}
@@ -0,0 +1,286 @@
+// Package pkcs12 encodes a private key and certificate as a password-protected
+// PKCS #12 (.p12) file.
+//
+// The encoding uses the legacy algorithms that macOS Keychain, Xcode, and
+// fastlane import reliably: the key is shrouded with
+// pbeWithSHAAnd3-KeyTripleDES-CBC, the certificate bag is stored unencrypted
+// (as with openssl's -certpbe NONE), and the file is integrity-protected with
+// an HMAC-SHA1 MAC.
+package pkcs12
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf16"
+)
+
+const iterations = 2048
+
+var (
+	oidData                  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidCertBag               = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidShroudedKeyBag        = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidCertTypeX509          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+	oidFriendlyName          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 20}
+	oidLocalKeyID            = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 21}
+	oidPBEWithSHAAnd3KeyTDES = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 3}
+	oidSHA1                  = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+)
+
+type pfx struct {
+	Version  int
+	AuthSafe contentInfo
+	MacData  macData
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+type macData struct {
+	Mac        digestInfo
+	MacSalt    []byte
+	Iterations int
+}
+
+type digestInfo struct {
+	Algorithm algorithmIdentifier
+	Digest    []byte
+}
+
+type algorithmIdentifier struct {
+	Algorithm  asn1.ObjectIdentifier
+	Parameters asn1.RawValue `asn1:"optional"`
+}
+
+type pbeParams struct {
+	Salt       []byte
+	Iterations int
+}
+
+type safeBag struct {
+	ID         asn1.ObjectIdentifier
+	Value      asn1.RawValue
+	Attributes []attribute `asn1:"set"`
+}
+
+type attribute struct {
+	ID    asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+type certBag struct {
+	ID   asn1.ObjectIdentifier
+	Data asn1.RawValue
+}
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm     algorithmIdentifier
+	EncryptedData []byte
+}
+
+// Encode returns a .p12 containing key (any type accepted by
+// x509.MarshalPKCS8PrivateKey) and its certificate, protected by password.
+// friendlyName is shown as the item name when the file is imported; it
+// defaults to the certificate subject common name.
+func Encode(random io.Reader, key any, cert *x509.Certificate, friendlyName, password string) ([]byte, error) {
+	if cert == nil {
+		return nil, errors.New("certificate is required")
+	}
+	if password == "" {
+		return nil, errors.New("password is required")
+	}
+	if friendlyName == "" {
+		friendlyName = cert.Subject.CommonName
+	}
+	encodedPassword, err := bmpString(password)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID := sha1.Sum(cert.Raw)
+	attributes, err := bagAttributes(keyID[:], friendlyName)
+	if err != nil {
+		return nil, err
+	}
+
+	certValue, err := asn1.Marshal(certBag{ID: oidCertTypeX509, Data: explicit(mustOctetString(cert.Raw))})
+	if err != nil {
+		return nil, err
+	}
+	certContents, err := asn1.Marshal([]safeBag{{ID: oidCertBag, Value: explicit(certValue), Attributes: attributes}})
+	if err != nil {
+		return nil, err
+	}
+
+	keyValue, err := shroudKey(random, key, encodedPassword)
+	if err != nil {
+		return nil, err
+	}
+	keyContents, err := asn1.Marshal([]safeBag{{ID: oidShroudedKeyBag, Value: explicit(keyValue), Attributes: attributes}})
+	if err != nil {
+		return nil, err
+	}
+
+	authenticatedSafe, err := asn1.Marshal([]contentInfo{dataContent(certContents), dataContent(keyContents)})
+	if err != nil {
+		return nil, err
+	}
+
+	macSalt := make([]byte, 8)
+	if _, err := io.ReadFull(random, macSalt); err != nil {
+		return nil, err
+	}
+	macKey := deriveKey(macSalt, encodedPassword, iterations, 3, sha1.Size)
+	mac := hmac.New(sha1.New, macKey)
+	mac.Write(authenticatedSafe)
+
+	return asn1.Marshal(pfx{
+		Version:  3,
+		AuthSafe: dataContent(authenticatedSafe),
+		MacData: macData{
+			Mac: digestInfo{
+				Algorithm: algorithmIdentifier{Algorithm: oidSHA1, Parameters: asn1.NullRawValue},
+				Digest:    mac.Sum(nil),
+			},
+			MacSalt:    macSalt,
+			Iterations: iterations,
+		},
+	})
+}
+
+func shroudKey(random io.Reader, key any, password []byte) ([]byte, error) {
+	plaintext, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("marshal private key: %w", err)
+	}
+	salt := make([]byte, 8)
+	if _, err := io.ReadFull(random, salt); err != nil {
+		return nil, err
+	}
+	params, err := asn1.Marshal(pbeParams{Salt: salt, Iterations: iterations})
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := des.NewTripleDESCipher(deriveKey(salt, password, iterations, 1, 24))
+	if err != nil {
+		return nil, err
+	}
+	iv := deriveKey(salt, password, iterations, 2, block.BlockSize())
+	padding := block.BlockSize() - len(plaintext)%block.BlockSize()
+	ciphertext := append(plaintext, bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, ciphertext)
+
+	return asn1.Marshal(encryptedPrivateKeyInfo{
+		Algorithm:     algorithmIdentifier{Algorithm: oidPBEWithSHAAnd3KeyTDES, Parameters: asn1.RawValue{FullBytes: params}},
+		EncryptedData: ciphertext,
+	})
+}
+
+func bagAttributes(keyID []byte, friendlyName string) ([]attribute, error) {
+	localKeyID, err := asn1.Marshal(keyID)
+	if err != nil {
+		return nil, err
+	}
+	attributes := []attribute{{ID: oidLocalKeyID, Value: asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: localKeyID}}}
+	if friendlyName != "" {
+		name, err := bmpString(friendlyName)
+		if err != nil {
+			return nil, err
+		}
+		// Attribute values are BMPStrings without the trailing null.
+		encoded, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagBMPString, Bytes: name[:len(name)-2]})
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, attribute{ID: oidFriendlyName, Value: asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: encoded}})
+	}
+	return attributes, nil
+}
+
+func dataContent(content []byte) contentInfo {
+	return contentInfo{ContentType: oidData, Content: explicit(mustOctetString(content))}
+}
+
+// explicit wraps DER bytes in a [0] EXPLICIT context-specific tag.
+func explicit(der []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: der}
+}
+
+func mustOctetString(data []byte) []byte {
+	encoded, err := asn1.Marshal(data)
+	if err != nil {
+		panic(err)
+	}
+	return encoded
+}
+
+// bmpString encodes s as big-endian UTF-16 with a trailing null, the password
+// format PKCS #12 key derivation expects.
+func bmpString(s string) ([]byte, error) {
+	encoded := make([]byte, 0, 2*len(s)+2)
+	for _, r := range s {
+		if r > 0xFFFF || utf16.IsSurrogate(r) {
+			return nil, fmt.Errorf("character %q cannot be encoded in a PKCS #12 password or name", r)
+		}
+		encoded = append(encoded, byte(r>>8), byte(r))
+	}
+	return append(encoded, 0, 0), nil
+}
+
+// deriveKey implements the PKCS #12 key derivation function (RFC 7292,
+// appendix B.2) with SHA-1. id is 1 for keys, 2 for IVs, and 3 for MAC keys.
+func deriveKey(salt, password []byte, iterations int, id byte, size int) []byte {
+	const u, v = sha1.Size, 64
+
+	fill := func(data []byte) []byte {
+		if len(data) == 0 {
+			return nil
+		}
+		out := make([]byte, v*((len(data)+v-1)/v))
+		for i := range out {
+			out[i] = data[i%len(data)]
+		}
+		return out
+	}
+	input := append(fill(salt), fill(password)...)
+	diversifier := bytes.Repeat([]byte{id}, v)
+
+	var out []byte
+	for len(out) < size {
+		hash := sha1.New()
+		hash.Write(diversifier)
+		hash.Write(input)
+		a := hash.Sum(nil)
+		for i := 1; i < iterations; i++ {
+			sum := sha1.Sum(a)
+			a = sum[:]
+		}
+		out = append(out, a...)
+
+		// I_j = (I_j + B + 1) mod 2^(v*8) for each v-byte block of I.
+		b := make([]byte, v)
+		for i := range b {
+			b[i] = a[i%u]
+		}
+		for j := 0; j < len(input); j += v {
+			carry := 1
+			for k := v - 1; k >= 0; k-- {
+				sum := int(input[j+k]) + int(b[k]) + carry
+				input[j+k] = byte(sum)
+				carry = sum >> 8
+			}
+		}
+	}
+	return out[:size]
+}
@@ -0,0 +1,183 @@
+package pkcs12
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testCertificate(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Apple Distribution: Example"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return key, cert
+}
+
+// contentOctets unwraps a data ContentInfo to its octet string payload.
+func contentOctets(t *testing.T, info contentInfo) []byte {
+	t.Helper()
+	if !info.ContentType.Equal(oidData) {
+		t.Fatalf("unexpected content type %v", info.ContentType)
+	}
+	var octets []byte
+	if _, err := asn1.Unmarshal(info.Content.Bytes, &octets); err != nil {
+		t.Fatalf("unmarshal content: %v", err)
+	}
+	return octets
+}
+
+// TestDeriveKeyKnownAnswers checks the RFC 7292 appendix B.2 derivation
+// against vectors computed independently of deriveKey: the 3DES key and IV
+// vectors are the ones used by golang.org/x/crypto/pkcs12, and the MAC key
+// verifies the MAC of a file written by "openssl pkcs12 -export -macalg sha1".
+func TestDeriveKeyKnownAnswers(t *testing.T) {
+	sesame, _ := bmpString("sesame")
+	tests := []struct {
+		name       string
+		salt       string
+		iterations int
+		id         byte
+		size       int
+		want       string
+	}{
+		{name: "3des key", salt: "ffffffffffffffff", iterations: 2048, id: 1, size: 24, want: "7cd9fd3e2b3be7691a44e3bef0f9ea0fb9b897d4e325d9d1"},
+		{name: "3des iv", salt: "ffffffffffffffff", iterations: 2048, id: 2, size: 8, want: "3f5a277f9c21ff82"},
+		{name: "mac key", salt: "d1aa486f7b4fb5fc", iterations: 2048, id: 3, size: 20, want: "aefa1ab89a863f0d4da4b5926b143839afae7472"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			salt, _ := hex.DecodeString(test.salt)
+			got := hex.EncodeToString(deriveKey(salt, sesame, test.iterations, test.id, test.size))
+			if got != test.want {
+				t.Fatalf("deriveKey() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	key, cert := testCertificate(t)
+	const password = "s3cret-päss"
+
+	data, err := Encode(rand.Reader, key, cert, "", password)
+	if err != nil {
+		t.Fatalf("Encode() error: %v", err)
+	}
+
+	var file pfx
+	if rest, err := asn1.Unmarshal(data, &file); err != nil || len(rest) != 0 {
+		t.Fatalf("unmarshal pfx: %v (rest %d)", err, len(rest))
+	}
+	if file.Version != 3 {
+		t.Fatalf("version = %d, want 3", file.Version)
+	}
+	authSafe := contentOctets(t, file.AuthSafe)
+
+	encodedPassword, _ := bmpString(password)
+	mac := hmac.New(sha1.New, deriveKey(file.MacData.MacSalt, encodedPassword, file.MacData.Iterations, 3, sha1.Size))
+	mac.Write(authSafe)
+	if !hmac.Equal(mac.Sum(nil), file.MacData.Mac.Digest) {
+		t.Fatalf("MAC does not verify")
+	}
+
+	var contents []contentInfo
+	if _, err := asn1.Unmarshal(authSafe, &contents); err != nil || len(contents) != 2 {
+		t.Fatalf("unmarshal authenticated safe: %v (%d contents)", err, len(contents))
+	}
+
+	var certBags []safeBag
+	if _, err := asn1.Unmarshal(contentOctets(t, contents[0]), &certBags); err != nil {
+		t.Fatalf("unmarshal cert bags: %v", err)
+	}
+	var bag certBag
+	if _, err := asn1.Unmarshal(certBags[0].Value.Bytes, &bag); err != nil {
+		t.Fatalf("unmarshal cert bag: %v", err)
+	}
+	var certDER []byte
+	if _, err := asn1.Unmarshal(bag.Data.Bytes, &certDER); err != nil || !bytes.Equal(certDER, cert.Raw) {
+		t.Fatalf("certificate bag does not hold the certificate: %v", err)
+	}
+	if !bytes.Contains(certBags[0].Attributes[1].Value.Bytes, []byte{0, 'A', 0, 'p', 0, 'p'}) {
+		t.Fatalf("expected friendly name from the certificate common name")
+	}
+
+	var keyBags []safeBag
+	if _, err := asn1.Unmarshal(contentOctets(t, contents[1]), &keyBags); err != nil {
+		t.Fatalf("unmarshal key bags: %v", err)
+	}
+	var shrouded encryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(keyBags[0].Value.Bytes, &shrouded); err != nil {
+		t.Fatalf("unmarshal shrouded key: %v", err)
+	}
+	var params pbeParams
+	if _, err := asn1.Unmarshal(shrouded.Algorithm.Parameters.FullBytes, &params); err != nil {
+		t.Fatalf("unmarshal pbe params: %v", err)
+	}
+	block, err := des.NewTripleDESCipher(deriveKey(params.Salt, encodedPassword, params.Iterations, 1, 24))
+	if err != nil {
+		t.Fatalf("cipher: %v", err)
+	}
+	plaintext := make([]byte, len(shrouded.EncryptedData))
+	cipher.NewCBCDecrypter(block, deriveKey(params.Salt, encodedPassword, params.Iterations, 2, 8)).CryptBlocks(plaintext, shrouded.EncryptedData)
+	plaintext = plaintext[:len(plaintext)-int(plaintext[len(plaintext)-1])]
+	decoded, err := x509.ParsePKCS8PrivateKey(plaintext)
+	if err != nil {
+		t.Fatalf("parse decrypted key: %v", err)
+	}
+	if !key.Equal(decoded) {
+		t.Fatalf("decrypted key does not match")
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	key, cert := testCertificate(t)
+
+	tests := []struct {
+		name     string
+		cert     *x509.Certificate
+		password string
+		wantErr  string
+	}{
+		{name: "missing certificate", password: "pw", wantErr: "certificate is required"},
+		{name: "missing password", cert: cert, wantErr: "password is required"},
+		{name: "unencodable password", cert: cert, password: "pw\U0001F600", wantErr: "cannot be encoded"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Encode(rand.Reader, key, test.cert, "", test.password)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package shared
+
+// Step statuses reported by plan-and-apply commands such as metadata apply,
+// testflight sync push, and iap storekit import.
+const (
+	StepStatusPlanned = "planned"
+	StepStatusApplied = "applied"
+	StepStatusFailed  = "failed"
+	StepStatusSkipped = "skipped"
+)
+
+// PlannedResource pairs a resource from a config file with its live ID, which
+// is empty until a planned create is applied.
+type PlannedResource[T any] struct {
+	Config T
+	ID     string
+}
+
+// ApplySteps runs count steps in order, calling apply for each and mark with
+// its outcome. Later steps can depend on resources created by earlier ones, so
+// it stops at the first failure, marks the remaining steps skipped, and
+// returns the failed step's index and error. The index is -1 when every step
+// was applied.
+func ApplySteps(count int, apply func(i int) error, mark func(i int, status string, err error)) (int, error) {
+	for i := 0; i < count; i++ {
+		if err := apply(i); err != nil {
+			mark(i, StepStatusFailed, err)
+			for j := i + 1; j < count; j++ {
+				mark(j, StepStatusSkipped, nil)
+			}
+			return i, err
+		}
+		mark(i, StepStatusApplied, nil)
+	}
+	return -1, nil
+}
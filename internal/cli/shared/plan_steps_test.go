@@ -0,0 +1,54 @@
+package shared
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestApplyStepsStopsAtFirstFailure(t *testing.T) {
+	boom := errors.New("boom")
+	var ran []int
+	statuses := make([]string, 4)
+	errs := make([]error, 4)
+
+	failed, err := ApplySteps(len(statuses), func(i int) error {
+		ran = append(ran, i)
+		if i == 1 {
+			return boom
+		}
+		return nil
+	}, func(i int, status string, err error) {
+		statuses[i] = status
+		errs[i] = err
+	})
+
+	if failed != 1 || !errors.Is(err, boom) {
+		t.Fatalf("ApplySteps() = %d, %v; want 1, boom", failed, err)
+	}
+	if !slices.Equal(ran, []int{0, 1}) {
+		t.Fatalf("expected steps after the failure not to run, ran %v", ran)
+	}
+	want := []string{StepStatusApplied, StepStatusFailed, StepStatusSkipped, StepStatusSkipped}
+	if !slices.Equal(statuses, want) {
+		t.Fatalf("statuses = %v, want %v", statuses, want)
+	}
+	if errs[0] != nil || !errors.Is(errs[1], boom) || errs[2] != nil || errs[3] != nil {
+		t.Fatalf("expected only the failed step to carry the error, got %v", errs)
+	}
+}
+
+func TestApplyStepsAllApplied(t *testing.T) {
+	statuses := make([]string, 3)
+	failed, err := ApplySteps(len(statuses), func(int) error { return nil }, func(i int, status string, _ error) {
+		statuses[i] = status
+	})
+	if failed != -1 || err != nil {
+		t.Fatalf("ApplySteps() = %d, %v; want -1, nil", failed, err)
+	}
+	for i, status := range statuses {
+		if status != StepStatusApplied {
+			t.Fatalf("step %d status = %q, want applied", i, status)
+		}
+	}
+}
@@ -0,0 +1,380 @@
+package shared
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"howett.net/plist"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// Provisioning profile types derived from the profile contents.
+const (
+	ProfileTypeDevelopment = "development"
+	ProfileTypeAdHoc       = "ad-hoc"
+	ProfileTypeAppStore    = "app-store"
+	ProfileTypeEnterprise  = "enterprise"
+)
+
+// ProvisioningProfile is the property list embedded in a .mobileprovision file.
+type ProvisioningProfile struct {
+	AppIDName                   string         `plist:"AppIDName"`
+	ApplicationIdentifierPrefix []string       `plist:"ApplicationIdentifierPrefix"`
+	CreationDate                time.Time      `plist:"CreationDate"`
+	Platform                    []string       `plist:"Platform"`
+	DeveloperCertificates       [][]byte       `plist:"DeveloperCertificates"`
+	Entitlements                map[string]any `plist:"Entitlements"`
+	ExpirationDate              time.Time      `plist:"ExpirationDate"`
+	Name                        string         `plist:"Name"`
+	ProvisionedDevices          []string       `plist:"ProvisionedDevices"`
+	ProvisionsAllDevices        bool           `plist:"ProvisionsAllDevices"`
+	TeamIdentifier              []string       `plist:"TeamIdentifier"`
+	TeamName                    string         `plist:"TeamName"`
+	UUID                        string         `plist:"UUID"`
+}
+
+// ParseProvisioningProfile decodes a .mobileprovision file. The file is a CMS
+// signed message wrapping a property list; the signature is not verified.
+func ParseProvisioningProfile(data []byte) (*ProvisioningProfile, error) {
+	content, err := provisioningProfilePlist(data)
+	if err != nil {
+		return nil, err
+	}
+	var profile ProvisioningProfile
+	if _, err := plist.Unmarshal(content, &profile); err != nil {
+		return nil, fmt.Errorf("decode provisioning profile plist: %w", err)
+	}
+	if profile.UUID == "" && profile.Name == "" {
+		return nil, errors.New("not a provisioning profile: missing UUID and Name")
+	}
+	return &profile, nil
+}
+
+// Type reports whether the profile is for development, ad hoc, App Store, or
+// enterprise distribution.
+func (p *ProvisioningProfile) Type() string {
+	if getTaskAllow, _ := p.Entitlements["get-task-allow"].(bool); getTaskAllow {
+		return ProfileTypeDevelopment
+	}
+	if p.ProvisionsAllDevices {
+		return ProfileTypeEnterprise
+	}
+	if len(p.ProvisionedDevices) > 0 {
+		return ProfileTypeAdHoc
+	}
+	return ProfileTypeAppStore
+}
+
+// ApplicationIdentifier returns the application-identifier entitlement.
+func (p *ProvisioningProfile) ApplicationIdentifier() string {
+	for _, key := range []string{"application-identifier", "com.apple.application-identifier"} {
+		if value, ok := p.Entitlements[key].(string); ok {
+			return value
+		}
+	}
+	return ""
+}
+
+// BundleID returns the application identifier without its team prefix.
+func (p *ProvisioningProfile) BundleID() string {
+	appID := p.ApplicationIdentifier()
+	for _, prefix := range p.ApplicationIdentifierPrefix {
+		if trimmed, ok := strings.CutPrefix(appID, prefix+"."); ok {
+			return trimmed
+		}
+	}
+	if _, after, ok := strings.Cut(appID, "."); ok {
+		return after
+	}
+	return appID
+}
+
+// Certificates parses the developer certificates embedded in the profile.
+func (p *ProvisioningProfile) Certificates() ([]*x509.Certificate, error) {
+	certs := make([]*x509.Certificate, 0, len(p.DeveloperCertificates))
+	for i, der := range p.DeveloperCertificates {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("parse developer certificate %d: %w", i+1, err)
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// InspectProvisioningProfile summarizes a profile as of now. Certificates are
+// left unchecked; see CrossCheckProfileCertificates.
+func InspectProvisioningProfile(profile *ProvisioningProfile, source string, now time.Time) (*asc.ProfileInspectResult, error) {
+	certs, err := profile.Certificates()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &asc.ProfileInspectResult{
+		Source:                source,
+		Name:                  profile.Name,
+		UUID:                  profile.UUID,
+		ProfileType:           profile.Type(),
+		TeamName:              profile.TeamName,
+		AppIDName:             profile.AppIDName,
+		ApplicationIdentifier: profile.ApplicationIdentifier(),
+		BundleID:              profile.BundleID(),
+		Platforms:             profile.Platform,
+		ExpirationDate:        formatProfileTime(profile.ExpirationDate),
+		CreationDate:          formatProfileTime(profile.CreationDate),
+		Expired:               !profile.ExpirationDate.IsZero() && !profile.ExpirationDate.After(now),
+		DaysUntilExpiration:   int(math.Floor(profile.ExpirationDate.Sub(now).Hours() / 24)),
+		ProvisionsAllDevices:  profile.ProvisionsAllDevices,
+		ProvisionedDevices:    profile.ProvisionedDevices,
+		Entitlements:          profile.Entitlements,
+		Certificates:          make([]asc.ProfileCertificateInfo, 0, len(certs)),
+	}
+	if len(profile.TeamIdentifier) > 0 {
+		result.TeamID = profile.TeamIdentifier[0]
+	}
+	if len(profile.ApplicationIdentifierPrefix) > 0 {
+		result.AppIDPrefix = profile.ApplicationIdentifierPrefix[0]
+	}
+	if result.Expired {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("profile expired on %s", result.ExpirationDate))
+	}
+
+	for _, cert := range certs {
+		info := asc.ProfileCertificateInfo{
+			CommonName:     cert.Subject.CommonName,
+			SerialNumber:   strings.ToUpper(cert.SerialNumber.Text(16)),
+			ExpirationDate: formatProfileTime(cert.NotAfter),
+			Expired:        !cert.NotAfter.After(now),
+			SHA1:           CertificateSHA1(cert.Raw),
+			SHA256:         CertificateSHA256(cert.Raw),
+		}
+		if info.Expired {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("certificate %q expired on %s", info.CommonName, info.ExpirationDate))
+		}
+		result.Certificates = append(result.Certificates, info)
+	}
+	return result, nil
+}
+
+// CrossCheckProfileCertificates records which embedded certificates are still
+// listed in App Store Connect, matching by SHA-1 fingerprint and falling back
+// to the serial number when certificate content is not returned.
+func CrossCheckProfileCertificates(result *asc.ProfileInspectResult, certificates []asc.Resource[asc.CertificateAttributes]) {
+	byFingerprint := make(map[string]string, len(certificates))
+	bySerial := make(map[string]string, len(certificates))
+	for _, item := range certificates {
+		if serial := normalizeSerial(item.Attributes.SerialNumber); serial != "" {
+			bySerial[serial] = item.ID
+		}
+		content := strings.Join(strings.Fields(item.Attributes.CertificateContent), "")
+		if content == "" {
+			continue
+		}
+		der, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			continue
+		}
+		byFingerprint[CertificateSHA1(der)] = item.ID
+	}
+
+	result.CertificatesChecked = true
+	for i := range result.Certificates {
+		cert := &result.Certificates[i]
+		id, ok := byFingerprint[cert.SHA1]
+		if !ok {
+			id, ok = bySerial[normalizeSerial(cert.SerialNumber)]
+		}
+		found := ok
+		cert.InAppStoreConnect = &found
+		if ok {
+			cert.CertificateID = id
+			continue
+		}
+		result.Warnings = append(result.Warnings, fmt.Sprintf("certificate %q (%s) is not listed in App Store Connect; it may have been revoked", cert.CommonName, cert.SerialNumber))
+	}
+}
+
+// CertificateSHA1 returns the colon-separated uppercase SHA-1 fingerprint of
+// a DER-encoded certificate.
+func CertificateSHA1(der []byte) string {
+	sum := sha1.Sum(der)
+	return formatFingerprint(sum[:])
+}
+
+// CertificateSHA256 returns the colon-separated uppercase SHA-256 fingerprint
+// of a DER-encoded certificate.
+func CertificateSHA256(der []byte) string {
+	sum := sha256.Sum256(der)
+	return formatFingerprint(sum[:])
+}
+
+func formatFingerprint(sum []byte) string {
+	encoded := strings.ToUpper(hex.EncodeToString(sum))
+	parts := make([]string, 0, len(sum))
+	for i := 0; i < len(encoded); i += 2 {
+		parts = append(parts, encoded[i:i+2])
+	}
+	return strings.Join(parts, ":")
+}
+
+func normalizeSerial(serial string) string {
+	serial = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(serial), ":", ""))
+	return strings.TrimLeft(serial, "0")
+}
+
+func formatProfileTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// provisioningProfilePlist extracts the signed content from a CMS message.
+// Apple signs profiles with indefinite-length BER, which encoding/asn1 does
+// not accept, so the structure is walked by hand. Unsigned plists are passed
+// through as-is.
+func provisioningProfilePlist(data []byte) ([]byte, error) {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.HasPrefix(trimmed, []byte("<?xml")) || bytes.HasPrefix(trimmed, []byte("bplist")) {
+		return trimmed, nil
+	}
+
+	root, _, err := parseBER(data)
+	if err != nil {
+		return nil, fmt.Errorf("not a provisioning profile: %w", err)
+	}
+	// ContentInfo { contentType, [0] SignedData { version, digestAlgorithms,
+	// encapContentInfo { eContentType, [0] eContent } } }
+	signedData, err := root.child(1)
+	if err == nil {
+		signedData, err = signedData.child(0)
+	}
+	var encap *berNode
+	if err == nil {
+		encap, err = signedData.child(2)
+	}
+	var eContent *berNode
+	if err == nil {
+		eContent, err = encap.child(1)
+	}
+	if err == nil {
+		eContent, err = eContent.child(0)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("not a provisioning profile: %w", err)
+	}
+	return eContent.octets(), nil
+}
+
+type berNode struct {
+	tag         int
+	constructed bool
+	content     []byte
+	children    []*berNode
+}
+
+func (n *berNode) child(index int) (*berNode, error) {
+	if !n.constructed || index >= len(n.children) {
+		return nil, errors.New("unexpected CMS structure")
+	}
+	return n.children[index], nil
+}
+
+// octets concatenates the content of a primitive or constructed OCTET STRING.
+func (n *berNode) octets() []byte {
+	if !n.constructed {
+		return n.content
+	}
+	var out []byte
+	for _, child := range n.children {
+		out = append(out, child.octets()...)
+	}
+	return out
+}
+
+// parseBER decodes one BER element from data and returns it with the number
+// of bytes consumed.
+func parseBER(data []byte) (*berNode, int, error) {
+	if len(data) < 2 {
+		return nil, 0, errors.New("truncated BER element")
+	}
+	node := &berNode{tag: int(data[0] & 0x1f), constructed: data[0]&0x20 != 0}
+	offset := 1
+	if node.tag == 0x1f {
+		node.tag = 0
+		for {
+			if offset >= len(data) {
+				return nil, 0, errors.New("truncated BER tag")
+			}
+			b := data[offset]
+			offset++
+			node.tag = node.tag<<7 | int(b&0x7f)
+			if b&0x80 == 0 {
+				break
+			}
+		}
+	}
+	if offset >= len(data) {
+		return nil, 0, errors.New("truncated BER length")
+	}
+
+	lengthByte := data[offset]
+	offset++
+	if lengthByte == 0x80 {
+		if !node.constructed {
+			return nil, 0, errors.New("indefinite length on primitive BER element")
+		}
+		for {
+			if offset+2 > len(data) {
+				return nil, 0, errors.New("missing BER end-of-contents")
+			}
+			if data[offset] == 0 && data[offset+1] == 0 {
+				return node, offset + 2, nil
+			}
+			child, n, err := parseBER(data[offset:])
+			if err != nil {
+				return nil, 0, err
+			}
+			node.children = append(node.children, child)
+			offset += n
+		}
+	}
+
+	length := int(lengthByte)
+	if lengthByte&0x80 != 0 {
+		count := int(lengthByte & 0x7f)
+		if count > 4 || offset+count > len(data) {
+			return nil, 0, errors.New("invalid BER length")
+		}
+		length = 0
+		for _, b := range data[offset : offset+count] {
+			length = length<<8 | int(b)
+		}
+		offset += count
+	}
+	if length < 0 || offset+length > len(data) {
+		return nil, 0, errors.New("BER element exceeds input")
+	}
+	node.content = data[offset : offset+length]
+	if node.constructed {
+		for pos := 0; pos < length; {
+			child, n, err := parseBER(node.content[pos:])
+			if err != nil {
+				return nil, 0, err
+			}
+			node.children = append(node.children, child)
+			pos += n
+		}
+	}
+	return node, offset + length, nil
+}
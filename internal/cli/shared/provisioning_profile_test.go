@@ -0,0 +1,251 @@
+package shared
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"howett.net/plist"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestParseProvisioningProfile(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	cert := newTestCertificate(t, "Apple Development: Jane Doe (ABC123)", 0x1A2B, now.Add(365*24*time.Hour))
+	data := buildTestProvisioningProfile(t, map[string]any{
+		"Name":                        "Demo Development",
+		"UUID":                        "11111111-2222-3333-4444-555555555555",
+		"TeamIdentifier":              []string{"TEAM123456"},
+		"TeamName":                    "Demo Team",
+		"AppIDName":                   "Demo",
+		"ApplicationIdentifierPrefix": []string{"TEAM123456"},
+		"Platform":                    []string{"iOS"},
+		"CreationDate":                now.Add(-24 * time.Hour),
+		"ExpirationDate":              now.Add(10*24*time.Hour + time.Hour),
+		"ProvisionedDevices":          []string{"00008030-AAAA", "00008030-BBBB"},
+		"DeveloperCertificates":       [][]byte{cert.Raw},
+		"Entitlements": map[string]any{
+			"application-identifier":                 "TEAM123456.com.example.demo",
+			"get-task-allow":                         true,
+			"com.apple.developer.team-identifier":    "TEAM123456",
+			"keychain-access-groups":                 []string{"TEAM123456.*"},
+			"com.apple.developer.associated-domains": "*",
+		},
+	})
+
+	profile, err := ParseProvisioningProfile(data)
+	if err != nil {
+		t.Fatalf("ParseProvisioningProfile() error: %v", err)
+	}
+	if profile.Type() != ProfileTypeDevelopment {
+		t.Fatalf("expected development profile, got %q", profile.Type())
+	}
+	if profile.BundleID() != "com.example.demo" {
+		t.Fatalf("expected bundle ID com.example.demo, got %q", profile.BundleID())
+	}
+
+	result, err := InspectProvisioningProfile(profile, "demo.mobileprovision", now)
+	if err != nil {
+		t.Fatalf("InspectProvisioningProfile() error: %v", err)
+	}
+	if result.TeamID != "TEAM123456" || result.AppIDPrefix != "TEAM123456" {
+		t.Fatalf("unexpected team fields: %+v", result)
+	}
+	if result.Expired || result.DaysUntilExpiration != 10 {
+		t.Fatalf("expected 10 days until expiration, got expired=%t days=%d", result.Expired, result.DaysUntilExpiration)
+	}
+	if len(result.ProvisionedDevices) != 2 {
+		t.Fatalf("expected 2 devices, got %v", result.ProvisionedDevices)
+	}
+	if len(result.Certificates) != 1 {
+		t.Fatalf("expected 1 certificate, got %d", len(result.Certificates))
+	}
+	info := result.Certificates[0]
+	if info.SerialNumber != "1A2B" {
+		t.Fatalf("expected serial 1A2B, got %q", info.SerialNumber)
+	}
+	if info.SHA1 != CertificateSHA1(cert.Raw) || len(info.SHA256) != 95 {
+		t.Fatalf("unexpected fingerprints: %+v", info)
+	}
+	if info.InAppStoreConnect != nil || len(result.Warnings) != 0 {
+		t.Fatalf("expected unchecked certificate without warnings, got %+v %v", info, result.Warnings)
+	}
+}
+
+func TestParseProvisioningProfile_ProfileTypes(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile map[string]any
+		want    string
+	}{
+		{
+			name:    "app store",
+			profile: map[string]any{"Name": "Store"},
+			want:    ProfileTypeAppStore,
+		},
+		{
+			name:    "ad hoc",
+			profile: map[string]any{"Name": "AdHoc", "ProvisionedDevices": []string{"UDID"}},
+			want:    ProfileTypeAdHoc,
+		},
+		{
+			name:    "enterprise",
+			profile: map[string]any{"Name": "InHouse", "ProvisionsAllDevices": true},
+			want:    ProfileTypeEnterprise,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			profile, err := ParseProvisioningProfile(buildTestProvisioningProfile(t, test.profile))
+			if err != nil {
+				t.Fatalf("ParseProvisioningProfile() error: %v", err)
+			}
+			if got := profile.Type(); got != test.want {
+				t.Fatalf("expected %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseProvisioningProfile_Invalid(t *testing.T) {
+	if _, err := ParseProvisioningProfile([]byte("not a profile")); err == nil || !strings.Contains(err.Error(), "not a provisioning profile") {
+		t.Fatalf("expected not a provisioning profile error, got %v", err)
+	}
+}
+
+func TestInspectProvisioningProfile_Expired(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	cert := newTestCertificate(t, "Apple Distribution: Demo", 7, now.Add(-time.Hour))
+	profile, err := ParseProvisioningProfile(buildTestProvisioningProfile(t, map[string]any{
+		"Name":                  "Old",
+		"ExpirationDate":        now.Add(-48 * time.Hour),
+		"DeveloperCertificates": [][]byte{cert.Raw},
+	}))
+	if err != nil {
+		t.Fatalf("ParseProvisioningProfile() error: %v", err)
+	}
+	result, err := InspectProvisioningProfile(profile, "old.mobileprovision", now)
+	if err != nil {
+		t.Fatalf("InspectProvisioningProfile() error: %v", err)
+	}
+	if !result.Expired || !result.Certificates[0].Expired {
+		t.Fatalf("expected profile and certificate to be expired: %+v", result)
+	}
+	if len(result.Warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %v", result.Warnings)
+	}
+}
+
+func TestCrossCheckProfileCertificates(t *testing.T) {
+	now := time.Now()
+	listed := newTestCertificate(t, "Listed", 10, now.Add(time.Hour))
+	bySerial := newTestCertificate(t, "Serial Only", 11, now.Add(time.Hour))
+	revoked := newTestCertificate(t, "Revoked", 12, now.Add(time.Hour))
+	profile, err := ParseProvisioningProfile(buildTestProvisioningProfile(t, map[string]any{
+		"Name":                  "Check",
+		"ExpirationDate":        now.Add(time.Hour),
+		"DeveloperCertificates": [][]byte{listed.Raw, bySerial.Raw, revoked.Raw},
+	}))
+	if err != nil {
+		t.Fatalf("ParseProvisioningProfile() error: %v", err)
+	}
+	result, err := InspectProvisioningProfile(profile, "check.mobileprovision", now)
+	if err != nil {
+		t.Fatalf("InspectProvisioningProfile() error: %v", err)
+	}
+
+	CrossCheckProfileCertificates(result, []asc.Resource[asc.CertificateAttributes]{
+		{ID: "CERT_1", Attributes: asc.CertificateAttributes{CertificateContent: base64.StdEncoding.EncodeToString(listed.Raw)}},
+		{ID: "CERT_2", Attributes: asc.CertificateAttributes{SerialNumber: "0B"}},
+	})
+
+	if !result.CertificatesChecked {
+		t.Fatal("expected certificates to be checked")
+	}
+	wantIDs := []string{"CERT_1", "CERT_2", ""}
+	for i, cert := range result.Certificates {
+		if cert.CertificateID != wantIDs[i] {
+			t.Fatalf("certificate %d: expected ID %q, got %q", i, wantIDs[i], cert.CertificateID)
+		}
+		if cert.InAppStoreConnect == nil || *cert.InAppStoreConnect != (wantIDs[i] != "") {
+			t.Fatalf("certificate %d: unexpected InAppStoreConnect %v", i, cert.InAppStoreConnect)
+		}
+	}
+	if len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], "Revoked") {
+		t.Fatalf("expected revoked certificate warning, got %v", result.Warnings)
+	}
+}
+
+func newTestCertificate(t *testing.T, commonName string, serial int64, notAfter time.Time) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.Add(-2 * 365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return cert
+}
+
+// buildTestProvisioningProfile wraps a plist in an unsigned CMS SignedData
+// message using indefinite lengths and a chunked OCTET STRING, as Apple does.
+func buildTestProvisioningProfile(t *testing.T, fields map[string]any) []byte {
+	t.Helper()
+	content, err := plist.Marshal(fields, plist.XMLFormat)
+	if err != nil {
+		t.Fatalf("marshal plist: %v", err)
+	}
+	mustMarshal := func(value any) []byte {
+		encoded, err := asn1.Marshal(value)
+		if err != nil {
+			t.Fatalf("marshal ASN.1: %v", err)
+		}
+		return encoded
+	}
+	indefinite := func(tag byte, children ...[]byte) []byte {
+		out := []byte{tag, 0x80}
+		for _, child := range children {
+			out = append(out, child...)
+		}
+		return append(out, 0, 0)
+	}
+
+	half := len(content) / 2
+	eContent := indefinite(0x24, mustMarshal(content[:half]), mustMarshal(content[half:]))
+	encapContentInfo := indefinite(0x30,
+		mustMarshal(asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}),
+		indefinite(0xa0, eContent),
+	)
+	signedData := indefinite(0x30,
+		mustMarshal(1),
+		[]byte{0x31, 0x00},
+		encapContentInfo,
+		[]byte{0x31, 0x00},
+	)
+	return indefinite(0x30,
+		mustMarshal(asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}),
+		indefinite(0xa0, signedData),
+	)
+}
@@ -0,0 +1,335 @@
+package shared
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+// DefaultReportBackfillConcurrency is the default number of parallel report downloads.
+const DefaultReportBackfillConcurrency = 4
+
+// ReportArchiveManifestName is the manifest file kept in a report archive directory.
+const ReportArchiveManifestName = "manifest.json"
+
+// ReportArchiveManifest records which report periods have been fetched into an
+// archive directory, and which were not yet available for a later retry.
+type ReportArchiveManifest struct {
+	UpdatedAt time.Time            `json:"updatedAt"`
+	Reports   []ReportArchiveEntry `json:"reports"`
+}
+
+// ReportArchiveEntry describes one archived (or pending) report period.
+type ReportArchiveEntry struct {
+	Kind          string    `json:"kind"`
+	VendorNumber  string    `json:"vendorNumber"`
+	ReportType    string    `json:"reportType"`
+	ReportSubType string    `json:"reportSubType,omitempty"`
+	Frequency     string    `json:"frequency,omitempty"`
+	Version       string    `json:"version,omitempty"`
+	RegionCode    string    `json:"regionCode,omitempty"`
+	Period        string    `json:"period"`
+	Status        string    `json:"status"`
+	Path          string    `json:"path,omitempty"`
+	Size          int64     `json:"size,omitempty"`
+	SHA256        string    `json:"sha256,omitempty"`
+	FetchedAt     time.Time `json:"fetchedAt,omitzero"`
+	LastAttemptAt time.Time `json:"lastAttemptAt,omitzero"`
+	Attempts      int       `json:"attempts"`
+	Error         string    `json:"error,omitempty"`
+}
+
+// ReportBackfillOptions configures a date-range report backfill.
+type ReportBackfillOptions struct {
+	ArchiveDir string
+	// Subdir is the archive-relative directory for this report series,
+	// e.g. sales/12345678/SALES_SUMMARY_DAILY_1_0.
+	Subdir string
+	// Template carries the report identity copied into each manifest entry.
+	Template    ReportArchiveEntry
+	Periods     []string
+	Concurrency int
+	Decompress  bool
+	Download    func(ctx context.Context, period string) (*asc.ReportDownload, error)
+}
+
+// ReportPeriods expands an inclusive date range into report dates for the
+// frequency. from and to use the same layout as --date. Weekly periods are the
+// Sundays that end each week in the range.
+func ReportPeriods(from, to string, frequency asc.SalesReportFrequency) ([]string, error) {
+	layout := "2006-01-02"
+	switch frequency {
+	case asc.SalesReportFrequencyMonthly:
+		layout = "2006-01"
+	case asc.SalesReportFrequencyYearly:
+		layout = "2006"
+	}
+	start, err := time.Parse(layout, from)
+	if err != nil {
+		return nil, fmt.Errorf("--from must be in %s format", reportLayoutName(layout))
+	}
+	end, err := time.Parse(layout, to)
+	if err != nil {
+		return nil, fmt.Errorf("--to must be in %s format", reportLayoutName(layout))
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("--to must not be before --from")
+	}
+
+	if frequency == asc.SalesReportFrequencyWeekly {
+		start = start.AddDate(0, 0, (7-int(start.Weekday()))%7)
+	}
+	var periods []string
+	for current := start; !current.After(end); {
+		periods = append(periods, current.Format(layout))
+		switch frequency {
+		case asc.SalesReportFrequencyWeekly:
+			current = current.AddDate(0, 0, 7)
+		case asc.SalesReportFrequencyMonthly:
+			current = current.AddDate(0, 1, 0)
+		case asc.SalesReportFrequencyYearly:
+			current = current.AddDate(1, 0, 0)
+		default:
+			current = current.AddDate(0, 0, 1)
+		}
+	}
+	if len(periods) == 0 {
+		return nil, fmt.Errorf("no %s report periods between %s and %s", strings.ToLower(string(frequency)), from, to)
+	}
+	return periods, nil
+}
+
+func reportLayoutName(layout string) string {
+	return strings.NewReplacer("2006", "YYYY", "01", "MM", "02", "DD").Replace(layout)
+}
+
+// LoadReportArchiveManifest reads the manifest in dir, returning an empty
+// manifest when none exists yet.
+func LoadReportArchiveManifest(dir string) (*ReportArchiveManifest, error) {
+	path := filepath.Join(dir, ReportArchiveManifestName)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &ReportArchiveManifest{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var manifest ReportArchiveManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return &manifest, nil
+}
+
+// Save writes the manifest atomically, sorted by report path.
+func (m *ReportArchiveManifest) Save(dir string) error {
+	sort.SliceStable(m.Reports, func(i, j int) bool {
+		return reportArchiveKey(m.Reports[i]) < reportArchiveKey(m.Reports[j])
+	})
+	m.UpdatedAt = time.Now().UTC()
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteFileAtomic(filepath.Join(dir, ReportArchiveManifestName), append(data, '\n'), 0o600)
+}
+
+func reportArchiveKey(entry ReportArchiveEntry) string {
+	return strings.Join([]string{entry.Kind, entry.VendorNumber, entry.ReportType, entry.ReportSubType, entry.Frequency, entry.Version, entry.RegionCode, entry.Period}, "\x00")
+}
+
+// RunReportBackfill downloads every period not already in the archive with
+// bounded concurrency, updating the manifest after each period. Periods the
+// API reports as not found are recorded as unavailable and retried on the next
+// run. Failed periods are reported in the result rather than as an error.
+func RunReportBackfill(ctx context.Context, opts ReportBackfillOptions) (*asc.ReportBackfillResult, error) {
+	if err := os.MkdirAll(opts.ArchiveDir, 0o755); err != nil {
+		return nil, fmt.Errorf("create archive directory: %w", err)
+	}
+	manifest, err := LoadReportArchiveManifest(opts.ArchiveDir)
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int, len(manifest.Reports))
+	for i, entry := range manifest.Reports {
+		index[reportArchiveKey(entry)] = i
+	}
+
+	workers := max(min(opts.Concurrency, len(opts.Periods)), 1)
+	sem := make(chan struct{}, workers)
+	results := make([]asc.ReportBackfillPeriod, len(opts.Periods))
+	var mu sync.Mutex
+	var saveErr error
+	var wg sync.WaitGroup
+
+	for i, period := range opts.Periods {
+		wg.Go(func() {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				results[i] = asc.ReportBackfillPeriod{Period: period, Status: asc.ReportPeriodFailed, Error: ctx.Err().Error()}
+				return
+			}
+			defer func() { <-sem }()
+
+			entry := opts.Template
+			entry.Period = period
+			entry.Path = filepath.ToSlash(filepath.Join(opts.Subdir, period+".tsv.gz"))
+			key := reportArchiveKey(entry)
+
+			mu.Lock()
+			if pos, ok := index[key]; ok {
+				entry = manifest.Reports[pos]
+			}
+			mu.Unlock()
+
+			entry, results[i] = fetchReportPeriod(ctx, opts, entry)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if pos, ok := index[key]; ok {
+				manifest.Reports[pos] = entry
+			} else {
+				index[key] = len(manifest.Reports)
+				manifest.Reports = append(manifest.Reports, entry)
+			}
+			if err := manifest.Save(opts.ArchiveDir); err != nil && saveErr == nil {
+				saveErr = fmt.Errorf("save report manifest: %w", err)
+			}
+		})
+	}
+	wg.Wait()
+	if saveErr != nil {
+		return nil, saveErr
+	}
+
+	result := &asc.ReportBackfillResult{
+		ArchiveDir:   opts.ArchiveDir,
+		ManifestPath: filepath.Join(opts.ArchiveDir, ReportArchiveManifestName),
+		From:         opts.Periods[0],
+		To:           opts.Periods[len(opts.Periods)-1],
+		Data:         results,
+	}
+	for _, period := range results {
+		switch period.Status {
+		case asc.ReportPeriodDownloaded:
+			result.Downloaded++
+		case asc.ReportPeriodSkipped:
+			result.Skipped++
+		case asc.ReportPeriodUnavailable:
+			result.Unavailable++
+		default:
+			result.Failed++
+		}
+	}
+	return result, nil
+}
+
+// fetchReportPeriod downloads one period unless its file is already archived.
+func fetchReportPeriod(ctx context.Context, opts ReportBackfillOptions, entry ReportArchiveEntry) (ReportArchiveEntry, asc.ReportBackfillPeriod) {
+	path := filepath.Join(opts.ArchiveDir, filepath.FromSlash(entry.Path))
+	outcome := asc.ReportBackfillPeriod{Period: entry.Period, FilePath: path}
+
+	if info, err := os.Stat(path); err == nil && info.Mode().IsRegular() {
+		if entry.Status != asc.ReportPeriodDownloaded {
+			// Present on disk but not yet in the manifest (e.g. copied in).
+			entry.Status = asc.ReportPeriodDownloaded
+			entry.Size = info.Size()
+			entry.SHA256, _ = hashReportFile(path)
+			entry.FetchedAt = info.ModTime().UTC()
+			entry.Error = ""
+		}
+		outcome.Status = asc.ReportPeriodSkipped
+		outcome.FileSize = info.Size()
+		return entry, outcome
+	}
+
+	entry.Attempts++
+	entry.LastAttemptAt = time.Now().UTC()
+	size, sum, err := downloadReportPeriod(ctx, opts, entry.Period, path)
+	if err != nil {
+		entry.Status = asc.ReportPeriodFailed
+		if asc.IsNotFound(err) {
+			entry.Status = asc.ReportPeriodUnavailable
+		}
+		entry.Error = err.Error()
+		entry.Size = 0
+		entry.SHA256 = ""
+		outcome.Status = entry.Status
+		outcome.FilePath = ""
+		outcome.Error = entry.Error
+		return entry, outcome
+	}
+
+	entry.Status = asc.ReportPeriodDownloaded
+	entry.Size = size
+	entry.SHA256 = sum
+	entry.FetchedAt = entry.LastAttemptAt
+	entry.Error = ""
+	outcome.Status = asc.ReportPeriodDownloaded
+	outcome.FileSize = size
+	return entry, outcome
+}
+
+// downloadReportPeriod writes the report to a temporary file and renames it
+// into place so an interrupted download never looks archived.
+func downloadReportPeriod(ctx context.Context, opts ReportBackfillOptions, period, path string) (int64, string, error) {
+	requestCtx, cancel := ContextWithTimeout(ctx)
+	defer cancel()
+
+	download, err := opts.Download(requestCtx, period)
+	if err != nil {
+		return 0, "", err
+	}
+	defer download.Body.Close()
+
+	partial := path + ".part"
+	if err := os.Remove(partial); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return 0, "", err
+	}
+	hash := sha256.New()
+	size, err := WriteStreamToFile(partial, io.TeeReader(download.Body, hash))
+	if err != nil {
+		os.Remove(partial)
+		return 0, "", fmt.Errorf("write report: %w", err)
+	}
+	if opts.Decompress {
+		decompressed := strings.TrimSuffix(path, ".gz")
+		os.Remove(decompressed)
+		if _, err := DecompressGzipFile(partial, decompressed); err != nil {
+			os.Remove(partial)
+			return 0, "", fmt.Errorf("decompress report: %w", err)
+		}
+	}
+	if err := os.Rename(partial, path); err != nil {
+		os.Remove(partial)
+		return 0, "", err
+	}
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func hashReportFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
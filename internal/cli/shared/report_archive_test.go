@@ -0,0 +1,87 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestReportPeriods(t *testing.T) {
+	tests := []struct {
+		name      string
+		from      string
+		to        string
+		frequency asc.SalesReportFrequency
+		want      []string
+		wantErr   string
+	}{
+		{
+			name:      "daily",
+			from:      "2024-02-28",
+			to:        "2024-03-01",
+			frequency: asc.SalesReportFrequencyDaily,
+			want:      []string{"2024-02-28", "2024-02-29", "2024-03-01"},
+		},
+		{
+			name:      "weekly uses week-ending sundays",
+			from:      "2024-01-03",
+			to:        "2024-01-21",
+			frequency: asc.SalesReportFrequencyWeekly,
+			want:      []string{"2024-01-07", "2024-01-14", "2024-01-21"},
+		},
+		{
+			name:      "monthly",
+			from:      "2024-11",
+			to:        "2025-02",
+			frequency: asc.SalesReportFrequencyMonthly,
+			want:      []string{"2024-11", "2024-12", "2025-01", "2025-02"},
+		},
+		{
+			name:      "yearly",
+			from:      "2022",
+			to:        "2023",
+			frequency: asc.SalesReportFrequencyYearly,
+			want:      []string{"2022", "2023"},
+		},
+		{
+			name:      "reversed range",
+			from:      "2024-02",
+			to:        "2024-01",
+			frequency: asc.SalesReportFrequencyMonthly,
+			wantErr:   "--to must not be before --from",
+		},
+		{
+			name:      "wrong layout",
+			from:      "2024-01-01",
+			to:        "2024-02",
+			frequency: asc.SalesReportFrequencyMonthly,
+			wantErr:   "--from must be in YYYY-MM format",
+		},
+		{
+			name:      "no sunday in range",
+			from:      "2024-01-01",
+			to:        "2024-01-06",
+			frequency: asc.SalesReportFrequencyWeekly,
+			wantErr:   "no weekly report periods",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ReportPeriods(test.from, test.to, test.frequency)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReportPeriods() error: %v", err)
+			}
+			if strings.Join(got, ",") != strings.Join(test.want, ",") {
+				t.Fatalf("ReportPeriods() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -109,3 +109,22 @@ func DecompressGzipFile(sourcePath, destPath string) (int64, error) {
 	}
 	return written, out.Sync()
 }
+
+// ParseReportFiles parses each report file (gzip-compressed or not) with parse
+// and concatenates the rows.
+func ParseReportFiles[T any](paths []string, parse func(io.Reader) ([]T, error)) ([]T, error) {
+	rows := []T{}
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		parsed, err := parse(file)
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		rows = append(rows, parsed...)
+	}
+	return rows, nil
+}
@@ -88,6 +88,7 @@ func BindRootFlags(fs *flag.FlagSet) {
 	fs.Var(&apiDebug, "api-debug", "Enable HTTP debug logging to stderr (redacts sensitive values)")
 	fs.BoolVar(&noUpdate, "no-update", false, "Skip update checks and auto-update")
 	BindCIFlags(fs)
+	BindOutputFilterFlags(fs)
 }
 
 // SelectedProfile returns the current profile override.
@@ -519,6 +520,13 @@ func strictAuthEnabled() bool {
 }
 
 func printOutput(data any, format string, pretty bool) error {
+	if outputFiltersSet() {
+		return printFilteredOutput(data, format, pretty)
+	}
+	return printFormatted(data, format, pretty)
+}
+
+func printFormatted(data any, format string, pretty bool) error {
 	format = strings.ToLower(format)
 	switch format {
 	case "json":
@@ -536,11 +544,46 @@ func printOutput(data any, format string, pretty bool) error {
 			return fmt.Errorf("--pretty is only valid with JSON output")
 		}
 		return asc.PrintTable(data)
+	case "csv":
+		if pretty {
+			return fmt.Errorf("--pretty is only valid with JSON output")
+		}
+		return asc.PrintCSV(data)
+	case "tsv":
+		if pretty {
+			return fmt.Errorf("--pretty is only valid with JSON output")
+		}
+		return asc.PrintTSV(data)
+	case "yaml", "yml":
+		if pretty {
+			return fmt.Errorf("--pretty is only valid with JSON output")
+		}
+		return asc.PrintYAML(data)
+	case "ndjson":
+		if pretty {
+			return fmt.Errorf("--pretty is only valid with JSON output")
+		}
+		return asc.PrintNDJSON(data)
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
 }
 
+// streamPagesContext makes PaginateAll print each page as NDJSON as it
+// arrives when format is ndjson; the aggregated result is then empty.
+// --query and --template need the whole result, so they disable streaming.
+func streamPagesContext(ctx context.Context, format string) context.Context {
+	if !strings.EqualFold(strings.TrimSpace(format), "ndjson") {
+		return ctx
+	}
+	if strings.TrimSpace(outputQuery) != "" || outputTemplate != "" {
+		return ctx
+	}
+	return asc.WithPageHandler(ctx, func(page asc.PaginatedResponse) error {
+		return printOutput(page, "ndjson", false)
+	})
+}
+
 func printStreamPage(data any) error {
 	return asc.PrintJSON(data)
 }
@@ -596,7 +639,8 @@ var (
 
 // DefaultOutputFormat returns the default output format for CLI commands.
 // It checks the ASC_DEFAULT_OUTPUT environment variable first, falling back to "json".
-// Valid values are "json", "table", "markdown", and "md".
+// Valid values are "json", "table", "markdown", "md", "csv", "tsv", "yaml",
+// "yml", and "ndjson".
 func DefaultOutputFormat() string {
 	defaultOutputOnce.Do(func() {
 		defaultOutputValue = resolveDefaultOutput()
@@ -611,10 +655,10 @@ func resolveDefaultOutput() string {
 	}
 	normalized := strings.ToLower(env)
 	switch normalized {
-	case "json", "table", "markdown", "md":
+	case "json", "table", "markdown", "md", "csv", "tsv", "yaml", "yml", "ndjson":
 		return normalized
 	default:
-		fmt.Fprintf(os.Stderr, "Warning: invalid %s value %q (expected json, table, markdown, or md); using json\n", defaultOutputEnvVar, env)
+		fmt.Fprintf(os.Stderr, "Warning: invalid %s value %q (expected json, table, markdown, md, csv, tsv, yaml, or ndjson); using json\n", defaultOutputEnvVar, env)
 		return "json"
 	}
 }
@@ -684,10 +728,16 @@ func validateNextURL(next string) error {
 	if err != nil {
 		return fmt.Errorf("--next must be a valid URL: %w", err)
 	}
-	if parsed.Scheme != "https" || parsed.Host != "api.appstoreconnect.apple.com" {
-		return fmt.Errorf("--next must be an App Store Connect URL")
+	if parsed.Scheme == "https" && parsed.Host == "api.appstoreconnect.apple.com" {
+		return nil
 	}
-	return nil
+	// Allow links from a configured base URL (e.g. asc mock serve).
+	if resolved, err := asc.ResolveBaseURL(); err == nil {
+		if base, err := url.Parse(resolved); err == nil && parsed.Scheme == base.Scheme && parsed.Host == base.Host {
+			return nil
+		}
+	}
+	return fmt.Errorf("--next must be an App Store Connect URL")
 }
 
 func validateSort(value string, allowed ...string) error {
@@ -754,6 +804,13 @@ func ValidateSort(value string, allowed ...string) error {
 	return validateSort(value, allowed...)
 }
 
+// StreamPagesContext returns ctx set up to stream paginated results as NDJSON
+// when format is ndjson. Pass it to asc.PaginateAll for lists that are printed
+// directly with PrintOutput.
+func StreamPagesContext(ctx context.Context, format string) context.Context {
+	return streamPagesContext(ctx, format)
+}
+
 // PrintStreamPage writes a single page of data as a JSON line to stdout.
 // Used with --stream --paginate to emit results page-by-page as NDJSON
 // instead of buffering all pages in memory.
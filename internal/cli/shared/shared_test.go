@@ -130,6 +130,18 @@ func TestDefaultOutputFormat_JSON(t *testing.T) {
 	}
 }
 
+func TestDefaultOutputFormat_StructuredFormats(t *testing.T) {
+	for _, value := range []string{"csv", "tsv", "yaml", "yml", "ndjson"} {
+		t.Run(value, func(t *testing.T) {
+			resetDefaultOutput(t)
+			t.Setenv("ASC_DEFAULT_OUTPUT", value)
+			if got := DefaultOutputFormat(); got != value {
+				t.Fatalf("expected %q, got %q", value, got)
+			}
+		})
+	}
+}
+
 func TestDefaultOutputFormat_CaseInsensitive(t *testing.T) {
 	for _, value := range []string{"TABLE", "Table", "tAbLe", "MARKDOWN", "JSON"} {
 		t.Run(value, func(t *testing.T) {
@@ -0,0 +1,319 @@
+package shared
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const triageUnknown = "unknown"
+
+// ParseSince parses a --since value: a duration such as 7d, 2w, or 24h, a
+// date (2006-01-02), or an RFC 3339 timestamp.
+func ParseSince(value string, now time.Time) (time.Time, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return time.Time{}, fmt.Errorf("--since must not be empty")
+	}
+	if parsed, err := time.Parse("2006-01-02", trimmed); err == nil {
+		return parsed, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, trimmed); err == nil {
+		return parsed, nil
+	}
+
+	invalid := fmt.Errorf("--since must be a duration like 7d, 2w, or 24h, a date, or an RFC 3339 timestamp")
+	lower := strings.ToLower(trimmed)
+	if unit := lower[len(lower)-1]; unit == 'd' || unit == 'w' {
+		count, err := strconv.Atoi(lower[:len(lower)-1])
+		if err != nil || count <= 0 {
+			return time.Time{}, invalid
+		}
+		days := count
+		if unit == 'w' {
+			days *= 7
+		}
+		return now.AddDate(0, 0, -days), nil
+	}
+	duration, err := time.ParseDuration(lower)
+	if err != nil || duration <= 0 {
+		return time.Time{}, invalid
+	}
+	return now.Add(-duration), nil
+}
+
+// TriageBuild is the build a feedback or crash submission was sent from.
+// Version is the build number; AppVersion is the version string of its
+// pre-release version, when known.
+type TriageBuild struct {
+	ID           string
+	Version      string
+	AppVersion   string
+	UploadedDate string
+}
+
+// Label returns the build as "1.2 (5)", just its build number when the app
+// version is unknown, or its ID when neither is.
+func (b TriageBuild) Label() string {
+	if b.Version != "" && b.AppVersion != "" {
+		return fmt.Sprintf("%s (%s)", b.AppVersion, b.Version)
+	}
+	if b.Version != "" {
+		return b.Version
+	}
+	if b.ID != "" {
+		return b.ID
+	}
+	return triageUnknown
+}
+
+// IncludedBuilds returns the builds in a response's included resources,
+// keyed by ID.
+func IncludedBuilds(included json.RawMessage) (map[string]TriageBuild, error) {
+	builds := make(map[string]TriageBuild)
+	if len(included) == 0 {
+		return builds, nil
+	}
+	var resources []struct {
+		Type       string              `json:"type"`
+		ID         string              `json:"id"`
+		Attributes asc.BuildAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(included, &resources); err != nil {
+		return nil, fmt.Errorf("decode included builds: %w", err)
+	}
+	for _, resource := range resources {
+		if resource.Type != "builds" {
+			continue
+		}
+		builds[resource.ID] = TriageBuild{
+			ID:           resource.ID,
+			Version:      resource.Attributes.Version,
+			UploadedDate: resource.Attributes.UploadedDate,
+		}
+	}
+	return builds, nil
+}
+
+// SubmissionBuild returns the build of a feedback or crash submission from
+// builds returned by IncludedBuilds, or just its ID when it was not included.
+func SubmissionBuild(builds map[string]TriageBuild, relationships json.RawMessage) TriageBuild {
+	if len(relationships) == 0 {
+		return TriageBuild{}
+	}
+	var decoded asc.BetaFeedbackSubmissionRelationships
+	if err := json.Unmarshal(relationships, &decoded); err != nil || decoded.Build == nil || decoded.Build.Data.ID == "" {
+		return TriageBuild{}
+	}
+	if build, ok := builds[decoded.Build.Data.ID]; ok {
+		return build
+	}
+	return TriageBuild{ID: decoded.Build.Data.ID}
+}
+
+// CrashesClient fetches an app's crash submissions.
+type CrashesClient interface {
+	GetCrashes(ctx context.Context, appID string, opts ...asc.CrashOption) (*asc.CrashesResponse, error)
+}
+
+// FetchCrashesSince pages through an app's crashes newest first until one is
+// older than cutoff, and returns them with the builds they were sent from.
+func FetchCrashesSince(ctx context.Context, client CrashesClient, appID string, cutoff time.Time, buildIDs []string) ([]asc.Resource[asc.CrashAttributes], map[string]TriageBuild, error) {
+	opts := []asc.CrashOption{
+		asc.WithCrashSort("-createdDate"),
+		asc.WithCrashLimit(200),
+		asc.WithCrashIncludeBuild(),
+		asc.WithCrashBuildIDs(buildIDs),
+	}
+	var crashes []asc.Resource[asc.CrashAttributes]
+	builds := map[string]TriageBuild{}
+	for {
+		requestCtx, cancel := ContextWithTimeout(ctx)
+		resp, err := client.GetCrashes(requestCtx, appID, opts...)
+		cancel()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch crashes: %w", err)
+		}
+		included, err := IncludedBuilds(resp.Included)
+		if err != nil {
+			return nil, nil, err
+		}
+		for id, build := range included {
+			builds[id] = build
+		}
+
+		for _, item := range resp.Data {
+			created, err := time.Parse(time.RFC3339, item.Attributes.CreatedDate)
+			if err == nil && created.Before(cutoff) {
+				return crashes, builds, nil
+			}
+			crashes = append(crashes, item)
+		}
+		if resp.Links.Next == "" {
+			return crashes, builds, nil
+		}
+		opts = []asc.CrashOption{asc.WithCrashNextURL(resp.Links.Next)}
+	}
+}
+
+// BuildPreReleaseVersionClient fetches the pre-release version of a build.
+type BuildPreReleaseVersionClient interface {
+	GetBuildPreReleaseVersion(ctx context.Context, buildID string) (*asc.PreReleaseVersionResponse, error)
+}
+
+// ResolveBuildAppVersions fills in the app version of each build from its
+// pre-release version. Builds without one keep just their build number.
+func ResolveBuildAppVersions(ctx context.Context, client BuildPreReleaseVersionClient, builds map[string]TriageBuild) error {
+	for id, build := range builds {
+		requestCtx, cancel := ContextWithTimeout(ctx)
+		resp, err := client.GetBuildPreReleaseVersion(requestCtx, id)
+		cancel()
+		if err != nil {
+			if asc.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("fetch pre-release version for build %s: %w", id, err)
+		}
+		build.AppVersion = resp.Data.Attributes.Version
+		builds[id] = build
+	}
+	return nil
+}
+
+// TriageSubmission is the part of a feedback or crash submission that
+// triage reports break down.
+type TriageSubmission struct {
+	ID          string
+	CreatedDate string
+	Build       TriageBuild
+	OSVersion   string
+	DeviceModel string
+	Tester      string
+}
+
+// TriageTally counts the submissions of a triage group by build, OS version,
+// and device model, and collects their testers.
+type TriageTally struct {
+	Count     int
+	FirstSeen string
+	LastSeen  string
+	IDs       []string
+
+	first, last  time.Time
+	builds       map[string]int
+	buildLabels  map[string]string
+	osVersions   map[string]int
+	deviceModels map[string]int
+	testers      map[string]bool
+}
+
+// NewTriageTally returns an empty tally.
+func NewTriageTally() *TriageTally {
+	return &TriageTally{
+		builds:       make(map[string]int),
+		buildLabels:  make(map[string]string),
+		osVersions:   make(map[string]int),
+		deviceModels: make(map[string]int),
+		testers:      make(map[string]bool),
+	}
+}
+
+// Add counts a submission.
+func (t *TriageTally) Add(submission TriageSubmission) {
+	t.Count++
+	t.IDs = append(t.IDs, submission.ID)
+	buildKey := triageValue(submission.Build.ID)
+	t.builds[buildKey]++
+	t.buildLabels[buildKey] = submission.Build.Label()
+	t.osVersions[triageValue(submission.OSVersion)]++
+	t.deviceModels[triageValue(submission.DeviceModel)]++
+	if tester := strings.TrimSpace(submission.Tester); tester != "" {
+		t.testers[tester] = true
+	}
+
+	created, err := time.Parse(time.RFC3339, submission.CreatedDate)
+	if err != nil {
+		return
+	}
+	if t.first.IsZero() || created.Before(t.first) {
+		t.first, t.FirstSeen = created, submission.CreatedDate
+	}
+	if t.last.IsZero() || created.After(t.last) {
+		t.last, t.LastSeen = created, submission.CreatedDate
+	}
+}
+
+// HasBuild reports whether any submission came from the build with the
+// given ID. Builds are counted by ID because build numbers repeat across app
+// versions.
+func (t *TriageTally) HasBuild(buildID string) bool {
+	return t.builds[triageValue(buildID)] > 0
+}
+
+// Last returns the time of the newest submission.
+func (t *TriageTally) Last() time.Time {
+	return t.last
+}
+
+// Builds returns submission counts per build, most common first, labeled
+// with each build's version and build number.
+func (t *TriageTally) Builds() []asc.TriageCount {
+	result := make([]asc.TriageCount, 0, len(t.builds))
+	for id, count := range t.builds {
+		result = append(result, asc.TriageCount{Value: t.buildLabels[id], Count: count})
+	}
+	sortTriageCounts(result)
+	return result
+}
+
+// OSVersions returns submission counts per OS version, most common first.
+func (t *TriageTally) OSVersions() []asc.TriageCount {
+	return sortedTriageCounts(t.osVersions)
+}
+
+// DeviceModels returns submission counts per device model, most common first.
+func (t *TriageTally) DeviceModels() []asc.TriageCount {
+	return sortedTriageCounts(t.deviceModels)
+}
+
+// Testers returns the testers who sent submissions, sorted.
+func (t *TriageTally) Testers() []string {
+	testers := make([]string, 0, len(t.testers))
+	for tester := range t.testers {
+		testers = append(testers, tester)
+	}
+	slices.Sort(testers)
+	return testers
+}
+
+func triageValue(value string) string {
+	if trimmed := strings.TrimSpace(value); trimmed != "" {
+		return trimmed
+	}
+	return triageUnknown
+}
+
+func sortedTriageCounts(counts map[string]int) []asc.TriageCount {
+	result := make([]asc.TriageCount, 0, len(counts))
+	for value, count := range counts {
+		result = append(result, asc.TriageCount{Value: value, Count: count})
+	}
+	sortTriageCounts(result)
+	return result
+}
+
+func sortTriageCounts(counts []asc.TriageCount) {
+	slices.SortFunc(counts, func(a, b asc.TriageCount) int {
+		if a.Count != b.Count {
+			return b.Count - a.Count
+		}
+		return cmp.Compare(a.Value, b.Value)
+	})
+}
@@ -0,0 +1,149 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{value: "7d", want: now.AddDate(0, 0, -7)},
+		{value: "2W", want: now.AddDate(0, 0, -14)},
+		{value: "36h", want: now.Add(-36 * time.Hour)},
+		{value: "2026-03-01", want: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{value: "2026-03-01T08:30:00Z", want: time.Date(2026, 3, 1, 8, 30, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		got, err := ParseSince(test.value, now)
+		if err != nil {
+			t.Fatalf("ParseSince(%q) error: %v", test.value, err)
+		}
+		if !got.Equal(test.want) {
+			t.Fatalf("ParseSince(%q) = %v, want %v", test.value, got, test.want)
+		}
+	}
+
+	for _, value := range []string{"", "0d", "-3d", "xd", "soon", "-1h"} {
+		if _, err := ParseSince(value, now); err == nil {
+			t.Fatalf("ParseSince(%q) expected error", value)
+		}
+	}
+}
+
+func TestSubmissionBuild(t *testing.T) {
+	builds, err := IncludedBuilds(json.RawMessage(`[
+		{"type":"builds","id":"b1","attributes":{"version":"42","uploadedDate":"2026-03-01T00:00:00Z"}},
+		{"type":"betaTesters","id":"t1","attributes":{}}
+	]`))
+	if err != nil {
+		t.Fatalf("IncludedBuilds() error: %v", err)
+	}
+	if len(builds) != 1 || builds["b1"].Version != "42" {
+		t.Fatalf("unexpected builds: %+v", builds)
+	}
+
+	if got := SubmissionBuild(builds, json.RawMessage(`{"build":{"data":{"type":"builds","id":"b1"}}}`)); got.Label() != "42" {
+		t.Fatalf("expected included build version, got %+v", got)
+	}
+	if got := SubmissionBuild(builds, json.RawMessage(`{"build":{"data":{"type":"builds","id":"b2"}}}`)); got.Label() != "b2" {
+		t.Fatalf("expected build ID fallback, got %+v", got)
+	}
+	if got := SubmissionBuild(builds, nil); got.Label() != "unknown" {
+		t.Fatalf("expected unknown build, got %+v", got)
+	}
+}
+
+func TestTriageTally(t *testing.T) {
+	tally := NewTriageTally()
+	tally.Add(TriageSubmission{ID: "1", CreatedDate: "2026-03-02T00:00:00Z", Build: TriageBuild{ID: "b42", Version: "42"}, OSVersion: "17.2", DeviceModel: "iPhone15,3", Tester: "b@example.com"})
+	tally.Add(TriageSubmission{ID: "2", CreatedDate: "2026-03-05T00:00:00Z", Build: TriageBuild{ID: "b42", Version: "42"}, OSVersion: "17.3", Tester: "a@example.com"})
+	tally.Add(TriageSubmission{ID: "3", CreatedDate: "2026-03-01T00:00:00Z", Build: TriageBuild{ID: "b41", Version: "41"}, OSVersion: "17.2", Tester: "b@example.com"})
+
+	if tally.Count != 3 || !reflect.DeepEqual(tally.IDs, []string{"1", "2", "3"}) {
+		t.Fatalf("unexpected count or IDs: %d %v", tally.Count, tally.IDs)
+	}
+	if tally.FirstSeen != "2026-03-01T00:00:00Z" || tally.LastSeen != "2026-03-05T00:00:00Z" {
+		t.Fatalf("unexpected first/last seen: %s %s", tally.FirstSeen, tally.LastSeen)
+	}
+	if want := []asc.TriageCount{{Value: "42", Count: 2}, {Value: "41", Count: 1}}; !reflect.DeepEqual(tally.Builds(), want) {
+		t.Fatalf("Builds() = %+v, want %+v", tally.Builds(), want)
+	}
+	if want := []asc.TriageCount{{Value: "17.2", Count: 2}, {Value: "17.3", Count: 1}}; !reflect.DeepEqual(tally.OSVersions(), want) {
+		t.Fatalf("OSVersions() = %+v, want %+v", tally.OSVersions(), want)
+	}
+	if want := []asc.TriageCount{{Value: "unknown", Count: 2}, {Value: "iPhone15,3", Count: 1}}; !reflect.DeepEqual(tally.DeviceModels(), want) {
+		t.Fatalf("DeviceModels() = %+v, want %+v", tally.DeviceModels(), want)
+	}
+	if want := []string{"a@example.com", "b@example.com"}; !reflect.DeepEqual(tally.Testers(), want) {
+		t.Fatalf("Testers() = %v, want %v", tally.Testers(), want)
+	}
+	if !tally.HasBuild("b41") || tally.HasBuild("41") || tally.HasBuild("b40") {
+		t.Fatal("unexpected HasBuild result")
+	}
+}
+
+func TestTriageTallyKeysBuildsByID(t *testing.T) {
+	tally := NewTriageTally()
+	tally.Add(TriageSubmission{ID: "1", Build: TriageBuild{ID: "b1", Version: "5", AppVersion: "1.2"}})
+	tally.Add(TriageSubmission{ID: "2", Build: TriageBuild{ID: "b2", Version: "5", AppVersion: "1.3"}})
+	tally.Add(TriageSubmission{ID: "3", Build: TriageBuild{ID: "b2", Version: "5", AppVersion: "1.3"}})
+
+	if want := []asc.TriageCount{{Value: "1.3 (5)", Count: 2}, {Value: "1.2 (5)", Count: 1}}; !reflect.DeepEqual(tally.Builds(), want) {
+		t.Fatalf("Builds() = %+v, want %+v", tally.Builds(), want)
+	}
+	if !tally.HasBuild("b1") || !tally.HasBuild("b2") || tally.HasBuild("5") {
+		t.Fatal("expected builds sharing a build number to be told apart by ID")
+	}
+}
+
+type stubCrashesClient struct {
+	pages    []*asc.CrashesResponse
+	requests int
+}
+
+func (c *stubCrashesClient) GetCrashes(_ context.Context, _ string, _ ...asc.CrashOption) (*asc.CrashesResponse, error) {
+	page := c.pages[c.requests]
+	c.requests++
+	return page, nil
+}
+
+func TestFetchCrashesSince(t *testing.T) {
+	crash := func(id, created string) asc.Resource[asc.CrashAttributes] {
+		return asc.Resource[asc.CrashAttributes]{ID: id, Attributes: asc.CrashAttributes{CreatedDate: created}}
+	}
+	client := &stubCrashesClient{pages: []*asc.CrashesResponse{
+		{
+			Data:     []asc.Resource[asc.CrashAttributes]{crash("c1", "2026-03-05T00:00:00Z")},
+			Included: json.RawMessage(`[{"type":"builds","id":"b1","attributes":{"version":"5"}}]`),
+			Links:    asc.Links{Next: "https://api.appstoreconnect.apple.com/v1/apps/123/betaFeedbackCrashSubmissions?cursor=2"},
+		},
+		{
+			Data:     []asc.Resource[asc.CrashAttributes]{crash("c2", "2026-03-02T00:00:00Z"), crash("c3", "2026-02-20T00:00:00Z")},
+			Included: json.RawMessage(`[{"type":"builds","id":"b2","attributes":{"version":"6"}}]`),
+			Links:    asc.Links{Next: "https://api.appstoreconnect.apple.com/v1/apps/123/betaFeedbackCrashSubmissions?cursor=3"},
+		},
+	}}
+
+	crashes, builds, err := FetchCrashesSince(context.Background(), client, "123", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), nil)
+	if err != nil {
+		t.Fatalf("FetchCrashesSince() error: %v", err)
+	}
+	if client.requests != 2 {
+		t.Fatalf("expected paging to stop at the cutoff after 2 requests, got %d", client.requests)
+	}
+	if len(crashes) != 2 || crashes[0].ID != "c1" || crashes[1].ID != "c2" {
+		t.Fatalf("unexpected crashes: %+v", crashes)
+	}
+	if len(builds) != 2 || builds["b1"].Version != "5" || builds["b2"].Version != "6" {
+		t.Fatalf("unexpected builds: %+v", builds)
+	}
+}
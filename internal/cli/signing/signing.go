@@ -19,11 +19,13 @@ func SigningCommand() *ffcli.Command {
 		LongHelp: `Manage signing assets for App Store Connect.
 
 Examples:
-  asc signing fetch --bundle-id com.example.app --profile-type IOS_APP_STORE --output ./signing`,
+  asc signing fetch --bundle-id com.example.app --profile-type IOS_APP_STORE --output ./signing
+  asc signing audit --days 30 --report junit --report-file signing-audit.xml`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			SigningFetchCommand(),
+			SigningAuditCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
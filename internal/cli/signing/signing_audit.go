@@ -0,0 +1,415 @@
+package signing
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+const defaultAuditDays = 30
+
+// SigningAuditCommand returns the signing audit subcommand.
+func SigningAuditCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("audit", flag.ExitOnError)
+
+	days := fs.Int("days", defaultAuditDays, "Flag certificates and profiles expiring within this many days")
+	strict := fs.Bool("strict", false, "Treat warnings as blocking")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "audit",
+		ShortUsage: "asc signing audit [flags]",
+		ShortHelp:  "Audit certificates, profiles, and bundle IDs for expiry and health.",
+		LongHelp: `Audit certificates, profiles, and bundle IDs for expiry and health.
+
+Sweeps every certificate, provisioning profile, bundle ID, and device in the
+team and reports:
+  - certificates and profiles that are expired or expire within --days (error)
+  - profiles that are not ACTIVE, e.g. INVALID (error)
+  - profiles that embed revoked certificates (error)
+  - profiles that include disabled or removed devices (warning)
+  - bundle IDs with no active, unexpired profile, explicit or wildcard (warning)
+
+The command exits non-zero when errors are found (or warnings, with --strict),
+so it can gate a release pipeline. With the root --report junit flag, the
+report has one test case per audited asset.
+
+Examples:
+  asc signing audit
+  asc signing audit --days 60 --output table
+  asc --report junit --report-file signing-audit.xml signing audit --strict`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *days < 0 {
+				return fmt.Errorf("signing audit: --days must be 0 or greater")
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("signing audit: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			input, err := fetchSigningAuditInput(requestCtx, client)
+			if err != nil {
+				return fmt.Errorf("signing audit: %w", err)
+			}
+
+			now := time.Now().UTC()
+			result := auditSigningAssets(input, now, *days, *strict)
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if shared.ReportFormat() == shared.ReportFormatJUnit {
+				junit := signingAuditJUnitReport(result, now)
+				shared.SetCommandReport(&junit)
+			}
+			if result.Summary.Blocking > 0 {
+				return shared.NewReportedError(fmt.Errorf("signing audit: found %d blocking issue(s)", result.Summary.Blocking))
+			}
+			return nil
+		},
+	}
+}
+
+// signingAuditInput holds every signing asset in the team.
+type signingAuditInput struct {
+	certificates []asc.Resource[asc.CertificateAttributes]
+	profiles     []asc.Resource[asc.ProfileAttributes]
+	bundleIDs    []asc.Resource[asc.BundleIDAttributes]
+	devices      []asc.Resource[asc.DeviceAttributes]
+}
+
+func fetchSigningAuditInput(ctx context.Context, client *asc.Client) (signingAuditInput, error) {
+	var input signingAuditInput
+	var err error
+
+	input.certificates, err = shared.FetchAllPages(ctx, "certificates",
+		func(ctx context.Context) (*asc.CertificatesResponse, error) {
+			return client.GetCertificates(ctx, asc.WithCertificatesLimit(200))
+		},
+		func(ctx context.Context, next string) (*asc.CertificatesResponse, error) {
+			return client.GetCertificates(ctx, asc.WithCertificatesNextURL(next))
+		})
+	if err != nil {
+		return input, err
+	}
+	input.profiles, err = shared.FetchAllPages(ctx, "profiles",
+		func(ctx context.Context) (*asc.ProfilesResponse, error) {
+			return client.GetProfiles(ctx, asc.WithProfilesLimit(200))
+		},
+		func(ctx context.Context, next string) (*asc.ProfilesResponse, error) {
+			return client.GetProfiles(ctx, asc.WithProfilesNextURL(next))
+		})
+	if err != nil {
+		return input, err
+	}
+	input.bundleIDs, err = shared.FetchAllPages(ctx, "bundle IDs",
+		func(ctx context.Context) (*asc.BundleIDsResponse, error) {
+			return client.GetBundleIDs(ctx, asc.WithBundleIDsLimit(200))
+		},
+		func(ctx context.Context, next string) (*asc.BundleIDsResponse, error) {
+			return client.GetBundleIDs(ctx, asc.WithBundleIDsNextURL(next))
+		})
+	if err != nil {
+		return input, err
+	}
+	input.devices, err = shared.FetchAllPages(ctx, "devices",
+		func(ctx context.Context) (*asc.DevicesResponse, error) {
+			return client.GetDevices(ctx, asc.WithDevicesLimit(200))
+		},
+		func(ctx context.Context, next string) (*asc.DevicesResponse, error) {
+			return client.GetDevices(ctx, asc.WithDevicesNextURL(next))
+		})
+	return input, err
+}
+
+// signingAuditor accumulates findings per asset.
+type signingAuditor struct {
+	now    time.Time
+	days   int
+	checks []validation.CheckResult
+	assets []asc.SigningAuditAsset
+	status map[string]validation.Severity
+}
+
+func (a *signingAuditor) add(severity validation.Severity, id, resourceType, resourceID, message, remediation string) {
+	a.checks = append(a.checks, validation.CheckResult{
+		ID:           id,
+		Severity:     severity,
+		Message:      message,
+		Remediation:  remediation,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+	})
+	key := resourceType + "/" + resourceID
+	if current, ok := a.status[key]; !ok || severityRank(severity) > severityRank(current) {
+		a.status[key] = severity
+	}
+}
+
+// checkExpiry records an expired or soon-to-expire asset and returns the
+// days until expiration, or nil when the date is unknown.
+func (a *signingAuditor) checkExpiry(resourceType, resourceID, name, value, remediation string) *int {
+	expires, ok := parseSigningTime(value)
+	if !ok {
+		return nil
+	}
+	days := int(math.Floor(expires.Sub(a.now).Hours() / 24))
+	switch {
+	case !expires.After(a.now):
+		a.add(validation.SeverityError, "signing."+resourceType+".expired", resourceType, resourceID,
+			fmt.Sprintf("%s %q expired on %s", assetLabel(resourceType), name, expires.Format("2006-01-02")), remediation)
+	case days < a.days:
+		a.add(validation.SeverityError, "signing."+resourceType+".expiring", resourceType, resourceID,
+			fmt.Sprintf("%s %q expires on %s (%d days)", assetLabel(resourceType), name, expires.Format("2006-01-02"), days), remediation)
+	}
+	return &days
+}
+
+func auditSigningAssets(input signingAuditInput, now time.Time, days int, strict bool) *asc.SigningAuditResult {
+	auditor := &signingAuditor{now: now, days: days, status: map[string]validation.Severity{}}
+
+	for _, cert := range input.certificates {
+		name := firstNonEmpty(cert.Attributes.Name, cert.Attributes.DisplayName, cert.Attributes.SerialNumber)
+		daysLeft := auditor.checkExpiry(asc.SigningAuditCertificate, cert.ID, name, cert.Attributes.ExpirationDate,
+			"Create a replacement certificate and re-issue the profiles that use it")
+		auditor.assets = append(auditor.assets, asc.SigningAuditAsset{
+			Type:                asc.SigningAuditCertificate,
+			ID:                  cert.ID,
+			Name:                name,
+			Kind:                cert.Attributes.CertificateType,
+			ExpirationDate:      cert.Attributes.ExpirationDate,
+			DaysUntilExpiration: daysLeft,
+		})
+	}
+
+	devices := make(map[string]asc.DeviceAttributes, len(input.devices))
+	for _, device := range input.devices {
+		devices[strings.ToUpper(device.Attributes.UDID)] = device.Attributes
+	}
+
+	covered := map[string]bool{}
+	for _, profile := range input.profiles {
+		auditProfile(auditor, profile, input.certificates, devices, covered)
+	}
+
+	for _, bundleID := range input.bundleIDs {
+		if !bundleIDCovered(covered, bundleID.Attributes.Identifier) {
+			auditor.add(validation.SeverityWarning, "signing.bundleId.no_profile", asc.SigningAuditBundleID, bundleID.ID,
+				fmt.Sprintf("Bundle ID %q has no provisioning profile", bundleID.Attributes.Identifier),
+				"Create a profile for it, or delete the bundle ID if it is unused")
+		}
+		auditor.assets = append(auditor.assets, asc.SigningAuditAsset{
+			Type: asc.SigningAuditBundleID,
+			ID:   bundleID.ID,
+			Name: bundleID.Attributes.Identifier,
+			Kind: string(bundleID.Attributes.Platform),
+		})
+	}
+
+	for i := range auditor.assets {
+		asset := &auditor.assets[i]
+		asset.Status = "ok"
+		if severity, ok := auditor.status[asset.Type+"/"+asset.ID]; ok {
+			asset.Status = string(severity)
+		}
+	}
+	sort.SliceStable(auditor.checks, func(i, j int) bool {
+		return severityRank(auditor.checks[i].Severity) > severityRank(auditor.checks[j].Severity)
+	})
+
+	return &asc.SigningAuditResult{
+		GeneratedAt:  now.Format(time.RFC3339),
+		Days:         days,
+		Strict:       strict,
+		Certificates: len(input.certificates),
+		Profiles:     len(input.profiles),
+		BundleIDs:    len(input.bundleIDs),
+		Devices:      len(input.devices),
+		Summary:      validation.Summarize(auditor.checks, strict),
+		Assets:       auditor.assets,
+		Checks:       auditor.checks,
+	}
+}
+
+// bundleIDCovered reports whether a profile covers the bundle identifier,
+// either exactly or through a wildcard App ID such as com.example.*.
+func bundleIDCovered(covered map[string]bool, identifier string) bool {
+	if covered[identifier] {
+		return true
+	}
+	for profileBundleID := range covered {
+		if prefix, ok := strings.CutSuffix(profileBundleID, "*"); ok && strings.HasPrefix(identifier, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func auditProfile(auditor *signingAuditor, profile asc.Resource[asc.ProfileAttributes], certificates []asc.Resource[asc.CertificateAttributes], devices map[string]asc.DeviceAttributes, covered map[string]bool) {
+	attrs := profile.Attributes
+	asset := asc.SigningAuditAsset{
+		Type:           asc.SigningAuditProfile,
+		ID:             profile.ID,
+		Name:           attrs.Name,
+		Kind:           attrs.ProfileType,
+		ExpirationDate: attrs.ExpirationDate,
+	}
+	asset.DaysUntilExpiration = auditor.checkExpiry(asc.SigningAuditProfile, profile.ID, attrs.Name, attrs.ExpirationDate,
+		"Regenerate the profile")
+	expires, ok := parseSigningTime(attrs.ExpirationDate)
+	usable := !ok || expires.After(auditor.now)
+	if attrs.ProfileState != "" && attrs.ProfileState != asc.ProfileStateActive {
+		usable = false
+		auditor.add(validation.SeverityError, "signing.profile.invalid", asc.SigningAuditProfile, profile.ID,
+			fmt.Sprintf("Profile %q is %s", attrs.Name, attrs.ProfileState),
+			"Regenerate the profile; it usually becomes invalid when a certificate is revoked or the App ID changes")
+	}
+	defer func() { auditor.assets = append(auditor.assets, asset) }()
+
+	content := strings.TrimSpace(attrs.ProfileContent)
+	if content == "" {
+		return
+	}
+	decoded, err := decodeBase64Content("profile", content)
+	var parsed *shared.ProvisioningProfile
+	if err == nil {
+		parsed, err = shared.ParseProvisioningProfile(decoded)
+	}
+	var inspected *asc.ProfileInspectResult
+	if err == nil {
+		inspected, err = shared.InspectProvisioningProfile(parsed, profile.ID, auditor.now)
+	}
+	if err != nil {
+		auditor.add(validation.SeverityWarning, "signing.profile.unreadable", asc.SigningAuditProfile, profile.ID,
+			fmt.Sprintf("Profile %q could not be decoded: %v", attrs.Name, err), "")
+		return
+	}
+	// Only a profile that can still sign covers its bundle ID, so one whose
+	// profiles are all invalid or expired is still reported.
+	if usable {
+		covered[parsed.BundleID()] = true
+	}
+
+	// Only the revocation result of the cross-check is used here; expiry is
+	// reported from the certificate list.
+	inspected.Warnings = nil
+	shared.CrossCheckProfileCertificates(inspected, certificates)
+	for _, cert := range inspected.Certificates {
+		if cert.InAppStoreConnect != nil && !*cert.InAppStoreConnect {
+			auditor.add(validation.SeverityError, "signing.profile.revoked_certificate", asc.SigningAuditProfile, profile.ID,
+				fmt.Sprintf("Profile %q includes certificate %q (%s) which is revoked or no longer listed", attrs.Name, cert.CommonName, cert.SerialNumber),
+				"Regenerate the profile with an active certificate")
+		}
+	}
+
+	for _, udid := range parsed.ProvisionedDevices {
+		device, ok := devices[strings.ToUpper(udid)]
+		switch {
+		case !ok:
+			auditor.add(validation.SeverityWarning, "signing.profile.removed_device", asc.SigningAuditProfile, profile.ID,
+				fmt.Sprintf("Profile %q includes device %s which is no longer registered", attrs.Name, udid),
+				"Regenerate the profile to drop the device")
+		case device.Status == asc.DeviceStatusDisabled:
+			auditor.add(validation.SeverityWarning, "signing.profile.disabled_device", asc.SigningAuditProfile, profile.ID,
+				fmt.Sprintf("Profile %q includes disabled device %q (%s)", attrs.Name, device.Name, udid),
+				"Regenerate the profile to drop the device, or re-enable it")
+		}
+	}
+}
+
+// signingAuditJUnitReport has one test case per audited asset. Blocking
+// findings fail the test case; other findings are written to system-out.
+func signingAuditJUnitReport(result *asc.SigningAuditResult, now time.Time) shared.JUnitReport {
+	byResource := map[string][]validation.CheckResult{}
+	for _, check := range result.Checks {
+		key := check.ResourceType + "/" + check.ResourceID
+		byResource[key] = append(byResource[key], check)
+	}
+
+	report := shared.JUnitReport{Name: "asc signing audit", Timestamp: now}
+	for _, asset := range result.Assets {
+		testCase := shared.JUnitTestCase{
+			Name:      fmt.Sprintf("%s (%s)", asset.Name, asset.ID),
+			Classname: "signing." + asset.Type,
+		}
+		var failures, notes []string
+		for _, check := range byResource[asset.Type+"/"+asset.ID] {
+			blocking := check.Severity == validation.SeverityError || (result.Strict && check.Severity == validation.SeverityWarning)
+			if blocking {
+				if testCase.Failure == "" {
+					testCase.Failure = check.ID
+				}
+				failures = append(failures, check.Message)
+			} else {
+				notes = append(notes, string(check.Severity)+": "+check.Message)
+			}
+		}
+		testCase.Message = strings.Join(failures, "; ")
+		testCase.SystemOut = strings.Join(notes, "\n")
+		report.Tests = append(report.Tests, testCase)
+	}
+	return report
+}
+
+func severityRank(severity validation.Severity) int {
+	switch severity {
+	case validation.SeverityError:
+		return 3
+	case validation.SeverityWarning:
+		return 2
+	case validation.SeverityInfo:
+		return 1
+	}
+	return 0
+}
+
+func assetLabel(resourceType string) string {
+	switch resourceType {
+	case asc.SigningAuditCertificate:
+		return "Certificate"
+	case asc.SigningAuditProfile:
+		return "Profile"
+	}
+	return resourceType
+}
+
+// parseSigningTime accepts the timestamp formats App Store Connect uses for
+// certificate and profile expiration dates.
+func parseSigningTime(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.000-0700", "2006-01-02T15:04:05-0700"} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+	return ""
+}
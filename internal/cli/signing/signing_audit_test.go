@@ -0,0 +1,288 @@
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"howett.net/plist"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+func TestAuditSigningAssets(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	active := newAuditTestCertificate(t, "Apple Distribution: Active", 1)
+	revoked := newAuditTestCertificate(t, "Apple Development: Revoked", 2)
+
+	input := signingAuditInput{
+		certificates: []asc.Resource[asc.CertificateAttributes]{
+			{ID: "CERT_ACTIVE", Attributes: asc.CertificateAttributes{
+				Name:               "Active",
+				CertificateType:    "DISTRIBUTION",
+				ExpirationDate:     "2027-01-01T00:00:00.000+00:00",
+				CertificateContent: base64.StdEncoding.EncodeToString(active.Raw),
+			}},
+			{ID: "CERT_SOON", Attributes: asc.CertificateAttributes{
+				Name:            "Soon",
+				CertificateType: "DISTRIBUTION",
+				ExpirationDate:  "2026-03-11T00:00:00.000+0000",
+			}},
+		},
+		profiles: []asc.Resource[asc.ProfileAttributes]{
+			{ID: "PROFILE_STORE", Attributes: asc.ProfileAttributes{
+				Name:           "Store",
+				ProfileType:    "IOS_APP_STORE",
+				ProfileState:   asc.ProfileStateActive,
+				ExpirationDate: "2027-01-01T00:00:00Z",
+				ProfileContent: auditTestProfileContent(t, "com.example.app", nil, active.Raw),
+			}},
+			{ID: "PROFILE_DEV", Attributes: asc.ProfileAttributes{
+				Name:           "Dev",
+				ProfileType:    "IOS_APP_DEVELOPMENT",
+				ProfileState:   "INVALID",
+				ExpirationDate: "2026-02-01T00:00:00Z",
+				ProfileContent: auditTestProfileContent(t, "com.example.app", []string{"UDID-ENABLED", "udid-disabled", "UDID-GONE"}, revoked.Raw),
+			}},
+		},
+		bundleIDs: []asc.Resource[asc.BundleIDAttributes]{
+			{ID: "BUNDLE_APP", Attributes: asc.BundleIDAttributes{Identifier: "com.example.app", Platform: "IOS"}},
+			{ID: "BUNDLE_OLD", Attributes: asc.BundleIDAttributes{Identifier: "com.example.old", Platform: "IOS"}},
+		},
+		devices: []asc.Resource[asc.DeviceAttributes]{
+			{ID: "D1", Attributes: asc.DeviceAttributes{Name: "Enabled", UDID: "UDID-ENABLED", Status: asc.DeviceStatusEnabled}},
+			{ID: "D2", Attributes: asc.DeviceAttributes{Name: "Disabled", UDID: "UDID-DISABLED", Status: asc.DeviceStatusDisabled}},
+		},
+	}
+
+	result := auditSigningAssets(input, now, 30, false)
+
+	wantChecks := map[string]string{
+		"signing.certificate.expiring":        "CERT_SOON",
+		"signing.profile.expired":             "PROFILE_DEV",
+		"signing.profile.invalid":             "PROFILE_DEV",
+		"signing.profile.revoked_certificate": "PROFILE_DEV",
+		"signing.profile.disabled_device":     "PROFILE_DEV",
+		"signing.profile.removed_device":      "PROFILE_DEV",
+		"signing.bundleId.no_profile":         "BUNDLE_OLD",
+	}
+	if len(result.Checks) != len(wantChecks) {
+		t.Fatalf("expected %d checks, got %+v", len(wantChecks), result.Checks)
+	}
+	for _, check := range result.Checks {
+		if wantChecks[check.ID] != check.ResourceID {
+			t.Fatalf("unexpected check %s for %s", check.ID, check.ResourceID)
+		}
+	}
+	if result.Summary.Errors != 4 || result.Summary.Warnings != 3 || result.Summary.Blocking != 4 {
+		t.Fatalf("unexpected summary: %+v", result.Summary)
+	}
+	if result.Checks[0].Severity != validation.SeverityError {
+		t.Fatalf("expected errors to sort first, got %+v", result.Checks[0])
+	}
+
+	statuses := map[string]string{}
+	for _, asset := range result.Assets {
+		statuses[asset.ID] = asset.Status
+	}
+	wantStatuses := map[string]string{
+		"CERT_ACTIVE":   "ok",
+		"CERT_SOON":     "error",
+		"PROFILE_STORE": "ok",
+		"PROFILE_DEV":   "error",
+		"BUNDLE_APP":    "ok",
+		"BUNDLE_OLD":    "warning",
+	}
+	for id, want := range wantStatuses {
+		if statuses[id] != want {
+			t.Fatalf("expected %s status %q, got %q", id, want, statuses[id])
+		}
+	}
+
+	strict := auditSigningAssets(input, now, 30, true)
+	if strict.Summary.Blocking != 7 {
+		t.Fatalf("expected warnings to block in strict mode, got %+v", strict.Summary)
+	}
+}
+
+func TestAuditSigningAssetsWildcardProfile(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cert := newAuditTestCertificate(t, "Apple Development: Wildcard", 3)
+
+	input := signingAuditInput{
+		certificates: []asc.Resource[asc.CertificateAttributes]{
+			{ID: "CERT", Attributes: asc.CertificateAttributes{
+				Name:               "Wildcard",
+				ExpirationDate:     "2027-01-01T00:00:00Z",
+				CertificateContent: base64.StdEncoding.EncodeToString(cert.Raw),
+			}},
+		},
+		profiles: []asc.Resource[asc.ProfileAttributes]{
+			{ID: "PROFILE_WILDCARD", Attributes: asc.ProfileAttributes{
+				Name:           "Wildcard",
+				ProfileType:    "IOS_APP_DEVELOPMENT",
+				ProfileState:   asc.ProfileStateActive,
+				ExpirationDate: "2027-01-01T00:00:00Z",
+				ProfileContent: auditTestProfileContent(t, "com.example.*", nil, cert.Raw),
+			}},
+		},
+		bundleIDs: []asc.Resource[asc.BundleIDAttributes]{
+			{ID: "BUNDLE_APP", Attributes: asc.BundleIDAttributes{Identifier: "com.example.app", Platform: "IOS"}},
+			{ID: "BUNDLE_WIDGET", Attributes: asc.BundleIDAttributes{Identifier: "com.example.app.widget", Platform: "IOS"}},
+			{ID: "BUNDLE_OTHER", Attributes: asc.BundleIDAttributes{Identifier: "org.other.app", Platform: "IOS"}},
+		},
+	}
+
+	result := auditSigningAssets(input, now, 30, false)
+	if len(result.Checks) != 1 || result.Checks[0].ID != "signing.bundleId.no_profile" || result.Checks[0].ResourceID != "BUNDLE_OTHER" {
+		t.Fatalf("expected only the bundle ID outside the wildcard to lack a profile, got %+v", result.Checks)
+	}
+}
+
+func TestAuditSigningAssetsBrokenProfilesDoNotCover(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cert := newAuditTestCertificate(t, "Apple Distribution: Broken", 4)
+	profile := func(id, bundleID string, state asc.ProfileState, expires string) asc.Resource[asc.ProfileAttributes] {
+		return asc.Resource[asc.ProfileAttributes]{ID: id, Attributes: asc.ProfileAttributes{
+			Name:           id,
+			ProfileType:    "IOS_APP_STORE",
+			ProfileState:   state,
+			ExpirationDate: expires,
+			ProfileContent: auditTestProfileContent(t, bundleID, nil, cert.Raw),
+		}}
+	}
+
+	input := signingAuditInput{
+		certificates: []asc.Resource[asc.CertificateAttributes]{
+			{ID: "CERT", Attributes: asc.CertificateAttributes{
+				Name:               "Broken",
+				ExpirationDate:     "2027-01-01T00:00:00Z",
+				CertificateContent: base64.StdEncoding.EncodeToString(cert.Raw),
+			}},
+		},
+		profiles: []asc.Resource[asc.ProfileAttributes]{
+			profile("PROFILE_INVALID", "com.example.invalid", "INVALID", "2027-01-01T00:00:00Z"),
+			profile("PROFILE_EXPIRED", "com.example.expired", asc.ProfileStateActive, "2026-02-01T00:00:00Z"),
+			profile("PROFILE_OK", "com.example.ok", asc.ProfileStateActive, "2027-01-01T00:00:00Z"),
+		},
+		bundleIDs: []asc.Resource[asc.BundleIDAttributes]{
+			{ID: "BUNDLE_INVALID", Attributes: asc.BundleIDAttributes{Identifier: "com.example.invalid", Platform: "IOS"}},
+			{ID: "BUNDLE_EXPIRED", Attributes: asc.BundleIDAttributes{Identifier: "com.example.expired", Platform: "IOS"}},
+			{ID: "BUNDLE_OK", Attributes: asc.BundleIDAttributes{Identifier: "com.example.ok", Platform: "IOS"}},
+		},
+	}
+
+	result := auditSigningAssets(input, now, 30, false)
+	var uncovered []string
+	for _, check := range result.Checks {
+		if check.ID == "signing.bundleId.no_profile" {
+			uncovered = append(uncovered, check.ResourceID)
+		}
+	}
+	slices.Sort(uncovered)
+	if want := []string{"BUNDLE_EXPIRED", "BUNDLE_INVALID"}; !slices.Equal(uncovered, want) {
+		t.Fatalf("expected bundle IDs with only broken profiles to lack a profile, got %v", uncovered)
+	}
+}
+
+func TestSigningAuditJUnitReport(t *testing.T) {
+	result := &asc.SigningAuditResult{
+		Assets: []asc.SigningAuditAsset{
+			{Type: asc.SigningAuditCertificate, ID: "CERT_1", Name: "Dist", Status: "error"},
+			{Type: asc.SigningAuditBundleID, ID: "BUNDLE_1", Name: "com.example.old", Status: "warning"},
+			{Type: asc.SigningAuditProfile, ID: "PROFILE_1", Name: "Store", Status: "ok"},
+		},
+		Checks: []validation.CheckResult{
+			{ID: "signing.certificate.expiring", Severity: validation.SeverityError, ResourceType: asc.SigningAuditCertificate, ResourceID: "CERT_1", Message: "expires soon"},
+			{ID: "signing.bundleId.no_profile", Severity: validation.SeverityWarning, ResourceType: asc.SigningAuditBundleID, ResourceID: "BUNDLE_1", Message: "no profile"},
+		},
+	}
+
+	report := signingAuditJUnitReport(result, time.Now())
+	if len(report.Tests) != 3 {
+		t.Fatalf("expected 3 test cases, got %d", len(report.Tests))
+	}
+	if report.Tests[0].Failure != "signing.certificate.expiring" || report.Tests[0].Message != "expires soon" {
+		t.Fatalf("expected certificate failure, got %+v", report.Tests[0])
+	}
+	if report.Tests[1].Failure != "" || !strings.Contains(report.Tests[1].SystemOut, "no profile") {
+		t.Fatalf("expected non-blocking warning in system-out, got %+v", report.Tests[1])
+	}
+
+	result.Strict = true
+	report = signingAuditJUnitReport(result, time.Now())
+	if report.Tests[1].Failure != "signing.bundleId.no_profile" {
+		t.Fatalf("expected warning to fail in strict mode, got %+v", report.Tests[1])
+	}
+	data, err := report.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	if !strings.Contains(string(data), `failures="2"`) {
+		t.Fatalf("expected 2 failures, got:\n%s", data)
+	}
+}
+
+func TestParseSigningTime(t *testing.T) {
+	for _, value := range []string{"2026-03-01T00:00:00Z", "2026-03-01T00:00:00.000+00:00", "2026-03-01T00:00:00.000+0000"} {
+		parsed, ok := parseSigningTime(value)
+		if !ok || !parsed.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Fatalf("parseSigningTime(%q) = %v, %t", value, parsed, ok)
+		}
+	}
+	if _, ok := parseSigningTime("soon"); ok {
+		t.Fatal("expected invalid timestamp to fail")
+	}
+}
+
+func newAuditTestCertificate(t *testing.T, commonName string, serial int64) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return cert
+}
+
+// auditTestProfileContent returns base64 profile content. The plist is left
+// unsigned, which the profile parser accepts.
+func auditTestProfileContent(t *testing.T, bundleID string, devices []string, certs ...[]byte) string {
+	t.Helper()
+	fields := map[string]any{
+		"Name":                        bundleID,
+		"ApplicationIdentifierPrefix": []string{"TEAM123456"},
+		"DeveloperCertificates":       certs,
+		"Entitlements":                map[string]any{"application-identifier": "TEAM123456." + bundleID},
+	}
+	if len(devices) > 0 {
+		fields["ProvisionedDevices"] = devices
+	}
+	data, err := plist.Marshal(fields, plist.XMLFormat)
+	if err != nil {
+		t.Fatalf("marshal plist: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
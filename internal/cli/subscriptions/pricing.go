@@ -54,7 +54,7 @@ func SubscriptionsPricingCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	subscriptionID := fs.String("subscription-id", "", "Subscription ID")
 	territory := fs.String("territory", "USA", "Territory for pricing (e.g., USA)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -472,45 +472,28 @@ func territoryToCurrency(territory string) string {
 }
 
 func printSubscriptionPricingResult(result *subscriptionPricingResult, format string, pretty bool) error {
-	switch strings.ToLower(strings.TrimSpace(format)) {
-	case "json":
-		return shared.PrintOutput(result, "json", pretty)
+	format = strings.ToLower(strings.TrimSpace(format))
+	var render func([]string, [][]string)
+	switch format {
 	case "table":
-		if pretty {
-			return fmt.Errorf("--pretty is only valid with JSON output")
-		}
-		return printSubscriptionPricingTable(result)
+		render = asc.RenderTable
 	case "markdown", "md":
-		if pretty {
-			return fmt.Errorf("--pretty is only valid with JSON output")
-		}
-		return printSubscriptionPricingMarkdown(result)
+		render = asc.RenderMarkdown
+	case "csv":
+		render = asc.RenderCSV
+	case "tsv":
+		render = asc.RenderTSV
 	default:
-		return fmt.Errorf("unsupported format: %s", format)
+		return shared.PrintOutput(result, format, pretty)
 	}
-}
-
-func printSubscriptionPricingTable(result *subscriptionPricingResult) error {
-	headers := []string{"ID", "Name", "Product ID", "Period", "State", "Group", "Current Price", "Proceeds", "Proceeds Y2"}
-	rows := make([][]string, 0, len(result.Subscriptions))
-	for _, item := range result.Subscriptions {
-		rows = append(rows, []string{
-			item.ID,
-			compactSubText(item.Name),
-			item.ProductID,
-			item.SubscriptionPeriod,
-			item.State,
-			compactSubText(item.GroupName),
-			formatSubMoney(item.CurrentPrice),
-			formatSubMoney(item.Proceeds),
-			formatSubMoney(item.ProceedsYear2),
-		})
+	if pretty {
+		return fmt.Errorf("--pretty is only valid with JSON output")
 	}
-	asc.RenderTable(headers, rows)
+	render(subscriptionPricingRows(result))
 	return nil
 }
 
-func printSubscriptionPricingMarkdown(result *subscriptionPricingResult) error {
+func subscriptionPricingRows(result *subscriptionPricingResult) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Product ID", "Period", "State", "Group", "Current Price", "Proceeds", "Proceeds Y2"}
 	rows := make([][]string, 0, len(result.Subscriptions))
 	for _, item := range result.Subscriptions {
@@ -526,8 +509,7 @@ func printSubscriptionPricingMarkdown(result *subscriptionPricingResult) error {
 			formatSubMoney(item.ProceedsYear2),
 		})
 	}
-	asc.RenderMarkdown(headers, rows)
-	return nil
+	return headers, rows
 }
 
 func formatSubMoney(value *subMoney) string {
@@ -39,7 +39,7 @@ func SubscriptionsPromotedPurchaseGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("promoted-purchase get", flag.ExitOnError)
 
 	subscriptionID := fs.String("id", "", "Subscription ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
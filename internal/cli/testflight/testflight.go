@@ -86,7 +86,7 @@ func TestFlightAppsListCommand() *ffcli.Command {
 	bundleID := fs.String("bundle-id", "", "Filter by bundle ID(s), comma-separated")
 	name := fs.String("name", "", "Filter by app name(s), comma-separated")
 	sku := fs.String("sku", "", "Filter by SKU(s), comma-separated")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	sort := fs.String("sort", "", "Sort by name or -name, bundleId or -bundleId")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
@@ -149,7 +149,7 @@ Examples:
 				}
 
 				// Fetch all remaining pages
-				apps, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				apps, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, *output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetApps(ctx, asc.WithAppsNextURL(nextURL))
 				})
 				if err != nil {
@@ -174,7 +174,7 @@ func TestFlightAppsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (required)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
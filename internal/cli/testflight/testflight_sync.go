@@ -94,11 +94,13 @@ func TestFlightSyncCommand() *ffcli.Command {
 		LongHelp: `Sync TestFlight configuration.
 
 Examples:
-  asc testflight sync pull --app "APP_ID" --output "./testflight.yaml"`,
+  asc testflight sync pull --app "APP_ID" --output "./testflight.yaml"
+  asc testflight sync push --file "./testflight.yaml" --dry-run`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			TestFlightSyncPullCommand(),
+			TestFlightSyncPushCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
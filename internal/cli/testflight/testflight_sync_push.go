@@ -0,0 +1,726 @@
+package testflight
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	syncActionCreateGroup  = "create-group"
+	syncActionUpdateGroup  = "update-group"
+	syncActionCreateTester = "create-tester"
+	syncActionAddTester    = "add-tester"
+	syncActionRemoveTester = "remove-tester"
+	syncActionAddBuild     = "add-build"
+	syncActionRemoveBuild  = "remove-build"
+
+	maxPublicLinkLimit = 10000
+)
+
+type testFlightPushClient interface {
+	testFlightSyncClient
+	CreateBetaGroupWithAttributes(ctx context.Context, appID string, attrs asc.BetaGroupAttributes) (*asc.BetaGroupResponse, error)
+	UpdateBetaGroup(ctx context.Context, groupID string, req asc.BetaGroupUpdateRequest) (*asc.BetaGroupResponse, error)
+	GetBetaTesters(ctx context.Context, appID string, opts ...asc.BetaTestersOption) (*asc.BetaTestersResponse, error)
+	CreateBetaTester(ctx context.Context, email, firstName, lastName string, groupIDs []string) (*asc.BetaTesterResponse, error)
+	AddBetaTestersToGroup(ctx context.Context, groupID string, testerIDs []string) error
+	RemoveBetaTestersFromGroup(ctx context.Context, groupID string, testerIDs []string) error
+	AddBetaGroupsToBuild(ctx context.Context, buildID string, groupIDs []string) error
+	RemoveBetaGroupsFromBuild(ctx context.Context, buildID string, groupIDs []string) error
+}
+
+// testFlightPushGroup is a group from the file and the name it is reported by.
+type testFlightPushGroup struct {
+	shared.PlannedResource[TestFlightGroupConfig]
+	name string
+}
+
+type testFlightPushStep struct {
+	action  string
+	group   *testFlightPushGroup
+	groups  []*testFlightPushGroup
+	tester  TestFlightTesterConfig
+	target  string
+	details string
+	update  *asc.BetaGroupUpdateAttributes
+}
+
+// TestFlightSyncPushCommand applies a TestFlight YAML config to App Store Connect.
+func TestFlightSyncPushCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("push", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env; defaults to app.id in the file)")
+	file := fs.String("file", "", "Path to TestFlight YAML config (required)")
+	dryRun := fs.Bool("dry-run", false, "Print the plan without applying changes")
+	prune := fs.Bool("prune", false, "Remove testers and builds that are not listed in the file")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "push",
+		ShortUsage: "asc testflight sync push --file ./testflight.yaml [flags]",
+		ShortHelp:  "Apply a TestFlight YAML config.",
+		LongHelp: `Apply a TestFlight YAML config.
+
+Compares the file (in the format written by "sync pull") with the live
+TestFlight state, prints the plan, and applies it:
+  - creates missing beta groups and updates public link, link limit,
+    and feedback settings
+  - adds testers to groups, creating testers that do not exist yet
+  - assigns builds to groups
+
+Groups match by ID, or by name when the ID is omitted. Testers match by ID,
+or by email when the ID is omitted. Group references in testers and builds
+may use group IDs or names. Tester memberships are only managed when the
+file has a testers section, and build assignments only when it lists builds.
+
+With --prune, testers and builds not listed for a group in the file are
+removed from it. Groups missing from the file are never deleted.
+
+Examples:
+  asc testflight sync push --file "./testflight.yaml" --dry-run
+  asc testflight sync push --file "./testflight.yaml"
+  asc testflight sync push --app "APP_ID" --file "./testflight.yaml" --prune --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			fileValue := strings.TrimSpace(*file)
+			if fileValue == "" {
+				fmt.Fprintf(os.Stderr, "Error: --file is required\n\n")
+				return flag.ErrHelp
+			}
+
+			config, err := readTestFlightConfigYAML(fileValue)
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			resolvedAppID := shared.ResolveAppID(*appID)
+			fileAppID := strings.TrimSpace(config.App.ID)
+			switch {
+			case resolvedAppID == "":
+				resolvedAppID = fileAppID
+			case fileAppID != "" && fileAppID != resolvedAppID:
+				return fmt.Errorf("testflight sync push: app %q does not match app.id %q in %s", resolvedAppID, fileAppID, fileValue)
+			}
+			if resolvedAppID == "" {
+				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID or app.id in the file)\n\n")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			steps, err := planTestFlightPush(requestCtx, client, resolvedAppID, config, *prune)
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			var applyErr error
+			actions := testFlightPushActions(steps)
+			if !*dryRun {
+				applyErr = applyTestFlightPush(requestCtx, client, resolvedAppID, steps, actions)
+			}
+
+			result := &asc.TestFlightSyncPushResult{
+				File:    filepath.Clean(fileValue),
+				AppID:   resolvedAppID,
+				DryRun:  *dryRun,
+				Prune:   *prune,
+				Actions: actions,
+			}
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if applyErr != nil {
+				return fmt.Errorf("testflight sync push: %w", applyErr)
+			}
+			return nil
+		},
+	}
+}
+
+func readTestFlightConfigYAML(path string) (*TestFlightConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var config TestFlightConfig
+	if err := decoder.Decode(&config); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("config %s is empty", path)
+		}
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	if err := validateTestFlightPushConfig(&config); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return &config, nil
+}
+
+func validateTestFlightPushConfig(config *TestFlightConfig) error {
+	names := make(map[string]struct{}, len(config.Groups))
+	for i, group := range config.Groups {
+		name := strings.TrimSpace(group.Name)
+		if name == "" && strings.TrimSpace(group.ID) == "" {
+			return fmt.Errorf("groups[%d] needs an id or name", i)
+		}
+		if name != "" {
+			key := strings.ToLower(name)
+			if _, ok := names[key]; ok {
+				return fmt.Errorf("group %q is listed more than once", name)
+			}
+			names[key] = struct{}{}
+		}
+		if group.PublicLinkLimit != nil {
+			if *group.PublicLinkLimit < 1 || *group.PublicLinkLimit > maxPublicLinkLimit {
+				return fmt.Errorf("group %q publicLinkLimit must be between 1 and %d", groupLabel(group), maxPublicLinkLimit)
+			}
+			if group.IsInternalGroup {
+				return fmt.Errorf("group %q is internal and cannot have a public link limit", groupLabel(group))
+			}
+		}
+		if group.IsInternalGroup && group.PublicLinkEnabled {
+			return fmt.Errorf("group %q is internal and cannot have a public link", groupLabel(group))
+		}
+	}
+	for i, tester := range config.Testers {
+		if strings.TrimSpace(tester.ID) == "" && strings.TrimSpace(tester.Email) == "" {
+			return fmt.Errorf("testers[%d] needs an id or email", i)
+		}
+	}
+	for i, build := range config.Builds {
+		if strings.TrimSpace(build.ID) == "" {
+			return fmt.Errorf("builds[%d] needs an id", i)
+		}
+	}
+	return nil
+}
+
+func groupLabel(group TestFlightGroupConfig) string {
+	if name := strings.TrimSpace(group.Name); name != "" {
+		return name
+	}
+	return strings.TrimSpace(group.ID)
+}
+
+// planTestFlightPush diffs the desired config against live state and returns
+// the ordered steps needed to converge.
+func planTestFlightPush(ctx context.Context, client testFlightPushClient, appID string, config *TestFlightConfig, prune bool) ([]*testFlightPushStep, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+
+	groupFirstPage, err := client.GetBetaGroups(ctx, appID, asc.WithBetaGroupsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("fetch beta groups: %w", err)
+	}
+	liveGroups, err := paginateBetaGroups(ctx, client, appID, groupFirstPage)
+	if err != nil {
+		return nil, fmt.Errorf("fetch beta groups: %w", err)
+	}
+
+	groups := make([]*testFlightPushGroup, 0, len(config.Groups))
+	var groupSteps []*testFlightPushStep
+	for _, cfg := range config.Groups {
+		live, err := matchLiveBetaGroup(liveGroups.Data, cfg)
+		if err != nil {
+			return nil, err
+		}
+		group := &testFlightPushGroup{PlannedResource: shared.PlannedResource[TestFlightGroupConfig]{Config: cfg}, name: groupLabel(cfg)}
+		groups = append(groups, group)
+		if live == nil {
+			groupSteps = append(groupSteps, &testFlightPushStep{
+				action:  syncActionCreateGroup,
+				group:   group,
+				details: describeNewGroup(cfg),
+			})
+			continue
+		}
+		group.ID = live.ID
+		if group.name == live.ID {
+			group.name = live.Attributes.Name
+		}
+		if cfg.IsInternalGroup != live.Attributes.IsInternalGroup {
+			return nil, fmt.Errorf("group %q: isInternalGroup cannot be changed after creation", group.name)
+		}
+		if update, changes := betaGroupUpdateFor(cfg, live.Attributes); update != nil {
+			groupSteps = append(groupSteps, &testFlightPushStep{
+				action:  syncActionUpdateGroup,
+				group:   group,
+				details: strings.Join(changes, "; "),
+				update:  update,
+			})
+		}
+	}
+
+	steps := groupSteps
+	if config.Testers != nil {
+		testerSteps, err := planTesterSteps(ctx, client, appID, config.Testers, groups, prune)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, testerSteps...)
+	}
+	if buildsManaged(config) {
+		buildSteps, err := planBuildSteps(ctx, client, config, groups, prune)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, buildSteps...)
+	}
+	return steps, nil
+}
+
+func matchLiveBetaGroup(live []asc.Resource[asc.BetaGroupAttributes], cfg TestFlightGroupConfig) (*asc.Resource[asc.BetaGroupAttributes], error) {
+	if id := strings.TrimSpace(cfg.ID); id != "" {
+		for i := range live {
+			if live[i].ID == id {
+				return &live[i], nil
+			}
+		}
+		return nil, fmt.Errorf("beta group %q not found", id)
+	}
+
+	name := strings.TrimSpace(cfg.Name)
+	var match *asc.Resource[asc.BetaGroupAttributes]
+	for i := range live {
+		if !strings.EqualFold(strings.TrimSpace(live[i].Attributes.Name), name) {
+			continue
+		}
+		if match != nil {
+			return nil, fmt.Errorf("multiple beta groups named %q; use group ID", name)
+		}
+		match = &live[i]
+	}
+	return match, nil
+}
+
+// betaGroupUpdateFor returns the update needed to make live match cfg, or nil
+// when they already agree, along with a description of each change.
+func betaGroupUpdateFor(cfg TestFlightGroupConfig, live asc.BetaGroupAttributes) (*asc.BetaGroupUpdateAttributes, []string) {
+	update := &asc.BetaGroupUpdateAttributes{}
+	var changes []string
+
+	if name := strings.TrimSpace(cfg.Name); name != "" && name != live.Name {
+		update.Name = name
+		changes = append(changes, fmt.Sprintf("name: %q -> %q", live.Name, name))
+	}
+	if !cfg.IsInternalGroup {
+		if cfg.PublicLinkEnabled != live.PublicLinkEnabled {
+			update.PublicLinkEnabled = boolPtr(cfg.PublicLinkEnabled)
+			changes = append(changes, fmt.Sprintf("publicLinkEnabled: %t -> %t", live.PublicLinkEnabled, cfg.PublicLinkEnabled))
+		}
+		wantLimit := cfg.PublicLinkLimit != nil
+		if wantLimit != live.PublicLinkLimitEnabled || (wantLimit && *cfg.PublicLinkLimit != live.PublicLinkLimit) {
+			update.PublicLinkLimitEnabled = boolPtr(wantLimit)
+			if wantLimit {
+				update.PublicLinkLimit = *cfg.PublicLinkLimit
+			}
+			changes = append(changes, fmt.Sprintf("publicLinkLimit: %s -> %s",
+				formatPublicLinkLimit(live.PublicLinkLimitEnabled, live.PublicLinkLimit),
+				formatPublicLinkLimit(wantLimit, update.PublicLinkLimit)))
+		}
+	}
+	if cfg.FeedbackEnabled != live.FeedbackEnabled {
+		update.FeedbackEnabled = boolPtr(cfg.FeedbackEnabled)
+		changes = append(changes, fmt.Sprintf("feedbackEnabled: %t -> %t", live.FeedbackEnabled, cfg.FeedbackEnabled))
+	}
+
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return update, changes
+}
+
+func formatPublicLinkLimit(enabled bool, limit int) string {
+	if !enabled {
+		return "none"
+	}
+	return fmt.Sprintf("%d", limit)
+}
+
+func describeNewGroup(cfg TestFlightGroupConfig) string {
+	parts := []string{fmt.Sprintf("internal=%t", cfg.IsInternalGroup)}
+	if !cfg.IsInternalGroup {
+		parts = append(parts, fmt.Sprintf("publicLinkEnabled=%t", cfg.PublicLinkEnabled))
+		if cfg.PublicLinkLimit != nil {
+			parts = append(parts, fmt.Sprintf("publicLinkLimit=%d", *cfg.PublicLinkLimit))
+		}
+	}
+	parts = append(parts, fmt.Sprintf("feedbackEnabled=%t", cfg.FeedbackEnabled))
+	return strings.Join(parts, ", ")
+}
+
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+// resolveGroupRef finds a group from the file by ID or name.
+func resolveGroupRef(groups []*testFlightPushGroup, ref string) (*testFlightPushGroup, error) {
+	ref = strings.TrimSpace(ref)
+	for _, group := range groups {
+		if group.ID != "" && group.ID == ref {
+			return group, nil
+		}
+		if strings.TrimSpace(group.Config.ID) == ref {
+			return group, nil
+		}
+	}
+	for _, group := range groups {
+		if strings.EqualFold(group.name, ref) {
+			return group, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown group %q (groups must be listed under groups)", ref)
+}
+
+func planTesterSteps(ctx context.Context, client testFlightPushClient, appID string, testers []TestFlightTesterConfig, groups []*testFlightPushGroup, prune bool) ([]*testFlightPushStep, error) {
+	liveMembers := make(map[*testFlightPushGroup]map[string]asc.BetaTesterAttributes)
+	testerIDsByEmail := make(map[string]string)
+	for _, group := range groups {
+		members := make(map[string]asc.BetaTesterAttributes)
+		liveMembers[group] = members
+		if group.ID == "" {
+			continue
+		}
+		firstPage, err := client.GetBetaGroupTesters(ctx, group.ID, asc.WithBetaGroupTestersLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("fetch beta group testers: %w", err)
+		}
+		resp, err := paginateBetaGroupTesters(ctx, client, group.ID, firstPage)
+		if err != nil {
+			return nil, fmt.Errorf("fetch beta group testers: %w", err)
+		}
+		for _, tester := range resp.Data {
+			members[tester.ID] = tester.Attributes
+			if email := strings.ToLower(strings.TrimSpace(tester.Attributes.Email)); email != "" {
+				testerIDsByEmail[email] = tester.ID
+			}
+		}
+	}
+
+	var createSteps, addSteps, removeSteps []*testFlightPushStep
+	desired := make(map[*testFlightPushGroup]map[string]struct{})
+	for _, tester := range testers {
+		testerGroups := make([]*testFlightPushGroup, 0, len(tester.Groups))
+		for _, ref := range uniqueSortedStrings(tester.Groups) {
+			group, err := resolveGroupRef(groups, ref)
+			if err != nil {
+				return nil, fmt.Errorf("tester %q: %w", testerLabel(tester), err)
+			}
+			testerGroups = append(testerGroups, group)
+		}
+
+		testerID, err := resolveTesterID(ctx, client, appID, tester, testerIDsByEmail)
+		if err != nil {
+			return nil, err
+		}
+		if testerID == "" {
+			if len(testerGroups) == 0 {
+				continue
+			}
+			createSteps = append(createSteps, &testFlightPushStep{
+				action:  syncActionCreateTester,
+				groups:  testerGroups,
+				tester:  tester,
+				target:  testerLabel(tester),
+				details: "groups: " + strings.Join(groupNames(testerGroups), ", "),
+			})
+			continue
+		}
+		tester.ID = testerID
+
+		for _, group := range testerGroups {
+			if desired[group] == nil {
+				desired[group] = make(map[string]struct{})
+			}
+			desired[group][testerID] = struct{}{}
+			if _, ok := liveMembers[group][testerID]; ok {
+				continue
+			}
+			addSteps = append(addSteps, &testFlightPushStep{
+				action: syncActionAddTester,
+				group:  group,
+				tester: tester,
+				target: testerLabel(tester),
+			})
+		}
+	}
+
+	if prune {
+		for _, group := range groups {
+			ids := make([]string, 0, len(liveMembers[group]))
+			for id := range liveMembers[group] {
+				if _, ok := desired[group][id]; !ok {
+					ids = append(ids, id)
+				}
+			}
+			sort.Strings(ids)
+			for _, id := range ids {
+				attrs := liveMembers[group][id]
+				tester := TestFlightTesterConfig{ID: id, Email: attrs.Email}
+				removeSteps = append(removeSteps, &testFlightPushStep{
+					action: syncActionRemoveTester,
+					group:  group,
+					tester: tester,
+					target: testerLabel(tester),
+				})
+			}
+		}
+	}
+
+	steps := append(createSteps, addSteps...)
+	return append(steps, removeSteps...), nil
+}
+
+// resolveTesterID returns the live ID for a tester, looking it up by email
+// when needed. An empty ID means the tester does not exist yet.
+func resolveTesterID(ctx context.Context, client testFlightPushClient, appID string, tester TestFlightTesterConfig, idsByEmail map[string]string) (string, error) {
+	if id := strings.TrimSpace(tester.ID); id != "" {
+		return id, nil
+	}
+	email := strings.TrimSpace(tester.Email)
+	if id, ok := idsByEmail[strings.ToLower(email)]; ok {
+		return id, nil
+	}
+	resp, err := client.GetBetaTesters(ctx, appID, asc.WithBetaTestersEmail(email), asc.WithBetaTestersLimit(1))
+	if err != nil {
+		return "", fmt.Errorf("look up tester %q: %w", email, err)
+	}
+	for _, item := range resp.Data {
+		if strings.EqualFold(strings.TrimSpace(item.Attributes.Email), email) {
+			idsByEmail[strings.ToLower(email)] = item.ID
+			return item.ID, nil
+		}
+	}
+	return "", nil
+}
+
+func testerLabel(tester TestFlightTesterConfig) string {
+	if email := strings.TrimSpace(tester.Email); email != "" {
+		return email
+	}
+	return strings.TrimSpace(tester.ID)
+}
+
+func groupNames(groups []*testFlightPushGroup) []string {
+	names := make([]string, 0, len(groups))
+	for _, group := range groups {
+		names = append(names, group.name)
+	}
+	return names
+}
+
+func buildsManaged(config *TestFlightConfig) bool {
+	if config.Builds != nil {
+		return true
+	}
+	for _, group := range config.Groups {
+		if group.Builds != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func planBuildSteps(ctx context.Context, client testFlightPushClient, config *TestFlightConfig, groups []*testFlightPushGroup, prune bool) ([]*testFlightPushStep, error) {
+	desired := make(map[*testFlightPushGroup][]string)
+	for _, group := range groups {
+		desired[group] = append(desired[group], group.Config.Builds...)
+	}
+	for _, build := range config.Builds {
+		for _, ref := range build.Groups {
+			group, err := resolveGroupRef(groups, ref)
+			if err != nil {
+				return nil, fmt.Errorf("build %q: %w", build.ID, err)
+			}
+			desired[group] = append(desired[group], build.ID)
+		}
+	}
+
+	var addSteps, removeSteps []*testFlightPushStep
+	for _, group := range groups {
+		live := make(map[string]struct{})
+		if group.ID != "" {
+			firstPage, err := client.GetBetaGroupBuilds(ctx, group.ID, asc.WithBetaGroupBuildsLimit(200))
+			if err != nil {
+				return nil, fmt.Errorf("fetch beta group builds: %w", err)
+			}
+			resp, err := paginateBetaGroupBuilds(ctx, client, group.ID, firstPage)
+			if err != nil {
+				return nil, fmt.Errorf("fetch beta group builds: %w", err)
+			}
+			for _, build := range resp.Data {
+				live[build.ID] = struct{}{}
+			}
+		}
+
+		wanted := uniqueSortedStrings(desired[group])
+		wantedSet := make(map[string]struct{}, len(wanted))
+		for _, buildID := range wanted {
+			wantedSet[buildID] = struct{}{}
+			if _, ok := live[buildID]; ok {
+				continue
+			}
+			addSteps = append(addSteps, &testFlightPushStep{action: syncActionAddBuild, group: group, target: buildID})
+		}
+		if !prune {
+			continue
+		}
+		stale := make([]string, 0)
+		for buildID := range live {
+			if _, ok := wantedSet[buildID]; !ok {
+				stale = append(stale, buildID)
+			}
+		}
+		sort.Strings(stale)
+		for _, buildID := range stale {
+			removeSteps = append(removeSteps, &testFlightPushStep{action: syncActionRemoveBuild, group: group, target: buildID})
+		}
+	}
+	return append(addSteps, removeSteps...), nil
+}
+
+func testFlightPushActions(steps []*testFlightPushStep) []asc.TestFlightSyncAction {
+	actions := make([]asc.TestFlightSyncAction, 0, len(steps))
+	for _, step := range steps {
+		action := asc.TestFlightSyncAction{
+			Action:  step.action,
+			Target:  step.target,
+			Details: step.details,
+			Status:  shared.StepStatusPlanned,
+		}
+		if step.group != nil {
+			action.Group = step.group.name
+		}
+		actions = append(actions, action)
+	}
+	return actions
+}
+
+// applyTestFlightPush runs steps in order and records each outcome in actions.
+// It stops at the first failure and marks the remaining steps as skipped.
+func applyTestFlightPush(ctx context.Context, client testFlightPushClient, appID string, steps []*testFlightPushStep, actions []asc.TestFlightSyncAction) error {
+	failed, err := shared.ApplySteps(len(steps), func(i int) error {
+		return applyTestFlightPushStep(ctx, client, appID, steps[i])
+	}, func(i int, status string, err error) {
+		actions[i].Status = status
+		if err != nil {
+			actions[i].Error = err.Error()
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", steps[failed].action, actionSubject(actions[failed]), err)
+	}
+	return nil
+}
+
+func actionSubject(action asc.TestFlightSyncAction) string {
+	switch {
+	case action.Target != "" && action.Group != "":
+		return fmt.Sprintf("%q in group %q", action.Target, action.Group)
+	case action.Target != "":
+		return fmt.Sprintf("%q", action.Target)
+	default:
+		return fmt.Sprintf("%q", action.Group)
+	}
+}
+
+func applyTestFlightPushStep(ctx context.Context, client testFlightPushClient, appID string, step *testFlightPushStep) error {
+	switch step.action {
+	case syncActionCreateGroup:
+		return createPushGroup(ctx, client, appID, step.group)
+	case syncActionUpdateGroup:
+		_, err := client.UpdateBetaGroup(ctx, step.group.ID, betaGroupUpdateRequest(step.group.ID, step.update))
+		return err
+	case syncActionCreateTester:
+		groupIDs := make([]string, 0, len(step.groups))
+		for _, group := range step.groups {
+			groupIDs = append(groupIDs, group.ID)
+		}
+		firstName, lastName := splitTesterName(step.tester.Name)
+		_, err := client.CreateBetaTester(ctx, step.tester.Email, firstName, lastName, groupIDs)
+		return err
+	case syncActionAddTester:
+		return client.AddBetaTestersToGroup(ctx, step.group.ID, []string{step.tester.ID})
+	case syncActionRemoveTester:
+		return client.RemoveBetaTestersFromGroup(ctx, step.group.ID, []string{step.tester.ID})
+	case syncActionAddBuild:
+		return client.AddBetaGroupsToBuild(ctx, step.target, []string{step.group.ID})
+	case syncActionRemoveBuild:
+		return client.RemoveBetaGroupsFromBuild(ctx, step.target, []string{step.group.ID})
+	default:
+		return fmt.Errorf("unknown action %q", step.action)
+	}
+}
+
+// createPushGroup creates a group and patches any settings the create
+// response does not already reflect.
+func createPushGroup(ctx context.Context, client testFlightPushClient, appID string, group *testFlightPushGroup) error {
+	cfg := group.Config
+	attrs := asc.BetaGroupAttributes{
+		Name:            strings.TrimSpace(cfg.Name),
+		IsInternalGroup: cfg.IsInternalGroup,
+		FeedbackEnabled: cfg.FeedbackEnabled,
+	}
+	if !cfg.IsInternalGroup {
+		attrs.PublicLinkEnabled = cfg.PublicLinkEnabled
+		if cfg.PublicLinkLimit != nil {
+			attrs.PublicLinkLimitEnabled = true
+			attrs.PublicLinkLimit = *cfg.PublicLinkLimit
+		}
+	}
+	created, err := client.CreateBetaGroupWithAttributes(ctx, appID, attrs)
+	if err != nil {
+		return err
+	}
+	if created == nil || strings.TrimSpace(created.Data.ID) == "" {
+		return fmt.Errorf("create response is missing the group ID")
+	}
+	group.ID = created.Data.ID
+
+	if update, _ := betaGroupUpdateFor(cfg, created.Data.Attributes); update != nil {
+		if _, err := client.UpdateBetaGroup(ctx, group.ID, betaGroupUpdateRequest(group.ID, update)); err != nil {
+			return fmt.Errorf("update new group settings: %w", err)
+		}
+	}
+	return nil
+}
+
+func betaGroupUpdateRequest(groupID string, attrs *asc.BetaGroupUpdateAttributes) asc.BetaGroupUpdateRequest {
+	return asc.BetaGroupUpdateRequest{
+		Data: asc.BetaGroupUpdateData{
+			Type:       asc.ResourceTypeBetaGroups,
+			ID:         groupID,
+			Attributes: attrs,
+		},
+	}
+}
+
+// splitTesterName splits "First Last" as written by sync pull.
+func splitTesterName(name string) (string, string) {
+	first, last, _ := strings.Cut(strings.TrimSpace(name), " ")
+	return strings.TrimSpace(first), strings.TrimSpace(last)
+}
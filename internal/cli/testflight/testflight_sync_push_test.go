@@ -0,0 +1,317 @@
+package testflight
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type testFlightPushStub struct {
+	testFlightSyncStub
+	testersByEmail map[string]string
+	failAction     string
+	calls          []string
+}
+
+func (s *testFlightPushStub) CreateBetaGroupWithAttributes(ctx context.Context, appID string, attrs asc.BetaGroupAttributes) (*asc.BetaGroupResponse, error) {
+	s.calls = append(s.calls, "create-group "+attrs.Name)
+	if s.failAction == "create-group" {
+		return nil, errors.New("boom")
+	}
+	// Simulate the API ignoring feedbackEnabled on create.
+	attrs.FeedbackEnabled = false
+	return &asc.BetaGroupResponse{Data: asc.Resource[asc.BetaGroupAttributes]{ID: "new-" + attrs.Name, Attributes: attrs}}, nil
+}
+
+func (s *testFlightPushStub) UpdateBetaGroup(ctx context.Context, groupID string, req asc.BetaGroupUpdateRequest) (*asc.BetaGroupResponse, error) {
+	s.calls = append(s.calls, "update-group "+groupID)
+	return &asc.BetaGroupResponse{}, nil
+}
+
+func (s *testFlightPushStub) GetBetaTesters(ctx context.Context, appID string, opts ...asc.BetaTestersOption) (*asc.BetaTestersResponse, error) {
+	resp := &asc.BetaTestersResponse{}
+	for email, id := range s.testersByEmail {
+		resp.Data = append(resp.Data, asc.Resource[asc.BetaTesterAttributes]{ID: id, Attributes: asc.BetaTesterAttributes{Email: email}})
+	}
+	return resp, nil
+}
+
+func (s *testFlightPushStub) CreateBetaTester(ctx context.Context, email, firstName, lastName string, groupIDs []string) (*asc.BetaTesterResponse, error) {
+	s.calls = append(s.calls, "create-tester "+email+" "+firstName+"/"+lastName+" "+strings.Join(groupIDs, ","))
+	return &asc.BetaTesterResponse{}, nil
+}
+
+func (s *testFlightPushStub) AddBetaTestersToGroup(ctx context.Context, groupID string, testerIDs []string) error {
+	s.calls = append(s.calls, "add-tester "+groupID+" "+strings.Join(testerIDs, ","))
+	return nil
+}
+
+func (s *testFlightPushStub) RemoveBetaTestersFromGroup(ctx context.Context, groupID string, testerIDs []string) error {
+	s.calls = append(s.calls, "remove-tester "+groupID+" "+strings.Join(testerIDs, ","))
+	return nil
+}
+
+func (s *testFlightPushStub) AddBetaGroupsToBuild(ctx context.Context, buildID string, groupIDs []string) error {
+	s.calls = append(s.calls, "add-build "+buildID+" "+strings.Join(groupIDs, ","))
+	return nil
+}
+
+func (s *testFlightPushStub) RemoveBetaGroupsFromBuild(ctx context.Context, buildID string, groupIDs []string) error {
+	s.calls = append(s.calls, "remove-build "+buildID+" "+strings.Join(groupIDs, ","))
+	return nil
+}
+
+func newTestFlightPushStub() *testFlightPushStub {
+	return &testFlightPushStub{
+		testFlightSyncStub: testFlightSyncStub{
+			groups: &asc.BetaGroupsResponse{
+				Data: []asc.Resource[asc.BetaGroupAttributes]{
+					{
+						ID: "group-1",
+						Attributes: asc.BetaGroupAttributes{
+							Name:              "Beta",
+							PublicLinkEnabled: true,
+							FeedbackEnabled:   true,
+						},
+					},
+				},
+			},
+			buildsByGroup: map[string]*asc.BuildsResponse{
+				"group-1": {Data: []asc.Resource[asc.BuildAttributes]{{ID: "build-old"}}},
+			},
+			testersByGroup: map[string]*asc.BetaTestersResponse{
+				"group-1": {
+					Data: []asc.Resource[asc.BetaTesterAttributes]{
+						{ID: "tester-1", Attributes: asc.BetaTesterAttributes{Email: "ada@example.com"}},
+						{ID: "tester-gone", Attributes: asc.BetaTesterAttributes{Email: "gone@example.com"}},
+					},
+				},
+			},
+		},
+		testersByEmail: map[string]string{"grace@example.com": "tester-2"},
+	}
+}
+
+func newTestFlightPushConfig() *TestFlightConfig {
+	limit := 50
+	return &TestFlightConfig{
+		App: TestFlightAppConfig{ID: "app-1"},
+		Groups: []TestFlightGroupConfig{
+			{ID: "group-1", Name: "Beta", PublicLinkEnabled: true, PublicLinkLimit: &limit, FeedbackEnabled: true, Builds: []string{"build-1"}},
+			{Name: "Partners", FeedbackEnabled: true},
+		},
+		Testers: []TestFlightTesterConfig{
+			{Email: "ada@example.com", Groups: []string{"group-1"}},
+			{Email: "grace@example.com", Groups: []string{"Beta", "Partners"}},
+			{Email: "new@example.com", Name: "New Person", Groups: []string{"Partners"}},
+		},
+	}
+}
+
+func TestPlanTestFlightPush(t *testing.T) {
+	tests := []struct {
+		name  string
+		prune bool
+		want  []string
+	}{
+		{
+			name: "without prune",
+			want: []string{
+				"update-group Beta publicLinkLimit: none -> 50",
+				"create-group Partners internal=false, publicLinkEnabled=false, feedbackEnabled=true",
+				"create-tester new@example.com groups: Partners",
+				"add-tester Beta grace@example.com",
+				"add-tester Partners grace@example.com",
+				"add-build Beta build-1",
+			},
+		},
+		{
+			name:  "with prune",
+			prune: true,
+			want: []string{
+				"update-group Beta publicLinkLimit: none -> 50",
+				"create-group Partners internal=false, publicLinkEnabled=false, feedbackEnabled=true",
+				"create-tester new@example.com groups: Partners",
+				"add-tester Beta grace@example.com",
+				"add-tester Partners grace@example.com",
+				"remove-tester Beta gone@example.com",
+				"add-build Beta build-1",
+				"remove-build Beta build-old",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			steps, err := planTestFlightPush(context.Background(), newTestFlightPushStub(), "app-1", newTestFlightPushConfig(), test.prune)
+			if err != nil {
+				t.Fatalf("planTestFlightPush() error: %v", err)
+			}
+			got := make([]string, 0, len(steps))
+			for _, action := range testFlightPushActions(steps) {
+				got = append(got, strings.Join(strings.Fields(strings.Join([]string{action.Action, action.Group, action.Target, action.Details}, " ")), " "))
+			}
+			if strings.Join(got, "\n") != strings.Join(test.want, "\n") {
+				t.Fatalf("unexpected plan:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(test.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestApplyTestFlightPushUsesCreatedGroupIDs(t *testing.T) {
+	stub := newTestFlightPushStub()
+	steps, err := planTestFlightPush(context.Background(), stub, "app-1", newTestFlightPushConfig(), false)
+	if err != nil {
+		t.Fatalf("planTestFlightPush() error: %v", err)
+	}
+	actions := testFlightPushActions(steps)
+	if err := applyTestFlightPush(context.Background(), stub, "app-1", steps, actions); err != nil {
+		t.Fatalf("applyTestFlightPush() error: %v", err)
+	}
+
+	want := []string{
+		"update-group group-1",
+		"create-group Partners",
+		"update-group new-Partners",
+		"create-tester new@example.com New/Person new-Partners",
+		"add-tester group-1 tester-2",
+		"add-tester new-Partners tester-2",
+		"add-build build-1 group-1",
+	}
+	if strings.Join(stub.calls, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected calls:\n%s\nwant:\n%s", strings.Join(stub.calls, "\n"), strings.Join(want, "\n"))
+	}
+	for _, action := range actions {
+		if action.Status != shared.StepStatusApplied {
+			t.Fatalf("expected all actions applied, got %+v", action)
+		}
+	}
+}
+
+func TestApplyTestFlightPushStopsOnFailure(t *testing.T) {
+	stub := newTestFlightPushStub()
+	stub.failAction = "create-group"
+	steps, err := planTestFlightPush(context.Background(), stub, "app-1", newTestFlightPushConfig(), false)
+	if err != nil {
+		t.Fatalf("planTestFlightPush() error: %v", err)
+	}
+	actions := testFlightPushActions(steps)
+	err = applyTestFlightPush(context.Background(), stub, "app-1", steps, actions)
+	if err == nil || !strings.Contains(err.Error(), `create-group "Partners"`) {
+		t.Fatalf("expected create-group error, got %v", err)
+	}
+	wantStatuses := []string{shared.StepStatusApplied, shared.StepStatusFailed}
+	for len(wantStatuses) < len(actions) {
+		wantStatuses = append(wantStatuses, shared.StepStatusSkipped)
+	}
+	for i, action := range actions {
+		if action.Status != wantStatuses[i] {
+			t.Fatalf("action %d: expected status %q, got %+v", i, wantStatuses[i], action)
+		}
+	}
+	if actions[1].Error != "boom" {
+		t.Fatalf("expected failure to be recorded, got %+v", actions[1])
+	}
+}
+
+func TestPlanTestFlightPushErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *TestFlightConfig
+		wantErr string
+	}{
+		{
+			name:    "unknown group id",
+			config:  &TestFlightConfig{Groups: []TestFlightGroupConfig{{ID: "missing"}}},
+			wantErr: `beta group "missing" not found`,
+		},
+		{
+			name:    "internal flag change",
+			config:  &TestFlightConfig{Groups: []TestFlightGroupConfig{{Name: "Beta", IsInternalGroup: true}}},
+			wantErr: "isInternalGroup cannot be changed",
+		},
+		{
+			name: "tester references unknown group",
+			config: &TestFlightConfig{
+				Groups:  []TestFlightGroupConfig{{Name: "Beta"}},
+				Testers: []TestFlightTesterConfig{{ID: "tester-1", Groups: []string{"Nope"}}},
+			},
+			wantErr: `unknown group "Nope"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := planTestFlightPush(context.Background(), newTestFlightPushStub(), "app-1", test.config, false)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestReadTestFlightConfigYAML(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "valid",
+			content: "app:\n  id: app-1\ngroups:\n  - name: Beta\n    publicLinkLimit: 10\n",
+		},
+		{
+			name:    "unknown field",
+			content: "groups:\n  - name: Beta\n    publicLink: true\n",
+			wantErr: "field publicLink not found",
+		},
+		{
+			name:    "duplicate group",
+			content: "groups:\n  - name: Beta\n  - name: beta\n",
+			wantErr: `group "beta" is listed more than once`,
+		},
+		{
+			name:    "limit out of range",
+			content: "groups:\n  - name: Beta\n    publicLinkLimit: 0\n",
+			wantErr: "publicLinkLimit must be between 1 and 10000",
+		},
+		{
+			name:    "tester without identity",
+			content: "groups:\n  - name: Beta\ntesters:\n  - groups: [Beta]\n",
+			wantErr: "testers[0] needs an id or email",
+		},
+		{
+			name:    "empty file",
+			content: "",
+			wantErr: "is empty",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "testflight.yaml")
+			if err := os.WriteFile(path, []byte(test.content), 0o600); err != nil {
+				t.Fatalf("write config: %v", err)
+			}
+			config, err := readTestFlightConfigYAML(path)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readTestFlightConfigYAML() error: %v", err)
+			}
+			if config.App.ID != "app-1" || len(config.Groups) != 1 || *config.Groups[0].PublicLinkLimit != 10 {
+				t.Fatalf("unexpected config: %+v", config)
+			}
+		})
+	}
+}
@@ -2,14 +2,17 @@ package validate
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
 )
 
 // ValidateCommand returns the asc validate command.
@@ -20,13 +23,15 @@ func ValidateCommand() *ffcli.Command {
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
 	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
 	strict := fs.Bool("strict", false, "Treat warnings as errors (exit non-zero)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	rulesPath := fs.String("rules", "", "Rules file path (default: "+validation.DefaultRulesPath+" when present)")
+	format := fs.String("format", "", "Report format: sarif (SARIF 2.1.0 JSON, findings placed on the rules file; overrides --output)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "validate",
 		ShortUsage: "asc validate --app \"APP_ID\" --version-id \"VERSION_ID\" [flags]",
-		ShortHelp:  "Validate metadata, screenshots, and age ratings before submission.",
+		ShortHelp:  "Validate metadata, screenshots, age ratings, and in-app purchases before submission.",
 		LongHelp: `Validate pre-submission readiness for an App Store version.
 
 Checks:
@@ -34,11 +39,43 @@ Checks:
   - Required fields and localizations
   - Screenshot size compatibility
   - Age rating completeness
+  - In-app purchase and subscription readiness (state, review screenshot,
+    localizations, and subscription prices in every available territory)
+
+If in-app purchases or subscriptions cannot be fetched, for example with an
+API key that has no access to them, a monetization.unavailable warning is
+reported instead of failing the run.
+
+A rules file (.asc/validate.yaml by default) can turn checks off, change
+their severity, suppress findings per locale, field, or resource with a
+justification, and add custom regex rules:
+
+  checks:
+    screenshots.*:
+      severity: warning
+    metadata.required.whats_new:
+      enabled: false
+  suppressions:
+    - id: metadata.length.keywords
+      locale: ja
+      justification: Keyword limits are reviewed manually for Japanese
+  custom:
+    - id: custom.banned_words
+      pattern: (?i)\b(free|best)\b
+      fields: [description, subtitle]
+      severity: error
+      message: Avoid pricing claims and superlatives
+
+--format sarif reports each finding against the App Store Connect resource
+it concerns. Code scanning only annotates files, so every result is placed on
+line 1 of the rules file in use (.asc/validate.yaml when none is loaded).
 
 Examples:
   asc validate --app "APP_ID" --version-id "VERSION_ID"
   asc validate --app "APP_ID" --version-id "VERSION_ID" --platform IOS --output table
-  asc validate --app "APP_ID" --version-id "VERSION_ID" --strict`,
+  asc validate --app "APP_ID" --version-id "VERSION_ID" --strict
+  asc validate --app "APP_ID" --version-id "VERSION_ID" --rules ci/validate.yaml
+  asc validate --app "APP_ID" --version-id "VERSION_ID" --format sarif > validate.sarif`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -62,14 +99,46 @@ Examples:
 				normalizedPlatform = value
 			}
 
+			formatValue := strings.ToLower(strings.TrimSpace(*format))
+			if formatValue != "" && formatValue != formatSARIF {
+				return fmt.Errorf("validate: unsupported --format %q (use sarif)", *format)
+			}
+
+			rules, rulesFile, err := loadRules(strings.TrimSpace(*rulesPath))
+			if err != nil {
+				return fmt.Errorf("validate: %w", err)
+			}
+
 			return runValidate(ctx, validateOptions{
 				AppID:     resolvedAppID,
 				VersionID: strings.TrimSpace(*versionID),
 				Platform:  normalizedPlatform,
 				Strict:    *strict,
+				Rules:     rules,
+				RulesFile: rulesFile,
+				Format:    formatValue,
 				Output:    *output,
 				Pretty:    *pretty,
 			})
 		},
 	}
 }
+
+// loadRules reads the rules file named by --rules, or the default rules file
+// when it exists. It returns the path that was loaded, if any.
+func loadRules(path string) (*validation.Rules, string, error) {
+	if path == "" {
+		if _, err := os.Stat(validation.DefaultRulesPath); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil, "", nil
+			}
+			return nil, "", fmt.Errorf("read rules: %w", err)
+		}
+		path = validation.DefaultRulesPath
+	}
+	rules, err := validation.LoadRules(path)
+	if err != nil {
+		return nil, "", err
+	}
+	return rules, filepath.ToSlash(path), nil
+}
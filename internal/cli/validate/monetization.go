@@ -0,0 +1,289 @@
+package validate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+// openReviewSubmissionStates are the review submission states whose items
+// still go to App Review.
+var openReviewSubmissionStates = []string{
+	string(asc.ReviewSubmissionStateReadyForReview),
+	string(asc.ReviewSubmissionStateWaitingForReview),
+	string(asc.ReviewSubmissionStateInReview),
+	string(asc.ReviewSubmissionStateUnresolvedIssues),
+}
+
+// fetchMonetization fetches the app's in-app purchases and subscriptions and
+// marks the ones attached to an open review submission for platform.
+func fetchMonetization(ctx context.Context, client *asc.Client, appID string, platform string) ([]validation.InAppPurchase, []validation.Subscription, error) {
+	iaps, err := fetchInAppPurchases(ctx, client, appID)
+	if err != nil {
+		return nil, nil, err
+	}
+	subscriptions, err := fetchSubscriptions(ctx, client, appID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	needsAttachment := false
+	for _, iap := range iaps {
+		needsAttachment = needsAttachment || readyToSubmit(iap.State)
+	}
+	for _, sub := range subscriptions {
+		needsAttachment = needsAttachment || readyToSubmit(sub.State)
+	}
+	if !needsAttachment {
+		return iaps, subscriptions, nil
+	}
+
+	attached, err := fetchAttachedProducts(ctx, client, appID, platform)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range iaps {
+		_, iaps[i].Attached = attached[iaps[i].ID]
+	}
+	for i := range subscriptions {
+		_, subscriptions[i].Attached = attached[subscriptions[i].ID]
+	}
+	return iaps, subscriptions, nil
+}
+
+func readyToSubmit(state string) bool {
+	return strings.EqualFold(strings.TrimSpace(state), "READY_TO_SUBMIT")
+}
+
+// fetchAttachedProducts returns the IDs of in-app purchases and subscriptions
+// that are items of the app's open review submissions for platform.
+func fetchAttachedProducts(ctx context.Context, client *asc.Client, appID string, platform string) (map[string]struct{}, error) {
+	opts := []asc.ReviewSubmissionsOption{
+		asc.WithReviewSubmissionsStates(openReviewSubmissionStates),
+		asc.WithReviewSubmissionsLimit(200),
+	}
+	if strings.TrimSpace(platform) != "" {
+		opts = append(opts, asc.WithReviewSubmissionsPlatforms([]string{platform}))
+	}
+	firstPage, err := client.GetReviewSubmissions(ctx, appID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch review submissions: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetReviewSubmissions(ctx, appID, asc.WithReviewSubmissionsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch review submissions: %w", err)
+	}
+	submissions, ok := paginated.(*asc.ReviewSubmissionsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected review submissions response type %T", paginated)
+	}
+
+	attached := make(map[string]struct{})
+	for _, submission := range submissions.Data {
+		firstItems, err := client.GetReviewSubmissionItems(ctx, submission.ID,
+			asc.WithReviewSubmissionItemsInclude([]string{"inAppPurchaseV2", "subscription"}),
+			asc.WithReviewSubmissionItemsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch items for review submission %s: %w", submission.ID, err)
+		}
+		paginatedItems, err := asc.PaginateAll(ctx, firstItems, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return client.GetReviewSubmissionItems(ctx, submission.ID, asc.WithReviewSubmissionItemsNextURL(nextURL))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch items for review submission %s: %w", submission.ID, err)
+		}
+		items, ok := paginatedItems.(*asc.ReviewSubmissionItemsResponse)
+		if !ok {
+			return nil, fmt.Errorf("unexpected review submission items response type %T", paginatedItems)
+		}
+		for _, item := range items.Data {
+			if item.Relationships == nil {
+				continue
+			}
+			for _, rel := range []*asc.Relationship{item.Relationships.InAppPurchaseV2, item.Relationships.Subscription} {
+				if rel != nil && strings.TrimSpace(rel.Data.ID) != "" {
+					attached[strings.TrimSpace(rel.Data.ID)] = struct{}{}
+				}
+			}
+		}
+	}
+	return attached, nil
+}
+
+// fetchInAppPurchases lists the app's in-app purchases. Review screenshots
+// and localizations are only fetched for products still pending review.
+func fetchInAppPurchases(ctx context.Context, client *asc.Client, appID string) ([]validation.InAppPurchase, error) {
+	resources, err := shared.FetchAllPages(ctx, "in-app purchases",
+		func(ctx context.Context) (*asc.InAppPurchasesV2Response, error) {
+			return client.GetInAppPurchasesV2(ctx, appID, asc.WithIAPLimit(200))
+		},
+		func(ctx context.Context, next string) (*asc.InAppPurchasesV2Response, error) {
+			return client.GetInAppPurchasesV2(ctx, appID, asc.WithIAPNextURL(next))
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	iaps := make([]validation.InAppPurchase, 0, len(resources))
+	for _, resource := range resources {
+		attrs := resource.Attributes
+		iap := validation.InAppPurchase{
+			ID:        resource.ID,
+			Name:      attrs.Name,
+			ProductID: attrs.ProductID,
+			Type:      attrs.InAppPurchaseType,
+			State:     attrs.State,
+		}
+		if validation.MonetizationPendingReview(attrs.State) {
+			locsResp, err := client.GetInAppPurchaseLocalizations(ctx, resource.ID, asc.WithIAPLocalizationsLimit(200))
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch localizations for in-app purchase %s: %w", resource.ID, err)
+			}
+			for _, loc := range locsResp.Data {
+				iap.Locales = append(iap.Locales, loc.Attributes.Locale)
+			}
+
+			screenshotResp, err := client.GetInAppPurchaseAppStoreReviewScreenshotForIAP(ctx, resource.ID)
+			if err != nil && !asc.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to fetch review screenshot for in-app purchase %s: %w", resource.ID, err)
+			}
+			iap.HasReviewScreenshot = err == nil && strings.TrimSpace(screenshotResp.Data.ID) != ""
+		}
+		iaps = append(iaps, iap)
+	}
+	return iaps, nil
+}
+
+// fetchSubscriptions lists subscriptions across all of the app's groups.
+// Pending subscriptions also get their review screenshot, localizations,
+// available territories, and priced territories.
+func fetchSubscriptions(ctx context.Context, client *asc.Client, appID string) ([]validation.Subscription, error) {
+	groups, err := shared.FetchAllPages(ctx, "subscription groups",
+		func(ctx context.Context) (*asc.SubscriptionGroupsResponse, error) {
+			return client.GetSubscriptionGroups(ctx, appID, asc.WithSubscriptionGroupsLimit(200))
+		},
+		func(ctx context.Context, next string) (*asc.SubscriptionGroupsResponse, error) {
+			return client.GetSubscriptionGroups(ctx, appID, asc.WithSubscriptionGroupsNextURL(next))
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	var subscriptions []validation.Subscription
+	for _, group := range groups {
+		resources, err := shared.FetchAllPages(ctx, "subscriptions for group "+group.ID,
+			func(ctx context.Context) (*asc.SubscriptionsResponse, error) {
+				return client.GetSubscriptions(ctx, group.ID, asc.WithSubscriptionsLimit(200))
+			},
+			func(ctx context.Context, next string) (*asc.SubscriptionsResponse, error) {
+				return client.GetSubscriptions(ctx, group.ID, asc.WithSubscriptionsNextURL(next))
+			})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range resources {
+			attrs := resource.Attributes
+			sub := validation.Subscription{
+				ID:        resource.ID,
+				Name:      attrs.Name,
+				ProductID: attrs.ProductID,
+				GroupName: group.Attributes.ReferenceName,
+				State:     attrs.State,
+			}
+			if validation.MonetizationPendingReview(attrs.State) {
+				if err := fetchSubscriptionDetails(ctx, client, &sub); err != nil {
+					return nil, err
+				}
+			}
+			subscriptions = append(subscriptions, sub)
+		}
+	}
+	return subscriptions, nil
+}
+
+func fetchSubscriptionDetails(ctx context.Context, client *asc.Client, sub *validation.Subscription) error {
+	locsResp, err := client.GetSubscriptionLocalizations(ctx, sub.ID, asc.WithSubscriptionLocalizationsLimit(200))
+	if err != nil {
+		return fmt.Errorf("failed to fetch localizations for subscription %s: %w", sub.ID, err)
+	}
+	for _, loc := range locsResp.Data {
+		sub.Locales = append(sub.Locales, loc.Attributes.Locale)
+	}
+
+	screenshotResp, err := client.GetSubscriptionAppStoreReviewScreenshotForSubscription(ctx, sub.ID)
+	if err != nil && !asc.IsNotFound(err) {
+		return fmt.Errorf("failed to fetch review screenshot for subscription %s: %w", sub.ID, err)
+	}
+	sub.HasReviewScreenshot = err == nil && strings.TrimSpace(screenshotResp.Data.ID) != ""
+
+	availabilityResp, err := client.GetSubscriptionAvailabilityForSubscription(ctx, sub.ID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to fetch availability for subscription %s: %w", sub.ID, err)
+	}
+	availabilityID := strings.TrimSpace(availabilityResp.Data.ID)
+	if availabilityID == "" {
+		return nil
+	}
+	sub.HasAvailability = true
+
+	territories, err := shared.FetchAllPages(ctx, "available territories for subscription "+sub.ID,
+		func(ctx context.Context) (*asc.TerritoriesResponse, error) {
+			return client.GetSubscriptionAvailabilityAvailableTerritories(ctx, availabilityID, asc.WithSubscriptionAvailabilityTerritoriesLimit(200))
+		},
+		func(ctx context.Context, next string) (*asc.TerritoriesResponse, error) {
+			return client.GetSubscriptionAvailabilityAvailableTerritories(ctx, availabilityID, asc.WithSubscriptionAvailabilityTerritoriesNextURL(next))
+		})
+	if err != nil {
+		return err
+	}
+	for _, territory := range territories {
+		sub.AvailableTerritories = append(sub.AvailableTerritories, territory.ID)
+	}
+
+	prices, err := shared.FetchAllPages(ctx, "prices for subscription "+sub.ID,
+		func(ctx context.Context) (*asc.SubscriptionPricesResponse, error) {
+			return client.GetSubscriptionPrices(ctx, sub.ID,
+				asc.WithSubscriptionPricesLimit(200),
+				asc.WithSubscriptionPricesInclude([]string{"territory"}))
+		},
+		func(ctx context.Context, next string) (*asc.SubscriptionPricesResponse, error) {
+			return client.GetSubscriptionPrices(ctx, sub.ID, asc.WithSubscriptionPricesNextURL(next))
+		})
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]struct{}, len(prices))
+	for _, price := range prices {
+		if len(price.Relationships) == 0 {
+			continue
+		}
+		var relationships asc.SubscriptionPriceRelationships
+		if err := json.Unmarshal(price.Relationships, &relationships); err != nil {
+			return fmt.Errorf("decode relationships for subscription price %s: %w", price.ID, err)
+		}
+		if relationships.Territory == nil {
+			continue
+		}
+		territoryID := strings.TrimSpace(relationships.Territory.Data.ID)
+		if territoryID == "" {
+			continue
+		}
+		if _, ok := seen[territoryID]; ok {
+			continue
+		}
+		seen[territoryID] = struct{}{}
+		sub.PricedTerritories = append(sub.PricedTerritories, territoryID)
+	}
+	return nil
+}
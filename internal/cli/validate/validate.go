@@ -10,11 +10,16 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
 )
 
+const formatSARIF = "sarif"
+
 type validateOptions struct {
 	AppID     string
 	VersionID string
 	Platform  string
 	Strict    bool
+	Rules     *validation.Rules
+	RulesFile string
+	Format    string
 	Output    string
 	Pretty    bool
 }
@@ -106,7 +111,15 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 		platform = string(versionResp.Data.Attributes.Platform)
 	}
 
-	report := validation.Validate(validation.Input{
+	// Monetization checks are best effort: keys without access to in-app
+	// purchases should still get the metadata and screenshot checks.
+	var monetizationErr string
+	inAppPurchases, subscriptions, err := fetchMonetization(requestCtx, client, opts.AppID, platform)
+	if err != nil {
+		monetizationErr = err.Error()
+	}
+
+	report := validation.ValidateWithRules(validation.Input{
 		AppID:                opts.AppID,
 		VersionID:            opts.VersionID,
 		VersionString:        versionResp.Data.Attributes.VersionString,
@@ -116,9 +129,22 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 		AppInfoLocalizations: appInfoLocalizations,
 		ScreenshotSets:       screenshotSets,
 		AgeRatingDeclaration: ageRatingDecl,
-	}, opts.Strict)
-
-	if err := shared.PrintOutput(&report, opts.Output, opts.Pretty); err != nil {
+		InAppPurchases:       inAppPurchases,
+		Subscriptions:        subscriptions,
+		MonetizationError:    monetizationErr,
+	}, opts.Strict, opts.Rules)
+
+	if opts.Format == formatSARIF {
+		sarif := validation.SARIF(report, opts.RulesFile)
+		if opts.Pretty {
+			err = asc.PrintPrettyJSON(sarif)
+		} else {
+			err = asc.PrintJSON(sarif)
+		}
+		if err != nil {
+			return err
+		}
+	} else if err := shared.PrintOutput(&report, opts.Output, opts.Pretty); err != nil {
 		return err
 	}
 
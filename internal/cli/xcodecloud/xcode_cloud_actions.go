@@ -18,7 +18,7 @@ func xcodeCloudActionsListFlags(fs *flag.FlagSet) (runID *string, limit *int, ne
 	limit = fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next = fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate = fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output = fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output = fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty = fs.Bool("pretty", false, "Pretty-print JSON output")
 	return
 }
@@ -87,7 +87,7 @@ func XcodeCloudActionsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Build action ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -130,7 +130,7 @@ func XcodeCloudActionsBuildRunCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("build-run", flag.ExitOnError)
 
 	id := fs.String("id", "", "Build action ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -203,7 +203,7 @@ func xcodeCloudActionsList(ctx context.Context, runID string, limit int, next st
 			return fmt.Errorf("xcode-cloud actions: failed to fetch: %w", err)
 		}
 
-		resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		resp, err := asc.PaginateAll(shared.StreamPagesContext(requestCtx, output), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			return client.GetCiBuildActions(ctx, resolvedRunID, asc.WithCiBuildActionsNextURL(nextURL))
 		})
 		if err != nil {
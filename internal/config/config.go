@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -138,6 +140,7 @@ type Config struct {
 	MaxDelay             string        `json:"max_delay"`
 	RetryLog             string        `json:"retry_log"`
 	Debug                string        `json:"debug"`
+	BaseURL              string        `json:"base_url,omitempty"`
 }
 
 // ErrNotFound is returned when the config file doesn't exist
@@ -346,9 +349,50 @@ func (c *Config) Validate() error {
 	if baseSet && maxSet && maxDelay < baseDelay {
 		return wrapInvalidConfig(fmt.Errorf("max_delay must be >= base_delay"))
 	}
+	if err := ValidateBaseURL(c.BaseURL); err != nil {
+		return wrapInvalidConfig(fmt.Errorf("base_url: %w", err))
+	}
 	return nil
 }
 
+// ValidateBaseURL checks that an API base URL override is an absolute URL.
+// HTTPS is required unless the host is a loopback address (e.g. a local mock server).
+func ValidateBaseURL(raw string) error {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return fmt.Errorf("must be an absolute URL")
+	}
+	if parsed.User != nil {
+		return fmt.Errorf("must not include credentials")
+	}
+	if parsed.RawQuery != "" || parsed.Fragment != "" {
+		return fmt.Errorf("must not include a query or fragment")
+	}
+	switch parsed.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if isLoopbackHost(parsed.Hostname()) {
+			return nil
+		}
+		return fmt.Errorf("must use https (http is only allowed for localhost)")
+	default:
+		return fmt.Errorf("must use https")
+	}
+}
+
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 func wrapInvalidConfig(err error) error {
 	return fmt.Errorf("%w: %s", ErrInvalidConfig, err)
 }
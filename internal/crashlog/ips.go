@@ -0,0 +1,157 @@
+package crashlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// parseIPS parses an .ips report: a one-line JSON header followed by the JSON
+// body. A body without its header line is accepted too.
+func parseIPS(text string) (*Report, error) {
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+
+	var first map[string]any
+	if err := decoder.Decode(&first); err != nil {
+		return nil, fmt.Errorf("parse .ips header: %w", err)
+	}
+	report := &Report{Format: FormatIPS, body: first}
+	if !isIPSBody(first) {
+		report.header = strings.TrimSpace(text[:decoder.InputOffset()])
+		var body map[string]any
+		if err := decoder.Decode(&body); err != nil {
+			return nil, fmt.Errorf("parse .ips body: %w", err)
+		}
+		if !isIPSBody(body) {
+			return nil, fmt.Errorf("parse .ips body: no threads or usedImages found")
+		}
+		report.body = body
+	}
+
+	if exception, ok := report.body["exception"].(map[string]any); ok {
+		report.Exception = jsonString(exception["type"])
+		if signal := jsonString(exception["signal"]); signal != "" {
+			report.Exception = strings.TrimSpace(report.Exception + " (" + signal + ")")
+		}
+	}
+
+	for _, item := range jsonList(report.body["usedImages"]) {
+		fields, _ := item.(map[string]any)
+		image := Image{
+			Name:        jsonString(fields["name"]),
+			UUID:        normalizeUUID(jsonString(fields["uuid"])),
+			Arch:        jsonString(fields["arch"]),
+			Path:        jsonString(fields["path"]),
+			LoadAddress: jsonUint(fields["base"]),
+		}
+		image.EndAddress = image.LoadAddress + jsonUint(fields["size"])
+		if image.Name == "" && image.Path != "" {
+			image.Name = path.Base(image.Path)
+		}
+		report.Images = append(report.Images, image)
+	}
+
+	faulting, hasFaulting := report.body["faultingThread"].(json.Number)
+	for i, item := range jsonList(report.body["threads"]) {
+		fields, _ := item.(map[string]any)
+		thread := Thread{Number: i, Name: jsonString(fields["name"])}
+		if thread.Name == "" {
+			thread.Name = jsonString(fields["queue"])
+		}
+		thread.Crashed, _ = fields["triggered"].(bool)
+		if hasFaulting && faulting.String() == strconv.Itoa(i) {
+			thread.Crashed = true
+		}
+		thread.Frames = report.ipsFrames(fields["frames"])
+		report.Threads = append(report.Threads, thread)
+	}
+	if frames := report.ipsFrames(report.body["lastExceptionBacktrace"]); len(frames) > 0 {
+		report.Threads = append(report.Threads, Thread{Number: -1, Name: "Last Exception Backtrace", Frames: frames})
+	}
+	return report, nil
+}
+
+func (r *Report) ipsFrames(value any) []Frame {
+	items := jsonList(value)
+	frames := make([]Frame, 0, len(items))
+	for i, item := range items {
+		fields, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		frame := Frame{Index: i, ImageIndex: -1, Symbol: jsonString(fields["symbol"]), raw: fields, line: -1}
+		if index, err := strconv.Atoi(jsonString(fields["imageIndex"])); err == nil && index >= 0 && index < len(r.Images) {
+			frame.ImageIndex = index
+			frame.Address = r.Images[index].LoadAddress + jsonUint(fields["imageOffset"])
+		}
+		frames = append(frames, frame)
+	}
+	return frames
+}
+
+func (r *Report) renderIPS() ([]byte, error) {
+	for _, thread := range r.Threads {
+		for _, frame := range thread.Frames {
+			if frame.Location == nil || frame.raw == nil {
+				continue
+			}
+			frame.raw["symbol"] = frame.Location.Function
+			frame.raw["symbolLocation"] = frame.Location.Offset
+			if frame.Location.File != "" && frame.Location.Line > 0 {
+				frame.raw["sourceFile"] = path.Base(frame.Location.File)
+				frame.raw["sourceLine"] = frame.Location.Line
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	if r.header != "" {
+		out.WriteString(r.header)
+		out.WriteString("\n")
+	}
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(r.body); err != nil {
+		return nil, fmt.Errorf("encode .ips body: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+func isIPSBody(fields map[string]any) bool {
+	_, hasThreads := fields["threads"]
+	_, hasImages := fields["usedImages"]
+	return hasThreads || hasImages
+}
+
+func jsonList(value any) []any {
+	items, _ := value.([]any)
+	return items
+}
+
+func jsonString(value any) string {
+	switch typed := value.(type) {
+	case string:
+		return typed
+	case json.Number:
+		return typed.String()
+	default:
+		return ""
+	}
+}
+
+func jsonUint(value any) uint64 {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0
+	}
+	parsed, err := strconv.ParseUint(number.String(), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return parsed
+}
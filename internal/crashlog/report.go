@@ -0,0 +1,136 @@
+// Package crashlog parses Apple crash reports and symbolicates them offline
+// against dSYM bundles, without atos or any other Xcode tooling.
+package crashlog
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Format identifies the layout of a crash report.
+type Format string
+
+const (
+	// FormatIPS is the JSON .ips format written since iOS 15 and macOS 12.
+	FormatIPS Format = "ips"
+	// FormatText is the legacy plain-text crash report format.
+	FormatText Format = "text"
+)
+
+// Image is a binary image loaded into the crashed process.
+type Image struct {
+	Name        string
+	UUID        string
+	Arch        string
+	Path        string
+	LoadAddress uint64
+	EndAddress  uint64
+}
+
+// Location is a resolved source location.
+type Location struct {
+	Function string
+	// Offset is the distance in bytes from the start of Function.
+	Offset uint64
+	File   string
+	Line   int
+}
+
+// Frame is one entry of a backtrace.
+type Frame struct {
+	Index      int
+	ImageIndex int
+	Address    uint64
+	// Symbol is the symbol already present in the report, if any.
+	Symbol   string
+	Location *Location
+
+	raw  map[string]any
+	line int
+}
+
+// Thread is a backtrace: a thread of the process, or the last exception
+// backtrace, which has Number -1.
+type Thread struct {
+	Number  int
+	Name    string
+	Crashed bool
+	Frames  []Frame
+}
+
+// Report is a parsed crash report.
+type Report struct {
+	Format Format
+	// Exception is the exception type and signal, e.g. "EXC_CRASH (SIGABRT)".
+	Exception string
+	Images    []Image
+	Threads   []Thread
+
+	header string
+	body   map[string]any
+	lines  []string
+}
+
+// Parse reads a crash report in either the .ips or legacy text format.
+func Parse(data []byte) (*Report, error) {
+	text := strings.TrimSpace(strings.TrimPrefix(string(data), "\ufeff"))
+	if text == "" {
+		return nil, fmt.Errorf("crash report is empty")
+	}
+	if strings.HasPrefix(text, "{") {
+		return parseIPS(text)
+	}
+	return parseText(text)
+}
+
+// Image returns the image a frame belongs to, or nil when it is unknown.
+func (r *Report) Image(frame Frame) *Image {
+	if frame.ImageIndex < 0 || frame.ImageIndex >= len(r.Images) {
+		return nil
+	}
+	return &r.Images[frame.ImageIndex]
+}
+
+// Render returns the report in its original format, with resolved frames
+// rewritten the way Xcode symbolicates them.
+func (r *Report) Render() ([]byte, error) {
+	switch r.Format {
+	case FormatIPS:
+		return r.renderIPS()
+	case FormatText:
+		return r.renderText(), nil
+	default:
+		return nil, fmt.Errorf("unsupported crash report format %q", r.Format)
+	}
+}
+
+// String formats a location as "function + offset (file:line)".
+func (l Location) String() string {
+	text := fmt.Sprintf("%s + %d", l.Function, l.Offset)
+	if l.File != "" && l.Line > 0 {
+		text += fmt.Sprintf(" (%s:%d)", path.Base(l.File), l.Line)
+	}
+	return text
+}
+
+// normalizeUUID returns a UUID as uppercase hex without dashes.
+func normalizeUUID(value string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(value), "-", ""))
+}
+
+// imageForAddress returns the index of the image containing address, falling
+// back to the image called name.
+func imageForAddress(images []Image, address uint64, name string) int {
+	for i, image := range images {
+		if address >= image.LoadAddress && address <= image.EndAddress {
+			return i
+		}
+	}
+	for i, image := range images {
+		if name != "" && image.Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,239 @@
+package crashlog
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const testIPS = `{"app_name":"Demo","bug_type":"309","os_version":"iPhone OS 17.2 (21C62)","bundleID":"com.example.demo","incident_id":"A1B2"}
+{
+  "procName" : "Demo",
+  "faultingThread" : 1,
+  "threads" : [
+    {"id":1,"queue":"com.apple.main-thread","frames":[{"imageOffset":4096,"imageIndex":1,"symbol":"mach_msg2_trap","symbolLocation":8}]},
+    {"id":2,"triggered":true,"frames":[{"imageOffset":13000,"imageIndex":0},{"imageOffset":12500,"imageIndex":0}]}
+  ],
+  "lastExceptionBacktrace" : [{"imageOffset":200,"imageIndex":1,"symbol":"__exceptionPreprocess"}],
+  "usedImages" : [
+    {"source":"P","arch":"arm64","base":4305764352,"size":32768,"uuid":"b8f392e4-a5ae-3cf0-936e-437531307ff7","path":"/private/var/containers/Bundle/Application/X/Demo.app/Demo","name":"Demo"},
+    {"source":"P","arch":"arm64e","base":7000000000,"size":65536,"uuid":"11111111-2222-3333-4444-555555555555","path":"/usr/lib/system/libsystem_kernel.dylib"}
+  ]
+}`
+
+const testText = `Incident Identifier: A1B2
+Hardware Model:      iPhone15,3
+Process:             Demo [123]
+
+Thread 0 name:  Dispatch queue: com.apple.main-thread
+Thread 0:
+0   libsystem_kernel.dylib        	0x00000001a1001000 mach_msg2_trap + 8
+
+Thread 1 Crashed:
+0   Demo                          	0x0000000100a8f2c8 0x100a8c000 + 13000
+1   Demo                          	0x0000000100a8f0d4 0x100a8c000 + 12500
+
+Thread 1 crashed with ARM Thread State (64-bit):
+    x0: 0x0000000000000000   x1: 0x0000000000000001
+
+Binary Images:
+       0x100a8c000 -        0x100a93fff Demo arm64  <b8f392e4a5ae3cf0936e437531307ff7> /private/var/containers/Bundle/Application/X/Demo.app/Demo
+       0x1a1000000 -        0x1a100ffff libsystem_kernel.dylib arm64e  <11111111222233334444555555555555> /usr/lib/system/libsystem_kernel.dylib
+
+EOF`
+
+func TestParseIPS(t *testing.T) {
+	report, err := Parse([]byte(testIPS))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if report.Format != FormatIPS {
+		t.Fatalf("expected ips format, got %q", report.Format)
+	}
+	if len(report.Images) != 2 {
+		t.Fatalf("expected 2 images, got %d", len(report.Images))
+	}
+	image := report.Images[0]
+	if image.Name != "Demo" || image.UUID != "B8F392E4A5AE3CF0936E437531307FF7" || image.LoadAddress != 4305764352 || image.EndAddress != 4305764352+32768 {
+		t.Fatalf("unexpected image: %+v", image)
+	}
+	if report.Images[1].Name != "libsystem_kernel.dylib" {
+		t.Fatalf("expected the name from the path, got %q", report.Images[1].Name)
+	}
+
+	if len(report.Threads) != 3 {
+		t.Fatalf("expected 2 threads and the exception backtrace, got %d", len(report.Threads))
+	}
+	if report.Threads[0].Name != "com.apple.main-thread" || report.Threads[0].Crashed {
+		t.Fatalf("unexpected thread 0: %+v", report.Threads[0])
+	}
+	crashed := report.Threads[1]
+	if !crashed.Crashed || len(crashed.Frames) != 2 {
+		t.Fatalf("unexpected crashed thread: %+v", crashed)
+	}
+	if frame := crashed.Frames[0]; frame.ImageIndex != 0 || frame.Address != 4305764352+13000 || frame.Symbol != "" {
+		t.Fatalf("unexpected frame: %+v", frame)
+	}
+	if exception := report.Threads[2]; exception.Number != -1 || exception.Frames[0].Symbol != "__exceptionPreprocess" {
+		t.Fatalf("unexpected exception backtrace: %+v", exception)
+	}
+}
+
+func TestParseText(t *testing.T) {
+	report, err := Parse([]byte(testText))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if report.Format != FormatText {
+		t.Fatalf("expected text format, got %q", report.Format)
+	}
+	if len(report.Images) != 2 {
+		t.Fatalf("expected 2 images, got %d", len(report.Images))
+	}
+	if image := report.Images[0]; image.Name != "Demo" || image.Arch != "arm64" || image.UUID != "B8F392E4A5AE3CF0936E437531307FF7" || image.LoadAddress != 0x100a8c000 {
+		t.Fatalf("unexpected image: %+v", image)
+	}
+
+	if len(report.Threads) != 2 {
+		t.Fatalf("expected 2 threads, got %d", len(report.Threads))
+	}
+	main := report.Threads[0]
+	if main.Name != "Dispatch queue: com.apple.main-thread" || main.Crashed || main.Frames[0].Symbol != "mach_msg2_trap" || main.Frames[0].ImageIndex != 1 {
+		t.Fatalf("unexpected thread 0: %+v", main)
+	}
+	crashed := report.Threads[1]
+	if !crashed.Crashed || len(crashed.Frames) != 2 {
+		t.Fatalf("unexpected crashed thread: %+v", crashed)
+	}
+	if frame := crashed.Frames[1]; frame.Index != 1 || frame.ImageIndex != 0 || frame.Address != 0x100a8f0d4 || frame.Symbol != "" {
+		t.Fatalf("unexpected frame: %+v", frame)
+	}
+}
+
+func TestParseRejectsUnknownInput(t *testing.T) {
+	for _, input := range []string{"", "hello world", `{"app_name":"Demo"}`} {
+		if _, err := Parse([]byte(input)); err == nil {
+			t.Errorf("Parse(%q) expected error", input)
+		}
+	}
+}
+
+func TestRenderText(t *testing.T) {
+	report, err := Parse([]byte(testText))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	report.Threads[1].Frames[0].Location = &Location{Function: "Demo.crash()", Offset: 20, File: "/src/Demo/Crash.swift", Line: 12}
+	report.Threads[1].Frames[1].Location = &Location{Function: "main", Offset: 52}
+
+	out, err := report.Render()
+	if err != nil {
+		t.Fatalf("Render() error: %v", err)
+	}
+	text := string(out)
+	for _, want := range []string{
+		"0   Demo                          \t0x0000000100a8f2c8 Demo.crash() + 20 (Crash.swift:12)\n",
+		"1   Demo                          \t0x0000000100a8f0d4 main + 52\n",
+		"0   libsystem_kernel.dylib        \t0x00000001a1001000 mach_msg2_trap + 8\n",
+		"Incident Identifier: A1B2\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, text)
+		}
+	}
+}
+
+func TestRenderIPS(t *testing.T) {
+	report, err := Parse([]byte(testIPS))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	report.Threads[1].Frames[0].Location = &Location{Function: "Demo.crash()", Offset: 20, File: "/src/Demo/Crash.swift", Line: 12}
+
+	out, err := report.Render()
+	if err != nil {
+		t.Fatalf("Render() error: %v", err)
+	}
+	header, body, ok := strings.Cut(string(out), "\n")
+	if !ok || !strings.HasPrefix(header, `{"app_name":"Demo"`) {
+		t.Fatalf("expected the header line to be kept, got %q", header)
+	}
+	var decoded struct {
+		Threads []struct {
+			Frames []map[string]any `json:"frames"`
+		} `json:"threads"`
+		UsedImages []map[string]any `json:"usedImages"`
+	}
+	if err := json.Unmarshal([]byte(body), &decoded); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	frame := decoded.Threads[1].Frames[0]
+	if frame["symbol"] != "Demo.crash()" || frame["symbolLocation"] != float64(20) || frame["sourceFile"] != "Crash.swift" || frame["sourceLine"] != float64(12) {
+		t.Fatalf("unexpected symbolicated frame: %v", frame)
+	}
+	if _, ok := decoded.Threads[1].Frames[1]["symbol"]; ok {
+		t.Fatalf("expected unresolved frame to be left alone: %v", decoded.Threads[1].Frames[1])
+	}
+	if decoded.UsedImages[0]["base"] != float64(4305764352) {
+		t.Fatalf("expected image base to round-trip, got %v", decoded.UsedImages[0]["base"])
+	}
+}
+
+func TestReportException(t *testing.T) {
+	ips, err := Parse([]byte(strings.Replace(testIPS, `"faultingThread" : 1,`, `"faultingThread" : 1, "exception" : {"type":"EXC_CRASH","signal":"SIGABRT"},`, 1)))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if ips.Exception != "EXC_CRASH (SIGABRT)" {
+		t.Fatalf("unexpected .ips exception %q", ips.Exception)
+	}
+
+	text, err := Parse([]byte(strings.Replace(testText, "Process:", "Exception Type:  EXC_BAD_ACCESS (SIGSEGV)\nProcess:", 1)))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if text.Exception != "EXC_BAD_ACCESS (SIGSEGV)" {
+		t.Fatalf("unexpected text exception %q", text.Exception)
+	}
+}
+
+func TestTopFrames(t *testing.T) {
+	text := `Exception Type:  EXC_CRASH (SIGABRT)
+
+Thread 0 Crashed:
+0   libsystem_kernel.dylib        	0x00000001a1001000 __pthread_kill + 8
+1   libsystem_c.dylib             	0x00000001a2002000 abort + 180
+2   Demo                          	0x0000000100a8f2c8 0x100a8c000 + 13000
+3   Demo                          	0x0000000100a8f0d4 Demo.main() + 52
+4   dyld                          	0x00000001a3003000 start + 2000
+
+Binary Images:
+       0x100a8c000 -        0x100a93fff Demo arm64  <b8f392e4a5ae3cf0936e437531307ff7> /private/var/Demo.app/Demo
+       0x1a1000000 -        0x1a100ffff libsystem_kernel.dylib arm64e  <11111111222233334444555555555555> /usr/lib/system/libsystem_kernel.dylib
+       0x1a2000000 -        0x1a200ffff libsystem_c.dylib arm64e  <11111111222233334444555555555556> /usr/lib/system/libsystem_c.dylib
+`
+	report, err := Parse([]byte(text))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	got := report.TopFrames(3)
+	want := []string{"Demo`+0x32c8", "Demo`Demo.main()", "???`start"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("TopFrames() = %v, want %v", got, want)
+	}
+
+	report.Threads[0].Frames[2].Location = &Location{Function: "Demo.crash()", Offset: 4}
+	if got := report.TopFrames(1); len(got) != 1 || got[0] != "Demo`Demo.crash()" {
+		t.Fatalf("expected symbolicated frame, got %v", got)
+	}
+
+	// The last exception backtrace is preferred over the crashed thread, and
+	// a backtrace made only of noise frames is kept as is.
+	ips, err := Parse([]byte(testIPS))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if got := ips.TopFrames(2); len(got) != 1 || got[0] != "libsystem_kernel.dylib`__exceptionPreprocess" {
+		t.Fatalf("unexpected exception backtrace frames: %v", got)
+	}
+}
@@ -0,0 +1,92 @@
+package crashlog
+
+import "fmt"
+
+// noiseImages are system libraries whose frames sit on top of most crashes
+// (abort, pthread_kill, exception throwing) without telling them apart.
+var noiseImages = map[string]bool{
+	"libsystem_kernel.dylib":   true,
+	"libsystem_pthread.dylib":  true,
+	"libsystem_c.dylib":        true,
+	"libsystem_platform.dylib": true,
+	"libc++abi.dylib":          true,
+	"libobjc.A.dylib":          true,
+	"libdyld.dylib":            true,
+}
+
+var noiseSymbols = map[string]bool{
+	"__exceptionPreprocess": true,
+	"objc_exception_throw":  true,
+}
+
+// TopFrames returns up to n normalized frames that identify the crash, as
+// "Image`symbol", or "Image`+0xOFFSET" when a frame has no symbol. The last
+// exception backtrace is preferred over the crashed thread, and leading frames
+// in system libraries that are common to most crashes are skipped.
+func (r *Report) TopFrames(n int) []string {
+	thread := r.signatureThread()
+	if thread == nil || n <= 0 {
+		return nil
+	}
+	frames := thread.Frames
+	for i, frame := range frames {
+		if !r.isNoiseFrame(frame) {
+			frames = frames[i:]
+			break
+		}
+	}
+
+	top := make([]string, 0, n)
+	for _, frame := range frames {
+		if len(top) == n {
+			break
+		}
+		top = append(top, r.normalizeFrame(frame))
+	}
+	return top
+}
+
+func (r *Report) signatureThread() *Thread {
+	var crashed *Thread
+	for i := range r.Threads {
+		thread := &r.Threads[i]
+		if thread.Number == -1 && len(thread.Frames) > 0 {
+			return thread
+		}
+		if thread.Crashed && crashed == nil {
+			crashed = thread
+		}
+	}
+	if crashed == nil && len(r.Threads) > 0 {
+		crashed = &r.Threads[0]
+	}
+	return crashed
+}
+
+func (r *Report) isNoiseFrame(frame Frame) bool {
+	if noiseSymbols[frameSymbol(frame)] {
+		return true
+	}
+	image := r.Image(frame)
+	return image != nil && noiseImages[image.Name]
+}
+
+func (r *Report) normalizeFrame(frame Frame) string {
+	name := "???"
+	var offset uint64
+	if image := r.Image(frame); image != nil {
+		name = image.Name
+		offset = frame.Address - image.LoadAddress
+	}
+	if symbol := frameSymbol(frame); symbol != "" {
+		return name + "`" + symbol
+	}
+	return fmt.Sprintf("%s`+0x%x", name, offset)
+}
+
+func frameSymbol(frame Frame) string {
+	if frame.Location != nil {
+		return frame.Location.Function
+	}
+	return frame.Symbol
+}
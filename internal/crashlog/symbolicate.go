@@ -0,0 +1,50 @@
+package crashlog
+
+// Result summarizes a Symbolicate run.
+type Result struct {
+	// Resolved is the number of frames resolved from the loaded symbols.
+	Resolved int
+	// Missing lists images that have frames without any symbol and no
+	// matching dSYM.
+	Missing []Image
+}
+
+// Symbolicate resolves every frame whose image has a loaded dSYM, replacing
+// any symbol already in the report. Frames after the first are return
+// addresses, so the call instruction before them is looked up instead.
+func Symbolicate(report *Report, symbols *Symbols) Result {
+	var result Result
+	missing := map[int]bool{}
+	for t := range report.Threads {
+		for f := range report.Threads[t].Frames {
+			frame := &report.Threads[t].Frames[f]
+			image := report.Image(*frame)
+			if image == nil || frame.Address < image.LoadAddress {
+				continue
+			}
+			if symbols.Path(image.UUID) == "" {
+				if frame.Symbol == "" && !missing[frame.ImageIndex] {
+					missing[frame.ImageIndex] = true
+					result.Missing = append(result.Missing, *image)
+				}
+				continue
+			}
+
+			offset := frame.Address - image.LoadAddress
+			returnAddress := frame.Index > 0 && offset > 0
+			if returnAddress {
+				offset--
+			}
+			location, ok := symbols.Lookup(image.UUID, offset)
+			if !ok {
+				continue
+			}
+			if returnAddress {
+				location.Offset++
+			}
+			frame.Location = &location
+			result.Resolved++
+		}
+	}
+	return result
+}
@@ -0,0 +1,282 @@
+package crashlog
+
+import (
+	"debug/dwarf"
+	"debug/macho"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const loadCmdUUID macho.LoadCmd = 0x1b
+
+// Symbols holds the debug information of one or more dSYMs, keyed by the
+// UUID of each Mach-O slice.
+type Symbols struct {
+	binaries map[string]*symbolBinary
+}
+
+type symbolBinary struct {
+	uuid     string
+	path     string
+	textAddr uint64
+	dwarf    *dwarf.Data
+	symbols  []machoSymbol
+}
+
+type machoSymbol struct {
+	name  string
+	value uint64
+}
+
+// LoadSymbols loads dSYM bundles, directories containing them, or Mach-O
+// files with debug information. Every slice of a universal binary is loaded.
+func LoadSymbols(paths ...string) (*Symbols, error) {
+	symbols := &Symbols{binaries: map[string]*symbolBinary{}}
+	for _, root := range paths {
+		info, err := os.Stat(root)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			if err := symbols.loadFile(root); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		found := false
+		err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			// dSYM bundles keep their Mach-O files in Contents/Resources/DWARF.
+			if !entry.Type().IsRegular() || filepath.Base(filepath.Dir(path)) != "DWARF" {
+				return nil
+			}
+			found = true
+			return symbols.loadFile(path)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, fmt.Errorf("no dSYM found in %s", root)
+		}
+	}
+	if len(symbols.binaries) == 0 {
+		return nil, errors.New("no Mach-O slices with a UUID found")
+	}
+	return symbols, nil
+}
+
+// UUIDs returns the UUIDs of the loaded slices, sorted.
+func (s *Symbols) UUIDs() []string {
+	uuids := make([]string, 0, len(s.binaries))
+	for uuid := range s.binaries {
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+	return uuids
+}
+
+// Path returns the file the slice with the given UUID was loaded from.
+func (s *Symbols) Path(uuid string) string {
+	if binary, ok := s.binaries[normalizeUUID(uuid)]; ok {
+		return binary.path
+	}
+	return ""
+}
+
+// Lookup resolves an offset from the load address of the image with the
+// given UUID. DWARF is preferred; the symbol table is the fallback.
+func (s *Symbols) Lookup(uuid string, offset uint64) (Location, bool) {
+	binary, ok := s.binaries[normalizeUUID(uuid)]
+	if !ok {
+		return Location{}, false
+	}
+	pc := binary.textAddr + offset
+	location := binary.lookupDWARF(pc)
+	if location.Function == "" {
+		symbol, ok := binary.lookupSymtab(pc)
+		if !ok {
+			return Location{}, false
+		}
+		location.Function, location.Offset = symbol.Function, symbol.Offset
+	}
+	return location, true
+}
+
+func (s *Symbols) loadFile(path string) error {
+	var files []*macho.File
+	fat, err := macho.OpenFat(path)
+	switch {
+	case err == nil:
+		defer fat.Close()
+		for _, arch := range fat.Arches {
+			files = append(files, arch.File)
+		}
+	case errors.Is(err, macho.ErrNotFat):
+		file, err := macho.Open(path)
+		if err != nil {
+			return fmt.Errorf("%s is not a Mach-O file: %w", path, err)
+		}
+		defer file.Close()
+		files = append(files, file)
+	default:
+		return fmt.Errorf("%s is not a Mach-O file: %w", path, err)
+	}
+
+	for _, file := range files {
+		binary, err := newSymbolBinary(path, file)
+		if err != nil {
+			return err
+		}
+		if binary != nil {
+			s.binaries[binary.uuid] = binary
+		}
+	}
+	return nil
+}
+
+func newSymbolBinary(path string, file *macho.File) (*symbolBinary, error) {
+	uuid := machoUUID(file)
+	text := file.Segment("__TEXT")
+	if uuid == "" || text == nil {
+		return nil, nil
+	}
+	binary := &symbolBinary{uuid: uuid, path: path, textAddr: text.Addr}
+
+	if data, err := file.DWARF(); err == nil {
+		binary.dwarf = data
+	}
+	if file.Symtab != nil {
+		for _, symbol := range file.Symtab.Syms {
+			// Skip debugger (stab) entries and anything outside __TEXT.
+			if symbol.Type&0xe0 != 0 || symbol.Name == "" || symbol.Value < text.Addr || symbol.Value >= text.Addr+text.Memsz {
+				continue
+			}
+			binary.symbols = append(binary.symbols, machoSymbol{name: strings.TrimPrefix(symbol.Name, "_"), value: symbol.Value})
+		}
+		sort.Slice(binary.symbols, func(i, j int) bool { return binary.symbols[i].value < binary.symbols[j].value })
+	}
+	if binary.dwarf == nil && len(binary.symbols) == 0 {
+		return nil, fmt.Errorf("%s has no debug information or symbols", path)
+	}
+	return binary, nil
+}
+
+// lookupDWARF returns the function and source line containing pc. Either
+// may be missing when the compilation unit lacks them.
+func (b *symbolBinary) lookupDWARF(pc uint64) Location {
+	var location Location
+	if b.dwarf == nil {
+		return location
+	}
+	reader := b.dwarf.Reader()
+	unit, err := reader.SeekPC(pc)
+	if err != nil {
+		return location
+	}
+
+	// Walk the unit's children; namespaces and types can nest definitions.
+	for depth := 1; depth > 0; {
+		entry, err := reader.Next()
+		if err != nil || entry == nil {
+			break
+		}
+		if entry.Tag == 0 {
+			depth--
+			continue
+		}
+		if entry.Tag != dwarf.TagSubprogram {
+			if entry.Children {
+				depth++
+			}
+			continue
+		}
+		if start, ok := b.rangeStart(entry, pc); ok {
+			location.Function = b.entryName(entry)
+			location.Offset = pc - start
+			break
+		}
+		if entry.Children {
+			reader.SkipChildren()
+		}
+	}
+
+	if lines, err := b.dwarf.LineReader(unit); err == nil && lines != nil {
+		var line dwarf.LineEntry
+		if lines.SeekPC(pc, &line) == nil && line.File != nil {
+			location.File = line.File.Name
+			location.Line = line.Line
+		}
+	}
+	return location
+}
+
+// rangeStart returns the start of the entry's range containing pc.
+func (b *symbolBinary) rangeStart(entry *dwarf.Entry, pc uint64) (uint64, bool) {
+	ranges, err := b.dwarf.Ranges(entry)
+	if err != nil {
+		return 0, false
+	}
+	for _, r := range ranges {
+		if pc >= r[0] && pc < r[1] {
+			return r[0], true
+		}
+	}
+	return 0, false
+}
+
+// entryName returns a subprogram's name, following the declaration or
+// abstract origin that out-of-line and inlined definitions refer to.
+func (b *symbolBinary) entryName(entry *dwarf.Entry) string {
+	for range 4 {
+		if name, ok := entry.Val(dwarf.AttrName).(string); ok && name != "" {
+			return name
+		}
+		offset, ok := entry.Val(dwarf.AttrSpecification).(dwarf.Offset)
+		if !ok {
+			offset, ok = entry.Val(dwarf.AttrAbstractOrigin).(dwarf.Offset)
+		}
+		if !ok {
+			break
+		}
+		reader := b.dwarf.Reader()
+		reader.Seek(offset)
+		next, err := reader.Next()
+		if err != nil || next == nil {
+			break
+		}
+		entry = next
+	}
+	if name, ok := entry.Val(dwarf.AttrLinkageName).(string); ok {
+		return name
+	}
+	return ""
+}
+
+func (b *symbolBinary) lookupSymtab(pc uint64) (Location, bool) {
+	index := sort.Search(len(b.symbols), func(i int) bool { return b.symbols[i].value > pc }) - 1
+	if index < 0 {
+		return Location{}, false
+	}
+	symbol := b.symbols[index]
+	return Location{Function: symbol.name, Offset: pc - symbol.value}, true
+}
+
+func machoUUID(file *macho.File) string {
+	for _, load := range file.Loads {
+		raw := load.Raw()
+		if len(raw) >= 24 && macho.LoadCmd(file.ByteOrder.Uint32(raw)) == loadCmdUUID {
+			return strings.ToUpper(hex.EncodeToString(raw[8:24]))
+		}
+	}
+	return ""
+}
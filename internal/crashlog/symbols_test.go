@@ -0,0 +1,159 @@
+package crashlog
+
+import (
+	"debug/macho"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testProgram = `package main
+
+//go:noinline
+func crash(values []int) int {
+	return values[len(values)]
+}
+
+func main() {
+	println(crash(nil))
+}
+`
+
+// buildTestBinary cross-compiles a small darwin/arm64 program. Go writes
+// DWARF and an LC_UUID into Mach-O output, so it stands in for a dSYM.
+func buildTestBinary(t *testing.T) string {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("skipping Mach-O build in short mode")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not available")
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module demo\n\ngo 1.21\n"), 0o644); err != nil {
+		t.Fatalf("write go.mod: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(testProgram), 0o644); err != nil {
+		t.Fatalf("write main.go: %v", err)
+	}
+	binary := filepath.Join(dir, "Demo.dSYM", "Contents", "Resources", "DWARF", "Demo")
+	cmd := exec.Command(goTool, "build", "-o", binary, ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOOS=darwin", "GOARCH=arm64", "CGO_ENABLED=0", "GOFLAGS=", "GOTOOLCHAIN=local")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("build test binary: %v\n%s", err, out)
+	}
+	return binary
+}
+
+// testSymbol returns the UUID of a Mach-O file and the offset of a symbol
+// from its __TEXT segment.
+func testSymbol(t *testing.T, path, name string) (string, uint64) {
+	t.Helper()
+	file, err := macho.Open(path)
+	if err != nil {
+		t.Fatalf("open test binary: %v", err)
+	}
+	defer file.Close()
+	for _, symbol := range file.Symtab.Syms {
+		if strings.TrimPrefix(symbol.Name, "_") == name {
+			return machoUUID(file), symbol.Value - file.Segment("__TEXT").Addr
+		}
+	}
+	t.Fatalf("symbol %s not found", name)
+	return "", 0
+}
+
+func TestSymbolicateWithDSYM(t *testing.T) {
+	binary := buildTestBinary(t)
+	uuid, offset := testSymbol(t, binary, "main.crash")
+	if len(uuid) != 32 {
+		t.Fatalf("expected LC_UUID in test binary, got %q", uuid)
+	}
+	dsym := filepath.Dir(filepath.Dir(filepath.Dir(filepath.Dir(binary))))
+
+	symbols, err := LoadSymbols(dsym)
+	if err != nil {
+		t.Fatalf("LoadSymbols() error: %v", err)
+	}
+	if got := symbols.UUIDs(); len(got) != 1 || got[0] != uuid {
+		t.Fatalf("expected UUID %s, got %v", uuid, got)
+	}
+
+	const base = 0x104000000
+	crashLog := fmt.Sprintf(`Thread 0 Crashed:
+0   Demo                          	0x%016x 0x%x + %d
+1   Demo                          	0x%016x 0x%x + %d
+2   Other                         	0x0000000190000010 0x190000000 + 16
+
+Binary Images:
+       0x%x -        0x%x Demo arm64  <%s> /private/var/Demo.app/Demo
+       0x190000000 -        0x19000ffff Other arm64e  <00000000000000000000000000000001> /usr/lib/Other.dylib
+`, base+offset, base, offset, base+offset+8, base, offset+8, base, base+0x100000, strings.ToLower(uuid))
+
+	report, err := Parse([]byte(crashLog))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	result := Symbolicate(report, symbols)
+	if result.Resolved != 2 {
+		t.Fatalf("expected 2 resolved frames, got %d", result.Resolved)
+	}
+	if len(result.Missing) != 1 || result.Missing[0].Name != "Other" {
+		t.Fatalf("expected Other to be missing, got %+v", result.Missing)
+	}
+
+	frames := report.Threads[0].Frames
+	top := frames[0].Location
+	if top == nil || top.Function != "main.crash" || top.Offset != 0 || filepath.Base(top.File) != "main.go" || top.Line != 4 {
+		t.Fatalf("unexpected top frame location: %+v", top)
+	}
+	caller := frames[1].Location
+	if caller == nil || caller.Function != "main.crash" || caller.Offset != 8 || filepath.Base(caller.File) != "main.go" {
+		t.Fatalf("unexpected caller frame location: %+v", caller)
+	}
+	if frames[2].Location != nil {
+		t.Fatalf("expected frame without dSYM to stay unresolved, got %+v", frames[2].Location)
+	}
+
+	out, err := report.Render()
+	if err != nil {
+		t.Fatalf("Render() error: %v", err)
+	}
+	if !strings.Contains(string(out), "main.crash + 0 (main.go:4)") {
+		t.Fatalf("expected symbolicated frame in output, got:\n%s", out)
+	}
+}
+
+func TestSymbolTableFallback(t *testing.T) {
+	binary := buildTestBinary(t)
+	uuid, offset := testSymbol(t, binary, "main.crash")
+	symbols, err := LoadSymbols(binary)
+	if err != nil {
+		t.Fatalf("LoadSymbols() error: %v", err)
+	}
+	loaded := symbols.binaries[uuid]
+	location, ok := loaded.lookupSymtab(loaded.textAddr + offset + 4)
+	if !ok || location.Function != "main.crash" || location.Offset != 4 {
+		t.Fatalf("unexpected symbol table location: %+v", location)
+	}
+}
+
+func TestLoadSymbolsErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := LoadSymbols(dir); err == nil || !strings.Contains(err.Error(), "no dSYM found") {
+		t.Fatalf("expected missing dSYM error, got %v", err)
+	}
+	file := filepath.Join(dir, "notes.txt")
+	if err := os.WriteFile(file, []byte("not mach-o"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if _, err := LoadSymbols(file); err == nil || !strings.Contains(err.Error(), "is not a Mach-O file") {
+		t.Fatalf("expected Mach-O error, got %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+package crashlog
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	textThreadHeader    = regexp.MustCompile(`^Thread (\d+)( Crashed)?:\s*$`)
+	textThreadName      = regexp.MustCompile(`^Thread (\d+) name:\s*(.*)$`)
+	textExceptionHeader = regexp.MustCompile(`^Last Exception Backtrace:\s*$`)
+	textImagesHeader    = regexp.MustCompile(`^Binary Images:\s*$`)
+	textException       = regexp.MustCompile(`^Exception Type:\s*(.+?)\s*$`)
+	textFrame           = regexp.MustCompile(`^(\d+)\s+(.+?)\s+(0x[0-9a-fA-F]+)\s+(.*)$`)
+	textImage           = regexp.MustCompile(`^\s*(0x[0-9a-fA-F]+)\s*-\s*(0x[0-9a-fA-F]+)\s+\+?(.+?)\s+(\S+)\s+<([0-9a-fA-F-]+)>\s*(.*)$`)
+)
+
+// parseText parses a legacy plain-text crash report.
+func parseText(text string) (*Report, error) {
+	report := &Report{Format: FormatText}
+	report.lines = strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	var current *Thread
+	type frameRef struct {
+		thread, frame int
+		image         string
+	}
+	var refs []frameRef
+	names := map[int]string{}
+	inImages := false
+	flush := func() {
+		if current != nil {
+			report.Threads = append(report.Threads, *current)
+			current = nil
+		}
+	}
+
+	for i, line := range report.lines {
+		switch {
+		case strings.TrimSpace(line) == "":
+			flush()
+			inImages = false
+		case textImagesHeader.MatchString(line):
+			flush()
+			inImages = true
+		case inImages:
+			if image, ok := parseTextImage(line); ok {
+				report.Images = append(report.Images, image)
+			}
+		case textException.MatchString(line):
+			report.Exception = textException.FindStringSubmatch(line)[1]
+		case textThreadName.MatchString(line):
+			match := textThreadName.FindStringSubmatch(line)
+			number, _ := strconv.Atoi(match[1])
+			names[number] = strings.TrimSpace(match[2])
+		case textThreadHeader.MatchString(line):
+			flush()
+			match := textThreadHeader.FindStringSubmatch(line)
+			number, _ := strconv.Atoi(match[1])
+			current = &Thread{Number: number, Name: names[number], Crashed: match[2] != ""}
+		case textExceptionHeader.MatchString(line):
+			flush()
+			current = &Thread{Number: -1, Name: "Last Exception Backtrace"}
+		case current != nil:
+			frame, imageName, ok := parseTextFrame(line)
+			if !ok {
+				continue
+			}
+			frame.line = i
+			current.Frames = append(current.Frames, frame)
+			refs = append(refs, frameRef{thread: len(report.Threads), frame: len(current.Frames) - 1, image: imageName})
+		}
+	}
+	flush()
+
+	// Binary Images follow the backtraces, so frames are matched at the end.
+	for _, ref := range refs {
+		frame := &report.Threads[ref.thread].Frames[ref.frame]
+		frame.ImageIndex = imageForAddress(report.Images, frame.Address, ref.image)
+	}
+
+	if len(report.Threads) == 0 && len(report.Images) == 0 {
+		return nil, fmt.Errorf("no backtraces or binary images found in crash report")
+	}
+	return report, nil
+}
+
+func parseTextFrame(line string) (Frame, string, bool) {
+	match := textFrame.FindStringSubmatch(line)
+	if match == nil {
+		return Frame{}, "", false
+	}
+	index, _ := strconv.Atoi(match[1])
+	address, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(match[3]), "0x"), 16, 64)
+	if err != nil {
+		return Frame{}, "", false
+	}
+	frame := Frame{Index: index, ImageIndex: -1, Address: address, line: -1}
+	if tail := strings.TrimSpace(match[4]); !strings.HasPrefix(tail, "0x") {
+		if plus := strings.LastIndex(tail, " + "); plus > 0 {
+			tail = tail[:plus]
+		}
+		frame.Symbol = tail
+	}
+	return frame, strings.TrimSpace(match[2]), true
+}
+
+func parseTextImage(line string) (Image, bool) {
+	match := textImage.FindStringSubmatch(line)
+	if match == nil {
+		return Image{}, false
+	}
+	start, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(match[1]), "0x"), 16, 64)
+	if err != nil {
+		return Image{}, false
+	}
+	end, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(match[2]), "0x"), 16, 64)
+	if err != nil {
+		return Image{}, false
+	}
+	return Image{
+		Name:        strings.TrimSpace(match[3]),
+		Arch:        match[4],
+		UUID:        normalizeUUID(match[5]),
+		Path:        strings.TrimSpace(match[6]),
+		LoadAddress: start,
+		EndAddress:  end,
+	}, true
+}
+
+func (r *Report) renderText() []byte {
+	lines := append([]string(nil), r.lines...)
+	for _, thread := range r.Threads {
+		for _, frame := range thread.Frames {
+			if frame.Location == nil || frame.line < 0 || frame.line >= len(lines) {
+				continue
+			}
+			line := lines[frame.line]
+			match := textFrame.FindStringSubmatchIndex(line)
+			if match == nil {
+				continue
+			}
+			lines[frame.line] = line[:match[8]] + frame.Location.String()
+		}
+	}
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
@@ -0,0 +1,102 @@
+package mockserver
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// jwtAudience is the audience App Store Connect expects in API tokens.
+	jwtAudience = "appstoreconnect-v1"
+	// maxTokenLifetime mirrors App Store Connect's 20 minute token limit.
+	maxTokenLifetime = 20 * time.Minute
+)
+
+// AuthConfig describes how the mock server validates bearer tokens.
+// Empty fields are not checked; a nil PublicKey skips signature verification
+// but still requires a well-formed ES256 token.
+type AuthConfig struct {
+	KeyID     string
+	IssuerID  string
+	PublicKey *ecdsa.PublicKey
+	Disabled  bool
+}
+
+// verifyRequestToken validates the Authorization header the way App Store Connect does
+// for tokens produced by asc.GenerateJWT.
+func (a AuthConfig) verifyRequestToken(r *http.Request, now time.Time) error {
+	if a.Disabled {
+		return nil
+	}
+	header := strings.TrimSpace(r.Header.Get("Authorization"))
+	if header == "" {
+		return errors.New("missing Authorization header")
+	}
+	raw, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || strings.TrimSpace(raw) == "" {
+		return errors.New("authorization header must use the Bearer scheme")
+	}
+	return a.verifyToken(strings.TrimSpace(raw), now)
+}
+
+func (a AuthConfig) verifyToken(raw string, now time.Time) error {
+	claims := jwt.RegisteredClaims{}
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}),
+		jwt.WithAudience(jwtAudience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	}
+	if a.IssuerID != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(a.IssuerID))
+	}
+	parser := jwt.NewParser(parserOpts...)
+
+	var token *jwt.Token
+	var err error
+	if a.PublicKey != nil {
+		token, err = parser.ParseWithClaims(raw, &claims, func(token *jwt.Token) (any, error) {
+			return a.PublicKey, nil
+		})
+	} else {
+		token, _, err = parser.ParseUnverified(raw, &claims)
+		if err == nil {
+			err = jwt.NewValidator(parserOpts...).Validate(&claims)
+		}
+		if err == nil && token.Method.Alg() != jwt.SigningMethodES256.Alg() {
+			err = fmt.Errorf("unexpected signing method %q", token.Method.Alg())
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("invalid token: %w", err)
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	if strings.TrimSpace(kid) == "" {
+		return errors.New("token header is missing kid")
+	}
+	if a.KeyID != "" && kid != a.KeyID {
+		return fmt.Errorf("token kid %q does not match expected key ID", kid)
+	}
+	if claims.IssuedAt == nil {
+		return errors.New("token is missing iat")
+	}
+	if claims.ExpiresAt.Sub(claims.IssuedAt.Time) > maxTokenLifetime {
+		return fmt.Errorf("token lifetime exceeds %s", maxTokenLifetime)
+	}
+	if strings.TrimSpace(claims.Issuer) == "" {
+		return errors.New("token is missing iss")
+	}
+	if !slices.Contains(claims.Audience, jwtAudience) {
+		return fmt.Errorf("token audience must be %q", jwtAudience)
+	}
+	return nil
+}
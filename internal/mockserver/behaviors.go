@@ -0,0 +1,132 @@
+package mockserver
+
+import (
+	"time"
+)
+
+// afterCreate fills in server-managed attributes for resources whose
+// workflows depend on them (build uploads, review submissions).
+func (s *Server) afterCreate(base string, created *Resource) *Resource {
+	attributes := map[string]any{}
+	switch created.Type {
+	case "buildUploads":
+		attributes["state"] = map[string]any{"state": "AWAITING_UPLOAD"}
+		attributes["createdDate"] = s.timestamp()
+	case "buildUploadFiles":
+		length := created.Attributes["fileSize"]
+		attributes["uploadOperations"] = []map[string]any{{
+			"method": "PUT",
+			"url":    base + uploadPathPrefix + created.ID,
+			"offset": 0,
+			"length": length,
+		}}
+		attributes["assetDeliveryState"] = map[string]any{"state": "AWAITING_UPLOAD"}
+	case "reviewSubmissions":
+		attributes["state"] = "READY_FOR_REVIEW"
+		attributes["submittedDate"] = nil
+	case "betaTesters", "betaGroups", "appStoreVersions", "appStoreVersionSubmissions":
+		attributes["createdDate"] = s.timestamp()
+	default:
+		return created
+	}
+	updated, ok := s.store.Update(created.Type, created.ID, attributes, nil)
+	if !ok {
+		return created
+	}
+	return updated
+}
+
+// afterUpdate simulates server-side state transitions triggered by updates.
+func (s *Server) afterUpdate(updated *Resource, changed map[string]any) *Resource {
+	switch updated.Type {
+	case "buildUploadFiles":
+		if uploaded, _ := changed["uploaded"].(bool); uploaded {
+			s.completeBuildUpload(updated)
+			if refreshed, ok := s.store.Update(updated.Type, updated.ID, map[string]any{
+				"assetDeliveryState": map[string]any{"state": "COMPLETE"},
+			}, nil); ok {
+				return refreshed
+			}
+		}
+	case "reviewSubmissions":
+		if submitted, _ := changed["submitted"].(bool); submitted {
+			if refreshed, ok := s.store.Update(updated.Type, updated.ID, map[string]any{
+				"state":         "WAITING_FOR_REVIEW",
+				"submittedDate": s.timestamp(),
+			}, nil); ok {
+				return refreshed
+			}
+		}
+		if canceled, _ := changed["canceled"].(bool); canceled {
+			if refreshed, ok := s.store.Update(updated.Type, updated.ID, map[string]any{"state": "CANCELING"}, nil); ok {
+				return refreshed
+			}
+		}
+	}
+	return updated
+}
+
+// completeBuildUpload turns a committed build upload into a processed build
+// with a matching pre-release version, the way App Store Connect does after
+// processing finishes.
+func (s *Server) completeBuildUpload(file *Resource) {
+	uploadLink, ok := file.Relationships["buildUpload"]
+	if !ok || len(uploadLink.Data) == 0 {
+		return
+	}
+	upload, ok := s.store.Get("buildUploads", uploadLink.Data[0].ID)
+	if !ok {
+		return
+	}
+	appLink, ok := upload.Relationships["app"]
+	if !ok || len(appLink.Data) == 0 {
+		return
+	}
+	appID := appLink.Data[0].ID
+	version := attributeString(upload.Attributes["cfBundleShortVersionString"])
+	buildNumber := attributeString(upload.Attributes["cfBundleVersion"])
+	platform := attributeString(upload.Attributes["platform"])
+	if platform == "" {
+		platform = "IOS"
+	}
+
+	var preRelease *Resource
+	existing := s.store.List("preReleaseVersions", func(resource *Resource) bool {
+		return matchesFilter(resource, "app", []string{appID}) &&
+			attributeString(resource.Attributes["version"]) == version &&
+			attributeString(resource.Attributes["platform"]) == platform
+	})
+	if len(existing) > 0 {
+		preRelease = existing[0]
+	} else {
+		preRelease = s.store.Put(&Resource{
+			Type:          "preReleaseVersions",
+			Attributes:    map[string]any{"version": version, "platform": platform},
+			Relationships: map[string]Linkage{"app": linkTo("apps", appID)},
+		})
+	}
+
+	now := s.timestamp()
+	build := s.store.Put(&Resource{
+		Type: "builds",
+		Attributes: map[string]any{
+			"version":                 buildNumber,
+			"uploadedDate":            now,
+			"processingState":         "VALID",
+			"expired":                 false,
+			"usesNonExemptEncryption": false,
+		},
+		Relationships: map[string]Linkage{
+			"app":               linkTo("apps", appID),
+			"preReleaseVersion": linkTo("preReleaseVersions", preRelease.ID),
+		},
+	})
+
+	s.store.Update("buildUploads", upload.ID, map[string]any{
+		"state": map[string]any{"state": "COMPLETE"},
+	}, map[string]Linkage{"build": linkTo("builds", build.ID)})
+}
+
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339)
+}
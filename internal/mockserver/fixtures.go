@@ -0,0 +1,164 @@
+package mockserver
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc/types"
+)
+
+//go:embed fixtures/default.json
+var defaultFixtureData []byte
+
+// Fixture is a fixture document: seed resources for the in-memory store plus
+// canned routes that take precedence over the generic JSON:API handlers.
+type Fixture struct {
+	Resources []FixtureResource `json:"resources,omitempty"`
+	Routes    []Route           `json:"routes,omitempty"`
+}
+
+// FixtureResource is a JSON:API resource as written in fixture files.
+type FixtureResource struct {
+	Type          string             `json:"type"`
+	ID            string             `json:"id"`
+	Attributes    map[string]any     `json:"attributes,omitempty"`
+	Relationships map[string]Linkage `json:"relationships,omitempty"`
+}
+
+// Route is a canned response for a method and path.
+// Query, when set, must be a subset of the request's query parameters.
+type Route struct {
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Query   map[string]string `json:"query,omitempty"`
+	Status  int               `json:"status,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+func (r Route) matches(req *http.Request) bool {
+	method := strings.ToUpper(strings.TrimSpace(r.Method))
+	if method == "" {
+		method = http.MethodGet
+	}
+	if method != req.Method {
+		return false
+	}
+	if strings.TrimRight(r.Path, "/") != strings.TrimRight(req.URL.Path, "/") {
+		return false
+	}
+	return queryContains(req.URL.Query(), r.Query)
+}
+
+func queryContains(actual url.Values, expected map[string]string) bool {
+	for key, value := range expected {
+		if actual.Get(key) != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (r Route) write(w http.ResponseWriter) {
+	for key, value := range r.Headers {
+		w.Header().Set(key, value)
+	}
+	if w.Header().Get("Content-Type") == "" && len(r.Body) > 0 {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	status := r.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+	if len(r.Body) > 0 {
+		_, _ = w.Write(r.Body)
+	}
+}
+
+// DefaultFixture returns the built-in canned fixture: one iOS app with a
+// bundle ID, an editable App Store version, app info, and a beta group.
+func DefaultFixture() (*Fixture, error) {
+	return parseFixture(defaultFixtureData, "default fixture")
+}
+
+// LoadFixtures reads a fixture file, or every *.json file in a directory
+// (sorted by name), and merges them into one fixture.
+func LoadFixtures(path string) (*Fixture, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, fmt.Errorf("fixture path is required")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("read fixtures: %w", err)
+	}
+	files := []string{path}
+	if info.IsDir() {
+		matches, err := filepath.Glob(filepath.Join(path, "*.json"))
+		if err != nil {
+			return nil, fmt.Errorf("read fixtures: %w", err)
+		}
+		sort.Strings(matches)
+		files = matches
+	}
+
+	merged := &Fixture{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read fixtures: %w", err)
+		}
+		fixture, err := parseFixture(data, file)
+		if err != nil {
+			return nil, err
+		}
+		merged.Resources = append(merged.Resources, fixture.Resources...)
+		merged.Routes = append(merged.Routes, fixture.Routes...)
+	}
+	return merged, nil
+}
+
+func parseFixture(data []byte, source string) (*Fixture, error) {
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", source, err)
+	}
+	for i, resource := range fixture.Resources {
+		if strings.TrimSpace(resource.Type) == "" {
+			return nil, fmt.Errorf("parse %s: resources[%d] is missing type", source, i)
+		}
+	}
+	for i, route := range fixture.Routes {
+		if !strings.HasPrefix(route.Path, "/") {
+			return nil, fmt.Errorf("parse %s: routes[%d] path must start with /", source, i)
+		}
+	}
+	return &fixture, nil
+}
+
+// Seed loads fixture resources into the store.
+func (s *Store) Seed(fixture *Fixture) {
+	if fixture == nil {
+		return
+	}
+	for _, resource := range fixture.Resources {
+		s.Put(&Resource{
+			Type:          resource.Type,
+			ID:            resource.ID,
+			Attributes:    resource.Attributes,
+			Relationships: resource.Relationships,
+		})
+	}
+}
+
+func linkTo(resourceType, id string) Linkage {
+	return Linkage{Data: []types.ResourceData{{Type: types.ResourceType(resourceType), ID: id}}}
+}
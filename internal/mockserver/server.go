@@ -0,0 +1,511 @@
+// Package mockserver implements a local stand-in for the App Store Connect API.
+//
+// The server keeps JSON:API resources in memory, validates the ES256 bearer
+// tokens produced by asc.GenerateJWT, and answers generic list/get/create/
+// update/delete and relationship requests. Canned routes loaded from fixture
+// files take precedence, so recorded responses can be replayed verbatim.
+package mockserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc/types"
+)
+
+const (
+	defaultPageSize  = 50
+	maxPageSize      = 200
+	uploadPathPrefix = "/mock/uploads/"
+)
+
+// Options configure a mock server.
+type Options struct {
+	Auth     AuthConfig
+	Fixtures []*Fixture
+	// Logger receives one line per request when set.
+	Logger io.Writer
+	// Now overrides the clock used for token validation and timestamps.
+	Now func() time.Time
+}
+
+// Server is an in-memory App Store Connect API.
+type Server struct {
+	auth   AuthConfig
+	store  *Store
+	routes []Route
+	logger io.Writer
+	now    func() time.Time
+
+	logMu sync.Mutex
+}
+
+// New creates a server seeded with the provided fixtures.
+func New(opts Options) *Server {
+	server := &Server{
+		auth:   opts.Auth,
+		store:  NewStore(),
+		logger: opts.Logger,
+		now:    opts.Now,
+	}
+	if server.now == nil {
+		server.now = time.Now
+	}
+	for _, fixture := range opts.Fixtures {
+		if fixture == nil {
+			continue
+		}
+		server.store.Seed(fixture)
+		server.routes = append(server.routes, fixture.Routes...)
+	}
+	return server
+}
+
+// Store exposes the server's resource store (for assertions in tests).
+func (s *Server) Store() *Store {
+	return s.store
+}
+
+// Serve handles connections on listener until ctx is cancelled.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	httpServer := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.Serve(listener)
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			return err
+		}
+		return nil
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	s.serve(recorder, r)
+	s.logRequest(r, recorder.status)
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, uploadPathPrefix) {
+		s.handleUpload(w, r)
+		return
+	}
+	if !isAPIPath(r.URL.Path) {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", fmt.Sprintf("The path %s is not an App Store Connect API path.", r.URL.Path))
+		return
+	}
+	if err := s.auth.verifyRequestToken(r, s.now()); err != nil {
+		writeError(w, http.StatusUnauthorized, "NOT_AUTHORIZED", "Authentication credentials are missing or invalid.", err.Error())
+		return
+	}
+	for _, route := range s.routes {
+		if route.matches(r) {
+			route.write(w)
+			return
+		}
+	}
+	s.handleResource(w, r)
+}
+
+func isAPIPath(path string) bool {
+	for _, prefix := range []string{"/v1/", "/v2/", "/v3/"} {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) handleResource(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	// segments[0] is the API version (v1, v2, ...).
+	parts := segments[1:]
+	base := requestBaseURL(r)
+
+	switch {
+	case len(parts) == 1:
+		switch r.Method {
+		case http.MethodGet:
+			s.listResources(w, r, base, parts[0])
+		case http.MethodPost:
+			s.createResource(w, r, base, parts[0])
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+	case len(parts) == 2:
+		switch r.Method {
+		case http.MethodGet:
+			s.getResource(w, base, parts[0], parts[1])
+		case http.MethodPatch:
+			s.updateResource(w, r, base, parts[0], parts[1])
+		case http.MethodDelete:
+			s.deleteResource(w, parts[0], parts[1])
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+	case len(parts) == 3:
+		if r.Method != http.MethodGet {
+			writeMethodNotAllowed(w, r)
+			return
+		}
+		s.getRelated(w, r, base, parts[0], parts[1], parts[2])
+	case len(parts) == 4 && parts[2] == "relationships":
+		s.handleLinkage(w, r, parts[0], parts[1], parts[3])
+	default:
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", fmt.Sprintf("The path %s is not supported by the mock server.", r.URL.Path))
+	}
+}
+
+func (s *Server) listResources(w http.ResponseWriter, r *http.Request, base, resourceType string) {
+	resources := s.store.List(resourceType, nil)
+	writeList(w, r, base, filterResources(resources, r.URL.Query()))
+}
+
+func (s *Server) getResource(w http.ResponseWriter, base, resourceType, id string) {
+	resource, ok := s.store.Get(resourceType, id)
+	if !ok {
+		writeNotFound(w, resourceType, id)
+		return
+	}
+	writeJSON(w, http.StatusOK, types.SingleResponse[map[string]any]{Data: resource.document(base)})
+}
+
+func (s *Server) createResource(w http.ResponseWriter, r *http.Request, base, resourceType string) {
+	payload, err := decodeResourcePayload(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "The request entity is invalid", err.Error())
+		return
+	}
+	if payload.Type != resourceType {
+		writeError(w, http.StatusConflict, "ENTITY_ERROR.INCLUDED.INVALID_TYPE", "The provided entity has an invalid type", fmt.Sprintf("Expected type %q but got %q.", resourceType, payload.Type))
+		return
+	}
+	created := s.store.Put(&Resource{
+		Type:          resourceType,
+		Attributes:    payload.Attributes,
+		Relationships: payload.Relationships,
+	})
+	created = s.afterCreate(base, created)
+	writeJSON(w, http.StatusCreated, types.SingleResponse[map[string]any]{Data: created.document(base)})
+}
+
+func (s *Server) updateResource(w http.ResponseWriter, r *http.Request, base, resourceType, id string) {
+	payload, err := decodeResourcePayload(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "The request entity is invalid", err.Error())
+		return
+	}
+	if payload.ID != "" && payload.ID != id {
+		writeError(w, http.StatusConflict, "ENTITY_ERROR.ID.INVALID", "The provided entity id does not match", fmt.Sprintf("Expected id %q but got %q.", id, payload.ID))
+		return
+	}
+	updated, ok := s.store.Update(resourceType, id, payload.Attributes, payload.Relationships)
+	if !ok {
+		writeNotFound(w, resourceType, id)
+		return
+	}
+	updated = s.afterUpdate(updated, payload.Attributes)
+	writeJSON(w, http.StatusOK, types.SingleResponse[map[string]any]{Data: updated.document(base)})
+}
+
+func (s *Server) deleteResource(w http.ResponseWriter, resourceType, id string) {
+	if !s.store.Delete(resourceType, id) {
+		writeNotFound(w, resourceType, id)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getRelated(w http.ResponseWriter, r *http.Request, base, resourceType, id, relationship string) {
+	parent, ok := s.store.Get(resourceType, id)
+	if !ok {
+		writeNotFound(w, resourceType, id)
+		return
+	}
+	related, toMany := s.resolveRelated(parent, relationship)
+	if !toMany {
+		if len(related) == 0 {
+			writeJSON(w, http.StatusOK, map[string]any{"data": nil, "links": types.Links{Self: base + r.URL.Path}})
+			return
+		}
+		writeJSON(w, http.StatusOK, types.SingleResponse[map[string]any]{Data: related[0].document(base)})
+		return
+	}
+	writeList(w, r, base, filterResources(related, r.URL.Query()))
+}
+
+func (s *Server) handleLinkage(w http.ResponseWriter, r *http.Request, resourceType, id, relationship string) {
+	parent, ok := s.store.Get(resourceType, id)
+	if !ok {
+		writeNotFound(w, resourceType, id)
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		related, toMany := s.resolveRelated(parent, relationship)
+		data := make([]types.ResourceData, 0, len(related))
+		for _, resource := range related {
+			data = append(data, types.ResourceData{Type: types.ResourceType(resource.Type), ID: resource.ID})
+		}
+		if !toMany {
+			if len(data) == 0 {
+				writeJSON(w, http.StatusOK, map[string]any{"data": nil})
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]any{"data": data[0]})
+			return
+		}
+		writeJSON(w, http.StatusOK, types.LinkagesResponse{Data: data})
+		return
+	}
+
+	var payload Linkage
+	body, err := io.ReadAll(r.Body)
+	if err == nil {
+		err = json.Unmarshal(body, &payload)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "The request entity is invalid", err.Error())
+		return
+	}
+
+	mode := linkageReplace
+	switch r.Method {
+	case http.MethodPatch:
+	case http.MethodPost:
+		mode = linkageAdd
+	case http.MethodDelete:
+		mode = linkageRemove
+	default:
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	s.store.SetLinkage(resourceType, id, relationship, mode, payload)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// resolveRelated returns the resources for a relationship and whether it is to-many.
+// Stored linkage wins; otherwise resources of the relationship's type that point
+// back at the parent are used (e.g. /v1/apps/{id}/builds finds builds linked to the app).
+func (s *Server) resolveRelated(parent *Resource, relationship string) ([]*Resource, bool) {
+	if linkage, ok := parent.Relationships[relationship]; ok {
+		resources := make([]*Resource, 0, len(linkage.Data))
+		for _, item := range linkage.Data {
+			if resource, found := s.store.Get(string(item.Type), item.ID); found {
+				resources = append(resources, resource)
+			}
+		}
+		return resources, linkage.ToMany
+	}
+
+	toMany := strings.HasSuffix(relationship, "s")
+	relatedType := relationship
+	if !toMany {
+		relatedType = relationship + "s"
+	}
+	return s.store.Related(relatedType, parent.Type, parent.ID), toMany
+}
+
+func (s *Server) handleUpload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	if _, err := io.Copy(io.Discard, r.Body); err != nil {
+		writeError(w, http.StatusBadRequest, "UPLOAD_FAILED", "Upload failed", err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+type resourcePayload struct {
+	Type          string             `json:"type"`
+	ID            string             `json:"id"`
+	Attributes    map[string]any     `json:"attributes"`
+	Relationships map[string]Linkage `json:"relationships"`
+}
+
+func decodeResourcePayload(body io.Reader) (*resourcePayload, error) {
+	var document struct {
+		Data *resourcePayload `json:"data"`
+	}
+	if err := json.NewDecoder(body).Decode(&document); err != nil {
+		return nil, fmt.Errorf("invalid JSON body: %w", err)
+	}
+	if document.Data == nil {
+		return nil, fmt.Errorf("request body is missing data")
+	}
+	return document.Data, nil
+}
+
+// filterResources applies filter[...] and sort query parameters.
+func filterResources(resources []*Resource, query url.Values) []*Resource {
+	filtered := make([]*Resource, 0, len(resources))
+	for _, resource := range resources {
+		matched := true
+		for key, values := range query {
+			name, ok := strings.CutPrefix(key, "filter[")
+			if !ok || !strings.HasSuffix(name, "]") {
+				continue
+			}
+			name = strings.TrimSuffix(name, "]")
+			if !matchesFilter(resource, name, splitFilterValues(values)) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			filtered = append(filtered, resource)
+		}
+	}
+
+	if sortKey := strings.TrimSpace(query.Get("sort")); sortKey != "" {
+		descending := strings.HasPrefix(sortKey, "-")
+		field := strings.TrimPrefix(sortKey, "-")
+		sort.SliceStable(filtered, func(i, j int) bool {
+			left := attributeString(filtered[i].Attributes[field])
+			right := attributeString(filtered[j].Attributes[field])
+			if descending {
+				return left > right
+			}
+			return left < right
+		})
+	}
+	return filtered
+}
+
+func splitFilterValues(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				result = append(result, part)
+			}
+		}
+	}
+	return result
+}
+
+// writeList renders a paginated collection using limit and an opaque cursor.
+func writeList(w http.ResponseWriter, r *http.Request, base string, resources []*Resource) {
+	query := r.URL.Query()
+	limit := defaultPageSize
+	if raw := strings.TrimSpace(query.Get("limit")); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxPageSize {
+			writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "A parameter has an invalid value", fmt.Sprintf("'%s' is not a valid value for limit; it must be between 1 and %d.", raw, maxPageSize))
+			return
+		}
+		limit = parsed
+	}
+	offset := 0
+	if raw := strings.TrimSpace(query.Get("cursor")); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "A parameter has an invalid value", "The cursor is invalid.")
+			return
+		}
+		offset = parsed
+	}
+
+	total := len(resources)
+	end := min(offset+limit, total)
+	start := min(offset, total)
+
+	data := make([]types.Resource[map[string]any], 0, end-start)
+	for _, resource := range resources[start:end] {
+		data = append(data, resource.document(base))
+	}
+
+	links := types.Links{Self: base + r.URL.RequestURI()}
+	if end < total {
+		next := url.Values{}
+		for key, values := range query {
+			next[key] = values
+		}
+		next.Set("cursor", strconv.Itoa(end))
+		next.Set("limit", strconv.Itoa(limit))
+		links.Next = base + r.URL.Path + "?" + next.Encode()
+	}
+
+	meta, _ := json.Marshal(map[string]any{"paging": map[string]int{"total": total, "limit": limit}})
+	writeJSON(w, http.StatusOK, types.Response[map[string]any]{Data: data, Links: links, Meta: meta})
+}
+
+func requestBaseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+func writeJSON(w http.ResponseWriter, status int, payload any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(payload)
+}
+
+func writeError(w http.ResponseWriter, status int, code, title, detail string) {
+	writeJSON(w, status, map[string]any{
+		"errors": []map[string]string{{
+			"status": strconv.Itoa(status),
+			"code":   code,
+			"title":  title,
+			"detail": detail,
+		}},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, resourceType, id string) {
+	writeError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", fmt.Sprintf("There is no resource of type '%s' with id '%s'", resourceType, id))
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "The request method is not allowed", fmt.Sprintf("%s is not allowed on %s.", r.Method, r.URL.Path))
+}
+
+func (s *Server) logRequest(r *http.Request, status int) {
+	if s.logger == nil {
+		return
+	}
+	s.logMu.Lock()
+	defer s.logMu.Unlock()
+	fmt.Fprintf(s.logger, "%s %s %d\n", r.Method, r.URL.RequestURI(), status)
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,297 @@
+package mockserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func newTestKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "AuthKey.p8")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	return key, path
+}
+
+func newTestServer(t *testing.T, opts Options) (*httptest.Server, *asc.Client) {
+	t.Helper()
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	t.Setenv("ASC_BASE_URL", "")
+
+	key, keyPath := newTestKey(t)
+	if opts.Auth.PublicKey == nil && !opts.Auth.Disabled {
+		opts.Auth.PublicKey = &key.PublicKey
+	}
+	if opts.Fixtures == nil {
+		fixture, err := DefaultFixture()
+		if err != nil {
+			t.Fatalf("DefaultFixture() error: %v", err)
+		}
+		opts.Fixtures = []*Fixture{fixture}
+	}
+
+	ts := httptest.NewServer(New(opts))
+	t.Cleanup(ts.Close)
+
+	client, err := asc.NewClient("KEY123", "ISSUER456", keyPath, asc.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+	return ts, client
+}
+
+func TestServerRejectsMissingAndInvalidTokens(t *testing.T) {
+	ts, _ := newTestServer(t, Options{})
+
+	resp, err := http.Get(ts.URL + "/v1/apps")
+	if err != nil {
+		t.Fatalf("GET error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without token, got %d", resp.StatusCode)
+	}
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	token, err := asc.GenerateJWT("KEY123", "ISSUER456", otherKey)
+	if err != nil {
+		t.Fatalf("GenerateJWT() error: %v", err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/v1/apps", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for token signed by another key, got %d", resp.StatusCode)
+	}
+}
+
+func TestAuthConfigVerifyToken(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	token, err := asc.GenerateJWT("KEY123", "ISSUER456", key)
+	if err != nil {
+		t.Fatalf("GenerateJWT() error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		auth    AuthConfig
+		now     time.Time
+		wantErr string
+	}{
+		{name: "unverified structure", auth: AuthConfig{}, now: time.Now()},
+		{name: "verified signature", auth: AuthConfig{PublicKey: &key.PublicKey, KeyID: "KEY123", IssuerID: "ISSUER456"}, now: time.Now()},
+		{name: "wrong key id", auth: AuthConfig{KeyID: "OTHER"}, now: time.Now(), wantErr: "does not match expected key ID"},
+		{name: "wrong issuer", auth: AuthConfig{IssuerID: "OTHER"}, now: time.Now(), wantErr: "invalid token"},
+		{name: "expired", auth: AuthConfig{}, now: time.Now().Add(time.Hour), wantErr: "invalid token"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.auth.verifyToken(token, test.now)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected token to verify, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestServerServesDefaultFixtureThroughClient(t *testing.T) {
+	_, client := newTestServer(t, Options{})
+	ctx := context.Background()
+
+	apps, err := client.GetApps(ctx)
+	if err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if len(apps.Data) != 1 || apps.Data[0].ID != "1234567890" {
+		t.Fatalf("unexpected apps: %+v", apps.Data)
+	}
+
+	groups, err := client.GetBetaGroups(ctx, "1234567890")
+	if err != nil {
+		t.Fatalf("GetBetaGroups() error: %v", err)
+	}
+	if len(groups.Data) != 2 {
+		t.Fatalf("expected 2 beta groups, got %d", len(groups.Data))
+	}
+}
+
+func TestServerPaginatesLists(t *testing.T) {
+	fixture := &Fixture{}
+	for _, id := range []string{"1", "2", "3"} {
+		fixture.Resources = append(fixture.Resources, FixtureResource{Type: "apps", ID: id, Attributes: map[string]any{"name": "App " + id}})
+	}
+	_, client := newTestServer(t, Options{Fixtures: []*Fixture{fixture}})
+	ctx := context.Background()
+
+	firstPage, err := client.GetApps(ctx, asc.WithAppsLimit(2))
+	if err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if len(firstPage.Data) != 2 || firstPage.Links.Next == "" {
+		t.Fatalf("expected 2 apps and a next link, got %d apps, next=%q", len(firstPage.Data), firstPage.Links.Next)
+	}
+
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetApps(ctx, asc.WithAppsNextURL(nextURL))
+	})
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+	if got := len(all.(*asc.AppsResponse).Data); got != 3 {
+		t.Fatalf("expected 3 apps after pagination, got %d", got)
+	}
+}
+
+func TestServerBuildUploadCreatesBuild(t *testing.T) {
+	ts, client := newTestServer(t, Options{})
+	ctx := context.Background()
+
+	upload, err := client.CreateBuildUpload(ctx, asc.BuildUploadCreateRequest{
+		Data: asc.BuildUploadCreateData{
+			Type: asc.ResourceTypeBuildUploads,
+			Attributes: asc.BuildUploadAttributes{
+				CFBundleShortVersionString: "1.0.0",
+				CFBundleVersion:            "42",
+				Platform:                   asc.PlatformIOS,
+			},
+			Relationships: &asc.BuildUploadRelationships{
+				App: &asc.Relationship{Data: asc.ResourceData{Type: asc.ResourceTypeApps, ID: "1234567890"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateBuildUpload() error: %v", err)
+	}
+
+	file, err := client.CreateBuildUploadFile(ctx, asc.BuildUploadFileCreateRequest{
+		Data: asc.BuildUploadFileCreateData{
+			Type: asc.ResourceTypeBuildUploadFiles,
+			Attributes: asc.BuildUploadFileAttributes{
+				FileName:  "app.ipa",
+				FileSize:  4,
+				UTI:       asc.UTIIPA,
+				AssetType: asc.AssetTypeAsset,
+			},
+			Relationships: &asc.BuildUploadFileRelationships{
+				BuildUpload: &asc.Relationship{Data: asc.ResourceData{Type: asc.ResourceTypeBuildUploads, ID: upload.Data.ID}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateBuildUploadFile() error: %v", err)
+	}
+	ops := file.Data.Attributes.UploadOperations
+	if len(ops) != 1 || !strings.HasPrefix(ops[0].URL, ts.URL+uploadPathPrefix) {
+		t.Fatalf("expected one local upload operation, got %+v", ops)
+	}
+
+	ipaPath := filepath.Join(t.TempDir(), "app.ipa")
+	if err := os.WriteFile(ipaPath, []byte("data"), 0o600); err != nil {
+		t.Fatalf("write ipa: %v", err)
+	}
+	if err := asc.ExecuteUploadOperations(ctx, ipaPath, ops); err != nil {
+		t.Fatalf("ExecuteUploadOperations() error: %v", err)
+	}
+
+	uploaded := true
+	if _, err := client.UpdateBuildUploadFile(ctx, file.Data.ID, asc.BuildUploadFileUpdateRequest{
+		Data: asc.BuildUploadFileUpdateData{
+			Type:       asc.ResourceTypeBuildUploadFiles,
+			ID:         file.Data.ID,
+			Attributes: &asc.BuildUploadFileUpdateAttributes{Uploaded: &uploaded},
+		},
+	}); err != nil {
+		t.Fatalf("UpdateBuildUploadFile() error: %v", err)
+	}
+
+	preRelease, err := client.GetPreReleaseVersions(ctx, "1234567890", asc.WithPreReleaseVersionsVersion("1.0.0"))
+	if err != nil {
+		t.Fatalf("GetPreReleaseVersions() error: %v", err)
+	}
+	if len(preRelease.Data) != 1 {
+		t.Fatalf("expected one pre-release version, got %d", len(preRelease.Data))
+	}
+	builds, err := client.GetBuilds(ctx, "1234567890", asc.WithBuildsPreReleaseVersion(preRelease.Data[0].ID))
+	if err != nil {
+		t.Fatalf("GetBuilds() error: %v", err)
+	}
+	if len(builds.Data) != 1 || builds.Data[0].Attributes.Version != "42" || builds.Data[0].Attributes.ProcessingState != "VALID" {
+		t.Fatalf("unexpected builds: %+v", builds.Data)
+	}
+
+	if err := client.AddBetaGroupsToBuild(ctx, builds.Data[0].ID, []string{"GROUP1"}); err != nil {
+		t.Fatalf("AddBetaGroupsToBuild() error: %v", err)
+	}
+}
+
+func TestServerCannedRoutesTakePrecedence(t *testing.T) {
+	fixture := &Fixture{Routes: []Route{{
+		Method: http.MethodGet,
+		Path:   "/v1/apps",
+		Query:  map[string]string{"filter[bundleId]": "com.example.canned"},
+		Status: http.StatusOK,
+		Body:   json.RawMessage(`{"data":[{"type":"apps","id":"canned","attributes":{"name":"Canned"}}],"links":{}}`),
+	}}}
+	_, client := newTestServer(t, Options{Fixtures: []*Fixture{fixture}})
+
+	apps, err := client.GetApps(context.Background(), asc.WithAppsBundleIDs([]string{"com.example.canned"}))
+	if err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if len(apps.Data) != 1 || apps.Data[0].ID != "canned" {
+		t.Fatalf("expected canned route response, got %+v", apps.Data)
+	}
+}
+
+func TestServerReturnsNotFoundErrors(t *testing.T) {
+	_, client := newTestServer(t, Options{})
+
+	_, err := client.GetApp(context.Background(), "missing")
+	if err == nil {
+		t.Fatal("expected not found error")
+	}
+	if !asc.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,340 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc/types"
+)
+
+// Resource is a JSON:API resource held by the mock store.
+type Resource struct {
+	Type          string
+	ID            string
+	Attributes    map[string]any
+	Relationships map[string]Linkage
+}
+
+// Linkage is a relationship's resource identifiers. ToMany distinguishes
+// `"data": []` from `"data": {}` when rendering.
+type Linkage struct {
+	ToMany bool
+	Data   []types.ResourceData
+}
+
+// MarshalJSON renders the linkage as a JSON:API relationship object.
+func (l Linkage) MarshalJSON() ([]byte, error) {
+	if l.ToMany {
+		data := l.Data
+		if data == nil {
+			data = []types.ResourceData{}
+		}
+		return json.Marshal(map[string]any{"data": data})
+	}
+	if len(l.Data) == 0 {
+		return []byte(`{"data":null}`), nil
+	}
+	return json.Marshal(map[string]any{"data": l.Data[0]})
+}
+
+// UnmarshalJSON accepts a JSON:API relationship object with single or list data.
+func (l *Linkage) UnmarshalJSON(raw []byte) error {
+	var wrapper struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &wrapper); err != nil {
+		return err
+	}
+	return l.setData(wrapper.Data)
+}
+
+func (l *Linkage) setData(raw json.RawMessage) error {
+	trimmed := strings.TrimSpace(string(raw))
+	switch {
+	case trimmed == "" || trimmed == "null":
+		l.ToMany = false
+		l.Data = nil
+	case strings.HasPrefix(trimmed, "["):
+		var list []types.ResourceData
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return err
+		}
+		l.ToMany = true
+		l.Data = list
+	default:
+		var single types.ResourceData
+		if err := json.Unmarshal(raw, &single); err != nil {
+			return err
+		}
+		l.ToMany = false
+		l.Data = []types.ResourceData{single}
+	}
+	return nil
+}
+
+func (l Linkage) contains(resourceType, id string) bool {
+	for _, item := range l.Data {
+		if string(item.Type) == resourceType && item.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Resource) clone() *Resource {
+	copied := &Resource{
+		Type:          r.Type,
+		ID:            r.ID,
+		Attributes:    make(map[string]any, len(r.Attributes)),
+		Relationships: make(map[string]Linkage, len(r.Relationships)),
+	}
+	for key, value := range r.Attributes {
+		copied.Attributes[key] = value
+	}
+	for key, value := range r.Relationships {
+		copied.Relationships[key] = Linkage{ToMany: value.ToMany, Data: slices.Clone(value.Data)}
+	}
+	return copied
+}
+
+func (r *Resource) document(baseURL string) types.Resource[map[string]any] {
+	doc := types.Resource[map[string]any]{
+		Type:       types.ResourceType(r.Type),
+		ID:         r.ID,
+		Attributes: r.Attributes,
+	}
+	if doc.Attributes == nil {
+		doc.Attributes = map[string]any{}
+	}
+	if len(r.Relationships) > 0 {
+		if raw, err := json.Marshal(r.Relationships); err == nil {
+			doc.Relationships = raw
+		}
+	}
+	links, _ := json.Marshal(types.Links{Self: fmt.Sprintf("%s/v1/%s/%s", baseURL, r.Type, r.ID)})
+	doc.Links = links
+	return doc
+}
+
+// Store is a concurrency-safe in-memory JSON:API resource store.
+type Store struct {
+	mu        sync.RWMutex
+	resources map[string]map[string]*Resource
+	order     map[string][]string
+	nextID    int
+}
+
+// NewStore creates an empty store.
+func NewStore() *Store {
+	return &Store{
+		resources: make(map[string]map[string]*Resource),
+		order:     make(map[string][]string),
+		nextID:    1000,
+	}
+}
+
+// Put inserts or replaces a resource, assigning an ID when empty.
+func (s *Store) Put(resource *Resource) *Resource {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.putLocked(resource)
+}
+
+func (s *Store) putLocked(resource *Resource) *Resource {
+	stored := resource.clone()
+	if stored.ID == "" {
+		s.nextID++
+		stored.ID = strconv.Itoa(s.nextID)
+	}
+	byID, ok := s.resources[stored.Type]
+	if !ok {
+		byID = make(map[string]*Resource)
+		s.resources[stored.Type] = byID
+	}
+	if _, exists := byID[stored.ID]; !exists {
+		s.order[stored.Type] = append(s.order[stored.Type], stored.ID)
+	}
+	byID[stored.ID] = stored
+	return stored.clone()
+}
+
+// Get returns a copy of a resource.
+func (s *Store) Get(resourceType, id string) (*Resource, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	resource, ok := s.resources[resourceType][id]
+	if !ok {
+		return nil, false
+	}
+	return resource.clone(), true
+}
+
+// Update merges attributes and relationships into an existing resource.
+func (s *Store) Update(resourceType, id string, attributes map[string]any, relationships map[string]Linkage) (*Resource, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resource, ok := s.resources[resourceType][id]
+	if !ok {
+		return nil, false
+	}
+	if resource.Attributes == nil {
+		resource.Attributes = make(map[string]any)
+	}
+	for key, value := range attributes {
+		resource.Attributes[key] = value
+	}
+	if resource.Relationships == nil {
+		resource.Relationships = make(map[string]Linkage)
+	}
+	for key, value := range relationships {
+		resource.Relationships[key] = value
+	}
+	return resource.clone(), true
+}
+
+// Delete removes a resource and reports whether it existed.
+func (s *Store) Delete(resourceType, id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.resources[resourceType][id]; !ok {
+		return false
+	}
+	delete(s.resources[resourceType], id)
+	s.order[resourceType] = slices.DeleteFunc(s.order[resourceType], func(existing string) bool {
+		return existing == id
+	})
+	return true
+}
+
+// List returns resources of a type in insertion order that satisfy match.
+func (s *Store) List(resourceType string, match func(*Resource) bool) []*Resource {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	results := make([]*Resource, 0)
+	for _, id := range s.order[resourceType] {
+		resource := s.resources[resourceType][id]
+		if resource == nil {
+			continue
+		}
+		if match != nil && !match(resource) {
+			continue
+		}
+		results = append(results, resource.clone())
+	}
+	return results
+}
+
+// Related returns resources of relatedType that link back to the parent resource.
+func (s *Store) Related(relatedType, parentType, parentID string) []*Resource {
+	return s.List(relatedType, func(resource *Resource) bool {
+		for _, linkage := range resource.Relationships {
+			if linkage.contains(parentType, parentID) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// SetLinkage replaces, adds to, or removes from a relationship on a resource.
+func (s *Store) SetLinkage(resourceType, id, relationship string, mode linkageMode, linkage Linkage) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resource, ok := s.resources[resourceType][id]
+	if !ok {
+		return false
+	}
+	if resource.Relationships == nil {
+		resource.Relationships = make(map[string]Linkage)
+	}
+	current := resource.Relationships[relationship]
+	switch mode {
+	case linkageReplace:
+		current = linkage
+	case linkageAdd:
+		current.ToMany = true
+		for _, item := range linkage.Data {
+			if !current.contains(string(item.Type), item.ID) {
+				current.Data = append(current.Data, item)
+			}
+		}
+	case linkageRemove:
+		current.ToMany = true
+		current.Data = slices.DeleteFunc(current.Data, func(existing types.ResourceData) bool {
+			return linkage.contains(string(existing.Type), existing.ID)
+		})
+	}
+	resource.Relationships[relationship] = current
+	return true
+}
+
+// Types returns the resource types present in the store, sorted.
+func (s *Store) Types() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	names := make([]string, 0, len(s.resources))
+	for name, byID := range s.resources {
+		if len(byID) > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+type linkageMode int
+
+const (
+	linkageReplace linkageMode = iota
+	linkageAdd
+	linkageRemove
+)
+
+// matchesFilter reports whether a resource satisfies a JSON:API filter[key]=v1,v2
+// clause, checking the id, then attributes, then relationship linkage IDs.
+func matchesFilter(resource *Resource, key string, values []string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	if key == "id" {
+		return slices.Contains(values, resource.ID)
+	}
+	if value, ok := resource.Attributes[key]; ok {
+		return slices.Contains(values, attributeString(value))
+	}
+	if linkage, ok := resource.Relationships[key]; ok {
+		for _, item := range linkage.Data {
+			if slices.Contains(values, item.ID) {
+				return true
+			}
+		}
+		return false
+	}
+	// Unknown filters don't exclude resources, matching the lenient behavior
+	// callers rely on for filters the mock doesn't model.
+	return true
+}
+
+func attributeString(value any) string {
+	switch typed := value.(type) {
+	case string:
+		return typed
+	case bool:
+		return strconv.FormatBool(typed)
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		raw, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprint(typed)
+		}
+		return string(raw)
+	}
+}
@@ -0,0 +1,138 @@
+// Package openapi exposes the bundled App Store Connect OpenAPI path index
+// (a copy of docs/openapi/paths.txt) for offline method and path checks.
+package openapi
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//go:embed paths.txt
+var pathsIndex string
+
+// Operation is one method and path template from the snapshot,
+// e.g. GET /v1/apps/{id}/builds.
+type Operation struct {
+	Method string
+	Path   string
+}
+
+var loadIndex = sync.OnceValue(func() []Operation {
+	return parseIndex(pathsIndex)
+})
+
+func parseIndex(data string) []Operation {
+	lines := strings.Split(data, "\n")
+	operations := make([]Operation, 0, len(lines))
+	for _, line := range lines {
+		method, path, ok := strings.Cut(strings.TrimSpace(line), " ")
+		if !ok {
+			continue
+		}
+		operations = append(operations, Operation{
+			Method: strings.ToUpper(method),
+			Path:   strings.TrimSpace(path),
+		})
+	}
+	return operations
+}
+
+// Operations returns every operation in the snapshot, sorted by method and path.
+func Operations() []Operation {
+	return append([]Operation(nil), loadIndex()...)
+}
+
+// PathTemplates returns the unique path templates in the snapshot, sorted.
+func PathTemplates() []string {
+	seen := make(map[string]struct{})
+	paths := make([]string, 0, len(loadIndex()))
+	for _, op := range loadIndex() {
+		if _, ok := seen[op.Path]; ok {
+			continue
+		}
+		seen[op.Path] = struct{}{}
+		paths = append(paths, op.Path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Lookup resolves a concrete request path (such as /v1/apps/123/builds) to
+// its snapshot operation. Template segments like {id} match any value; when
+// several templates match, the one with the most literal segments wins.
+// Query strings are ignored.
+func Lookup(method, path string) (Operation, error) {
+	method = strings.ToUpper(strings.TrimSpace(method))
+	path = normalizePath(path)
+
+	var best *Operation
+	bestScore := -1
+	allowed := map[string]struct{}{}
+	for i, op := range loadIndex() {
+		score, ok := matchTemplate(op.Path, path)
+		if !ok {
+			continue
+		}
+		if op.Method != method {
+			allowed[op.Method] = struct{}{}
+			continue
+		}
+		if score > bestScore {
+			best = &loadIndex()[i]
+			bestScore = score
+		}
+	}
+	if best != nil {
+		return *best, nil
+	}
+	if len(allowed) > 0 {
+		methods := make([]string, 0, len(allowed))
+		for m := range allowed {
+			methods = append(methods, m)
+		}
+		sort.Strings(methods)
+		return Operation{}, fmt.Errorf("%s is not supported for %s (allowed: %s)", method, path, strings.Join(methods, ", "))
+	}
+	return Operation{}, fmt.Errorf("path %q is not in the OpenAPI snapshot", path)
+}
+
+func normalizePath(path string) string {
+	path = strings.TrimSpace(path)
+	if before, _, ok := strings.Cut(path, "?"); ok {
+		path = before
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	if len(path) > 1 {
+		path = strings.TrimRight(path, "/")
+	}
+	return path
+}
+
+// matchTemplate reports whether path matches template and how many literal
+// segments matched.
+func matchTemplate(template, path string) (int, bool) {
+	templateParts := strings.Split(strings.Trim(template, "/"), "/")
+	pathParts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(templateParts) != len(pathParts) {
+		return 0, false
+	}
+	score := 0
+	for i, part := range templateParts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			if pathParts[i] == "" {
+				return 0, false
+			}
+			continue
+		}
+		if part != pathParts[i] {
+			return 0, false
+		}
+		score++
+	}
+	return score, true
+}
@@ -0,0 +1,71 @@
+package openapi
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestEmbeddedIndexMatchesDocsSnapshot(t *testing.T) {
+	_, thisFile, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("unable to resolve test file path")
+	}
+	repoRoot := filepath.Clean(filepath.Join(filepath.Dir(thisFile), "..", ".."))
+	data, err := os.ReadFile(filepath.Join(repoRoot, "docs", "openapi", "paths.txt"))
+	if err != nil {
+		t.Fatalf("read docs/openapi/paths.txt: %v", err)
+	}
+	if string(data) != pathsIndex {
+		t.Fatal("internal/openapi/paths.txt is out of sync with docs/openapi/paths.txt; run scripts/update-openapi-index.py")
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		want    string
+		wantErr string
+	}{
+		{name: "collection", method: "GET", path: "/v1/apps", want: "/v1/apps"},
+		{name: "lowercase method and query", method: "get", path: "/v1/apps?limit=2", want: "/v1/apps"},
+		{name: "templated id", method: "GET", path: "/v1/apps/123/builds", want: "/v1/apps/{id}/builds"},
+		{name: "missing leading slash", method: "PATCH", path: "v1/apps/123", want: "/v1/apps/{id}"},
+		{name: "method not allowed", method: "DELETE", path: "/v1/apps", wantErr: "allowed: GET"},
+		{name: "unknown path", method: "GET", path: "/v1/nope", wantErr: "not in the OpenAPI snapshot"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			op, err := Lookup(test.method, test.path)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Lookup() error: %v", err)
+			}
+			if op.Path != test.want {
+				t.Fatalf("expected template %q, got %q", test.want, op.Path)
+			}
+		})
+	}
+}
+
+func TestPathTemplatesAreUniqueAndSorted(t *testing.T) {
+	paths := PathTemplates()
+	if len(paths) == 0 {
+		t.Fatal("expected path templates")
+	}
+	for i := 1; i < len(paths); i++ {
+		if paths[i-1] >= paths[i] {
+			t.Fatalf("paths not sorted/unique at %d: %q >= %q", i, paths[i-1], paths[i])
+		}
+	}
+}
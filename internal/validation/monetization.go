@@ -0,0 +1,232 @@
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	monetizationStateMissingMetadata = "MISSING_METADATA"
+	monetizationStateReadyToSubmit   = "READY_TO_SUBMIT"
+)
+
+// settledMonetizationStates are product states that no longer go through
+// review with the next submission.
+var settledMonetizationStates = map[string]struct{}{
+	"APPROVED":                    {},
+	"DEVELOPER_REMOVED_FROM_SALE": {},
+	"REMOVED_FROM_SALE":           {},
+}
+
+// maxListedTerritories caps how many territories are named in a message.
+const maxListedTerritories = 10
+
+// MonetizationPendingReview reports whether an in-app purchase or
+// subscription in the given state still has to pass review, and therefore
+// needs its review assets, localizations, and prices checked.
+func MonetizationPendingReview(state string) bool {
+	_, settled := settledMonetizationStates[strings.ToUpper(strings.TrimSpace(state))]
+	return !settled
+}
+
+func monetizationChecks(primaryLocale string, iaps []InAppPurchase, subscriptions []Subscription, fetchErr string) []CheckResult {
+	if strings.TrimSpace(fetchErr) != "" {
+		return []CheckResult{{
+			ID:          "monetization.unavailable",
+			Severity:    SeverityWarning,
+			Message:     "in-app purchases and subscriptions were not checked: " + strings.TrimSpace(fetchErr),
+			Remediation: "Use an API key with access to in-app purchases and subscriptions, or suppress this check in a rules file",
+		}}
+	}
+
+	var checks []CheckResult
+
+	for _, iap := range iaps {
+		if !MonetizationPendingReview(iap.State) {
+			continue
+		}
+		product := monetizationProduct{
+			kind:                "iap",
+			resourceType:        "inAppPurchase",
+			label:               "in-app purchase",
+			id:                  iap.ID,
+			name:                productLabel(iap.Name, iap.ProductID),
+			state:               iap.State,
+			locales:             iap.Locales,
+			hasReviewScreenshot: iap.HasReviewScreenshot,
+			attached:            iap.Attached,
+		}
+		checks = append(checks, product.checks(primaryLocale)...)
+	}
+
+	for _, sub := range subscriptions {
+		if !MonetizationPendingReview(sub.State) {
+			continue
+		}
+		product := monetizationProduct{
+			kind:                "subscription",
+			resourceType:        "subscription",
+			label:               "subscription",
+			id:                  sub.ID,
+			name:                productLabel(sub.Name, sub.ProductID),
+			state:               sub.State,
+			locales:             sub.Locales,
+			hasReviewScreenshot: sub.HasReviewScreenshot,
+			attached:            sub.Attached,
+		}
+		checks = append(checks, product.checks(primaryLocale)...)
+		checks = append(checks, subscriptionPriceChecks(sub, product.name)...)
+	}
+
+	return checks
+}
+
+// monetizationProduct holds the fields shared by in-app purchases and
+// subscriptions so both go through the same checks.
+type monetizationProduct struct {
+	kind                string
+	resourceType        string
+	label               string
+	id                  string
+	name                string
+	state               string
+	locales             []string
+	hasReviewScreenshot bool
+	attached            bool
+}
+
+func (p monetizationProduct) checks(primaryLocale string) []CheckResult {
+	var checks []CheckResult
+
+	switch strings.ToUpper(strings.TrimSpace(p.state)) {
+	case monetizationStateMissingMetadata:
+		checks = append(checks, p.check(
+			"missing_metadata",
+			SeverityWarning,
+			"",
+			fmt.Sprintf("%s %s is missing metadata and cannot be submitted with this version", p.label, p.name),
+			"Complete the product's metadata, pricing, and review information",
+		))
+	case monetizationStateReadyToSubmit:
+		if !p.attached {
+			checks = append(checks, p.check(
+				"not_attached",
+				SeverityWarning,
+				"",
+				fmt.Sprintf("%s %s is ready to submit but is not attached to the submission", p.label, p.name),
+				"Add the product to the version's In-App Purchases and Subscriptions section before submitting",
+			))
+		}
+	}
+
+	if !p.hasReviewScreenshot {
+		checks = append(checks, p.check(
+			"review_screenshot",
+			SeverityError,
+			"",
+			fmt.Sprintf("%s %s has no App Review screenshot", p.label, p.name),
+			"Upload a review screenshot showing the purchase in the app",
+		))
+	}
+
+	if len(p.locales) == 0 {
+		checks = append(checks, p.check(
+			"localizations",
+			SeverityError,
+			"",
+			fmt.Sprintf("%s %s has no localizations", p.label, p.name),
+			"Add a display name and description for at least the primary locale",
+		))
+	} else if strings.TrimSpace(primaryLocale) != "" && !containsFold(p.locales, primaryLocale) {
+		checks = append(checks, p.check(
+			"primary_locale",
+			SeverityWarning,
+			primaryLocale,
+			fmt.Sprintf("%s %s has no localization for the primary locale", p.label, p.name),
+			"Add a localization for the app's primary locale",
+		))
+	}
+
+	return checks
+}
+
+func (p monetizationProduct) check(suffix string, severity Severity, locale string, message string, remediation string) CheckResult {
+	return CheckResult{
+		ID:           "monetization." + p.kind + "." + suffix,
+		Severity:     severity,
+		Locale:       locale,
+		ResourceType: p.resourceType,
+		ResourceID:   p.id,
+		Message:      message,
+		Remediation:  remediation,
+	}
+}
+
+func subscriptionPriceChecks(sub Subscription, name string) []CheckResult {
+	if !sub.HasAvailability || len(sub.AvailableTerritories) == 0 {
+		return []CheckResult{{
+			ID:           "monetization.subscription.availability",
+			Severity:     SeverityError,
+			ResourceType: "subscription",
+			ResourceID:   sub.ID,
+			Message:      fmt.Sprintf("subscription %s is not available in any territory", name),
+			Remediation:  "Set the subscription's availability and choose at least one territory",
+		}}
+	}
+
+	priced := make(map[string]struct{}, len(sub.PricedTerritories))
+	for _, territory := range sub.PricedTerritories {
+		priced[strings.ToUpper(strings.TrimSpace(territory))] = struct{}{}
+	}
+	var missing []string
+	for _, territory := range sub.AvailableTerritories {
+		territory = strings.ToUpper(strings.TrimSpace(territory))
+		if _, ok := priced[territory]; !ok {
+			missing = append(missing, territory)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+
+	listed := missing
+	suffix := ""
+	if len(listed) > maxListedTerritories {
+		listed = listed[:maxListedTerritories]
+		suffix = fmt.Sprintf(", and %d more", len(missing)-maxListedTerritories)
+	}
+	return []CheckResult{{
+		ID:           "monetization.subscription.prices",
+		Severity:     SeverityError,
+		ResourceType: "subscription",
+		ResourceID:   sub.ID,
+		Message: fmt.Sprintf("subscription %s is missing prices in %d of %d available territories: %s%s",
+			name, len(missing), len(sub.AvailableTerritories), strings.Join(listed, ", "), suffix),
+		Remediation: "Set a price for every territory the subscription is available in",
+	}}
+}
+
+func productLabel(name string, productID string) string {
+	name = strings.TrimSpace(name)
+	productID = strings.TrimSpace(productID)
+	switch {
+	case name != "" && productID != "":
+		return fmt.Sprintf("%q (%s)", name, productID)
+	case productID != "":
+		return productID
+	default:
+		return fmt.Sprintf("%q", name)
+	}
+}
+
+func containsFold(values []string, target string) bool {
+	target = strings.TrimSpace(target)
+	for _, value := range values {
+		if strings.EqualFold(strings.TrimSpace(value), target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMonetizationChecks_InAppPurchases(t *testing.T) {
+	iaps := []InAppPurchase{
+		{ID: "iap-missing", Name: "Gems", ProductID: "com.example.gems", State: "MISSING_METADATA"},
+		{ID: "iap-ready", Name: "Coins", ProductID: "com.example.coins", State: "READY_TO_SUBMIT", Locales: []string{"de-DE"}, HasReviewScreenshot: true},
+		{ID: "iap-waiting", Name: "Pro", ProductID: "com.example.pro", State: "WAITING_FOR_REVIEW", Locales: []string{"en-US"}, HasReviewScreenshot: true},
+		{ID: "iap-approved", Name: "Old", ProductID: "com.example.old", State: "APPROVED"},
+	}
+
+	checks := monetizationChecks("en-US", iaps, nil, "")
+
+	want := []struct {
+		id         string
+		resourceID string
+		severity   Severity
+	}{
+		{"monetization.iap.missing_metadata", "iap-missing", SeverityWarning},
+		{"monetization.iap.review_screenshot", "iap-missing", SeverityError},
+		{"monetization.iap.localizations", "iap-missing", SeverityError},
+		{"monetization.iap.not_attached", "iap-ready", SeverityWarning},
+		{"monetization.iap.primary_locale", "iap-ready", SeverityWarning},
+	}
+	if len(checks) != len(want) {
+		t.Fatalf("expected %d checks, got %d (%v)", len(want), len(checks), checks)
+	}
+	for i, expected := range want {
+		if checks[i].ID != expected.id || checks[i].ResourceID != expected.resourceID || checks[i].Severity != expected.severity {
+			t.Fatalf("check %d: expected %+v, got %+v", i, expected, checks[i])
+		}
+	}
+	if !strings.Contains(checks[0].Message, "com.example.gems") {
+		t.Fatalf("expected message to name the product, got %q", checks[0].Message)
+	}
+}
+
+func TestMonetizationChecks_SubscriptionPrices(t *testing.T) {
+	tests := []struct {
+		name    string
+		sub     Subscription
+		wantID  string
+		wantMsg string
+	}{
+		{
+			name: "no availability",
+			sub: Subscription{
+				ID: "sub-1", Name: "Monthly", State: "WAITING_FOR_REVIEW",
+				Locales: []string{"en-US"}, HasReviewScreenshot: true,
+			},
+			wantID:  "monetization.subscription.availability",
+			wantMsg: "not available in any territory",
+		},
+		{
+			name: "missing prices",
+			sub: Subscription{
+				ID: "sub-1", Name: "Monthly", State: "WAITING_FOR_REVIEW",
+				Locales: []string{"en-US"}, HasReviewScreenshot: true, HasAvailability: true,
+				AvailableTerritories: []string{"USA", "GBR", "FRA"},
+				PricedTerritories:    []string{"usa"},
+			},
+			wantID:  "monetization.subscription.prices",
+			wantMsg: "2 of 3 available territories: FRA, GBR",
+		},
+		{
+			name: "fully priced",
+			sub: Subscription{
+				ID: "sub-1", Name: "Monthly", State: "WAITING_FOR_REVIEW",
+				Locales: []string{"en-US"}, HasReviewScreenshot: true, HasAvailability: true,
+				AvailableTerritories: []string{"USA", "GBR"},
+				PricedTerritories:    []string{"GBR", "USA"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			checks := monetizationChecks("en-US", nil, []Subscription{test.sub}, "")
+			if test.wantID == "" {
+				if len(checks) != 0 {
+					t.Fatalf("expected no checks, got %v", checks)
+				}
+				return
+			}
+			if len(checks) != 1 || checks[0].ID != test.wantID {
+				t.Fatalf("expected %s, got %v", test.wantID, checks)
+			}
+			if !strings.Contains(checks[0].Message, test.wantMsg) {
+				t.Fatalf("expected message to contain %q, got %q", test.wantMsg, checks[0].Message)
+			}
+		})
+	}
+}
+
+func TestMonetizationChecks_TruncatesTerritoryList(t *testing.T) {
+	available := []string{"AAA", "BBB", "CCC", "DDD", "EEE", "FFF", "GGG", "HHH", "III", "JJJ", "KKK", "LLL"}
+	sub := Subscription{
+		ID: "sub-1", ProductID: "com.example.monthly", State: "WAITING_FOR_REVIEW",
+		Locales: []string{"en-US"}, HasReviewScreenshot: true, HasAvailability: true,
+		AvailableTerritories: available,
+	}
+
+	checks := monetizationChecks("en-US", nil, []Subscription{sub}, "")
+	if len(checks) != 1 || !strings.HasSuffix(checks[0].Message, "JJJ, and 2 more") {
+		t.Fatalf("expected truncated territory list, got %v", checks)
+	}
+}
+
+func TestMonetizationChecks_NotAttachedOnlyForUnattachedProducts(t *testing.T) {
+	iaps := []InAppPurchase{
+		{ID: "iap-attached", Name: "Coins", State: "READY_TO_SUBMIT", Locales: []string{"en-US"}, HasReviewScreenshot: true, Attached: true},
+		{ID: "iap-unattached", Name: "Gems", State: "READY_TO_SUBMIT", Locales: []string{"en-US"}, HasReviewScreenshot: true},
+	}
+
+	checks := monetizationChecks("en-US", iaps, nil, "")
+
+	if len(checks) != 1 {
+		t.Fatalf("expected 1 check, got %d (%v)", len(checks), checks)
+	}
+	if checks[0].ID != "monetization.iap.not_attached" || checks[0].ResourceID != "iap-unattached" {
+		t.Fatalf("expected not_attached for iap-unattached, got %+v", checks[0])
+	}
+}
+
+func TestMonetizationChecks_FetchErrorDegradesToWarning(t *testing.T) {
+	iaps := []InAppPurchase{
+		{ID: "iap-1", Name: "Gems", State: "MISSING_METADATA"},
+	}
+
+	checks := monetizationChecks("en-US", iaps, nil, "failed to fetch in-app purchases: forbidden")
+
+	if len(checks) != 1 {
+		t.Fatalf("expected 1 check, got %d (%v)", len(checks), checks)
+	}
+	if checks[0].ID != "monetization.unavailable" || checks[0].Severity != SeverityWarning {
+		t.Fatalf("expected monetization.unavailable warning, got %+v", checks[0])
+	}
+	if !strings.Contains(checks[0].Message, "forbidden") {
+		t.Fatalf("expected message to include the fetch error, got %q", checks[0].Message)
+	}
+}
+
+func TestValidate_MonetizationWarningsBlockInStrictMode(t *testing.T) {
+	input := Input{
+		InAppPurchases: []InAppPurchase{
+			{ID: "iap-1", Name: "Gems", State: "READY_TO_SUBMIT", Locales: []string{"en-US"}, HasReviewScreenshot: true},
+		},
+	}
+
+	report := Validate(input, false)
+	if !hasCheckID(report.Checks, "monetization.iap.not_attached") {
+		t.Fatalf("expected not_attached check, got %v", report.Checks)
+	}
+	strictReport := Validate(input, true)
+	if strictReport.Summary.Blocking != report.Summary.Blocking+report.Summary.Warnings {
+		t.Fatalf("expected warnings to block in strict mode, got %+v", strictReport.Summary)
+	}
+}
@@ -2,13 +2,26 @@ package validation
 
 // Validate runs all validation rules and returns a report.
 func Validate(input Input, strict bool) Report {
+	return ValidateWithRules(input, strict, nil)
+}
+
+// ValidateWithRules runs all validation rules plus any custom rules, then
+// applies the rules file's check overrides and suppressions.
+func ValidateWithRules(input Input, strict bool, rules *Rules) Report {
 	checks := make([]CheckResult, 0)
 	checks = append(checks, metadataLengthChecks(input.VersionLocalizations, input.AppInfoLocalizations)...)
 	checks = append(checks, requiredFieldChecks(input.PrimaryLocale, input.VersionLocalizations, input.AppInfoLocalizations)...)
 	checks = append(checks, screenshotChecks(input.Platform, input.ScreenshotSets)...)
 	checks = append(checks, ageRatingChecks(input.AgeRatingDeclaration)...)
+	checks = append(checks, monetizationChecks(input.PrimaryLocale, input.InAppPurchases, input.Subscriptions, input.MonetizationError)...)
+
+	var suppressed []SuppressedCheck
+	if rules != nil {
+		checks = append(checks, rules.customChecks(input.VersionLocalizations, input.AppInfoLocalizations)...)
+		checks, suppressed = rules.apply(checks)
+	}
 
-	summary := summarize(checks, strict)
+	summary := Summarize(checks, strict)
 
 	return Report{
 		AppID:         input.AppID,
@@ -17,11 +30,13 @@ func Validate(input Input, strict bool) Report {
 		Platform:      input.Platform,
 		Summary:       summary,
 		Checks:        checks,
+		Suppressed:    suppressed,
 		Strict:        strict,
 	}
 }
 
-func summarize(checks []CheckResult, strict bool) Summary {
+// Summarize counts checks by severity. Warnings are blocking in strict mode.
+func Summarize(checks []CheckResult, strict bool) Summary {
 	summary := Summary{}
 	for _, check := range checks {
 		switch check.Severity {
@@ -0,0 +1,322 @@
+package validation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultRulesPath is the repo-level rules file picked up when present.
+const DefaultRulesPath = ".asc/validate.yaml"
+
+// Rules customizes which checks run, their severity, and which findings are
+// suppressed. It also carries custom regex rules for metadata text.
+type Rules struct {
+	// Checks maps a check ID, or a pattern such as "screenshots.*", to an
+	// override. An exact ID takes precedence over patterns, and longer
+	// patterns over shorter ones; patterns of equal length are tried in
+	// lexical order.
+	Checks       map[string]CheckRule `yaml:"checks"`
+	Suppressions []Suppression        `yaml:"suppressions"`
+	Custom       []CustomRule         `yaml:"custom"`
+}
+
+// CheckRule turns a check off or changes its severity.
+type CheckRule struct {
+	Enabled  *bool    `yaml:"enabled"`
+	Severity Severity `yaml:"severity"`
+}
+
+// Suppression silences findings for a check ID, optionally narrowed to a
+// locale, field, or resource. A justification is required.
+type Suppression struct {
+	ID            string `yaml:"id" json:"id"`
+	Locale        string `yaml:"locale" json:"locale,omitempty"`
+	Field         string `yaml:"field" json:"field,omitempty"`
+	ResourceID    string `yaml:"resourceId" json:"resourceId,omitempty"`
+	Justification string `yaml:"justification" json:"justification"`
+}
+
+// CustomRule reports metadata text that matches a regular expression, such
+// as banned words in descriptions.
+type CustomRule struct {
+	ID          string   `yaml:"id"`
+	Pattern     string   `yaml:"pattern"`
+	Severity    Severity `yaml:"severity"`
+	Fields      []string `yaml:"fields"`
+	Locales     []string `yaml:"locales"`
+	Message     string   `yaml:"message"`
+	Remediation string   `yaml:"remediation"`
+
+	regex *regexp.Regexp
+}
+
+// SuppressedCheck is a finding silenced by a suppression.
+type SuppressedCheck struct {
+	CheckResult
+	Justification string `json:"justification"`
+}
+
+// customRuleFields lists the metadata fields custom rules can match.
+var customRuleFields = map[string]struct{}{
+	"description":     {},
+	"keywords":        {},
+	"whatsNew":        {},
+	"promotionalText": {},
+	"supportUrl":      {},
+	"marketingUrl":    {},
+	"name":            {},
+	"subtitle":        {},
+}
+
+// LoadRules reads a rules file from disk.
+func LoadRules(filePath string) (*Rules, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("read rules: %w", err)
+	}
+	rules, err := ParseRules(data)
+	if err != nil {
+		return nil, fmt.Errorf("rules %s: %w", filePath, err)
+	}
+	return rules, nil
+}
+
+// ParseRules decodes and validates a YAML rules document.
+func ParseRules(data []byte) (*Rules, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var rules Rules
+	if err := decoder.Decode(&rules); err != nil {
+		if errors.Is(err, io.EOF) {
+			return &rules, nil
+		}
+		return nil, fmt.Errorf("parse: %w", err)
+	}
+	if err := rules.validate(); err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+func (r *Rules) validate() error {
+	for pattern, rule := range r.Checks {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("checks: invalid pattern %q", pattern)
+		}
+		if rule.Severity != "" && !validSeverity(rule.Severity) {
+			return fmt.Errorf("checks.%s: invalid severity %q (use error, warning, or info)", pattern, rule.Severity)
+		}
+	}
+	for i, suppression := range r.Suppressions {
+		if strings.TrimSpace(suppression.ID) == "" {
+			return fmt.Errorf("suppressions[%d] needs an id", i)
+		}
+		if _, err := path.Match(suppression.ID, ""); err != nil {
+			return fmt.Errorf("suppressions[%d]: invalid id pattern %q", i, suppression.ID)
+		}
+		if strings.TrimSpace(suppression.Justification) == "" {
+			return fmt.Errorf("suppressions[%d] (%s) needs a justification", i, suppression.ID)
+		}
+	}
+	ids := make(map[string]struct{}, len(r.Custom))
+	for i := range r.Custom {
+		rule := &r.Custom[i]
+		if strings.TrimSpace(rule.ID) == "" {
+			return fmt.Errorf("custom[%d] needs an id", i)
+		}
+		if _, ok := ids[rule.ID]; ok {
+			return fmt.Errorf("custom rule %q is listed more than once", rule.ID)
+		}
+		ids[rule.ID] = struct{}{}
+		if err := rule.compile(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddCustomRule registers a custom rule alongside any loaded from a file.
+func (r *Rules) AddCustomRule(rule CustomRule) error {
+	if strings.TrimSpace(rule.ID) == "" {
+		return fmt.Errorf("custom rule needs an id")
+	}
+	for _, existing := range r.Custom {
+		if existing.ID == rule.ID {
+			return fmt.Errorf("custom rule %q is listed more than once", rule.ID)
+		}
+	}
+	if err := rule.compile(); err != nil {
+		return err
+	}
+	r.Custom = append(r.Custom, rule)
+	return nil
+}
+
+func (rule *CustomRule) compile() error {
+	if strings.TrimSpace(rule.Pattern) == "" {
+		return fmt.Errorf("custom rule %q needs a pattern", rule.ID)
+	}
+	regex, err := regexp.Compile(rule.Pattern)
+	if err != nil {
+		return fmt.Errorf("custom rule %q: invalid pattern: %w", rule.ID, err)
+	}
+	rule.regex = regex
+	if rule.Severity == "" {
+		rule.Severity = SeverityWarning
+	} else if !validSeverity(rule.Severity) {
+		return fmt.Errorf("custom rule %q: invalid severity %q (use error, warning, or info)", rule.ID, rule.Severity)
+	}
+	for _, field := range rule.Fields {
+		if _, ok := customRuleFields[field]; !ok {
+			return fmt.Errorf("custom rule %q: unknown field %q", rule.ID, field)
+		}
+	}
+	return nil
+}
+
+func validSeverity(severity Severity) bool {
+	switch severity {
+	case SeverityError, SeverityWarning, SeverityInfo:
+		return true
+	}
+	return false
+}
+
+// customChecks runs the custom regex rules over metadata text.
+func (r *Rules) customChecks(versionLocs []VersionLocalization, appInfoLocs []AppInfoLocalization) []CheckResult {
+	var checks []CheckResult
+	for _, rule := range r.Custom {
+		for _, loc := range versionLocs {
+			checks = append(checks, rule.match("appStoreVersionLocalization", loc.ID, loc.Locale, map[string]string{
+				"description":     loc.Description,
+				"keywords":        loc.Keywords,
+				"whatsNew":        loc.WhatsNew,
+				"promotionalText": loc.PromotionalText,
+				"supportUrl":      loc.SupportURL,
+				"marketingUrl":    loc.MarketingURL,
+			})...)
+		}
+		for _, loc := range appInfoLocs {
+			checks = append(checks, rule.match("appInfoLocalization", loc.ID, loc.Locale, map[string]string{
+				"name":     loc.Name,
+				"subtitle": loc.Subtitle,
+			})...)
+		}
+	}
+	return checks
+}
+
+func (rule CustomRule) match(resourceType, resourceID, locale string, values map[string]string) []CheckResult {
+	if rule.regex == nil {
+		return nil
+	}
+	if len(rule.Locales) > 0 && !containsFold(rule.Locales, locale) {
+		return nil
+	}
+
+	var checks []CheckResult
+	for _, field := range sortedFieldNames(values) {
+		if len(rule.Fields) > 0 && !containsFold(rule.Fields, field) {
+			continue
+		}
+		found := rule.regex.FindString(values[field])
+		if found == "" {
+			continue
+		}
+		message := fmt.Sprintf("%s matches %q", field, found)
+		if strings.TrimSpace(rule.Message) != "" {
+			message = fmt.Sprintf("%s: %s matches %q", rule.Message, field, found)
+		}
+		checks = append(checks, CheckResult{
+			ID:           rule.ID,
+			Severity:     rule.Severity,
+			Locale:       locale,
+			Field:        field,
+			ResourceType: resourceType,
+			ResourceID:   resourceID,
+			Message:      message,
+			Remediation:  rule.Remediation,
+		})
+	}
+	return checks
+}
+
+// apply drops disabled checks, overrides severities, and moves suppressed
+// findings out of the result set.
+func (r *Rules) apply(checks []CheckResult) ([]CheckResult, []SuppressedCheck) {
+	kept := make([]CheckResult, 0, len(checks))
+	var suppressed []SuppressedCheck
+	for _, check := range checks {
+		if rule, ok := r.checkRule(check.ID); ok {
+			if rule.Enabled != nil && !*rule.Enabled {
+				continue
+			}
+			if rule.Severity != "" {
+				check.Severity = rule.Severity
+			}
+		}
+		if suppression, ok := r.suppression(check); ok {
+			suppressed = append(suppressed, SuppressedCheck{CheckResult: check, Justification: suppression.Justification})
+			continue
+		}
+		kept = append(kept, check)
+	}
+	return kept, suppressed
+}
+
+func (r *Rules) checkRule(id string) (CheckRule, bool) {
+	if rule, ok := r.Checks[id]; ok {
+		return rule, true
+	}
+	best := ""
+	for pattern := range r.Checks {
+		matched, _ := path.Match(pattern, id)
+		if !matched {
+			continue
+		}
+		if best == "" || len(pattern) > len(best) || (len(pattern) == len(best) && pattern < best) {
+			best = pattern
+		}
+	}
+	if best == "" {
+		return CheckRule{}, false
+	}
+	return r.Checks[best], true
+}
+
+func (r *Rules) suppression(check CheckResult) (Suppression, bool) {
+	for _, suppression := range r.Suppressions {
+		if matched, _ := path.Match(suppression.ID, check.ID); !matched {
+			continue
+		}
+		if suppression.Locale != "" && !strings.EqualFold(suppression.Locale, check.Locale) {
+			continue
+		}
+		if suppression.Field != "" && !strings.EqualFold(suppression.Field, check.Field) {
+			continue
+		}
+		if suppression.ResourceID != "" && suppression.ResourceID != check.ResourceID {
+			continue
+		}
+		return suppression, true
+	}
+	return Suppression{}, false
+}
+
+func sortedFieldNames(values map[string]string) []string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,229 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRules_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{
+			name:    "unknown key",
+			yaml:    "checkz: {}\n",
+			wantErr: "field checkz not found",
+		},
+		{
+			name:    "invalid severity",
+			yaml:    "checks:\n  metadata.length.keywords:\n    severity: fatal\n",
+			wantErr: `invalid severity "fatal"`,
+		},
+		{
+			name:    "suppression without justification",
+			yaml:    "suppressions:\n  - id: metadata.length.keywords\n    locale: ja\n",
+			wantErr: "needs a justification",
+		},
+		{
+			name:    "custom rule with bad regex",
+			yaml:    "custom:\n  - id: custom.words\n    pattern: \"(free\"\n",
+			wantErr: "invalid pattern",
+		},
+		{
+			name:    "custom rule with unknown field",
+			yaml:    "custom:\n  - id: custom.words\n    pattern: free\n    fields: [body]\n",
+			wantErr: `unknown field "body"`,
+		},
+		{
+			name:    "duplicate custom rule",
+			yaml:    "custom:\n  - id: custom.words\n    pattern: free\n  - id: custom.words\n    pattern: best\n",
+			wantErr: "listed more than once",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseRules([]byte(test.yaml))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseRules_Empty(t *testing.T) {
+	rules, err := ParseRules(nil)
+	if err != nil {
+		t.Fatalf("ParseRules() error: %v", err)
+	}
+	checks := []CheckResult{{ID: "metadata.length.keywords", Severity: SeverityError}}
+	kept, suppressed := rules.apply(checks)
+	if len(kept) != 1 || len(suppressed) != 0 {
+		t.Fatalf("expected empty rules to keep checks, got %v %v", kept, suppressed)
+	}
+}
+
+func TestRulesApply(t *testing.T) {
+	rules, err := ParseRules([]byte(`
+checks:
+  screenshots.*:
+    severity: warning
+  screenshots.display_type_unknown:
+    enabled: false
+  metadata.required.whats_new:
+    severity: info
+suppressions:
+  - id: metadata.length.*
+    locale: ja
+    justification: Japanese keywords are reviewed manually
+  - id: metadata.required.support_url
+    resourceId: loc-2
+    justification: Support page is shared with the web app
+`))
+	if err != nil {
+		t.Fatalf("ParseRules() error: %v", err)
+	}
+
+	checks := []CheckResult{
+		{ID: "screenshots.dimension_mismatch", Severity: SeverityError},
+		{ID: "screenshots.display_type_unknown", Severity: SeverityWarning},
+		{ID: "metadata.required.whats_new", Severity: SeverityError},
+		{ID: "metadata.length.keywords", Severity: SeverityError, Locale: "JA"},
+		{ID: "metadata.length.keywords", Severity: SeverityError, Locale: "en-US"},
+		{ID: "metadata.required.support_url", Severity: SeverityError, ResourceID: "loc-1"},
+		{ID: "metadata.required.support_url", Severity: SeverityError, ResourceID: "loc-2"},
+	}
+
+	kept, suppressed := rules.apply(checks)
+
+	want := []struct {
+		id       string
+		severity Severity
+	}{
+		{"screenshots.dimension_mismatch", SeverityWarning},
+		{"metadata.required.whats_new", SeverityInfo},
+		{"metadata.length.keywords", SeverityError},
+		{"metadata.required.support_url", SeverityError},
+	}
+	if len(kept) != len(want) {
+		t.Fatalf("expected %d kept checks, got %v", len(want), kept)
+	}
+	for i, expected := range want {
+		if kept[i].ID != expected.id || kept[i].Severity != expected.severity {
+			t.Fatalf("kept[%d]: expected %+v, got %+v", i, expected, kept[i])
+		}
+	}
+	if kept[2].Locale != "en-US" || kept[3].ResourceID != "loc-1" {
+		t.Fatalf("suppressed the wrong findings: %v", kept)
+	}
+
+	if len(suppressed) != 2 {
+		t.Fatalf("expected 2 suppressed checks, got %v", suppressed)
+	}
+	if suppressed[0].Justification != "Japanese keywords are reviewed manually" || suppressed[1].ResourceID != "loc-2" {
+		t.Fatalf("unexpected suppressed checks: %+v", suppressed)
+	}
+}
+
+func TestRulesApply_EqualLengthPatternsAreDeterministic(t *testing.T) {
+	rules, err := ParseRules([]byte(`
+checks:
+  metadata.*:
+    severity: warning
+  "*.keywords":
+    severity: info
+`))
+	if err != nil {
+		t.Fatalf("ParseRules() error: %v", err)
+	}
+
+	for i := 0; i < 50; i++ {
+		kept, _ := rules.apply([]CheckResult{{ID: "metadata.keywords", Severity: SeverityError}})
+		if len(kept) != 1 || kept[0].Severity != SeverityInfo {
+			t.Fatalf("expected the lexically first pattern to win, got %+v", kept)
+		}
+	}
+}
+
+func TestRulesCustomChecks(t *testing.T) {
+	rules, err := ParseRules([]byte(`
+custom:
+  - id: custom.banned_words
+    pattern: (?i)\b(free|best)\b
+    fields: [description, subtitle]
+    severity: error
+    message: Avoid pricing claims
+    remediation: Remove the word
+  - id: custom.german_only
+    pattern: kostenlos
+    locales: [de-DE]
+`))
+	if err != nil {
+		t.Fatalf("ParseRules() error: %v", err)
+	}
+
+	versionLocs := []VersionLocalization{
+		{ID: "ver-en", Locale: "en-US", Description: "The BEST app", Keywords: "free,best"},
+		{ID: "ver-de", Locale: "de-DE", Description: "kostenlos"},
+	}
+	appInfoLocs := []AppInfoLocalization{
+		{ID: "info-en", Locale: "en-US", Name: "Best", Subtitle: "Free forever"},
+	}
+
+	checks := rules.customChecks(versionLocs, appInfoLocs)
+	if len(checks) != 3 {
+		t.Fatalf("expected 3 custom checks, got %v", checks)
+	}
+	if checks[0].ID != "custom.banned_words" || checks[0].Field != "description" || checks[0].ResourceID != "ver-en" || checks[0].Severity != SeverityError {
+		t.Fatalf("unexpected description match: %+v", checks[0])
+	}
+	if checks[0].Message != `Avoid pricing claims: description matches "BEST"` || checks[0].Remediation != "Remove the word" {
+		t.Fatalf("unexpected message: %+v", checks[0])
+	}
+	if checks[1].Field != "subtitle" || checks[1].ResourceType != "appInfoLocalization" {
+		t.Fatalf("unexpected subtitle match: %+v", checks[1])
+	}
+	if checks[2].ID != "custom.german_only" || checks[2].Locale != "de-DE" || checks[2].Severity != SeverityWarning {
+		t.Fatalf("expected locale-scoped rule with default severity, got %+v", checks[2])
+	}
+}
+
+func TestRulesAddCustomRule(t *testing.T) {
+	rules := &Rules{}
+	if err := rules.AddCustomRule(CustomRule{ID: "custom.todo", Pattern: "TODO"}); err != nil {
+		t.Fatalf("AddCustomRule() error: %v", err)
+	}
+	if err := rules.AddCustomRule(CustomRule{ID: "custom.todo", Pattern: "FIXME"}); err == nil {
+		t.Fatal("expected duplicate rule to fail")
+	}
+
+	report := ValidateWithRules(Input{
+		VersionLocalizations: []VersionLocalization{{ID: "ver-1", Locale: "en-US", WhatsNew: "TODO: write notes"}},
+	}, false, rules)
+	if !hasCheckID(report.Checks, "custom.todo") {
+		t.Fatalf("expected custom rule in report, got %v", report.Checks)
+	}
+}
+
+func TestValidateWithRules_SuppressedChecksDoNotBlock(t *testing.T) {
+	rules, err := ParseRules([]byte(`
+suppressions:
+  - id: metadata.required.*
+    justification: Draft version
+  - id: age_rating.*
+    justification: Draft version
+`))
+	if err != nil {
+		t.Fatalf("ParseRules() error: %v", err)
+	}
+
+	report := ValidateWithRules(Input{}, true, rules)
+	if report.Summary.Blocking != 0 || len(report.Checks) != 0 {
+		t.Fatalf("expected all findings suppressed, got %+v", report)
+	}
+	if len(report.Suppressed) == 0 {
+		t.Fatal("expected suppressed findings to be reported")
+	}
+}
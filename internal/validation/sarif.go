@@ -0,0 +1,198 @@
+package validation
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifToolURI = "https://github.com/rudrankriyam/App-Store-Connect-CLI"
+)
+
+// SARIFLog is a SARIF 2.1.0 log, the format code-scanning tools ingest.
+type SARIFLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string        `json:"id"`
+	ShortDescription sarifMessage  `json:"shortDescription"`
+	Help             *sarifMessage `json:"help,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID              string             `json:"ruleId"`
+	Level               string             `json:"level"`
+	Message             sarifMessage       `json:"message"`
+	Locations           []sarifLocation    `json:"locations,omitempty"`
+	PartialFingerprints map[string]string  `json:"partialFingerprints,omitempty"`
+	Properties          map[string]string  `json:"properties,omitempty"`
+	Suppressions        []sarifSuppression `json:"suppressions,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName,omitempty"`
+	Kind               string `json:"kind"`
+}
+
+type sarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification"`
+}
+
+// SARIF converts a report to a SARIF log. Findings are about App Store
+// Connect resources rather than files, so each result carries a logical
+// location. Code scanning needs a file to place annotations on, so
+// artifactURI (typically the rules file, DefaultRulesPath when empty) is
+// used as the physical location of every result. In strict mode warnings
+// are reported at the error level.
+func SARIF(report Report, artifactURI string) SARIFLog {
+	if strings.TrimSpace(artifactURI) == "" {
+		artifactURI = DefaultRulesPath
+	}
+	rules := map[string]sarifRule{}
+	results := make([]sarifResult, 0, len(report.Checks)+len(report.Suppressed))
+
+	for _, check := range report.Checks {
+		addSARIFRule(rules, check)
+		results = append(results, sarifResultFor(check, report.Strict, artifactURI))
+	}
+	for _, suppressed := range report.Suppressed {
+		addSARIFRule(rules, suppressed.CheckResult)
+		result := sarifResultFor(suppressed.CheckResult, report.Strict, artifactURI)
+		result.Suppressions = []sarifSuppression{{Kind: "external", Justification: suppressed.Justification}}
+		results = append(results, result)
+	}
+
+	ids := make([]string, 0, len(rules))
+	for id := range rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	driverRules := make([]sarifRule, 0, len(ids))
+	for _, id := range ids {
+		driverRules = append(driverRules, rules[id])
+	}
+
+	return SARIFLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "asc validate",
+				InformationURI: sarifToolURI,
+				Rules:          driverRules,
+			}},
+			Results: results,
+		}},
+	}
+}
+
+func addSARIFRule(rules map[string]sarifRule, check CheckResult) {
+	if _, ok := rules[check.ID]; ok {
+		return
+	}
+	rule := sarifRule{ID: check.ID, ShortDescription: sarifMessage{Text: check.ID}}
+	if strings.TrimSpace(check.Remediation) != "" {
+		rule.Help = &sarifMessage{Text: check.Remediation}
+	}
+	rules[check.ID] = rule
+}
+
+func sarifResultFor(check CheckResult, strict bool, artifactURI string) sarifResult {
+	result := sarifResult{
+		RuleID:  check.ID,
+		Level:   sarifLevel(check.Severity, strict),
+		Message: sarifMessage{Text: check.Message},
+		PartialFingerprints: map[string]string{
+			"ascCheck/v1": strings.Join([]string{check.ID, check.ResourceType, check.ResourceID, check.Locale, check.Field}, "|"),
+		},
+	}
+
+	location := sarifLocation{
+		PhysicalLocation: &sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: artifactURI},
+			Region:           sarifRegion{StartLine: 1},
+		},
+	}
+	if check.ResourceType != "" || check.ResourceID != "" {
+		name := check.ResourceID
+		if name == "" {
+			name = check.ResourceType
+		}
+		location.LogicalLocations = []sarifLogicalLocation{{
+			Name:               name,
+			FullyQualifiedName: strings.Trim(check.ResourceType+"/"+check.ResourceID, "/"),
+			Kind:               "resource",
+		}}
+	}
+	result.Locations = []sarifLocation{location}
+
+	properties := map[string]string{}
+	if check.Locale != "" {
+		properties["locale"] = check.Locale
+	}
+	if check.Field != "" {
+		properties["field"] = check.Field
+	}
+	if len(properties) > 0 {
+		result.Properties = properties
+	}
+	return result
+}
+
+func sarifLevel(severity Severity, strict bool) string {
+	switch severity {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		if strict {
+			return "error"
+		}
+		return "warning"
+	default:
+		return "note"
+	}
+}
@@ -0,0 +1,103 @@
+package validation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSARIF(t *testing.T) {
+	report := Report{
+		Checks: []CheckResult{
+			{ID: "metadata.length.keywords", Severity: SeverityError, Locale: "en-US", Field: "keywords", ResourceType: "appStoreVersionLocalization", ResourceID: "loc-1", Message: "keywords exceed 100 characters", Remediation: "Shorten keywords"},
+			{ID: "screenshots.display_type_unknown", Severity: SeverityWarning, Message: "unknown display type"},
+			{ID: "monetization.iap.primary_locale", Severity: SeverityInfo, ResourceType: "inAppPurchase", ResourceID: "iap-1", Message: "no primary locale"},
+		},
+		Suppressed: []SuppressedCheck{
+			{CheckResult: CheckResult{ID: "metadata.length.keywords", Severity: SeverityError, Locale: "ja", Message: "keywords exceed 100 characters"}, Justification: "Reviewed manually"},
+		},
+	}
+
+	log := SARIF(report, ".asc/validate.yaml")
+	data, err := json.Marshal(log)
+	if err != nil {
+		t.Fatalf("marshal SARIF: %v", err)
+	}
+
+	var decoded struct {
+		Schema  string `json:"$schema"`
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Rules []struct {
+						ID string `json:"id"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Level     string `json:"level"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+					} `json:"physicalLocation"`
+					LogicalLocations []struct {
+						FullyQualifiedName string `json:"fullyQualifiedName"`
+					} `json:"logicalLocations"`
+				} `json:"locations"`
+				Properties   map[string]string `json:"properties"`
+				Suppressions []struct {
+					Kind          string `json:"kind"`
+					Justification string `json:"justification"`
+				} `json:"suppressions"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal SARIF: %v", err)
+	}
+
+	if decoded.Version != "2.1.0" || decoded.Schema == "" || len(decoded.Runs) != 1 {
+		t.Fatalf("unexpected SARIF envelope: %s", data)
+	}
+	run := decoded.Runs[0]
+	if len(run.Tool.Driver.Rules) != 3 {
+		t.Fatalf("expected 3 unique rules, got %+v", run.Tool.Driver.Rules)
+	}
+	if len(run.Results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(run.Results))
+	}
+
+	first := run.Results[0]
+	if first.Level != "error" || first.Properties["locale"] != "en-US" || first.Properties["field"] != "keywords" {
+		t.Fatalf("unexpected first result: %+v", first)
+	}
+	if len(first.Locations) != 1 || first.Locations[0].PhysicalLocation.ArtifactLocation.URI != ".asc/validate.yaml" {
+		t.Fatalf("expected rules file as physical location, got %+v", first.Locations)
+	}
+	if first.Locations[0].LogicalLocations[0].FullyQualifiedName != "appStoreVersionLocalization/loc-1" {
+		t.Fatalf("unexpected logical location: %+v", first.Locations[0].LogicalLocations)
+	}
+	if run.Results[1].Level != "warning" || run.Results[2].Level != "note" {
+		t.Fatalf("unexpected levels: %s, %s", run.Results[1].Level, run.Results[2].Level)
+	}
+	suppressed := run.Results[3]
+	if len(suppressed.Suppressions) != 1 || suppressed.Suppressions[0].Kind != "external" || suppressed.Suppressions[0].Justification != "Reviewed manually" {
+		t.Fatalf("expected suppression on last result, got %+v", suppressed)
+	}
+
+	report.Strict = true
+	strict := SARIF(report, "")
+	if level := strict.Runs[0].Results[1].Level; level != "error" {
+		t.Fatalf("expected warnings to be errors in strict mode, got %q", level)
+	}
+	locations := strict.Runs[0].Results[1].Locations
+	if len(locations) != 1 || locations[0].PhysicalLocation == nil || locations[0].PhysicalLocation.ArtifactLocation.URI != DefaultRulesPath {
+		t.Fatalf("expected default rules path as physical location, got %+v", locations)
+	}
+	if len(locations[0].LogicalLocations) != 0 {
+		t.Fatalf("expected no logical location without a resource, got %+v", locations[0].LogicalLocations)
+	}
+}
@@ -31,13 +31,14 @@ type Summary struct {
 
 // Report is the top-level validation output.
 type Report struct {
-	AppID         string        `json:"appId"`
-	VersionID     string        `json:"versionId"`
-	VersionString string        `json:"versionString,omitempty"`
-	Platform      string        `json:"platform,omitempty"`
-	Summary       Summary       `json:"summary"`
-	Checks        []CheckResult `json:"checks"`
-	Strict        bool          `json:"strict,omitempty"`
+	AppID         string            `json:"appId"`
+	VersionID     string            `json:"versionId"`
+	VersionString string            `json:"versionString,omitempty"`
+	Platform      string            `json:"platform,omitempty"`
+	Summary       Summary           `json:"summary"`
+	Checks        []CheckResult     `json:"checks"`
+	Suppressed    []SuppressedCheck `json:"suppressed,omitempty"`
+	Strict        bool              `json:"strict,omitempty"`
 }
 
 // Input collects the validation inputs.
@@ -51,6 +52,11 @@ type Input struct {
 	AppInfoLocalizations []AppInfoLocalization
 	ScreenshotSets       []ScreenshotSet
 	AgeRatingDeclaration *AgeRatingDeclaration
+	InAppPurchases       []InAppPurchase
+	Subscriptions        []Subscription
+	// MonetizationError is set when in-app purchases and subscriptions could
+	// not be fetched. Their checks are then replaced by a single warning.
+	MonetizationError string
 }
 
 // VersionLocalization represents version-level metadata.
@@ -122,3 +128,33 @@ type AgeRatingDeclaration struct {
 	KoreaAgeRatingOverride    *string
 	DeveloperAgeRatingInfoURL *string
 }
+
+// InAppPurchase represents an in-app purchase and its review assets.
+type InAppPurchase struct {
+	ID                  string
+	Name                string
+	ProductID           string
+	Type                string
+	State               string
+	Locales             []string
+	HasReviewScreenshot bool
+	// Attached reports whether the product is an item of an open review
+	// submission for the version's platform.
+	Attached bool
+}
+
+// Subscription represents an auto-renewable subscription, its review assets,
+// and the territories it is available and priced in.
+type Subscription struct {
+	ID                   string
+	Name                 string
+	ProductID            string
+	GroupName            string
+	State                string
+	Locales              []string
+	HasReviewScreenshot  bool
+	Attached             bool
+	HasAvailability      bool
+	AvailableTerritories []string
+	PricedTerritories    []string
+}
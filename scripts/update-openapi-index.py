@@ -9,6 +9,7 @@ def main() -> None:
     repo_root = Path(__file__).resolve().parents[1]
     spec_path = repo_root / "docs" / "openapi" / "latest.json"
     out_path = repo_root / "docs" / "openapi" / "paths.txt"
+    embedded_path = repo_root / "internal" / "openapi" / "paths.txt"
 
     if not spec_path.exists():
         raise SystemExit(f"Missing spec file: {spec_path}")
@@ -25,8 +26,10 @@ def main() -> None:
                 lines.append(f"{method.upper()} {path}")
 
     lines.sort()
-    out_path.write_text("\n".join(lines) + "\n")
-    print(f"Wrote {out_path} ({len(lines)} entries)")
+    content = "\n".join(lines) + "\n"
+    out_path.write_text(content)
+    embedded_path.write_text(content)
+    print(f"Wrote {out_path} and {embedded_path} ({len(lines)} entries)")
 
 
 if __name__ == "__main__":